@@ -10,8 +10,14 @@ import (
 	"github.com/openshift/github.com/docker/distribution/manifest"
 )
 
-// MediaTypeManifestList specifies the mediaType for manifest lists.
-const MediaTypeManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+const (
+	// MediaTypeManifestList specifies the mediaType for manifest lists.
+	MediaTypeManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// MediaTypeOCIIndex specifies the mediaType for OCI image indexes. An
+	// index has the same structure as a manifest list.
+	MediaTypeOCIIndex = "application/vnd.oci.image.index.v1+json"
+)
 
 // SchemaVersion provides a pre-initialized version structure for this
 // packages version of the manifest.
@@ -35,6 +41,25 @@ func init() {
 	if err != nil {
 		panic(fmt.Sprintf("Unable to register manifest: %s", err))
 	}
+
+	ociIndexFunc := func(b []byte) (distribution.Manifest, distribution.Descriptor, error) {
+		m := new(DeserializedManifestList)
+		err := m.UnmarshalJSON(b)
+		if err != nil {
+			return nil, distribution.Descriptor{}, err
+		}
+		// mediaType is optional in an OCI index
+		if len(m.MediaType) == 0 {
+			m.MediaType = MediaTypeOCIIndex
+		}
+
+		dgst := digest.FromBytes(b)
+		return m, distribution.Descriptor{Digest: dgst, Size: int64(len(b)), MediaType: MediaTypeOCIIndex}, err
+	}
+	err = distribution.RegisterManifestSchema(MediaTypeOCIIndex, ociIndexFunc)
+	if err != nil {
+		panic(fmt.Sprintf("Unable to register manifest: %s", err))
+	}
 }
 
 // PlatformSpec specifies a platform where a particular image manifest is
@@ -10,6 +10,7 @@ import (
 	ctxu "github.com/openshift/github.com/docker/distribution/context"
 	"github.com/openshift/github.com/docker/distribution/digest"
 	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/openshift/github.com/docker/distribution/reference"
@@ -99,6 +100,8 @@ func (imh *imageManifestHandler) GetImageManifest(w http.ResponseWriter, r *http
 
 	supportsSchema2 := false
 	supportsManifestList := false
+	supportsOCIManifest := false
+	supportsOCIIndex := false
 	// this parsing of Accept headers is not quite as full-featured as godoc.org's parser, but we don't care about "q=" values
 	// https://github.com/golang/gddo/blob/e91d4165076d7474d20abda83f92d15c7ebc3e81/httputil/header/header.go#L165-L202
 	for _, acceptHeader := range r.Header["Accept"] {
@@ -122,11 +125,31 @@ func (imh *imageManifestHandler) GetImageManifest(w http.ResponseWriter, r *http
 			if mediaType == manifestlist.MediaTypeManifestList {
 				supportsManifestList = true
 			}
+			if mediaType == ocischema.MediaTypeManifest {
+				supportsOCIManifest = true
+			}
+			if mediaType == manifestlist.MediaTypeOCIIndex {
+				supportsOCIIndex = true
+			}
+		}
+	}
+
+	// OCI manifests and indexes can't be rewritten into a format older
+	// clients understand, so refuse them to clients that don't ask for them.
+	if imh.Tag != "" {
+		if _, isOCIManifest := manifest.(*ocischema.DeserializedManifest); isOCIManifest && !supportsOCIManifest {
+			imh.Errors = append(imh.Errors, v2.ErrorCodeManifestUnknown.WithMessage("OCI manifest found, but accept header does not support OCI manifests"))
+			return
+		}
+		if list, isList := manifest.(*manifestlist.DeserializedManifestList); isList && list.MediaType == manifestlist.MediaTypeOCIIndex && !supportsOCIIndex {
+			imh.Errors = append(imh.Errors, v2.ErrorCodeManifestUnknown.WithMessage("OCI index found, but accept header does not support OCI indexes"))
+			return
 		}
 	}
 
 	schema2Manifest, isSchema2 := manifest.(*schema2.DeserializedManifest)
 	manifestList, isManifestList := manifest.(*manifestlist.DeserializedManifestList)
+	isManifestList = isManifestList && manifestList.MediaType == manifestlist.MediaTypeManifestList
 
 	// Only rewrite schema2 manifests when they are being fetched by tag.
 	// If they are being fetched by digest, we can't return something not
@@ -9,6 +9,7 @@ import (
 	"github.com/openshift/github.com/docker/distribution/digest"
 	"github.com/openshift/github.com/docker/distribution/manifest"
 	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 )
@@ -48,6 +49,7 @@ type manifestStore struct {
 	schema1Handler      ManifestHandler
 	schema2Handler      ManifestHandler
 	manifestListHandler ManifestHandler
+	ocischemaHandler    ManifestHandler
 }
 
 var _ distribution.ManifestService = &manifestStore{}
@@ -98,8 +100,28 @@ func (ms *manifestStore) Get(ctx context.Context, dgst digest.Digest, options ..
 		switch versioned.MediaType {
 		case schema2.MediaTypeManifest:
 			return ms.schema2Handler.Unmarshal(ctx, dgst, content)
-		case manifestlist.MediaTypeManifestList:
+		case manifestlist.MediaTypeManifestList, manifestlist.MediaTypeOCIIndex:
 			return ms.manifestListHandler.Unmarshal(ctx, dgst, content)
+		case ocischema.MediaTypeManifest:
+			return ms.ocischemaHandler.Unmarshal(ctx, dgst, content)
+		case "":
+			// OCI image manifests and indexes may omit the media type.
+			// An index is recognized by its list of manifests.
+			var index struct {
+				Manifests json.RawMessage `json:"manifests"`
+			}
+			if err = json.Unmarshal(content, &index); err != nil {
+				return nil, err
+			}
+			if index.Manifests == nil {
+				return ms.ocischemaHandler.Unmarshal(ctx, dgst, content)
+			}
+			m, err := ms.manifestListHandler.Unmarshal(ctx, dgst, content)
+			if err != nil {
+				return nil, err
+			}
+			m.(*manifestlist.DeserializedManifestList).MediaType = manifestlist.MediaTypeOCIIndex
+			return m, nil
 		default:
 			return nil, distribution.ErrManifestVerification{fmt.Errorf("unrecognized manifest content type %s", versioned.MediaType)}
 		}
@@ -118,6 +140,8 @@ func (ms *manifestStore) Put(ctx context.Context, manifest distribution.Manifest
 		return ms.schema2Handler.Put(ctx, manifest, ms.skipDependencyVerification)
 	case *manifestlist.DeserializedManifestList:
 		return ms.manifestListHandler.Put(ctx, manifest, ms.skipDependencyVerification)
+	case *ocischema.DeserializedManifest:
+		return ms.ocischemaHandler.Put(ctx, manifest, ms.skipDependencyVerification)
 	}
 
 	return "", fmt.Errorf("unrecognized manifest type %T", manifest)
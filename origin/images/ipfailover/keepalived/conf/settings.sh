@@ -68,6 +68,20 @@ HA_NOTIFY_SCRIPT="${OPENSHIFT_HA_NOTIFY_SCRIPT:-""}"
 # Default is 2
 HA_CHECK_INTERVAL="${OPENSHIFT_HA_CHECK_INTERVAL:-"2"}"
 
+# When "true", VRRP adverts are sent with unicast to the peers listed in
+# OPENSHIFT_HA_UNICAST_PEERS (see UNICAST_PEERS below) instead of multicast.
+HA_USE_UNICAST="${OPENSHIFT_HA_USE_UNICAST:-"false"}"
+
+# VIP groups, one per line, each of the form:
+#    VRID|PRIORITY|PREEMPTION|PREFERRED-NODES|CHECK-SCRIPT|VIPS
+# Every group becomes a single vrrp instance holding all of its VIPs.
+# Example:
+#    OPENSHIFT_HA_VIP_GROUPS="10|200|preempt_delay 300|node-a|/etc/keepalived/checks/web.sh|10.1.1.10-11"
+HA_VIP_GROUPS="${OPENSHIFT_HA_VIP_GROUPS:-""}"
+
+# Name of the node this pod runs on, used to find the VIP groups it prefers.
+HA_NODE_NAME="${OPENSHIFT_HA_NODE_NAME:-""}"
+
 
 #  ========================================================================
 #  Default settings - not currently exposed or overridden on OpenShift.
@@ -50,16 +50,19 @@ function generate_global_config() {
 #  Example:
 #      generate_script_config
 #      generate_script_config "10.1.2.3" 8080
+#      generate_script_config "10.1.2.3" 8080 chk_web "/etc/keepalived/checks/web.sh"
 #
 function generate_script_config() {
   local serviceip ; serviceip=${1:-"127.0.0.1"}
   local port=${2:-80}
+  local name=${3:-"${CHECK_SCRIPT_NAME}"}
+  local script=${4:-"${HA_CHECK_SCRIPT}"}
 
   echo ""
-  echo "vrrp_script ${CHECK_SCRIPT_NAME} {"
+  echo "vrrp_script ${name} {"
 
-  if [[ -n "${HA_CHECK_SCRIPT}" ]]; then
-    echo "   script \"${HA_CHECK_SCRIPT}\""
+  if [[ -n "${script}" ]]; then
+    echo "   script \"${script}\""
   else
     if [[ "${port}" == "0" ]]; then
       echo "   script \"true\""
@@ -94,11 +97,14 @@ function generate_authentication_info() {
 #
 #  Example:
 #      generate_track_script
+#      generate_track_script chk_web
 #
 function generate_track_script() {
+  local name=${1:-"${CHECK_SCRIPT_NAME}"}
+
   echo ""
   echo "   track_script {"
-  echo "      ${CHECK_SCRIPT_NAME}"
+  echo "      ${name}"
   echo "   }"
 }
 
@@ -217,6 +223,115 @@ vrrp_instance ${instance_name} {
 }
 
 
+#
+#  Returns 0 if the node this pod runs on is in the space separated list of
+#  preferred nodes, or if the list is empty.
+#
+#  Examples:
+#      is_preferred_node "node-a node-b"
+#
+function is_preferred_node() {
+  local nodes=${1:-""}
+
+  [[ -z "${nodes}" ]] && return 0
+
+  for node in ${nodes}; do
+    [[ "${node}" == "${HA_NODE_NAME}" ]] && return 0
+  done
+
+  return 1
+}
+
+
+#
+#  Generate the vrrp instance for a VIP group. All the VIPs of the group are
+#  held by a single instance, so they fail over together. The preferred nodes
+#  of the group use its priority, all other nodes use a lower one. The
+#  priorities are spread over the 64 values below the group priority by the
+#  ipslot of the node and are clamped to the 1..254 range keepalived accepts.
+#
+#  Examples:
+#      generate_vip_group_config "10.1.2.3" 80 enp0s8 42 \
+#          "10|200|preempt_delay 300|node-a||10.1.1.10-11"
+#
+function generate_vip_group_config() {
+  local ipaddr=$1
+  local port=$2
+  local interface=$3
+  local ipslot=$4
+  local vrid priority preempt nodes check vips
+  IFS='|' read -r vrid priority preempt nodes check vips <<< "$5"
+
+  local vrrpidoffset=${HA_VRRP_ID_OFFSET:-0}
+  local instance_name ; instance_name="$(vrrp_instance_basename "${HA_CONFIG_NAME}")_GROUP_${vrid}"
+  local checkname="${CHECK_SCRIPT_NAME}"
+  local initialstate="state BACKUP"
+
+  if [[ -n "${check}" ]]; then
+    checkname="${CHECK_SCRIPT_NAME}_${vrid}"
+    generate_script_config "${ipaddr}" "${port}" "${checkname}" "${check}"
+  fi
+
+  local offset=$((ipslot % 64))
+  if is_preferred_node "${nodes}"; then
+    #  Spread the priority when every node is preferred so one node wins.
+    [[ -z "${nodes}" ]] && priority=$((priority - offset))
+    #  keepalived only honors nopreempt on instances that start as BACKUP.
+    [[ "${preempt}" != "nopreempt" ]] && initialstate="state MASTER"
+  else
+    #  Stay below the group priority so that a preferred node always wins.
+    priority=$((priority - 1 - offset))
+  fi
+  [[ ${priority} -lt 1 ]] && priority=1
+  [[ ${priority} -gt 254 ]] && priority=254
+
+  local auth_section ; auth_section=$(generate_authentication_info "${HA_CONFIG_NAME}")
+  local vip_section ; vip_section=$(generate_vip_section "${vips}" "${interface}")
+  echo "
+vrrp_instance ${instance_name} {
+   interface ${interface}
+   ${initialstate}
+   virtual_router_id $((vrrpidoffset + vrid))
+   priority ${priority}
+   ${preempt}
+   ${auth_section}
+   $(generate_track_script "${checkname}")
+   "
+  if [[ -n $HA_NOTIFY_SCRIPT ]]; then
+      echo "   notify \"${HA_NOTIFY_SCRIPT}\""
+  fi
+  echo " $(generate_mucast_options)
+   ${vip_section}
+}
+"
+}
+
+
+#
+#  Set up the unicast source address and peers when unicast VRRP is in use.
+#  The address of this node is dropped from the peer list.
+#
+#  Examples:
+#      setup_unicast_peers "10.1.2.3"
+#
+function setup_unicast_peers() {
+  local ipaddr=$1
+
+  [[ "${HA_USE_UNICAST}" != "true" ]] && return 0
+
+  UNICAST_SOURCE_IPADDRESS="${ipaddr}"
+
+  local peers=()
+  OLD_IFS=$IFS
+  IFS=","
+  for peer in ${UNICAST_PEERS}; do
+    [[ "${peer}" != "${ipaddr}" ]] && peers+=("${peer}")
+  done
+  UNICAST_PEERS="${peers[*]}"
+  IFS=$OLD_IFS
+}
+
+
 #
 #  Generate failover configuration.
 #
@@ -229,6 +344,8 @@ function generate_failover_config() {
   local ipaddr ; ipaddr=$(get_device_ip_address "${interface}")
   local port="${HA_MONITOR_PORT//[^0-9]/}"
 
+  setup_unicast_peers "${ipaddr}"
+
   echo "! Configuration File for keepalived
 
 $(generate_global_config "${HA_CONFIG_NAME}")
@@ -274,5 +391,12 @@ $(generate_script_config "${ipaddr}" "${port}")
 
     counter=$((counter + 1))
   done
-}
 
+  if [[ -n "${HA_VIP_GROUPS}" ]]; then
+    while read -r group; do
+      [[ -z "${group}" ]] && continue
+      generate_vip_group_config "${ipaddr}" "${port}" "${interface}" \
+          "${ipslot}" "${group}"
+    done <<< "${HA_VIP_GROUPS}"
+  fi
+}
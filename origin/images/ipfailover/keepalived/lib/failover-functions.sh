@@ -18,6 +18,14 @@ function cleanup() {
 
   local interface=$(get_network_device "$NETWORK_INTERFACE")
   local vips=$(expand_ip_ranges "$HA_VIPS")
+
+  #  The VIPs of every group are held by the group's own vrrp instance.
+  local vrid priority preempt nodes check groupvips
+  while IFS='|' read -r vrid priority preempt nodes check groupvips; do
+    [[ -z "${groupvips}" ]] && continue
+    vips="${vips} $(expand_ip_ranges "${groupvips}")"
+  done <<< "${HA_VIP_GROUPS}"
+
   echo "  - Releasing VIPs ${vips} (interface ${interface}) ... "
 
   local regex='^.*?/[0-9]+$'
@@ -50,7 +58,14 @@ function setup_failover() {
   # (OPENSHIFT_HA_IPTABLES_CHAIN) make sure the rule to pass keepalived
   # multicast (224.0.0.18) traffic is in the table.
   chain="${HA_IPTABLES_CHAIN:-""}"
-  if [[ -n ${chain} ]]; then
+  if [[ -n ${chain} && "${HA_USE_UNICAST}" == "true" ]]; then
+    # Unicast adverts come straight from the peers, accept the VRRP protocol.
+    echo "  - check for iptables rule for keepalived unicast VRRP ..."
+    if ! iptables -C ${chain} -p vrrp -j ACCEPT > /dev/null 2>&1 ; then
+      echo "  - adding iptables rule to $chain to accept VRRP."
+      iptables -I ${chain} 1 -p vrrp -j ACCEPT
+    fi
+  elif [[ -n ${chain} ]]; then
     echo "  - check for iptables rule for keepalived multicast (224.0.0.18) ..."
     if ! iptables -S | grep 224.0.0.18 > /dev/null 2>&1 ; then
       # Add the rule to the beginning of the chain.
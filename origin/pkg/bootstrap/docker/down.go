@@ -22,6 +22,9 @@ var (
 	cmdDownLong = templates.LongDesc(`
 		Stops the container running OpenShift on Docker and associated containers.
 
+		The additional nodes of a profile are stopped as well. The configuration and data
+		of the profile are kept, so the cluster can be started again with the same profile.
+
 		If you started your OpenShift with a specific docker-machine, you need to specify the
 		same machine using the --docker-machine argument.`)
 
@@ -70,6 +73,10 @@ func (c *ClientStopConfig) Stop(out io.Writer) error {
 	if err = helper.StopAndRemoveContainer("origin"); err != nil {
 		glog.V(1).Infof("Error stopping origin container: %v", err)
 	}
+	glog.V(4).Infof("Stopping and removing additional node containers")
+	if err = openshift.NewHelper(client, nil, "", openshift.OpenShiftContainer, "", "").RemoveAdditionalNodes(); err != nil {
+		glog.V(1).Infof("Error stopping additional node containers: %v", err)
+	}
 	names, err := helper.ListContainerNames()
 	if err != nil {
 		return err
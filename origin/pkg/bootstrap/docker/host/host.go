@@ -26,12 +26,26 @@ done
 		"nsenter --mount=/rootfs/proc/1/ns/mnt mount -o bind %[1]s %[1]s"
 	cmdCreateVolumesDirShare = "cat /rootfs/proc/1/mountinfo | grep %[1]s | grep shared || " +
 		"nsenter --mount=/rootfs/proc/1/ns/mnt mount --make-shared %[1]s"
+	cmdCreateDirBindMount = "cat /rootfs/proc/1/mountinfo | grep %[1]s || " +
+		"nsenter --mount=/rootfs/proc/1/ns/mnt mount -o bind %[1]s %[1]s"
 
 	DefaultVolumesDir           = "/var/lib/origin/openshift.local.volumes"
 	DefaultConfigDir            = "/var/lib/origin/openshift.local.config"
 	DefaultPersistentVolumesDir = "/var/lib/origin/openshift.local.pv"
+	DefaultProfilesDir          = "/var/lib/origin/profiles"
 )
 
+// ProfileDir returns the directory on the Docker host that holds the configuration,
+// data and volumes of the named cluster profile
+func ProfileDir(profile string) string {
+	return path.Join(DefaultProfilesDir, profile)
+}
+
+// isProfileDir returns true if dir is kept in the directory of a cluster profile
+func isProfileDir(dir string) bool {
+	return strings.HasPrefix(dir, DefaultProfilesDir+"/")
+}
+
 // HostHelper contains methods to help check settings on a Docker host machine
 // using a privileged container
 type HostHelper struct {
@@ -147,18 +161,21 @@ func (h *HostHelper) Hostname() (string, error) {
 
 func (h *HostHelper) EnsureHostDirectories(createVolumeShare bool) error {
 	// Attempt to create host directories only if they are
-	// the default directories. If the user specifies them, then the
-	// user is responsible for ensuring they exist, are mountable, etc.
+	// the default directories or those of a profile. If the user specifies
+	// them, then the user is responsible for ensuring they exist, are mountable, etc.
 	dirs := []string{}
-	if h.configDir == DefaultConfigDir {
+	if h.configDir == DefaultConfigDir || isProfileDir(h.configDir) {
 		dirs = append(dirs, path.Join("/rootfs", h.configDir))
 	}
-	if h.volumesDir == DefaultVolumesDir {
+	if h.volumesDir == DefaultVolumesDir || isProfileDir(h.volumesDir) {
 		dirs = append(dirs, path.Join("/rootfs", h.volumesDir))
 	}
-	if h.persistentVolumesDir == DefaultPersistentVolumesDir {
+	if h.persistentVolumesDir == DefaultPersistentVolumesDir || isProfileDir(h.persistentVolumesDir) {
 		dirs = append(dirs, path.Join("/rootfs", h.persistentVolumesDir))
 	}
+	if isProfileDir(h.dataDir) {
+		dirs = append(dirs, path.Join("/rootfs", h.dataDir))
+	}
 	if len(dirs) > 0 {
 		cmd := fmt.Sprintf(cmdEnsureHostDirs, strings.Join(dirs, " "))
 		rc, err := h.runner().
@@ -178,6 +195,20 @@ func (h *HostHelper) EnsureHostDirectories(createVolumeShare bool) error {
 	return nil
 }
 
+// EnsureSharedDir ensures that a directory on the Docker host is a shared mount, so
+// that the mounts made below it by a container are visible to other containers
+func (h *HostHelper) EnsureSharedDir(dir string) error {
+	rc, err := h.hostPidCmd(fmt.Sprintf(cmdCreateDirBindMount, dir))
+	if err != nil || rc != 0 {
+		return errors.NewError("cannot create mount for %s", dir).WithCause(err)
+	}
+	rc, err = h.hostPidCmd(fmt.Sprintf(cmdCreateVolumesDirShare, dir))
+	if err != nil || rc != 0 {
+		return errors.NewError("cannot create share for %s", dir).WithCause(err)
+	}
+	return nil
+}
+
 func (h *HostHelper) hostPidCmd(cmd string) (int, error) {
 	return h.runner().
 		Image(h.image).
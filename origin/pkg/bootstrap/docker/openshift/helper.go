@@ -38,6 +38,7 @@ const (
 	AlternateDNSPort       = 8053
 	cmdDetermineNodeHost   = "for name in %s; do ls /var/lib/origin/openshift.local.config/node-$name &> /dev/null && echo $name && break; done"
 	OpenShiftContainer     = "origin"
+	ProfileEnvVar          = "OPENSHIFT_CLUSTER_PROFILE"
 )
 
 var (
@@ -94,6 +95,15 @@ type StartOptions struct {
 	NoProxy                  []string
 	KubeconfigContents       string
 	DockerRoot               string
+
+	// Profile is the name of the cluster profile being started
+	Profile string
+	// NetworkPlugin is the SDN plugin used by the cluster. When set, the
+	// OpenShift container runs from NodeImage next to an Open vSwitch
+	// container running from OpenvSwitchImage.
+	NetworkPlugin    string
+	NodeImage        string
+	OpenvSwitchImage string
 }
 
 // NewHelper creates a new OpenShift helper
@@ -257,6 +267,9 @@ func (h *Helper) Start(opt *StartOptions, out io.Writer) (string, error) {
 		}
 		binds = append(binds, fmt.Sprintf("%[1]s:%[1]s%[2]s", opt.HostVolumesDir, propagationMode))
 	}
+	if len(opt.Profile) > 0 {
+		env = append(env, fmt.Sprintf("%s=%s", ProfileEnvVar, opt.Profile))
+	}
 	env = append(env, opt.Environment...)
 	binds = append(binds, fmt.Sprintf("%[1]s:%[1]s", opt.DockerRoot))
 	binds = append(binds, fmt.Sprintf("%s:/var/lib/origin/openshift.local.config:z", opt.HostConfigDir))
@@ -294,6 +307,9 @@ func (h *Helper) Start(opt *StartOptions, out io.Writer) (string, error) {
 			fmt.Sprintf("--dns=0.0.0.0:%d", opt.DNSPort),
 			"--write-config=/var/lib/origin/openshift.local.config",
 		}
+		if len(opt.NetworkPlugin) > 0 {
+			createConfigCmd = append(createConfigCmd, fmt.Sprintf("--network-plugin=%s", opt.NetworkPlugin))
+		}
 		if opt.PortForwarding {
 			internalIP, err := h.ServerIP()
 			if err != nil {
@@ -383,7 +399,16 @@ func (h *Helper) Start(opt *StartOptions, out io.Writer) (string, error) {
 		binds = append(binds, fmt.Sprintf("%[1]s:%[1]s", opt.HostPersistentVolumesDir))
 		env = append(env, fmt.Sprintf("OPENSHIFT_PV_DIR=%s", opt.HostPersistentVolumesDir))
 	}
-	_, err = h.runHelper.New().Image(h.image).
+	runner := h.runHelper.New().Image(h.image)
+	if len(opt.NetworkPlugin) > 0 {
+		// the SDN plugin needs the Open vSwitch tools of the node image
+		if err = h.StartOpenvSwitch(OpenvSwitchContainer, opt.OpenvSwitchImage, "", "/var/run"); err != nil {
+			return "", err
+		}
+		binds = append(binds, "/lib/modules:/lib/modules:ro")
+		runner = h.runHelper.New().Image(opt.NodeImage).Entrypoint("/usr/bin/openshift")
+	}
+	_, err = runner.
 		Name(h.containerName).
 		Privileged().
 		HostNetwork().
@@ -509,11 +534,7 @@ func (h *Helper) StartNode(opt *StartOptions, out io.Writer) error {
 }
 
 func (h *Helper) OriginLog() string {
-	log := h.dockerHelper.ContainerLog(h.containerName, 10)
-	if len(log) > 0 {
-		return fmt.Sprintf("Last 10 lines of %q container log:\n%s\n", h.containerName, log)
-	}
-	return fmt.Sprintf("No log available from %q container\n", h.containerName)
+	return h.containerLog(h.containerName)
 }
 
 func (h *Helper) healthzReadyURL(ip string) string {
@@ -0,0 +1,244 @@
+package openshift
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	"github.com/openshift/origin/pkg/bootstrap/docker/errors"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+)
+
+const (
+	OpenvSwitchContainer = "origin-openvswitch"
+	NodeContainerPrefix  = "origin-node-"
+
+	// DefaultNodeDockerImage runs the Docker daemon of an additional node. Each
+	// node needs a daemon of its own, or its kubelet would remove the containers of
+	// the pods scheduled to the other nodes.
+	DefaultNodeDockerImage = "docker:1.12-dind"
+
+	nodeDockerContainerSuffix      = "-docker"
+	nodeOpenvSwitchContainerSuffix = "-openvswitch"
+
+	cmdCreateNodeConfig = "rm -rf %[1]s && openshift admin create-node-config " +
+		"--node-dir=%[1]s --node=%[2]s --hostnames=%[2]s,%[3]s " +
+		"--master=https://%[4]s:8443 --dns-ip=%[4]s --volume-dir=%[5]s --images=%[6]s --network-plugin=%[7]s " +
+		"--certificate-authority=%[8]s/ca.crt --node-client-certificate-authority=%[8]s/ca.crt " +
+		"--signer-cert=%[8]s/ca.crt --signer-key=%[8]s/ca.key --signer-serial=%[8]s/ca.serial.txt"
+)
+
+// NodeOptions represent the parameters used to start an additional node of a cluster
+type NodeOptions struct {
+	// Name is the name of the node, and of the container running it
+	Name string
+	// HostDir is the directory on the Docker host that holds the Docker storage,
+	// runtime files and volumes of the node
+	HostDir          string
+	HostConfigDir    string
+	MasterIP         string
+	Images           string
+	NetworkPlugin    string
+	NodeImage        string
+	OpenvSwitchImage string
+	DockerImage      string
+	Environment      []string
+	LogLevel         int
+}
+
+// NodeContainerNames returns the names of the additional nodes of a cluster
+// among the given container names
+func NodeContainerNames(names []string) []string {
+	result := []string{}
+	for _, name := range names {
+		name = strings.TrimLeft(name, "/")
+		if !strings.HasPrefix(name, NodeContainerPrefix) ||
+			strings.HasSuffix(name, nodeDockerContainerSuffix) ||
+			strings.HasSuffix(name, nodeOpenvSwitchContainerSuffix) {
+			continue
+		}
+		result = append(result, name)
+	}
+	return result
+}
+
+// StartOpenvSwitch starts the Open vSwitch daemons used by the SDN plugin of a node. If
+// network is empty, they run in the host network namespace, otherwise in that of the
+// network container. runDir is the directory on the host shared with the node as /var/run.
+func (h *Helper) StartOpenvSwitch(name, image, network, runDir string) error {
+	if err := h.removeContainer(name); err != nil {
+		return err
+	}
+	runner := h.runHelper.New().Image(image).
+		Name(name).
+		Privileged().
+		Bind(fmt.Sprintf("%s:/var/run:rw", runDir), "/lib/modules:/lib/modules:ro", "/sys:/sys:rw")
+	if len(network) > 0 {
+		runner = runner.ContainerNetwork(network)
+	} else {
+		runner = runner.HostNetwork().HostPid()
+	}
+	if _, err := runner.Start(); err != nil {
+		return errors.NewError("cannot start Open vSwitch container %s", name).WithCause(err)
+	}
+	return nil
+}
+
+// StartAdditionalNode starts a node that joins the cluster of the OpenShift container. The
+// node runs in its own network namespace, with a Docker daemon of its own, and is reachable
+// from the master and the other nodes through the bridge network of the Docker host.
+func (h *Helper) StartAdditionalNode(opt *NodeOptions, out io.Writer) error {
+	dockerContainer := opt.Name + nodeDockerContainerSuffix
+	for _, name := range []string{opt.Name, opt.Name + nodeOpenvSwitchContainerSuffix, dockerContainer} {
+		if err := h.removeContainer(name); err != nil {
+			return err
+		}
+	}
+
+	runDir := path.Join(opt.HostDir, "run")
+	dockerDir := path.Join(opt.HostDir, "docker")
+	volumesDir := path.Join(opt.HostDir, "volumes")
+
+	fmt.Fprintf(out, "Starting Docker daemon for node %s\n", opt.Name)
+	_, err := h.runHelper.New().Image(opt.DockerImage).
+		Name(dockerContainer).
+		Privileged().
+		Bind(
+			fmt.Sprintf("%s:/var/lib/docker:rw", dockerDir),
+			fmt.Sprintf("%s:/var/run:rw", runDir),
+			fmt.Sprintf("%[1]s:%[1]s:rslave", volumesDir),
+		).
+		Command("--insecure-registry=172.30.0.0/16").
+		Start()
+	if err != nil {
+		return errors.NewError("cannot start Docker daemon for node %s", opt.Name).WithCause(err)
+	}
+	ip, err := h.containerIP(dockerContainer)
+	if err != nil {
+		return err
+	}
+
+	// The node serving certificate is valid for the current IP of the node only,
+	// regenerate the node configuration on every start
+	fmt.Fprintf(out, "Creating configuration for node %s (%s)\n", opt.Name, ip)
+	configDir := "/var/lib/origin/openshift.local.config"
+	cmd := fmt.Sprintf(cmdCreateNodeConfig,
+		path.Join(configDir, "node-"+opt.Name), opt.Name, ip, opt.MasterIP, volumesDir, opt.Images, opt.NetworkPlugin,
+		path.Join(configDir, "master"))
+	rc, err := h.runHelper.New().Image(h.image).
+		DiscardContainer().
+		Bind(fmt.Sprintf("%s:%s:z", opt.HostConfigDir, configDir)).
+		Entrypoint("/bin/bash").
+		Command("-c", cmd).Run()
+	if err != nil || rc != 0 {
+		return errors.NewError("could not create configuration for node %s", opt.Name).WithCause(err)
+	}
+
+	if len(opt.NetworkPlugin) > 0 {
+		if err = h.StartOpenvSwitch(opt.Name+nodeOpenvSwitchContainerSuffix, opt.OpenvSwitchImage, dockerContainer, runDir); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "Starting OpenShift node %s\n", opt.Name)
+	startCmd := []string{
+		"start", "node",
+		fmt.Sprintf("--config=%s", path.Join(configDir, "node-"+opt.Name, "node-config.yaml")),
+	}
+	if opt.LogLevel > 0 {
+		startCmd = append(startCmd, fmt.Sprintf("--loglevel=%d", opt.LogLevel))
+	}
+	env := append([]string{"OPENSHIFT_CONTAINERIZED=false"}, opt.Environment...)
+	_, err = h.runHelper.New().Image(opt.NodeImage).
+		Name(opt.Name).
+		Entrypoint("/usr/bin/openshift").
+		Privileged().
+		ContainerNetwork(dockerContainer).
+		Bind(
+			fmt.Sprintf("%s:%s:z", opt.HostConfigDir, configDir),
+			fmt.Sprintf("%s:/var/run:rw", runDir),
+			fmt.Sprintf("%s:/var/lib/docker:ro", dockerDir),
+			fmt.Sprintf("%[1]s:%[1]s:shared", volumesDir),
+			"/sys:/sys:rw",
+			"/sys/fs/cgroup:/sys/fs/cgroup:rw",
+			"/dev:/dev",
+			"/lib/modules:/lib/modules:ro",
+		).
+		Env(env...).
+		Command(startCmd...).
+		Start()
+	if err != nil {
+		return errors.NewError("cannot start OpenShift node %s", opt.Name).WithCause(err)
+	}
+
+	// Wait a minimum amount of time and check whether we're still running. If not, we know the node didn't start
+	time.Sleep(initialStatusCheckWait)
+	_, running, err := h.dockerHelper.GetContainerState(opt.Name)
+	if err != nil {
+		return errors.NewError("cannot get state of OpenShift container %s", opt.Name).WithCause(err)
+	}
+	if !running {
+		return ErrOpenShiftFailedToStart(opt.Name).WithDetails(h.containerLog(opt.Name))
+	}
+	if err = cmdutil.WaitForSuccessfulDial(true, "tcp", fmt.Sprintf("%s:10250", ip), 200*time.Millisecond, 1*time.Second, serverUpTimeout); err != nil {
+		return ErrTimedOutWaitingForStart(opt.Name).WithDetails(h.containerLog(opt.Name))
+	}
+	return nil
+}
+
+// RemoveAdditionalNodes stops and removes the containers of the additional nodes
+// of a cluster and the Open vSwitch container of its master
+func (h *Helper) RemoveAdditionalNodes() error {
+	names, err := h.dockerHelper.ListContainerNames()
+	if err != nil {
+		return err
+	}
+	containers := []string{OpenvSwitchContainer}
+	for _, name := range names {
+		if name = strings.TrimLeft(name, "/"); strings.HasPrefix(name, NodeContainerPrefix) {
+			containers = append(containers, name)
+		}
+	}
+	for _, name := range containers {
+		if err := h.removeContainer(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeContainer stops and removes a container if it exists
+func (h *Helper) removeContainer(name string) error {
+	container, _, err := h.dockerHelper.GetContainerState(name)
+	if err != nil {
+		return errors.NewError("cannot get state of container %s", name).WithCause(err)
+	}
+	if container == nil {
+		return nil
+	}
+	glog.V(4).Infof("Stopping and removing container %s", name)
+	return h.dockerHelper.StopAndRemoveContainer(name)
+}
+
+func (h *Helper) containerIP(name string) (string, error) {
+	container, running, err := h.dockerHelper.GetContainerState(name)
+	if err != nil {
+		return "", errors.NewError("cannot get state of container %s", name).WithCause(err)
+	}
+	if !running || container.NetworkSettings == nil || len(container.NetworkSettings.IPAddress) == 0 {
+		return "", errors.NewError("container %s is not running", name).WithDetails(h.containerLog(name))
+	}
+	return container.NetworkSettings.IPAddress, nil
+}
+
+func (h *Helper) containerLog(name string) string {
+	log := h.dockerHelper.ContainerLog(name, 10)
+	if len(log) > 0 {
+		return fmt.Sprintf("Last 10 lines of %q container log:\n%s\n", name, log)
+	}
+	return fmt.Sprintf("No log available from %q container\n", name)
+}
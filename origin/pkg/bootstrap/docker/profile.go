@@ -0,0 +1,167 @@
+package docker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/github.com/ghodss/yaml"
+	"github.com/openshift/github.com/spf13/pflag"
+
+	"github.com/openshift/kubernetes/pkg/util/homedir"
+	kvalidation "github.com/openshift/kubernetes/pkg/util/validation"
+
+	"github.com/openshift/origin/pkg/bootstrap/docker/host"
+	cliconfig "github.com/openshift/origin/pkg/cmd/cli/config"
+)
+
+// profilesDir is the local directory where the options of the cluster profiles are saved
+var profilesDir = filepath.Join(homedir.HomeDir(), cliconfig.OpenShiftConfigHomeDir, "cluster-up")
+
+// supportedNetworkPlugins are the SDN plugins a cluster can be started with
+var supportedNetworkPlugins = []string{
+	"redhat/openshift-ovs-subnet",
+	"redhat/openshift-ovs-multitenant",
+	"redhat/openshift-ovs-networkpolicy",
+}
+
+// Profile is a named local cluster. The configuration, data and volumes of a profile
+// are kept in their own directory on the Docker host, and the options it was started
+// with are saved locally, so that starting the profile again brings back the same cluster.
+type Profile struct {
+	Name           string `json:"name"`
+	DockerMachine  string `json:"dockerMachine,omitempty"`
+	Image          string `json:"image,omitempty"`
+	ImageVersion   string `json:"imageVersion,omitempty"`
+	PublicHostname string `json:"publicHostname,omitempty"`
+	RoutingSuffix  string `json:"routingSuffix,omitempty"`
+	Nodes          int    `json:"nodes,omitempty"`
+	NetworkPlugin  string `json:"networkPlugin,omitempty"`
+
+	// saved is true if the profile was loaded from a saved file
+	saved bool
+}
+
+func validateProfileName(name string) error {
+	if errs := kvalidation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid profile name %q: %s", name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func profilePath(name string) string {
+	return filepath.Join(profilesDir, name+".yaml")
+}
+
+// loadProfile returns the saved profile with the given name, or a new
+// profile if none was saved
+func loadProfile(name string) (*Profile, error) {
+	data, err := ioutil.ReadFile(profilePath(name))
+	if os.IsNotExist(err) {
+		return &Profile{Name: name}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	profile := &Profile{}
+	if err := yaml.Unmarshal(data, profile); err != nil {
+		return nil, fmt.Errorf("cannot read profile %q: %v", name, err)
+	}
+	profile.Name = name
+	profile.saved = true
+	return profile, nil
+}
+
+func (p *Profile) save() error {
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(profilesDir, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(profilePath(p.Name), data, 0644)
+}
+
+// applyProfile loads the profile of the config. The options saved with it are used
+// unless they are set on the command line, the host directories are those of the profile,
+// and the existing configuration and data are reused.
+func (c *CommonStartConfig) applyProfile(flags *pflag.FlagSet) error {
+	if err := validateProfileName(c.Profile); err != nil {
+		return err
+	}
+	profile, err := loadProfile(c.Profile)
+	if err != nil {
+		return err
+	}
+
+	for flag, option := range map[string]struct{ value, saved *string }{
+		"docker-machine":  {&c.DockerMachine, &profile.DockerMachine},
+		"image":           {&c.Image, &profile.Image},
+		"version":         {&c.ImageVersion, &profile.ImageVersion},
+		"public-hostname": {&c.PublicHostname, &profile.PublicHostname},
+		"routing-suffix":  {&c.RoutingSuffix, &profile.RoutingSuffix},
+		"network-plugin":  {&c.NetworkPlugin, &profile.NetworkPlugin},
+	} {
+		if !flags.Changed(flag) && len(*option.saved) > 0 {
+			*option.value = *option.saved
+		}
+	}
+	if !flags.Changed("nodes") {
+		c.Nodes = profile.Nodes
+	}
+
+	dir := host.ProfileDir(c.Profile)
+	for flag, option := range map[string]struct {
+		value *string
+		dir   string
+	}{
+		"host-config-dir":  {&c.HostConfigDir, "openshift.local.config"},
+		"host-volumes-dir": {&c.HostVolumesDir, "openshift.local.volumes"},
+		"host-data-dir":    {&c.HostDataDir, "openshift.local.etcd"},
+		"host-pv-dir":      {&c.HostPersistentVolumesDir, "openshift.local.pv"},
+	} {
+		if !flags.Changed(flag) {
+			*option.value = path.Join(dir, option.dir)
+		}
+	}
+	if !flags.Changed("use-existing-config") {
+		c.UseExistingConfig = true
+	}
+
+	c.profile = profile
+	return nil
+}
+
+// validateProfileNetworkPlugin rejects starting a saved profile with another network plugin
+// while its existing configuration is reused. The network plugin is part of the master
+// configuration, which is only created the first time the profile is started.
+func (c *CommonStartConfig) validateProfileNetworkPlugin() error {
+	p := c.profile
+	if p == nil || !p.saved || !c.UseExistingConfig || p.NetworkPlugin == c.NetworkPlugin {
+		return nil
+	}
+	describe := func(plugin string) string {
+		if len(plugin) == 0 {
+			return "without a network plugin"
+		}
+		return "with network plugin " + plugin
+	}
+	return fmt.Errorf("profile %q was started %s and cannot be started %s while its configuration is reused, use --use-existing-config=false to create a new configuration", c.Profile, describe(p.NetworkPlugin), describe(c.NetworkPlugin))
+}
+
+// saveProfile records the options the profile was started with
+func (c *CommonStartConfig) saveProfile() error {
+	p := c.profile
+	p.DockerMachine = c.DockerMachine
+	p.Image = c.Image
+	p.ImageVersion = c.ImageVersion
+	p.PublicHostname = c.PublicHostname
+	p.RoutingSuffix = c.RoutingSuffix
+	p.Nodes = c.Nodes
+	p.NetworkPlugin = c.NetworkPlugin
+	return p.save()
+}
@@ -0,0 +1,113 @@
+package docker
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/openshift/github.com/spf13/pflag"
+)
+
+func TestApplyProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cluster-up")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(old string) { profilesDir = old }(profilesDir)
+	profilesDir = dir
+
+	saved := &Profile{Name: "sdn", Image: "example.com/origin", ImageVersion: "v1.5.0", RoutingSuffix: "apps.example.com", Nodes: 2, NetworkPlugin: "redhat/openshift-ovs-multitenant"}
+	if err := saved.save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	config := &ClientStartConfig{}
+	flags := pflag.NewFlagSet("up", pflag.ContinueOnError)
+	config.Bind(flags)
+	if err := flags.Parse([]string{"--profile=sdn", "--version=v1.5.1", "--host-pv-dir=/mypv"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := config.applyProfile(flags); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if config.Image != "example.com/origin" || config.RoutingSuffix != "apps.example.com" || config.Nodes != 2 || config.NetworkPlugin != "redhat/openshift-ovs-multitenant" {
+		t.Errorf("expected the saved options to be used, got %#v", config.CommonStartConfig)
+	}
+	if config.ImageVersion != "v1.5.1" || config.HostPersistentVolumesDir != "/mypv" {
+		t.Errorf("expected the options on the command line to be used, got version %q and pv dir %q", config.ImageVersion, config.HostPersistentVolumesDir)
+	}
+	if config.HostConfigDir != "/var/lib/origin/profiles/sdn/openshift.local.config" || config.HostDataDir != "/var/lib/origin/profiles/sdn/openshift.local.etcd" {
+		t.Errorf("expected the directories of the profile, got config dir %q and data dir %q", config.HostConfigDir, config.HostDataDir)
+	}
+	if !config.UseExistingConfig {
+		t.Errorf("expected the existing configuration to be used")
+	}
+	if got := config.componentImage("node"); got != "example.com/node:v1.5.1" {
+		t.Errorf("unexpected node image %q", got)
+	}
+
+	if err := config.saveProfile(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	loaded, err := loadProfile("sdn")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loaded.ImageVersion != "v1.5.1" || loaded.Nodes != 2 {
+		t.Errorf("expected the profile to be saved with the new options, got %#v", loaded)
+	}
+
+	config.Profile = "Not_Valid"
+	if err := config.applyProfile(flags); err == nil {
+		t.Errorf("expected an error for an invalid profile name")
+	}
+}
+
+func TestValidateProfileNetworkPlugin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cluster-up")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(old string) { profilesDir = old }(profilesDir)
+	profilesDir = dir
+
+	if err := (&Profile{Name: "single"}).save(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		plugin  string
+		invalid bool
+	}{
+		{name: "same plugin", args: []string{"--profile=single"}},
+		{name: "new profile", args: []string{"--profile=new", "--nodes=2"}, plugin: supportedNetworkPlugins[0]},
+		{name: "nodes added", args: []string{"--profile=single", "--nodes=2"}, plugin: supportedNetworkPlugins[0], invalid: true},
+		{name: "new configuration", args: []string{"--profile=single", "--nodes=2", "--use-existing-config=false"}, plugin: supportedNetworkPlugins[0]},
+	}
+	for _, test := range tests {
+		config := &ClientStartConfig{}
+		flags := pflag.NewFlagSet("up", pflag.ContinueOnError)
+		config.Bind(flags)
+		if err := flags.Parse(test.args); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if err := config.applyProfile(flags); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		// the network plugin defaulted when starting additional nodes
+		config.NetworkPlugin = test.plugin
+
+		err := config.validateProfileNetworkPlugin()
+		if test.invalid && err == nil {
+			t.Errorf("%s: expected the network plugin change to be rejected", test.name)
+		}
+		if !test.invalid && err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+	}
+}
@@ -98,6 +98,12 @@ func (h *Runner) HostNetwork() *Runner {
 	return h
 }
 
+// ContainerNetwork tells Docker to run using the Network namespace of another container
+func (h *Runner) ContainerNetwork(container string) *Runner {
+	h.hostConfig.NetworkMode = "container:" + container
+	return h
+}
+
 // Bind tells Docker to bind host dirs to container dirs
 func (h *Runner) Bind(binds ...string) *Runner {
 	h.hostConfig.Binds = append(h.hostConfig.Binds, binds...)
@@ -92,7 +92,12 @@ func (c *ClientStatusConfig) Status(f *clientcmd.Factory, out io.Writer) error {
 		return err
 	}
 
-	fmt.Print(status(container, config))
+	names, err := helper.ListContainerNames()
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(status(container, config, openshift.NodeContainerNames(names)))
 
 	return nil
 }
@@ -109,23 +114,37 @@ func isHealthy(f *clientcmd.Factory) (bool, error) {
 	return statusCode == 200, nil
 }
 
-func status(container *docker.Container, config *api.MasterConfig) string {
+func status(container *docker.Container, config *api.MasterConfig, nodes []string) string {
 	mountMap := make(map[string]string)
 	for _, mount := range container.Mounts {
 		mountMap[mount.Destination] = mount.Source
 	}
 
 	pvDir := ""
+	profile := ""
 	for _, env := range container.Config.Env {
 		if strings.HasPrefix(env, "OPENSHIFT_PV_DIR=") {
 			pvDir = strings.TrimPrefix(env, "OPENSHIFT_PV_DIR=")
 		}
+		if strings.HasPrefix(env, openshift.ProfileEnvVar+"=") {
+			profile = strings.TrimPrefix(env, openshift.ProfileEnvVar+"=")
+		}
 	}
 
 	duration := strings.ToLower(units.HumanDuration(time.Now().Sub(container.State.StartedAt)))
 
 	status := fmt.Sprintf("The OpenShift cluster was started %s ago\n\n", duration)
 
+	if len(profile) > 0 {
+		status = status + fmt.Sprintf("Profile:          %s\n", profile)
+	}
+	if len(nodes) > 0 {
+		status = status + fmt.Sprintf("Additional nodes: %s\n", strings.Join(nodes, ", "))
+	}
+	if len(profile) > 0 || len(nodes) > 0 {
+		status = status + fmt.Sprintf("\n")
+	}
+
 	status = status + fmt.Sprintf("Web console URL: %s\n", config.AssetConfig.MasterPublicURL)
 	if config.AssetConfig.MetricsPublicURL != "" {
 		status = status + fmt.Sprintf("Metrics URL:     %s\n", config.AssetConfig.MetricsPublicURL)
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -74,7 +75,14 @@ var (
 		This is to allow dynamic host names to be created for routes. An alternate routing suffix
 		can be specified using the --routing-suffix flag.
 
-		A public hostname can also be specified for the server with the --public-hostname flag.`)
+		A public hostname can also be specified for the server with the --public-hostname flag.
+
+		Clusters can be saved as named profiles with the --profile flag. The configuration, data and
+		volumes of a profile are kept in their own directory on the Docker host, and the options the
+		profile was started with are remembered, so that running the command again with the same
+		profile brings back the same cluster. A profile can start additional nodes on the Docker host
+		with the --nodes flag. Each node runs its own Docker daemon, and the nodes and the master are
+		connected by the OpenShift SDN, which uses the plugin specified with --network-plugin.`)
 
 	cmdUpExample = templates.Examples(`
 	  # Start OpenShift on a new docker machine named 'openshift'
@@ -90,7 +98,10 @@ var (
 	  %[1]s --image="registry.example.com/origin" --version="v1.1"
 
 	  # Specify which set of image streams to use
-	  %[1]s --image-streams=centos7`)
+	  %[1]s --image-streams=centos7
+
+	  # Start or switch to the cluster saved as profile 'sdn' with two additional nodes
+	  %[1]s --profile=sdn --nodes=2`)
 
 	imageStreams = map[string]string{
 		"centos7": "examples/image-streams/image-streams-centos7.json",
@@ -219,6 +230,13 @@ type CommonStartConfig struct {
 	shouldCreateUser     *bool
 
 	containerNetworkErr chan error
+
+	Profile         string
+	Nodes           int
+	NetworkPlugin   string
+	NodeDockerImage string
+
+	profile *Profile
 }
 
 func (c *CommonStartConfig) addTask(name string, fn taskFunc) {
@@ -286,6 +304,10 @@ type ClientStartConfig struct {
 
 func (config *ClientStartConfig) Bind(flags *pflag.FlagSet) {
 	config.CommonStartConfig.Bind(flags)
+	flags.StringVar(&config.Profile, "profile", "", "Name of the profile to save the cluster as, or of a saved cluster to start again")
+	flags.IntVar(&config.Nodes, "nodes", 0, "Number of additional nodes to start with the profile")
+	flags.StringVar(&config.NetworkPlugin, "network-plugin", "", fmt.Sprintf("The SDN plugin connecting the nodes, one of %s. Defaults to %s when starting additional nodes.", strings.Join(supportedNetworkPlugins, "|"), supportedNetworkPlugins[0]))
+	flags.StringVar(&config.NodeDockerImage, "node-docker-image", openshift.DefaultNodeDockerImage, "Specify the image running the Docker daemon of additional nodes")
 }
 
 func (c *CommonStartConfig) Complete(f *osclientcmd.Factory, cmd *cobra.Command) error {
@@ -346,6 +368,15 @@ func (c *CommonStartConfig) Complete(f *osclientcmd.Factory, cmd *cobra.Command)
 
 // Complete initializes fields based on command parameters and execution environment
 func (c *ClientStartConfig) Complete(f *osclientcmd.Factory, cmd *cobra.Command) error {
+	if len(c.Profile) > 0 {
+		if err := c.applyProfile(cmd.Flags()); err != nil {
+			return err
+		}
+	}
+	if c.Nodes > 0 && len(c.NetworkPlugin) == 0 {
+		c.NetworkPlugin = supportedNetworkPlugins[0]
+	}
+
 	if err := c.CommonStartConfig.Complete(f, cmd); err != nil {
 		return err
 	}
@@ -353,6 +384,12 @@ func (c *ClientStartConfig) Complete(f *osclientcmd.Factory, cmd *cobra.Command)
 	// Create an OpenShift configuration and start a container that uses it.
 	c.addTask("Starting OpenShift container", c.StartOpenShift)
 
+	// Start the additional nodes of a profile
+	c.addConditionalTask("Starting additional nodes", c.StartAdditionalNodes, func() bool { return c.Nodes > 0 })
+
+	// Remember the options of a profile
+	c.addConditionalTask(fmt.Sprintf("Saving profile %q", c.Profile), c.SaveProfile, func() bool { return len(c.Profile) > 0 })
+
 	// Add default redirect URIs to an OAuthClient to enable local web-console development.
 	c.addConditionalTask("Adding default OAuthClient redirect URIs", c.EnsureDefaultRedirectURIs, c.ShouldInitializeData)
 
@@ -402,6 +439,18 @@ func (c *ClientStartConfig) Validate(out, errout io.Writer) error {
 	if len(c.Tasks) == 0 {
 		return fmt.Errorf("no startup tasks to execute")
 	}
+	if c.Nodes < 0 {
+		return fmt.Errorf("--nodes must not be negative")
+	}
+	if c.Nodes > 0 && len(c.Profile) == 0 {
+		return fmt.Errorf("additional nodes can only be started with a profile, specify one with --profile")
+	}
+	if len(c.NetworkPlugin) > 0 && !sets.NewString(supportedNetworkPlugins...).Has(c.NetworkPlugin) {
+		return fmt.Errorf("unsupported network plugin %q, use one of %s", c.NetworkPlugin, strings.Join(supportedNetworkPlugins, ", "))
+	}
+	if err := c.validateProfileNetworkPlugin(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -572,13 +621,29 @@ func (c *CommonStartConfig) CheckExistingOpenShiftContainer(out io.Writer) error
 		}
 		fmt.Fprintf(out, "Deleted existing OpenShift container\n")
 	}
+	// Additional nodes left by a previous cluster would try to join this one
+	if err = c.OpenShiftHelper().RemoveAdditionalNodes(); err != nil {
+		return errors.NewError("cannot delete existing OpenShift nodes").WithCause(err)
+	}
 	return nil
 }
 
 // CheckOpenShiftImage checks whether the OpenShift image exists. If not it tells the
 // Docker daemon to pull it.
 func (c *CommonStartConfig) CheckOpenShiftImage(out io.Writer) error {
-	return c.DockerHelper().CheckAndPull(c.openshiftImage(), out)
+	images := []string{c.openshiftImage()}
+	if len(c.NetworkPlugin) > 0 {
+		images = append(images, c.componentImage("node"), c.componentImage("openvswitch"))
+	}
+	if c.Nodes > 0 {
+		images = append(images, c.NodeDockerImage)
+	}
+	for _, image := range images {
+		if err := c.DockerHelper().CheckAndPull(image, out); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // CheckDockerInsecureRegistry checks whether the Docker daemon is using the right --insecure-registry argument
@@ -628,7 +693,20 @@ func (c *CommonStartConfig) CheckDockerVersion(out io.Writer) error {
 }
 
 func (c *CommonStartConfig) EnsureHostDirectories(io.Writer) error {
-	return c.HostHelper().EnsureHostDirectories(!c.UseNsenterMount)
+	if len(c.Profile) == 0 {
+		return c.HostHelper().EnsureHostDirectories(!c.UseNsenterMount)
+	}
+	// The volumes of the master and of the additional nodes are kept in the profile
+	// directory, which is shared as a whole
+	profileDir := host.ProfileDir(c.Profile)
+	inProfileDir := strings.HasPrefix(c.HostVolumesDir, profileDir+"/")
+	if err := c.HostHelper().EnsureHostDirectories(!c.UseNsenterMount && !inProfileDir); err != nil {
+		return err
+	}
+	if c.Nodes == 0 && (c.UseNsenterMount || !inProfileDir) {
+		return nil
+	}
+	return c.HostHelper().EnsureSharedDir(profileDir)
 }
 
 // EnsureDefaultRedirectURIs merges a default URL to an auth client's RedirectURIs array
@@ -775,6 +853,11 @@ func (c *ClientStartConfig) StartOpenShift(out io.Writer) error {
 		HTTPSProxy:               c.HTTPSProxy,
 		NoProxy:                  c.NoProxy,
 		DockerRoot:               dockerRoot,
+
+		Profile:          c.Profile,
+		NetworkPlugin:    c.NetworkPlugin,
+		NodeImage:        c.componentImage("node"),
+		OpenvSwitchImage: c.componentImage("openvswitch"),
 	}
 	if c.ShouldInstallMetrics {
 		opt.MetricsHost = openshift.MetricsHost(c.RoutingSuffix, c.ServerIP)
@@ -805,6 +888,45 @@ func (c *ClientStartConfig) StartOpenShift(out io.Writer) error {
 	return nil
 }
 
+// StartAdditionalNodes starts the additional nodes of a profile
+func (c *ClientStartConfig) StartAdditionalNodes(out io.Writer) error {
+	masterIP := c.ServerIP
+	if c.PortForwarding {
+		var err error
+		if masterIP, err = c.OpenShiftHelper().ServerIP(); err != nil {
+			return err
+		}
+	}
+	for i := 1; i <= c.Nodes; i++ {
+		name := fmt.Sprintf("%s%d", openshift.NodeContainerPrefix, i)
+		opt := &openshift.NodeOptions{
+			Name:             name,
+			HostDir:          path.Join(host.ProfileDir(c.Profile), "nodes", name),
+			HostConfigDir:    c.HostConfigDir,
+			MasterIP:         masterIP,
+			Images:           c.imageFormat(),
+			NetworkPlugin:    c.NetworkPlugin,
+			NodeImage:        c.componentImage("node"),
+			OpenvSwitchImage: c.componentImage("openvswitch"),
+			DockerImage:      c.NodeDockerImage,
+			Environment:      c.Environment,
+			LogLevel:         c.ServerLogLevel,
+		}
+		if err := c.OpenShiftHelper().StartAdditionalNode(opt, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveProfile saves the options of the profile locally
+func (c *ClientStartConfig) SaveProfile(out io.Writer) error {
+	if err := c.saveProfile(); err != nil {
+		return errors.NewError("cannot save profile %q", c.Profile).WithCause(err)
+	}
+	return nil
+}
+
 func (c *ClientStartConfig) CheckContainerNetworking(out io.Writer) error {
 	networkErr := <-c.containerNetworkErr
 	if networkErr != nil {
@@ -822,6 +944,16 @@ func (c *CommonStartConfig) imageFormat() string {
 	return fmt.Sprintf("%s-${component}:%s", c.Image, c.ImageVersion)
 }
 
+// componentImage returns the image of a component that is released under its own
+// name rather than as an origin image, like the node and openvswitch images
+func (c *CommonStartConfig) componentImage(component string) string {
+	image := c.Image + "-" + component
+	if c.Image == "origin" || strings.HasSuffix(c.Image, "/origin") {
+		image = strings.TrimSuffix(c.Image, "origin") + component
+	}
+	return fmt.Sprintf("%s:%s", image, c.ImageVersion)
+}
+
 // InstallRegistry installs the OpenShift registry on the server
 func (c *ClientStartConfig) InstallRegistry(out io.Writer) error {
 	_, kubeClient, err := c.Clients()
@@ -928,6 +1060,15 @@ func (c *ClientStartConfig) ServerInfo(out io.Writer) error {
 		"The server is accessible via web console at:\n"+
 		"    %s\n\n%s%s", masterURL, metricsInfo, loggingInfo)
 
+	if len(c.Profile) > 0 {
+		msg += fmt.Sprintf("The cluster is saved as profile %q", c.Profile)
+		if c.Nodes > 0 {
+			msg += fmt.Sprintf(" and has %d additional nodes", c.Nodes)
+		}
+		msg += fmt.Sprintf(".\nTo start it again:\n"+
+			"    %s --profile=%s\n\n", c.command.CommandPath(), c.Profile)
+	}
+
 	if c.ShouldCreateUser() {
 		msg += fmt.Sprintf("You are logged in as:\n"+
 			"    User:     %s\n"+
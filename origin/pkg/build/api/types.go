@@ -59,6 +59,12 @@ const (
 	// forces the build to be processed by the build controller queue without waiting
 	// for a resync.
 	BuildAcceptedAnnotation = "build.openshift.io/accepted"
+	// BuildPullRequestLabel is the label holding the number of the pull request a build
+	// was triggered for.
+	BuildPullRequestLabel = "openshift.io/build.pull-request"
+	// BuildReportedStatusAnnotation is an annotation holding the last commit status that was
+	// reported for a build to the service hosting its source repository.
+	BuildReportedStatusAnnotation = "openshift.io/build.reported-status"
 )
 
 // +genclient=true
@@ -176,6 +182,20 @@ type GitHubWebHookCause struct {
 
 	// Secret is the obfuscated webhook secret that triggered a build.
 	Secret string
+
+	// PullRequest describes the pull request the build was triggered for, if any.
+	PullRequest *PullRequestCause
+}
+
+// PullRequestCause describes the pull request (or GitLab merge request) a
+// build was triggered for.
+type PullRequestCause struct {
+	// Number is the number of the pull request.
+	Number int64
+
+	// Ref is the Git reference of the head of the pull request, which is
+	// built in place of the reference of the build config.
+	Ref string
 }
 
 // ImageChangeCause contains information about the image that triggered a
@@ -228,6 +248,10 @@ type BuildStatus struct {
 
 	// Output describes the Docker image the build has produced.
 	Output BuildStatusOutput
+
+	// Stages describes the stages the build went through, in the order they
+	// started, with the time each of them took.
+	Stages []StageInfo
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -304,6 +328,10 @@ const (
 	// pushed to the registry.
 	StatusReasonPushImageToRegistryFailed StatusReason = "PushImageToRegistryFailed"
 
+	// StatusReasonPushAdditionalOutputFailed indicates that the image failed to
+	// be pushed to one of the additional outputs of the build.
+	StatusReasonPushAdditionalOutputFailed StatusReason = "PushAdditionalOutputFailed"
+
 	// StatusReasonPullBuilderImageFailed indicates that we failed to pull the
 	// builder image.
 	StatusReasonPullBuilderImageFailed StatusReason = "PullBuilderImageFailed"
@@ -331,27 +359,32 @@ const (
 
 // NOTE: These messages might change.
 const (
-	StatusMessageCannotCreateBuildPodSpec  = "Failed to create pod spec."
-	StatusMessageCannotCreateBuildPod      = "Failed creating build pod."
-	StatusMessageInvalidOutputRef          = "Output image could not be resolved."
-	StatusMessageCancelBuildFailed         = "Failed to cancel build."
-	StatusMessageBuildPodDeleted           = "The pod for this build was deleted before the build completed."
-	StatusMessageExceededRetryTimeout      = "Build did not complete and retrying timed out."
-	StatusMessageMissingPushSecret         = "Missing push secret."
-	StatusMessagePostCommitHookFailed      = "Build failed because of post commit hook."
-	StatusMessagePushImageToRegistryFailed = "Failed to push the image to the registry."
-	StatusMessagePullBuilderImageFailed    = "Failed pulling builder image."
-	StatusMessageFetchSourceFailed         = "Failed to fetch the input source."
-	StatusMessageInvalidContextDirectory   = "The supplied context directory does not exist."
-	StatusMessageCancelledBuild            = "The build was cancelled by the user."
-	StatusMessageDockerBuildFailed         = "Docker build strategy has failed."
-	StatusMessageBuildPodExists            = "The pod for this build already exists and is older than the build."
+	StatusMessageCannotCreateBuildPodSpec   = "Failed to create pod spec."
+	StatusMessageCannotCreateBuildPod       = "Failed creating build pod."
+	StatusMessageInvalidOutputRef           = "Output image could not be resolved."
+	StatusMessageCancelBuildFailed          = "Failed to cancel build."
+	StatusMessageBuildPodDeleted            = "The pod for this build was deleted before the build completed."
+	StatusMessageExceededRetryTimeout       = "Build did not complete and retrying timed out."
+	StatusMessageMissingPushSecret          = "Missing push secret."
+	StatusMessagePostCommitHookFailed       = "Build failed because of post commit hook."
+	StatusMessagePushImageToRegistryFailed  = "Failed to push the image to the registry."
+	StatusMessagePushAdditionalOutputFailed = "Failed to push the image to an additional output."
+	StatusMessagePullBuilderImageFailed     = "Failed pulling builder image."
+	StatusMessageFetchSourceFailed          = "Failed to fetch the input source."
+	StatusMessageInvalidContextDirectory    = "The supplied context directory does not exist."
+	StatusMessageCancelledBuild             = "The build was cancelled by the user."
+	StatusMessageDockerBuildFailed          = "Docker build strategy has failed."
+	StatusMessageBuildPodExists             = "The pod for this build already exists and is older than the build."
 )
 
 // BuildStatusOutput contains the status of the built image.
 type BuildStatusOutput struct {
 	// To describes the status of the built image being pushed to a registry.
 	To *BuildStatusOutputTo
+
+	// AdditionalOutputs describes the status of the built image being pushed to
+	// each of the additional outputs of the build, in the order they were pushed.
+	AdditionalOutputs []BuildStatusAdditionalOutput
 }
 
 // BuildStatusOutputTo describes the status of the built image with regards to
@@ -364,6 +397,58 @@ type BuildStatusOutputTo struct {
 	// completes successfully - e.g. when the registry returns no digest or
 	// returns it in a format that the builder doesn't understand.
 	ImageDigest string
+
+	// ImageSizeBytes is the uncompressed size of the built image, as reported
+	// by the Docker daemon that built it.
+	ImageSizeBytes int64
+}
+
+// BuildStatusAdditionalOutput describes the status of the built image with
+// regards to one of the additional outputs of the build.
+type BuildStatusAdditionalOutput struct {
+	// DockerImageReference is the reference, with any templates expanded, the
+	// built image was pushed to.
+	DockerImageReference string
+
+	// ImageDigest is the digest of the image pushed to DockerImageReference. It
+	// may not be set even if the push completes successfully.
+	ImageDigest string
+}
+
+// StageName identifies a stage of a build.
+type StageName string
+
+// Valid values for StageName.
+const (
+	// StageFetchInputs fetches the source and the other inputs of the build.
+	StageFetchInputs StageName = "FetchInputs"
+
+	// StagePullImages pulls the images the build starts from. Source builds
+	// only pull their builder image in this stage; the image of a previous
+	// build and the runtime image they may use are pulled in StageBuild.
+	StagePullImages StageName = "PullImages"
+
+	// StageBuild assembles and commits the image.
+	StageBuild StageName = "Build"
+
+	// StagePostCommit runs the post commit hook of the build.
+	StagePostCommit StageName = "PostCommit"
+
+	// StagePushImage pushes the image to its output and to any additional
+	// outputs of the build.
+	StagePushImage StageName = "PushImage"
+)
+
+// StageInfo describes a stage of a build.
+type StageInfo struct {
+	// Name identifies the stage.
+	Name StageName
+
+	// StartTime is the time the stage started.
+	StartTime unversioned.Time
+
+	// DurationMilliseconds is the time the stage took.
+	DurationMilliseconds int64
 }
 
 // BuildSource is the input used for the build.
@@ -408,6 +493,10 @@ type BuildSource struct {
 	// Secrets represents a list of secrets and their destinations that will
 	// be used only for the build.
 	Secrets []SecretBuildSource
+
+	// ConfigMaps represents a list of configMaps and their destinations that will
+	// be used for the build.
+	ConfigMaps []ConfigMapBuildSource
 }
 
 // ImageSource describes an image that is used as source for the build
@@ -454,6 +543,24 @@ type SecretBuildSource struct {
 	DestinationDir string
 }
 
+// ConfigMapBuildSource describes a configmap and its destination directory that will be
+// used only at the build time. The content of the configmap referenced here will
+// be copied into the destination directory instead of mounting.
+type ConfigMapBuildSource struct {
+	// ConfigMap is a reference to an existing configmap that you want to use in your
+	// build.
+	ConfigMap kapi.LocalObjectReference
+
+	// DestinationDir is the directory where the files from the configmap should be
+	// available for the build time.
+	// For the Source build strategy, these will be copied into the source directory
+	// before the assemble script runs.
+	// For the Docker build strategy, these will be copied into the build
+	// directory, where the Dockerfile is located, so users can ADD or COPY them
+	// during docker build.
+	DestinationDir string
+}
+
 type BinaryBuildSource struct {
 	// AsFile indicates that the provided binary input should be considered a single file
 	// within the build input. For example, specifying "webapp.war" would place the provided
@@ -599,6 +706,10 @@ type DockerBuildStrategy struct {
 	// DockerfilePath is the path of the Dockerfile that will be used to build the Docker image,
 	// relative to the root of the context (contextDir).
 	DockerfilePath string
+
+	// BuildArgs contains build arguments that will be resolved in the Dockerfile.  See
+	// https://docs.docker.com/engine/reference/builder/#/arg for more details.
+	BuildArgs []kapi.EnvVar
 }
 
 // SourceBuildStrategy defines input parameters specific to an Source build.
@@ -757,6 +868,23 @@ type BuildOutput struct {
 	// ImageLabels define a list of labels that are applied to the resulting image. If there
 	// are multiple labels with the same name then the last one in the list is used.
 	ImageLabels []ImageLabel
+
+	// AdditionalOutputs is a list of further locations the resulting image is pushed to
+	// once it has been pushed to To. The build fails if any of these pushes fails.
+	AdditionalOutputs []AdditionalBuildOutput
+}
+
+// AdditionalBuildOutput describes a further location the output of a build is pushed to.
+type AdditionalBuildOutput struct {
+	// To defines the location to push the output of this build to. Kind must be one of
+	// 'ImageStreamTag' or 'DockerImage'. The tag may reference the variables
+	// ${SOURCE_COMMIT}, ${SOURCE_REF} and ${BUILD_NUMBER}, which are expanded by the
+	// builder once the source revision is known.
+	To *kapi.ObjectReference
+
+	// PushSecret is the name of a Secret that would be used for setting up the
+	// authentication for pushing to To.
+	PushSecret *kapi.LocalObjectReference
 }
 
 // ImageLabel represents a label applied to the resulting image.
@@ -794,8 +922,57 @@ type BuildConfigSpec struct {
 
 	// CommonSpec is the desired build specification
 	CommonSpec
+
+	// StatusReporting configures reporting the status of builds as commit
+	// statuses to the service hosting the source repository. Optional.
+	StatusReporting *BuildStatusReporting
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to
+	// retain. Older builds are deleted when a build completes. If not
+	// specified, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int32
+
+	// FailedBuildsHistoryLimit is the number of old failed, errored and
+	// cancelled builds to retain. Older builds are deleted when a build
+	// completes. If not specified, all failed builds are retained.
+	FailedBuildsHistoryLimit *int32
+}
+
+// BuildStatusReporting describes how the status of builds is reported to the
+// service hosting the source repository.
+type BuildStatusReporting struct {
+	// Provider is the service the statuses are posted to.
+	Provider BuildStatusReportingProvider
+
+	// APIURL is the base URL of the provider API. If empty, the API of the
+	// public service is used.
+	APIURL string
+
+	// Secret refers to a secret holding an API token allowed to set commit
+	// statuses under the "token" key.
+	Secret kapi.LocalObjectReference
+
+	// Context is the name the statuses are posted under. If empty,
+	// "openshift/<namespace>/<build config name>" is used.
+	Context string
 }
 
+// BuildStatusReportingProvider is the type of service build statuses are
+// reported to.
+type BuildStatusReportingProvider string
+
+const (
+	// BuildStatusReportingGitHub reports statuses with the GitHub commit status API.
+	BuildStatusReportingGitHub BuildStatusReportingProvider = "GitHub"
+
+	// BuildStatusReportingGitLab reports statuses with the GitLab commit status API.
+	BuildStatusReportingGitLab BuildStatusReportingProvider = "GitLab"
+
+	// BuildStatusReportingSecretTokenKey is the key of the API token in the
+	// secret referenced by BuildStatusReporting.
+	BuildStatusReportingSecretTokenKey = "token"
+)
+
 // BuildRunPolicy defines the behaviour of how the new builds are executed
 // from the existing build configuration.
 type BuildRunPolicy string
@@ -829,8 +1006,42 @@ type WebHookTrigger struct {
 	// AllowEnv determines whether the webhook can set environment variables; can only
 	// be set to true for GenericWebHook
 	AllowEnv bool
+
+	// PullRequests enables builds of pull requests (and GitLab merge requests)
+	// targeting the branch of the build config. Can only be set for
+	// GitHubWebHook.
+	PullRequests *PullRequestBuildPolicy
+}
+
+// PullRequestBuildPolicy describes how pull requests are built. Pull requests
+// are built like any other build of the build config, so the code of the pull
+// request can read the source secret, the build secrets and the pull secret of
+// the build config. For that reason pull requests opened from forks of the
+// repository are ignored unless AllowForks is set.
+type PullRequestBuildPolicy struct {
+	// Output determines what is done with the image built from a pull request.
+	// Defaults to None.
+	Output PullRequestOutputPolicy
+
+	// AllowForks enables builds of pull requests opened from forks of the
+	// repository. Only set it if everyone able to open a pull request may read
+	// the secrets used by the build config.
+	AllowForks bool
 }
 
+// PullRequestOutputPolicy determines what is done with the image built from a
+// pull request.
+type PullRequestOutputPolicy string
+
+const (
+	// PullRequestOutputNone does not push the image built from a pull request.
+	PullRequestOutputNone PullRequestOutputPolicy = "None"
+
+	// PullRequestOutputTag pushes the image built from a pull request to the
+	// "pr-<number>" tag of the output of the build config.
+	PullRequestOutputTag PullRequestOutputPolicy = "Tag"
+)
+
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
 type ImageChangeTrigger struct {
 	// LastTriggeredImageID is used internally by the ImageChangeController to save last
@@ -862,7 +1073,7 @@ type BuildTriggerPolicy struct {
 // BuildTriggerType refers to a specific BuildTriggerPolicy implementation.
 type BuildTriggerType string
 
-//NOTE: Adding a new trigger type requires adding the type to KnownTriggerTypes
+// NOTE: Adding a new trigger type requires adding the type to KnownTriggerTypes
 var KnownTriggerTypes = sets.NewString(
 	string(GitHubWebHookBuildTriggerType),
 	string(GenericWebHookBuildTriggerType),
@@ -971,6 +1182,17 @@ type BuildRequest struct {
 	// TriggeredBy describes which triggers started the most recent update to the
 	// buildconfig and contains information about those triggers.
 	TriggeredBy []BuildTriggerCause
+
+	// DockerStrategyOptions will override the corresponding options of the Docker
+	// strategy of the build config.
+	DockerStrategyOptions *DockerStrategyOptions
+}
+
+// DockerStrategyOptions contains extra strategy options for Docker builds.
+type DockerStrategyOptions struct {
+	// BuildArgs contains build arguments that will be resolved in the Dockerfile. Arguments
+	// with the same name as those of the strategy replace them.
+	BuildArgs []kapi.EnvVar
 }
 
 type BinaryBuildRequestOptions struct {
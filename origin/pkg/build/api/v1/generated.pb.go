@@ -9,6 +9,7 @@
 		github.com/openshift/origin/pkg/build/api/v1/generated.proto
 
 	It has these top-level messages:
+		AdditionalBuildOutput
 		BinaryBuildRequestOptions
 		BinaryBuildSource
 		Build
@@ -25,14 +26,18 @@
 		BuildSource
 		BuildSpec
 		BuildStatus
+		BuildStatusAdditionalOutput
 		BuildStatusOutput
 		BuildStatusOutputTo
+		BuildStatusReporting
 		BuildStrategy
 		BuildTriggerCause
 		BuildTriggerPolicy
 		CommonSpec
+		ConfigMapBuildSource
 		CustomBuildStrategy
 		DockerBuildStrategy
+		DockerStrategyOptions
 		GenericWebHookCause
 		GenericWebHookEvent
 		GitBuildSource
@@ -47,11 +52,14 @@
 		JenkinsPipelineBuildStrategy
 		OptionalNodeSelector
 		ProxyConfig
+		PullRequestBuildPolicy
+		PullRequestCause
 		SecretBuildSource
 		SecretSpec
 		SourceBuildStrategy
 		SourceControlUser
 		SourceRevision
+		StageInfo
 		WebHookTrigger
 */
 package v1
@@ -79,187 +87,224 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 const _ = proto.GoGoProtoPackageIsVersion1
 
+func (m *AdditionalBuildOutput) Reset()                    { *m = AdditionalBuildOutput{} }
+func (*AdditionalBuildOutput) ProtoMessage()               {}
+func (*AdditionalBuildOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{0} }
+
 func (m *BinaryBuildRequestOptions) Reset()      { *m = BinaryBuildRequestOptions{} }
 func (*BinaryBuildRequestOptions) ProtoMessage() {}
 func (*BinaryBuildRequestOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptorGenerated, []int{0}
+	return fileDescriptorGenerated, []int{1}
 }
 
 func (m *BinaryBuildSource) Reset()                    { *m = BinaryBuildSource{} }
 func (*BinaryBuildSource) ProtoMessage()               {}
-func (*BinaryBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{1} }
+func (*BinaryBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{2} }
 
 func (m *Build) Reset()                    { *m = Build{} }
 func (*Build) ProtoMessage()               {}
-func (*Build) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{2} }
+func (*Build) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{3} }
 
 func (m *BuildConfig) Reset()                    { *m = BuildConfig{} }
 func (*BuildConfig) ProtoMessage()               {}
-func (*BuildConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{3} }
+func (*BuildConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{4} }
 
 func (m *BuildConfigList) Reset()                    { *m = BuildConfigList{} }
 func (*BuildConfigList) ProtoMessage()               {}
-func (*BuildConfigList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{4} }
+func (*BuildConfigList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{5} }
 
 func (m *BuildConfigSpec) Reset()                    { *m = BuildConfigSpec{} }
 func (*BuildConfigSpec) ProtoMessage()               {}
-func (*BuildConfigSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{5} }
+func (*BuildConfigSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{6} }
 
 func (m *BuildConfigStatus) Reset()                    { *m = BuildConfigStatus{} }
 func (*BuildConfigStatus) ProtoMessage()               {}
-func (*BuildConfigStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{6} }
+func (*BuildConfigStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{7} }
 
 func (m *BuildList) Reset()                    { *m = BuildList{} }
 func (*BuildList) ProtoMessage()               {}
-func (*BuildList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{7} }
+func (*BuildList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{8} }
 
 func (m *BuildLog) Reset()                    { *m = BuildLog{} }
 func (*BuildLog) ProtoMessage()               {}
-func (*BuildLog) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{8} }
+func (*BuildLog) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{9} }
 
 func (m *BuildLogOptions) Reset()                    { *m = BuildLogOptions{} }
 func (*BuildLogOptions) ProtoMessage()               {}
-func (*BuildLogOptions) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{9} }
+func (*BuildLogOptions) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{10} }
 
 func (m *BuildOutput) Reset()                    { *m = BuildOutput{} }
 func (*BuildOutput) ProtoMessage()               {}
-func (*BuildOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{10} }
+func (*BuildOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{11} }
 
 func (m *BuildPostCommitSpec) Reset()                    { *m = BuildPostCommitSpec{} }
 func (*BuildPostCommitSpec) ProtoMessage()               {}
-func (*BuildPostCommitSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{11} }
+func (*BuildPostCommitSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{12} }
 
 func (m *BuildRequest) Reset()                    { *m = BuildRequest{} }
 func (*BuildRequest) ProtoMessage()               {}
-func (*BuildRequest) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{12} }
+func (*BuildRequest) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
 
 func (m *BuildSource) Reset()                    { *m = BuildSource{} }
 func (*BuildSource) ProtoMessage()               {}
-func (*BuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
+func (*BuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
 
 func (m *BuildSpec) Reset()                    { *m = BuildSpec{} }
 func (*BuildSpec) ProtoMessage()               {}
-func (*BuildSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
+func (*BuildSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
 
 func (m *BuildStatus) Reset()                    { *m = BuildStatus{} }
 func (*BuildStatus) ProtoMessage()               {}
-func (*BuildStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
+func (*BuildStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
+
+func (m *BuildStatusAdditionalOutput) Reset()      { *m = BuildStatusAdditionalOutput{} }
+func (*BuildStatusAdditionalOutput) ProtoMessage() {}
+func (*BuildStatusAdditionalOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{17}
+}
 
 func (m *BuildStatusOutput) Reset()                    { *m = BuildStatusOutput{} }
 func (*BuildStatusOutput) ProtoMessage()               {}
-func (*BuildStatusOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
+func (*BuildStatusOutput) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
 
 func (m *BuildStatusOutputTo) Reset()                    { *m = BuildStatusOutputTo{} }
 func (*BuildStatusOutputTo) ProtoMessage()               {}
-func (*BuildStatusOutputTo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{17} }
+func (*BuildStatusOutputTo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
+
+func (m *BuildStatusReporting) Reset()                    { *m = BuildStatusReporting{} }
+func (*BuildStatusReporting) ProtoMessage()               {}
+func (*BuildStatusReporting) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
 
 func (m *BuildStrategy) Reset()                    { *m = BuildStrategy{} }
 func (*BuildStrategy) ProtoMessage()               {}
-func (*BuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
+func (*BuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{21} }
 
 func (m *BuildTriggerCause) Reset()                    { *m = BuildTriggerCause{} }
 func (*BuildTriggerCause) ProtoMessage()               {}
-func (*BuildTriggerCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
+func (*BuildTriggerCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{22} }
 
 func (m *BuildTriggerPolicy) Reset()                    { *m = BuildTriggerPolicy{} }
 func (*BuildTriggerPolicy) ProtoMessage()               {}
-func (*BuildTriggerPolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
+func (*BuildTriggerPolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{23} }
 
 func (m *CommonSpec) Reset()                    { *m = CommonSpec{} }
 func (*CommonSpec) ProtoMessage()               {}
-func (*CommonSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{21} }
+func (*CommonSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{24} }
+
+func (m *ConfigMapBuildSource) Reset()                    { *m = ConfigMapBuildSource{} }
+func (*ConfigMapBuildSource) ProtoMessage()               {}
+func (*ConfigMapBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
 
 func (m *CustomBuildStrategy) Reset()                    { *m = CustomBuildStrategy{} }
 func (*CustomBuildStrategy) ProtoMessage()               {}
-func (*CustomBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{22} }
+func (*CustomBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
 
 func (m *DockerBuildStrategy) Reset()                    { *m = DockerBuildStrategy{} }
 func (*DockerBuildStrategy) ProtoMessage()               {}
-func (*DockerBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{23} }
+func (*DockerBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{27} }
+
+func (m *DockerStrategyOptions) Reset()                    { *m = DockerStrategyOptions{} }
+func (*DockerStrategyOptions) ProtoMessage()               {}
+func (*DockerStrategyOptions) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
 
 func (m *GenericWebHookCause) Reset()                    { *m = GenericWebHookCause{} }
 func (*GenericWebHookCause) ProtoMessage()               {}
-func (*GenericWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{24} }
+func (*GenericWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
 
 func (m *GenericWebHookEvent) Reset()                    { *m = GenericWebHookEvent{} }
 func (*GenericWebHookEvent) ProtoMessage()               {}
-func (*GenericWebHookEvent) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
+func (*GenericWebHookEvent) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{30} }
 
 func (m *GitBuildSource) Reset()                    { *m = GitBuildSource{} }
 func (*GitBuildSource) ProtoMessage()               {}
-func (*GitBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
+func (*GitBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{31} }
 
 func (m *GitHubWebHookCause) Reset()                    { *m = GitHubWebHookCause{} }
 func (*GitHubWebHookCause) ProtoMessage()               {}
-func (*GitHubWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{27} }
+func (*GitHubWebHookCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{32} }
 
 func (m *GitInfo) Reset()                    { *m = GitInfo{} }
 func (*GitInfo) ProtoMessage()               {}
-func (*GitInfo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
+func (*GitInfo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{33} }
 
 func (m *GitSourceRevision) Reset()                    { *m = GitSourceRevision{} }
 func (*GitSourceRevision) ProtoMessage()               {}
-func (*GitSourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
+func (*GitSourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{34} }
 
 func (m *ImageChangeCause) Reset()                    { *m = ImageChangeCause{} }
 func (*ImageChangeCause) ProtoMessage()               {}
-func (*ImageChangeCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{30} }
+func (*ImageChangeCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{35} }
 
 func (m *ImageChangeTrigger) Reset()                    { *m = ImageChangeTrigger{} }
 func (*ImageChangeTrigger) ProtoMessage()               {}
-func (*ImageChangeTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{31} }
+func (*ImageChangeTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{36} }
 
 func (m *ImageLabel) Reset()                    { *m = ImageLabel{} }
 func (*ImageLabel) ProtoMessage()               {}
-func (*ImageLabel) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{32} }
+func (*ImageLabel) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{37} }
 
 func (m *ImageSource) Reset()                    { *m = ImageSource{} }
 func (*ImageSource) ProtoMessage()               {}
-func (*ImageSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{33} }
+func (*ImageSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{38} }
 
 func (m *ImageSourcePath) Reset()                    { *m = ImageSourcePath{} }
 func (*ImageSourcePath) ProtoMessage()               {}
-func (*ImageSourcePath) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{34} }
+func (*ImageSourcePath) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{39} }
 
 func (m *JenkinsPipelineBuildStrategy) Reset()      { *m = JenkinsPipelineBuildStrategy{} }
 func (*JenkinsPipelineBuildStrategy) ProtoMessage() {}
 func (*JenkinsPipelineBuildStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptorGenerated, []int{35}
+	return fileDescriptorGenerated, []int{40}
 }
 
 func (m *OptionalNodeSelector) Reset()                    { *m = OptionalNodeSelector{} }
 func (*OptionalNodeSelector) ProtoMessage()               {}
-func (*OptionalNodeSelector) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{36} }
+func (*OptionalNodeSelector) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{41} }
 
 func (m *ProxyConfig) Reset()                    { *m = ProxyConfig{} }
 func (*ProxyConfig) ProtoMessage()               {}
-func (*ProxyConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{37} }
+func (*ProxyConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{42} }
+
+func (m *PullRequestBuildPolicy) Reset()      { *m = PullRequestBuildPolicy{} }
+func (*PullRequestBuildPolicy) ProtoMessage() {}
+func (*PullRequestBuildPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{43}
+}
+
+func (m *PullRequestCause) Reset()                    { *m = PullRequestCause{} }
+func (*PullRequestCause) ProtoMessage()               {}
+func (*PullRequestCause) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{44} }
 
 func (m *SecretBuildSource) Reset()                    { *m = SecretBuildSource{} }
 func (*SecretBuildSource) ProtoMessage()               {}
-func (*SecretBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{38} }
+func (*SecretBuildSource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{45} }
 
 func (m *SecretSpec) Reset()                    { *m = SecretSpec{} }
 func (*SecretSpec) ProtoMessage()               {}
-func (*SecretSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{39} }
+func (*SecretSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{46} }
 
 func (m *SourceBuildStrategy) Reset()                    { *m = SourceBuildStrategy{} }
 func (*SourceBuildStrategy) ProtoMessage()               {}
-func (*SourceBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{40} }
+func (*SourceBuildStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{47} }
 
 func (m *SourceControlUser) Reset()                    { *m = SourceControlUser{} }
 func (*SourceControlUser) ProtoMessage()               {}
-func (*SourceControlUser) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{41} }
+func (*SourceControlUser) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{48} }
 
 func (m *SourceRevision) Reset()                    { *m = SourceRevision{} }
 func (*SourceRevision) ProtoMessage()               {}
-func (*SourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{42} }
+func (*SourceRevision) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{49} }
+
+func (m *StageInfo) Reset()                    { *m = StageInfo{} }
+func (*StageInfo) ProtoMessage()               {}
+func (*StageInfo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{50} }
 
 func (m *WebHookTrigger) Reset()                    { *m = WebHookTrigger{} }
 func (*WebHookTrigger) ProtoMessage()               {}
-func (*WebHookTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{43} }
+func (*WebHookTrigger) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{51} }
 
 func init() {
+	proto.RegisterType((*AdditionalBuildOutput)(nil), "github.com.openshift.origin.pkg.build.api.v1.AdditionalBuildOutput")
 	proto.RegisterType((*BinaryBuildRequestOptions)(nil), "github.com.openshift.origin.pkg.build.api.v1.BinaryBuildRequestOptions")
 	proto.RegisterType((*BinaryBuildSource)(nil), "github.com.openshift.origin.pkg.build.api.v1.BinaryBuildSource")
 	proto.RegisterType((*Build)(nil), "github.com.openshift.origin.pkg.build.api.v1.Build")
@@ -276,14 +321,18 @@ func init() {
 	proto.RegisterType((*BuildSource)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildSource")
 	proto.RegisterType((*BuildSpec)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildSpec")
 	proto.RegisterType((*BuildStatus)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildStatus")
+	proto.RegisterType((*BuildStatusAdditionalOutput)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildStatusAdditionalOutput")
 	proto.RegisterType((*BuildStatusOutput)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildStatusOutput")
 	proto.RegisterType((*BuildStatusOutputTo)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildStatusOutputTo")
+	proto.RegisterType((*BuildStatusReporting)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildStatusReporting")
 	proto.RegisterType((*BuildStrategy)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildStrategy")
 	proto.RegisterType((*BuildTriggerCause)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildTriggerCause")
 	proto.RegisterType((*BuildTriggerPolicy)(nil), "github.com.openshift.origin.pkg.build.api.v1.BuildTriggerPolicy")
 	proto.RegisterType((*CommonSpec)(nil), "github.com.openshift.origin.pkg.build.api.v1.CommonSpec")
+	proto.RegisterType((*ConfigMapBuildSource)(nil), "github.com.openshift.origin.pkg.build.api.v1.ConfigMapBuildSource")
 	proto.RegisterType((*CustomBuildStrategy)(nil), "github.com.openshift.origin.pkg.build.api.v1.CustomBuildStrategy")
 	proto.RegisterType((*DockerBuildStrategy)(nil), "github.com.openshift.origin.pkg.build.api.v1.DockerBuildStrategy")
+	proto.RegisterType((*DockerStrategyOptions)(nil), "github.com.openshift.origin.pkg.build.api.v1.DockerStrategyOptions")
 	proto.RegisterType((*GenericWebHookCause)(nil), "github.com.openshift.origin.pkg.build.api.v1.GenericWebHookCause")
 	proto.RegisterType((*GenericWebHookEvent)(nil), "github.com.openshift.origin.pkg.build.api.v1.GenericWebHookEvent")
 	proto.RegisterType((*GitBuildSource)(nil), "github.com.openshift.origin.pkg.build.api.v1.GitBuildSource")
@@ -298,13 +347,54 @@ func init() {
 	proto.RegisterType((*JenkinsPipelineBuildStrategy)(nil), "github.com.openshift.origin.pkg.build.api.v1.JenkinsPipelineBuildStrategy")
 	proto.RegisterType((*OptionalNodeSelector)(nil), "github.com.openshift.origin.pkg.build.api.v1.OptionalNodeSelector")
 	proto.RegisterType((*ProxyConfig)(nil), "github.com.openshift.origin.pkg.build.api.v1.ProxyConfig")
+	proto.RegisterType((*PullRequestBuildPolicy)(nil), "github.com.openshift.origin.pkg.build.api.v1.PullRequestBuildPolicy")
+	proto.RegisterType((*PullRequestCause)(nil), "github.com.openshift.origin.pkg.build.api.v1.PullRequestCause")
 	proto.RegisterType((*SecretBuildSource)(nil), "github.com.openshift.origin.pkg.build.api.v1.SecretBuildSource")
 	proto.RegisterType((*SecretSpec)(nil), "github.com.openshift.origin.pkg.build.api.v1.SecretSpec")
 	proto.RegisterType((*SourceBuildStrategy)(nil), "github.com.openshift.origin.pkg.build.api.v1.SourceBuildStrategy")
 	proto.RegisterType((*SourceControlUser)(nil), "github.com.openshift.origin.pkg.build.api.v1.SourceControlUser")
 	proto.RegisterType((*SourceRevision)(nil), "github.com.openshift.origin.pkg.build.api.v1.SourceRevision")
+	proto.RegisterType((*StageInfo)(nil), "github.com.openshift.origin.pkg.build.api.v1.StageInfo")
 	proto.RegisterType((*WebHookTrigger)(nil), "github.com.openshift.origin.pkg.build.api.v1.WebHookTrigger")
 }
+func (m *AdditionalBuildOutput) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *AdditionalBuildOutput) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.To != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.To.Size()))
+		n1, err := m.To.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.PushSecret != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.PushSecret.Size()))
+		n2, err := m.PushSecret.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
 func (m *BinaryBuildRequestOptions) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -323,11 +413,11 @@ func (m *BinaryBuildRequestOptions) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ObjectMeta.Size()))
-	n1, err := m.ObjectMeta.MarshalTo(data[i:])
+	n3, err := m.ObjectMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n1
+	i += n3
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.AsFile)))
@@ -399,27 +489,27 @@ func (m *Build) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ObjectMeta.Size()))
-	n2, err := m.ObjectMeta.MarshalTo(data[i:])
+	n4, err := m.ObjectMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n2
+	i += n4
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Spec.Size()))
-	n3, err := m.Spec.MarshalTo(data[i:])
+	n5, err := m.Spec.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n5
 	data[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Status.Size()))
-	n4, err := m.Status.MarshalTo(data[i:])
+	n6, err := m.Status.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n6
 	return i, nil
 }
 
@@ -441,27 +531,27 @@ func (m *BuildConfig) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ObjectMeta.Size()))
-	n5, err := m.ObjectMeta.MarshalTo(data[i:])
+	n7, err := m.ObjectMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n7
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Spec.Size()))
-	n6, err := m.Spec.MarshalTo(data[i:])
+	n8, err := m.Spec.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n8
 	data[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Status.Size()))
-	n7, err := m.Status.MarshalTo(data[i:])
+	n9, err := m.Status.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n9
 	return i, nil
 }
 
@@ -483,11 +573,11 @@ func (m *BuildConfigList) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ListMeta.Size()))
-	n8, err := m.ListMeta.MarshalTo(data[i:])
+	n10, err := m.ListMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n10
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			data[i] = 0x12
@@ -537,11 +627,31 @@ func (m *BuildConfigSpec) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.CommonSpec.Size()))
-	n9, err := m.CommonSpec.MarshalTo(data[i:])
+	n11, err := m.CommonSpec.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n11
+	if m.StatusReporting != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.StatusReporting.Size()))
+		n12, err := m.StatusReporting.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.SuccessfulBuildsHistoryLimit != nil {
+		data[i] = 0x28
+		i++
+		i = encodeVarintGenerated(data, i, uint64(*m.SuccessfulBuildsHistoryLimit))
+	}
+	if m.FailedBuildsHistoryLimit != nil {
+		data[i] = 0x30
+		i++
+		i = encodeVarintGenerated(data, i, uint64(*m.FailedBuildsHistoryLimit))
+	}
 	return i, nil
 }
 
@@ -584,11 +694,11 @@ func (m *BuildList) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ListMeta.Size()))
-	n10, err := m.ListMeta.MarshalTo(data[i:])
+	n13, err := m.ListMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n13
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			data[i] = 0x12
@@ -666,11 +776,11 @@ func (m *BuildLogOptions) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.SinceTime.Size()))
-		n11, err := m.SinceTime.MarshalTo(data[i:])
+		n14, err := m.SinceTime.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n14
 	}
 	data[i] = 0x30
 	i++
@@ -725,21 +835,21 @@ func (m *BuildOutput) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.To.Size()))
-		n12, err := m.To.MarshalTo(data[i:])
+		n15, err := m.To.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n15
 	}
 	if m.PushSecret != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.PushSecret.Size()))
-		n13, err := m.PushSecret.MarshalTo(data[i:])
+		n16, err := m.PushSecret.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n16
 	}
 	if len(m.ImageLabels) > 0 {
 		for _, msg := range m.ImageLabels {
@@ -753,6 +863,18 @@ func (m *BuildOutput) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.AdditionalOutputs) > 0 {
+		for _, msg := range m.AdditionalOutputs {
+			data[i] = 0x22
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -826,50 +948,50 @@ func (m *BuildRequest) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ObjectMeta.Size()))
-	n14, err := m.ObjectMeta.MarshalTo(data[i:])
+	n17, err := m.ObjectMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n17
 	if m.Revision != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Revision.Size()))
-		n15, err := m.Revision.MarshalTo(data[i:])
+		n18, err := m.Revision.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.TriggeredByImage != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.TriggeredByImage.Size()))
-		n16, err := m.TriggeredByImage.MarshalTo(data[i:])
+		n19, err := m.TriggeredByImage.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n19
 	}
 	if m.From != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.From.Size()))
-		n17, err := m.From.MarshalTo(data[i:])
+		n20, err := m.From.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n20
 	}
 	if m.Binary != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Binary.Size()))
-		n18, err := m.Binary.MarshalTo(data[i:])
+		n21, err := m.Binary.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if m.LastVersion != nil {
 		data[i] = 0x30
@@ -900,6 +1022,16 @@ func (m *BuildRequest) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.DockerStrategyOptions != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.DockerStrategyOptions.Size()))
+		n22, err := m.DockerStrategyOptions.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
 	return i, nil
 }
 
@@ -926,11 +1058,11 @@ func (m *BuildSource) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Binary.Size()))
-		n19, err := m.Binary.MarshalTo(data[i:])
+		n23, err := m.Binary.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n23
 	}
 	if m.Dockerfile != nil {
 		data[i] = 0x1a
@@ -942,11 +1074,11 @@ func (m *BuildSource) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x22
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Git.Size()))
-		n20, err := m.Git.MarshalTo(data[i:])
+		n24, err := m.Git.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n24
 	}
 	if len(m.Images) > 0 {
 		for _, msg := range m.Images {
@@ -968,11 +1100,11 @@ func (m *BuildSource) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.SourceSecret.Size()))
-		n21, err := m.SourceSecret.MarshalTo(data[i:])
+		n25, err := m.SourceSecret.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n25
 	}
 	if len(m.Secrets) > 0 {
 		for _, msg := range m.Secrets {
@@ -986,6 +1118,18 @@ func (m *BuildSource) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.ConfigMaps) > 0 {
+		for _, msg := range m.ConfigMaps {
+			data[i] = 0x4a
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1007,11 +1151,11 @@ func (m *BuildSpec) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.CommonSpec.Size()))
-	n22, err := m.CommonSpec.MarshalTo(data[i:])
+	n26, err := m.CommonSpec.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n26
 	if len(m.TriggeredBy) > 0 {
 		for _, msg := range m.TriggeredBy {
 			data[i] = 0x12
@@ -1066,21 +1210,21 @@ func (m *BuildStatus) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.StartTimestamp.Size()))
-		n23, err := m.StartTimestamp.MarshalTo(data[i:])
+		n27, err := m.StartTimestamp.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n27
 	}
 	if m.CompletionTimestamp != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.CompletionTimestamp.Size()))
-		n24, err := m.CompletionTimestamp.MarshalTo(data[i:])
+		n28, err := m.CompletionTimestamp.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n28
 	}
 	data[i] = 0x38
 	i++
@@ -1093,20 +1237,58 @@ func (m *BuildStatus) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Config.Size()))
-		n25, err := m.Config.MarshalTo(data[i:])
+		n29, err := m.Config.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n29
 	}
 	data[i] = 0x52
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Output.Size()))
-	n26, err := m.Output.MarshalTo(data[i:])
+	n30, err := m.Output.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n30
+	if len(m.Stages) > 0 {
+		for _, msg := range m.Stages {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BuildStatusAdditionalOutput) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BuildStatusAdditionalOutput) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.DockerImageReference)))
+	i += copy(data[i:], m.DockerImageReference)
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.ImageDigest)))
+	i += copy(data[i:], m.ImageDigest)
 	return i, nil
 }
 
@@ -1129,11 +1311,23 @@ func (m *BuildStatusOutput) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.To.Size()))
-		n27, err := m.To.MarshalTo(data[i:])
+		n31, err := m.To.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n31
+	}
+	if len(m.AdditionalOutputs) > 0 {
+		for _, msg := range m.AdditionalOutputs {
+			data[i] = 0x12
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -1157,6 +1351,47 @@ func (m *BuildStatusOutputTo) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.ImageDigest)))
 	i += copy(data[i:], m.ImageDigest)
+	data[i] = 0x10
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.ImageSizeBytes))
+	return i, nil
+}
+
+func (m *BuildStatusReporting) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *BuildStatusReporting) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Provider)))
+	i += copy(data[i:], m.Provider)
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.APIURL)))
+	i += copy(data[i:], m.APIURL)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.Secret.Size()))
+	n32, err := m.Secret.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	data[i] = 0x22
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Context)))
+	i += copy(data[i:], m.Context)
 	return i, nil
 }
 
@@ -1183,41 +1418,41 @@ func (m *BuildStrategy) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.DockerStrategy.Size()))
-		n28, err := m.DockerStrategy.MarshalTo(data[i:])
+		n33, err := m.DockerStrategy.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n33
 	}
 	if m.SourceStrategy != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.SourceStrategy.Size()))
-		n29, err := m.SourceStrategy.MarshalTo(data[i:])
+		n34, err := m.SourceStrategy.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n34
 	}
 	if m.CustomStrategy != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.CustomStrategy.Size()))
-		n30, err := m.CustomStrategy.MarshalTo(data[i:])
+		n35, err := m.CustomStrategy.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n35
 	}
 	if m.JenkinsPipelineStrategy != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.JenkinsPipelineStrategy.Size()))
-		n31, err := m.JenkinsPipelineStrategy.MarshalTo(data[i:])
+		n36, err := m.JenkinsPipelineStrategy.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n36
 	}
 	return i, nil
 }
@@ -1245,31 +1480,31 @@ func (m *BuildTriggerCause) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.GenericWebHook.Size()))
-		n32, err := m.GenericWebHook.MarshalTo(data[i:])
+		n37, err := m.GenericWebHook.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n37
 	}
 	if m.GitHubWebHook != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.GitHubWebHook.Size()))
-		n33, err := m.GitHubWebHook.MarshalTo(data[i:])
+		n38, err := m.GitHubWebHook.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n38
 	}
 	if m.ImageChangeBuild != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.ImageChangeBuild.Size()))
-		n34, err := m.ImageChangeBuild.MarshalTo(data[i:])
+		n39, err := m.ImageChangeBuild.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n39
 	}
 	return i, nil
 }
@@ -1297,31 +1532,31 @@ func (m *BuildTriggerPolicy) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.GitHubWebHook.Size()))
-		n35, err := m.GitHubWebHook.MarshalTo(data[i:])
+		n40, err := m.GitHubWebHook.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n40
 	}
 	if m.GenericWebHook != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.GenericWebHook.Size()))
-		n36, err := m.GenericWebHook.MarshalTo(data[i:])
+		n41, err := m.GenericWebHook.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n41
 	}
 	if m.ImageChange != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.ImageChange.Size()))
-		n37, err := m.ImageChange.MarshalTo(data[i:])
+		n42, err := m.ImageChange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n42
 	}
 	return i, nil
 }
@@ -1348,53 +1583,53 @@ func (m *CommonSpec) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Source.Size()))
-	n38, err := m.Source.MarshalTo(data[i:])
+	n43, err := m.Source.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n43
 	if m.Revision != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Revision.Size()))
-		n39, err := m.Revision.MarshalTo(data[i:])
+		n44, err := m.Revision.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n44
 	}
 	data[i] = 0x22
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Strategy.Size()))
-	n40, err := m.Strategy.MarshalTo(data[i:])
+	n45, err := m.Strategy.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n45
 	data[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Output.Size()))
-	n41, err := m.Output.MarshalTo(data[i:])
+	n46, err := m.Output.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n46
 	data[i] = 0x32
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Resources.Size()))
-	n42, err := m.Resources.MarshalTo(data[i:])
+	n47, err := m.Resources.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n47
 	data[i] = 0x3a
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.PostCommit.Size()))
-	n43, err := m.PostCommit.MarshalTo(data[i:])
+	n48, err := m.PostCommit.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n48
 	if m.CompletionDeadlineSeconds != nil {
 		data[i] = 0x40
 		i++
@@ -1404,12 +1639,42 @@ func (m *CommonSpec) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.NodeSelector.Size()))
-		n44, err := m.NodeSelector.MarshalTo(data[i:])
+		n49, err := m.NodeSelector.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n49
+	}
+	return i, nil
+}
+
+func (m *ConfigMapBuildSource) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ConfigMapBuildSource) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.ConfigMap.Size()))
+	n50, err := m.ConfigMap.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n50
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.DestinationDir)))
+	i += copy(data[i:], m.DestinationDir)
 	return i, nil
 }
 
@@ -1431,20 +1696,20 @@ func (m *CustomBuildStrategy) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.From.Size()))
-	n45, err := m.From.MarshalTo(data[i:])
+	n51, err := m.From.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n45
+	i += n51
 	if m.PullSecret != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.PullSecret.Size()))
-		n46, err := m.PullSecret.MarshalTo(data[i:])
+		n52, err := m.PullSecret.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n52
 	}
 	if len(m.Env) > 0 {
 		for _, msg := range m.Env {
@@ -1512,21 +1777,21 @@ func (m *DockerBuildStrategy) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.From.Size()))
-		n47, err := m.From.MarshalTo(data[i:])
+		n53, err := m.From.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n53
 	}
 	if m.PullSecret != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.PullSecret.Size()))
-		n48, err := m.PullSecret.MarshalTo(data[i:])
+		n54, err := m.PullSecret.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n54
 	}
 	data[i] = 0x18
 	i++
@@ -1560,10 +1825,22 @@ func (m *DockerBuildStrategy) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.DockerfilePath)))
 	i += copy(data[i:], m.DockerfilePath)
+	if len(m.BuildArgs) > 0 {
+		for _, msg := range m.BuildArgs {
+			data[i] = 0x3a
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *GenericWebHookCause) Marshal() (data []byte, err error) {
+func (m *DockerStrategyOptions) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1573,7 +1850,37 @@ func (m *GenericWebHookCause) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *GenericWebHookCause) MarshalTo(data []byte) (int, error) {
+func (m *DockerStrategyOptions) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildArgs) > 0 {
+		for _, msg := range m.BuildArgs {
+			data[i] = 0xa
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GenericWebHookCause) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *GenericWebHookCause) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1582,11 +1889,11 @@ func (m *GenericWebHookCause) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Revision.Size()))
-		n49, err := m.Revision.MarshalTo(data[i:])
+		n55, err := m.Revision.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n55
 	}
 	data[i] = 0x12
 	i++
@@ -1618,11 +1925,11 @@ func (m *GenericWebHookEvent) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Git.Size()))
-		n50, err := m.Git.MarshalTo(data[i:])
+		n56, err := m.Git.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n56
 	}
 	if len(m.Env) > 0 {
 		for _, msg := range m.Env {
@@ -1665,11 +1972,11 @@ func (m *GitBuildSource) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ProxyConfig.Size()))
-	n51, err := m.ProxyConfig.MarshalTo(data[i:])
+	n57, err := m.ProxyConfig.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n51
+	i += n57
 	return i, nil
 }
 
@@ -1692,16 +1999,26 @@ func (m *GitHubWebHookCause) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Revision.Size()))
-		n52, err := m.Revision.MarshalTo(data[i:])
+		n58, err := m.Revision.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n58
 	}
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.Secret)))
 	i += copy(data[i:], m.Secret)
+	if m.PullRequest != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.PullRequest.Size()))
+		n59, err := m.PullRequest.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
+	}
 	return i, nil
 }
 
@@ -1723,19 +2040,19 @@ func (m *GitInfo) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.GitBuildSource.Size()))
-	n53, err := m.GitBuildSource.MarshalTo(data[i:])
+	n60, err := m.GitBuildSource.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n53
+	i += n60
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.GitSourceRevision.Size()))
-	n54, err := m.GitSourceRevision.MarshalTo(data[i:])
+	n61, err := m.GitSourceRevision.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n54
+	i += n61
 	return i, nil
 }
 
@@ -1761,19 +2078,19 @@ func (m *GitSourceRevision) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Author.Size()))
-	n55, err := m.Author.MarshalTo(data[i:])
+	n62, err := m.Author.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n55
+	i += n62
 	data[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Committer.Size()))
-	n56, err := m.Committer.MarshalTo(data[i:])
+	n63, err := m.Committer.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n56
+	i += n63
 	data[i] = 0x22
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.Message)))
@@ -1804,11 +2121,11 @@ func (m *ImageChangeCause) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.FromRef.Size()))
-		n57, err := m.FromRef.MarshalTo(data[i:])
+		n64, err := m.FromRef.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n64
 	}
 	return i, nil
 }
@@ -1836,11 +2153,11 @@ func (m *ImageChangeTrigger) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.From.Size()))
-		n58, err := m.From.MarshalTo(data[i:])
+		n65, err := m.From.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n65
 	}
 	return i, nil
 }
@@ -1889,11 +2206,11 @@ func (m *ImageSource) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.From.Size()))
-	n59, err := m.From.MarshalTo(data[i:])
+	n66, err := m.From.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n59
+	i += n66
 	if len(m.Paths) > 0 {
 		for _, msg := range m.Paths {
 			data[i] = 0x12
@@ -1910,11 +2227,11 @@ func (m *ImageSource) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.PullSecret.Size()))
-		n60, err := m.PullSecret.MarshalTo(data[i:])
+		n67, err := m.PullSecret.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n67
 	}
 	return i, nil
 }
@@ -2042,6 +2359,61 @@ func (m *ProxyConfig) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *PullRequestBuildPolicy) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PullRequestBuildPolicy) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Output)))
+	i += copy(data[i:], m.Output)
+	data[i] = 0x10
+	i++
+	if m.AllowForks {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *PullRequestCause) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *PullRequestCause) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.Number))
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Ref)))
+	i += copy(data[i:], m.Ref)
+	return i, nil
+}
+
 func (m *SecretBuildSource) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -2060,11 +2432,11 @@ func (m *SecretBuildSource) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.Secret.Size()))
-	n61, err := m.Secret.MarshalTo(data[i:])
+	n68, err := m.Secret.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n61
+	i += n68
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.DestinationDir)))
@@ -2090,11 +2462,11 @@ func (m *SecretSpec) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.SecretSource.Size()))
-	n62, err := m.SecretSource.MarshalTo(data[i:])
+	n69, err := m.SecretSource.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n62
+	i += n69
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.MountPath)))
@@ -2120,20 +2492,20 @@ func (m *SourceBuildStrategy) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.From.Size()))
-	n63, err := m.From.MarshalTo(data[i:])
+	n70, err := m.From.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n63
+	i += n70
 	if m.PullSecret != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.PullSecret.Size()))
-		n64, err := m.PullSecret.MarshalTo(data[i:])
+		n71, err := m.PullSecret.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n71
 	}
 	if len(m.Env) > 0 {
 		for _, msg := range m.Env {
@@ -2173,11 +2545,11 @@ func (m *SourceBuildStrategy) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.RuntimeImage.Size()))
-		n65, err := m.RuntimeImage.MarshalTo(data[i:])
+		n72, err := m.RuntimeImage.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n72
 	}
 	if len(m.RuntimeArtifacts) > 0 {
 		for _, msg := range m.RuntimeArtifacts {
@@ -2243,15 +2615,48 @@ func (m *SourceRevision) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintGenerated(data, i, uint64(m.Git.Size()))
-		n66, err := m.Git.MarshalTo(data[i:])
+		n73, err := m.Git.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n73
 	}
 	return i, nil
 }
 
+func (m *StageInfo) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *StageInfo) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.StartTime.Size()))
+	n74, err := m.StartTime.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n74
+	data[i] = 0x18
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.DurationMilliseconds))
+	return i, nil
+}
+
 func (m *WebHookTrigger) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -2279,6 +2684,16 @@ func (m *WebHookTrigger) MarshalTo(data []byte) (int, error) {
 		data[i] = 0
 	}
 	i++
+	if m.PullRequests != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.PullRequests.Size()))
+		n75, err := m.PullRequests.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
+	}
 	return i, nil
 }
 
@@ -2309,6 +2724,20 @@ func encodeVarintGenerated(data []byte, offset int, v uint64) int {
 	data[offset] = uint8(v)
 	return offset + 1
 }
+func (m *AdditionalBuildOutput) Size() (n int) {
+	var l int
+	_ = l
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PushSecret != nil {
+		l = m.PushSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *BinaryBuildRequestOptions) Size() (n int) {
 	var l int
 	_ = l
@@ -2390,6 +2819,16 @@ func (m *BuildConfigSpec) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.CommonSpec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.StatusReporting != nil {
+		l = m.StatusReporting.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SuccessfulBuildsHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.SuccessfulBuildsHistoryLimit))
+	}
+	if m.FailedBuildsHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.FailedBuildsHistoryLimit))
+	}
 	return n
 }
 
@@ -2465,6 +2904,12 @@ func (m *BuildOutput) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.AdditionalOutputs) > 0 {
+		for _, e := range m.AdditionalOutputs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2524,6 +2969,10 @@ func (m *BuildRequest) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.DockerStrategyOptions != nil {
+		l = m.DockerStrategyOptions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2562,6 +3011,12 @@ func (m *BuildSource) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.ConfigMaps) > 0 {
+		for _, e := range m.ConfigMaps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2606,6 +3061,22 @@ func (m *BuildStatus) Size() (n int) {
 	}
 	l = m.Output.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BuildStatusAdditionalOutput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.DockerImageReference)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ImageDigest)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -2616,6 +3087,12 @@ func (m *BuildStatusOutput) Size() (n int) {
 		l = m.To.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.AdditionalOutputs) > 0 {
+		for _, e := range m.AdditionalOutputs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2624,6 +3101,21 @@ func (m *BuildStatusOutputTo) Size() (n int) {
 	_ = l
 	l = len(m.ImageDigest)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ImageSizeBytes))
+	return n
+}
+
+func (m *BuildStatusReporting) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.APIURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Secret.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Context)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -2720,6 +3212,16 @@ func (m *CommonSpec) Size() (n int) {
 	return n
 }
 
+func (m *ConfigMapBuildSource) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ConfigMap.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DestinationDir)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *CustomBuildStrategy) Size() (n int) {
 	var l int
 	_ = l
@@ -2769,6 +3271,24 @@ func (m *DockerBuildStrategy) Size() (n int) {
 	n += 2
 	l = len(m.DockerfilePath)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.BuildArgs) > 0 {
+		for _, e := range m.BuildArgs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DockerStrategyOptions) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.BuildArgs) > 0 {
+		for _, e := range m.BuildArgs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2823,6 +3343,10 @@ func (m *GitHubWebHookCause) Size() (n int) {
 	}
 	l = len(m.Secret)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2954,6 +3478,24 @@ func (m *ProxyConfig) Size() (n int) {
 	return n
 }
 
+func (m *PullRequestBuildPolicy) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Output)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *PullRequestCause) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Number))
+	l = len(m.Ref)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SecretBuildSource) Size() (n int) {
 	var l int
 	_ = l
@@ -3030,12 +3572,27 @@ func (m *SourceRevision) Size() (n int) {
 	return n
 }
 
+func (m *StageInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DurationMilliseconds))
+	return n
+}
+
 func (m *WebHookTrigger) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Secret)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if m.PullRequests != nil {
+		l = m.PullRequests.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3052,6 +3609,17 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *AdditionalBuildOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdditionalBuildOutput{`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "ObjectReference", "k8s_io_kubernetes_pkg_api_v1.ObjectReference", 1) + `,`,
+		`PushSecret:` + strings.Replace(fmt.Sprintf("%v", this.PushSecret), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *BinaryBuildRequestOptions) String() string {
 	if this == nil {
 		return "nil"
@@ -3122,6 +3690,9 @@ func (this *BuildConfigSpec) String() string {
 		`Triggers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Triggers), "BuildTriggerPolicy", "BuildTriggerPolicy", 1), `&`, ``, 1) + `,`,
 		`RunPolicy:` + fmt.Sprintf("%v", this.RunPolicy) + `,`,
 		`CommonSpec:` + strings.Replace(strings.Replace(this.CommonSpec.String(), "CommonSpec", "CommonSpec", 1), `&`, ``, 1) + `,`,
+		`StatusReporting:` + strings.Replace(fmt.Sprintf("%v", this.StatusReporting), "BuildStatusReporting", "BuildStatusReporting", 1) + `,`,
+		`SuccessfulBuildsHistoryLimit:` + valueToStringGenerated(this.SuccessfulBuildsHistoryLimit) + `,`,
+		`FailedBuildsHistoryLimit:` + valueToStringGenerated(this.FailedBuildsHistoryLimit) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3183,6 +3754,7 @@ func (this *BuildOutput) String() string {
 		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "ObjectReference", "k8s_io_kubernetes_pkg_api_v1.ObjectReference", 1) + `,`,
 		`PushSecret:` + strings.Replace(fmt.Sprintf("%v", this.PushSecret), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1) + `,`,
 		`ImageLabels:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ImageLabels), "ImageLabel", "ImageLabel", 1), `&`, ``, 1) + `,`,
+		`AdditionalOutputs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AdditionalOutputs), "AdditionalBuildOutput", "AdditionalBuildOutput", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3212,6 +3784,7 @@ func (this *BuildRequest) String() string {
 		`LastVersion:` + valueToStringGenerated(this.LastVersion) + `,`,
 		`Env:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Env), "EnvVar", "k8s_io_kubernetes_pkg_api_v1.EnvVar", 1), `&`, ``, 1) + `,`,
 		`TriggeredBy:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredBy), "BuildTriggerCause", "BuildTriggerCause", 1), `&`, ``, 1) + `,`,
+		`DockerStrategyOptions:` + strings.Replace(fmt.Sprintf("%v", this.DockerStrategyOptions), "DockerStrategyOptions", "DockerStrategyOptions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3229,6 +3802,7 @@ func (this *BuildSource) String() string {
 		`ContextDir:` + fmt.Sprintf("%v", this.ContextDir) + `,`,
 		`SourceSecret:` + strings.Replace(fmt.Sprintf("%v", this.SourceSecret), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1) + `,`,
 		`Secrets:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Secrets), "SecretBuildSource", "SecretBuildSource", 1), `&`, ``, 1) + `,`,
+		`ConfigMaps:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ConfigMaps), "ConfigMapBuildSource", "ConfigMapBuildSource", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3259,6 +3833,18 @@ func (this *BuildStatus) String() string {
 		`OutputDockerImageReference:` + fmt.Sprintf("%v", this.OutputDockerImageReference) + `,`,
 		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "ObjectReference", "k8s_io_kubernetes_pkg_api_v1.ObjectReference", 1) + `,`,
 		`Output:` + strings.Replace(strings.Replace(this.Output.String(), "BuildStatusOutput", "BuildStatusOutput", 1), `&`, ``, 1) + `,`,
+		`Stages:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Stages), "StageInfo", "StageInfo", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BuildStatusAdditionalOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BuildStatusAdditionalOutput{`,
+		`DockerImageReference:` + fmt.Sprintf("%v", this.DockerImageReference) + `,`,
+		`ImageDigest:` + fmt.Sprintf("%v", this.ImageDigest) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3269,6 +3855,7 @@ func (this *BuildStatusOutput) String() string {
 	}
 	s := strings.Join([]string{`&BuildStatusOutput{`,
 		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "BuildStatusOutputTo", "BuildStatusOutputTo", 1) + `,`,
+		`AdditionalOutputs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AdditionalOutputs), "BuildStatusAdditionalOutput", "BuildStatusAdditionalOutput", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3279,6 +3866,20 @@ func (this *BuildStatusOutputTo) String() string {
 	}
 	s := strings.Join([]string{`&BuildStatusOutputTo{`,
 		`ImageDigest:` + fmt.Sprintf("%v", this.ImageDigest) + `,`,
+		`ImageSizeBytes:` + fmt.Sprintf("%v", this.ImageSizeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BuildStatusReporting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BuildStatusReporting{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`APIURL:` + fmt.Sprintf("%v", this.APIURL) + `,`,
+		`Secret:` + strings.Replace(strings.Replace(this.Secret.String(), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1), `&`, ``, 1) + `,`,
+		`Context:` + fmt.Sprintf("%v", this.Context) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3341,11 +3942,22 @@ func (this *CommonSpec) String() string {
 	}, "")
 	return s
 }
-func (this *CustomBuildStrategy) String() string {
+func (this *ConfigMapBuildSource) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&CustomBuildStrategy{`,
+	s := strings.Join([]string{`&ConfigMapBuildSource{`,
+		`ConfigMap:` + strings.Replace(strings.Replace(this.ConfigMap.String(), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1), `&`, ``, 1) + `,`,
+		`DestinationDir:` + fmt.Sprintf("%v", this.DestinationDir) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CustomBuildStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CustomBuildStrategy{`,
 		`From:` + strings.Replace(strings.Replace(this.From.String(), "ObjectReference", "k8s_io_kubernetes_pkg_api_v1.ObjectReference", 1), `&`, ``, 1) + `,`,
 		`PullSecret:` + strings.Replace(fmt.Sprintf("%v", this.PullSecret), "LocalObjectReference", "k8s_io_kubernetes_pkg_api_v1.LocalObjectReference", 1) + `,`,
 		`Env:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Env), "EnvVar", "k8s_io_kubernetes_pkg_api_v1.EnvVar", 1), `&`, ``, 1) + `,`,
@@ -3368,6 +3980,17 @@ func (this *DockerBuildStrategy) String() string {
 		`Env:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Env), "EnvVar", "k8s_io_kubernetes_pkg_api_v1.EnvVar", 1), `&`, ``, 1) + `,`,
 		`ForcePull:` + fmt.Sprintf("%v", this.ForcePull) + `,`,
 		`DockerfilePath:` + fmt.Sprintf("%v", this.DockerfilePath) + `,`,
+		`BuildArgs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.BuildArgs), "EnvVar", "k8s_io_kubernetes_pkg_api_v1.EnvVar", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DockerStrategyOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DockerStrategyOptions{`,
+		`BuildArgs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.BuildArgs), "EnvVar", "k8s_io_kubernetes_pkg_api_v1.EnvVar", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3414,6 +4037,7 @@ func (this *GitHubWebHookCause) String() string {
 	s := strings.Join([]string{`&GitHubWebHookCause{`,
 		`Revision:` + strings.Replace(fmt.Sprintf("%v", this.Revision), "SourceRevision", "SourceRevision", 1) + `,`,
 		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`PullRequest:` + strings.Replace(fmt.Sprintf("%v", this.PullRequest), "PullRequestCause", "PullRequestCause", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3521,6 +4145,28 @@ func (this *ProxyConfig) String() string {
 	}, "")
 	return s
 }
+func (this *PullRequestBuildPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PullRequestBuildPolicy{`,
+		`Output:` + fmt.Sprintf("%v", this.Output) + `,`,
+		`AllowForks:` + fmt.Sprintf("%v", this.AllowForks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PullRequestCause) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PullRequestCause{`,
+		`Number:` + fmt.Sprintf("%v", this.Number) + `,`,
+		`Ref:` + fmt.Sprintf("%v", this.Ref) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SecretBuildSource) String() string {
 	if this == nil {
 		return "nil"
@@ -3582,6 +4228,18 @@ func (this *SourceRevision) String() string {
 	}, "")
 	return s
 }
+func (this *StageInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StageInfo{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`StartTime:` + strings.Replace(strings.Replace(this.StartTime.String(), "Time", "k8s_io_kubernetes_pkg_api_unversioned.Time", 1), `&`, ``, 1) + `,`,
+		`DurationMilliseconds:` + fmt.Sprintf("%v", this.DurationMilliseconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WebHookTrigger) String() string {
 	if this == nil {
 		return "nil"
@@ -3589,6 +4247,7 @@ func (this *WebHookTrigger) String() string {
 	s := strings.Join([]string{`&WebHookTrigger{`,
 		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
 		`AllowEnv:` + fmt.Sprintf("%v", this.AllowEnv) + `,`,
+		`PullRequests:` + strings.Replace(fmt.Sprintf("%v", this.PullRequests), "PullRequestBuildPolicy", "PullRequestBuildPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3601,6 +4260,122 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *AdditionalBuildOutput) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdditionalBuildOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdditionalBuildOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &k8s_io_kubernetes_pkg_api_v1.ObjectReference{}
+			}
+			if err := m.To.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PushSecret == nil {
+				m.PushSecret = &k8s_io_kubernetes_pkg_api_v1.LocalObjectReference{}
+			}
+			if err := m.PushSecret.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BinaryBuildRequestOptions) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -4473,6 +5248,79 @@ func (m *BuildConfigSpec) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusReporting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatusReporting == nil {
+				m.StatusReporting = &BuildStatusReporting{}
+			}
+			if err := m.StatusReporting.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulBuildsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulBuildsHistoryLimit = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedBuildsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedBuildsHistoryLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -5122,6 +5970,37 @@ func (m *BuildOutput) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalOutputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalOutputs = append(m.AdditionalOutputs, AdditionalBuildOutput{})
+			if err := m.AdditionalOutputs[len(m.AdditionalOutputs)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -5553,19 +6432,52 @@ func (m *BuildRequest) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerStrategyOptions", wireType)
 			}
-			iNdEx += skippy
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DockerStrategyOptions == nil {
+				m.DockerStrategyOptions = &DockerStrategyOptions{}
+			}
+			if err := m.DockerStrategyOptions.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -5852,6 +6764,37 @@ func (m *BuildSource) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMaps = append(m.ConfigMaps, ConfigMapBuildSource{})
+			if err := m.ConfigMaps[len(m.ConfigMaps)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -6297,6 +7240,145 @@ func (m *BuildStatus) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, StageInfo{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildStatusAdditionalOutput) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildStatusAdditionalOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildStatusAdditionalOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerImageReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DockerImageReference = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageDigest = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -6380,6 +7462,37 @@ func (m *BuildStatusOutput) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalOutputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalOutputs = append(m.AdditionalOutputs, BuildStatusAdditionalOutput{})
+			if err := m.AdditionalOutputs[len(m.AdditionalOutputs)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -6459,6 +7572,25 @@ func (m *BuildStatusOutputTo) Unmarshal(data []byte) error {
 			}
 			m.ImageDigest = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageSizeBytes", wireType)
+			}
+			m.ImageSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ImageSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -6480,7 +7612,7 @@ func (m *BuildStatusOutputTo) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *BuildStrategy) Unmarshal(data []byte) error {
+func (m *BuildStatusReporting) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6503,15 +7635,15 @@ func (m *BuildStrategy) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildStatusReporting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildStatusReporting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6536,13 +7668,13 @@ func (m *BuildStrategy) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = BuildStrategyType(data[iNdEx:postIndex])
+			m.Provider = BuildStatusReportingProvider(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DockerStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6552,15 +7684,182 @@ func (m *BuildStrategy) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIURL = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Secret.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuildStrategy) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = BuildStrategyType(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
@@ -7362,6 +8661,115 @@ func (m *CommonSpec) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *ConfigMapBuildSource) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMapBuildSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMapBuildSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConfigMap.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationDir = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CustomBuildStrategy) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -7723,7 +9131,87 @@ func (m *DockerBuildStrategy) Unmarshal(data []byte) error {
 			m.NoCache = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, k8s_io_kubernetes_pkg_api_v1.EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcePull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForcePull = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerfilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DockerfilePath = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildArgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7747,36 +9235,66 @@ func (m *DockerBuildStrategy) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, k8s_io_kubernetes_pkg_api_v1.EnvVar{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.BuildArgs = append(m.BuildArgs, k8s_io_kubernetes_pkg_api_v1.EnvVar{})
+			if err := m.BuildArgs[len(m.BuildArgs)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForcePull", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ForcePull = bool(v != 0)
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DockerStrategyOptions) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DockerStrategyOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DockerStrategyOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DockerfilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildArgs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7786,20 +9304,22 @@ func (m *DockerBuildStrategy) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DockerfilePath = string(data[iNdEx:postIndex])
+			m.BuildArgs = append(m.BuildArgs, k8s_io_kubernetes_pkg_api_v1.EnvVar{})
+			if err := m.BuildArgs[len(m.BuildArgs)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8306,6 +9826,39 @@ func (m *GitHubWebHookCause) Unmarshal(data []byte) error {
 			}
 			m.Secret = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestCause{}
+			}
+			if err := m.PullRequest.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -9422,20 +10975,160 @@ func (m *OptionalNodeSelector) Unmarshal(data []byte) error {
 					break
 				}
 			}
-			intStringLenmapvalue := int(stringLenmapvalue)
-			if intStringLenmapvalue < 0 {
+			intStringLenmapvalue := int(stringLenmapvalue)
+			if intStringLenmapvalue < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+			if postStringIndexmapvalue > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
+			iNdEx = postStringIndexmapvalue
+			if *m == nil {
+				*m = make(map[string]string)
+			}
+			(*m)[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProxyConfig) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPProxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.HTTPProxy = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPSProxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.HTTPSProxy = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-			if postStringIndexmapvalue > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapvalue := string(data[iNdEx:postStringIndexmapvalue])
-			iNdEx = postStringIndexmapvalue
-			if *m == nil {
-				*m = make(map[string]string)
-			}
-			(*m)[mapkey] = mapvalue
+			s := string(data[iNdEx:postIndex])
+			m.NoProxy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9458,7 +11151,7 @@ func (m *OptionalNodeSelector) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ProxyConfig) Unmarshal(data []byte) error {
+func (m *PullRequestBuildPolicy) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9481,15 +11174,15 @@ func (m *ProxyConfig) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProxyConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestBuildPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProxyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestBuildPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTPProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9514,14 +11207,13 @@ func (m *ProxyConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.HTTPProxy = &s
+			m.Output = PullRequestOutputPolicy(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTPSProxy", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowForks", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9531,25 +11223,84 @@ func (m *ProxyConfig) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.AllowForks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.HTTPSProxy = &s
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestCause) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestCause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestCause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Number |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9574,8 +11325,7 @@ func (m *ProxyConfig) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(data[iNdEx:postIndex])
-			m.NoProxy = &s
+			m.Ref = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10314,6 +12064,134 @@ func (m *SourceRevision) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *StageInfo) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = StageName(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMilliseconds", wireType)
+			}
+			m.DurationMilliseconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DurationMilliseconds |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WebHookTrigger) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -10392,6 +12270,39 @@ func (m *WebHookTrigger) Unmarshal(data []byte) error {
 				}
 			}
 			m.AllowEnv = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequests == nil {
+				m.PullRequests = &PullRequestBuildPolicy{}
+			}
+			if err := m.PullRequests.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -10519,213 +12430,251 @@ var (
 )
 
 var fileDescriptorGenerated = []byte{
-	// 3327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe4, 0x5b, 0x49, 0x6c, 0x1c, 0xc7,
-	0xd5, 0x56, 0xcf, 0x90, 0x33, 0xc3, 0x37, 0x14, 0x97, 0xa2, 0x6c, 0x8d, 0x68, 0x9b, 0x23, 0xb7,
-	0x17, 0xd8, 0xb0, 0x3c, 0x84, 0x64, 0xcb, 0xbf, 0xbc, 0x9b, 0x33, 0xa4, 0x64, 0xca, 0x94, 0xc4,
-	0xbf, 0x48, 0x6f, 0xfa, 0xf1, 0xff, 0x3f, 0x9a, 0xcd, 0x9a, 0x61, 0x9b, 0x3d, 0xdd, 0xe3, 0xae,
-	0x9a, 0xb1, 0x08, 0xc4, 0x80, 0x91, 0x20, 0x80, 0x73, 0xcb, 0xe2, 0x83, 0x2f, 0x41, 0x62, 0x20,
-	0x0b, 0x82, 0x1c, 0x82, 0x2c, 0x87, 0x00, 0xb9, 0x24, 0x40, 0x0e, 0x3e, 0x05, 0x3e, 0xe6, 0x10,
-	0x0c, 0x22, 0xfa, 0x90, 0x73, 0xae, 0x3a, 0x05, 0xb5, 0x74, 0x77, 0x75, 0xcf, 0x90, 0xd6, 0x34,
-	0x45, 0x23, 0x40, 0x2e, 0x02, 0xe7, 0xbd, 0x57, 0xdf, 0xab, 0x7a, 0xf5, 0xaa, 0xde, 0xd2, 0x25,
-	0x78, 0xa9, 0xe5, 0xb0, 0x9d, 0xee, 0x56, 0xcd, 0xf6, 0xdb, 0x8b, 0x7e, 0x87, 0x78, 0x74, 0xc7,
-	0x69, 0xb2, 0x45, 0x3f, 0x70, 0x5a, 0x8e, 0xb7, 0xd8, 0xd9, 0x6d, 0x2d, 0x6e, 0x75, 0x1d, 0x77,
-	0x7b, 0xd1, 0xea, 0x38, 0x8b, 0xbd, 0xf3, 0x8b, 0x2d, 0xe2, 0x91, 0xc0, 0x62, 0x64, 0xbb, 0xd6,
-	0x09, 0x7c, 0xe6, 0xa3, 0x73, 0xf1, 0xe8, 0x5a, 0x34, 0xba, 0x26, 0x47, 0xd7, 0x3a, 0xbb, 0xad,
-	0x9a, 0x18, 0x5d, 0xb3, 0x3a, 0x4e, 0xad, 0x77, 0x7e, 0xfe, 0x69, 0x4d, 0x57, 0xcb, 0x6f, 0xf9,
-	0x8b, 0x02, 0x64, 0xab, 0xdb, 0x14, 0xbf, 0xc4, 0x0f, 0xf1, 0x97, 0x04, 0x9f, 0xbf, 0xb8, 0x7b,
-	0x89, 0xd6, 0x1c, 0x7f, 0x71, 0xb7, 0xbb, 0x45, 0x02, 0x8f, 0x30, 0x42, 0xc5, 0x84, 0xf8, 0x54,
-	0xba, 0x5e, 0x8f, 0x04, 0xd4, 0xf1, 0x3d, 0xb2, 0x9d, 0x9e, 0xd3, 0xfc, 0xb9, 0x83, 0x87, 0x0d,
-	0xae, 0x60, 0xfe, 0xe9, 0xe1, 0xd2, 0x41, 0xd7, 0x63, 0x4e, 0x9b, 0x0c, 0x88, 0x9f, 0x1f, 0x2e,
-	0xde, 0x65, 0x8e, 0xbb, 0xe8, 0x78, 0x8c, 0xb2, 0x20, 0x3d, 0xc4, 0xfc, 0xfd, 0x18, 0x9c, 0xa9,
-	0x3b, 0x9e, 0x15, 0xec, 0xd5, 0xb9, 0x31, 0x30, 0x79, 0xbf, 0x4b, 0x28, 0xbb, 0xd1, 0x61, 0x8e,
-	0xef, 0x51, 0xf4, 0x0e, 0x94, 0xda, 0x84, 0x59, 0xdb, 0x16, 0xb3, 0x2a, 0xc6, 0x59, 0xe3, 0x89,
-	0xf2, 0x85, 0x27, 0x6a, 0x52, 0x47, 0x2d, 0xd6, 0x21, 0x4c, 0x29, 0x8d, 0x58, 0xbb, 0xb1, 0xf5,
-	0x1e, 0xb1, 0xd9, 0x35, 0xc2, 0xac, 0x3a, 0xfa, 0xbc, 0x5f, 0x3d, 0xb1, 0xdf, 0xaf, 0x42, 0x4c,
-	0xc3, 0x11, 0x1a, 0x7a, 0x1c, 0x0a, 0x16, 0xbd, 0xec, 0xb8, 0xa4, 0x92, 0x3b, 0x6b, 0x3c, 0x31,
-	0x51, 0x9f, 0x52, 0xd2, 0x85, 0x25, 0x41, 0xc5, 0x8a, 0x8b, 0x9e, 0x83, 0xa9, 0x80, 0xf4, 0x1c,
-	0x6e, 0xcd, 0x86, 0xdf, 0x6e, 0x3b, 0xac, 0x92, 0x4f, 0xca, 0x4b, 0x2a, 0x4e, 0x49, 0xa1, 0xe7,
-	0x61, 0x3a, 0xa4, 0x5c, 0x23, 0x94, 0x5a, 0x2d, 0x52, 0x19, 0x13, 0x03, 0xa7, 0xd5, 0xc0, 0xa2,
-	0x22, 0xe3, 0xb4, 0x1c, 0xaa, 0x03, 0x0a, 0x49, 0x4b, 0x5d, 0xb6, 0xe3, 0x07, 0xd7, 0xad, 0x36,
-	0xa9, 0x8c, 0x8b, 0xd1, 0xd1, 0xa2, 0x62, 0x0e, 0x1e, 0x22, 0x8d, 0x56, 0x60, 0x2e, 0x49, 0x5d,
-	0x69, 0x5b, 0x8e, 0x5b, 0x29, 0x08, 0x90, 0x39, 0x05, 0x52, 0xd6, 0x58, 0x78, 0x98, 0x3c, 0x7a,
-	0x03, 0xee, 0x4b, 0xae, 0x8b, 0x11, 0x39, 0x9b, 0xa2, 0x00, 0xba, 0x4f, 0x01, 0x9d, 0x4c, 0x30,
-	0xf1, 0xf0, 0x31, 0xe8, 0x3a, 0xdc, 0x3f, 0xc0, 0x90, 0xd3, 0x2a, 0x09, 0xb4, 0xfb, 0x15, 0xda,
-	0x54, 0x92, 0x8b, 0x0f, 0x18, 0x65, 0xbe, 0x08, 0xb3, 0x9a, 0xe7, 0x6c, 0xf8, 0xdd, 0xc0, 0x26,
-	0xda, 0xbe, 0x1a, 0x87, 0xed, 0xab, 0xf9, 0xa3, 0x1c, 0x8c, 0x8b, 0x71, 0xc7, 0xe8, 0x63, 0xef,
-	0xc2, 0x18, 0xed, 0x10, 0x5b, 0x78, 0x58, 0xf9, 0xc2, 0x7f, 0xd5, 0x46, 0xb9, 0x0e, 0x6a, 0x72,
-	0x51, 0x1d, 0x62, 0xd7, 0x27, 0x95, 0x92, 0x31, 0xfe, 0x0b, 0x0b, 0x48, 0x64, 0x41, 0x81, 0x32,
-	0x8b, 0x75, 0xa9, 0x70, 0xc7, 0xf2, 0x85, 0xe7, 0xb3, 0x80, 0x0b, 0x80, 0xd8, 0x42, 0xf2, 0x37,
-	0x56, 0xc0, 0xe6, 0xaf, 0x73, 0x50, 0x16, 0x72, 0x0d, 0xdf, 0x6b, 0x3a, 0xad, 0x63, 0xb4, 0xd3,
-	0xff, 0x27, 0xec, 0xf4, 0x72, 0x86, 0xa5, 0xc8, 0x29, 0x1e, 0x68, 0xad, 0x56, 0xca, 0x5a, 0xaf,
-	0x66, 0x57, 0x71, 0xb8, 0xcd, 0xbe, 0x30, 0x60, 0x5a, 0x93, 0x5e, 0x73, 0x28, 0x43, 0xff, 0x3b,
-	0x60, 0xb7, 0xc5, 0x43, 0xec, 0xa6, 0xdd, 0xdd, 0x35, 0x3e, 0x5c, 0x98, 0x6f, 0x46, 0xa9, 0x2b,
-	0x85, 0x14, 0xcd, 0x78, 0xff, 0x07, 0xe3, 0x0e, 0x23, 0x6d, 0x5a, 0xc9, 0x9d, 0xcd, 0x67, 0x74,
-	0x04, 0x39, 0xd9, 0xfa, 0x49, 0xa5, 0x65, 0x7c, 0x95, 0xe3, 0x61, 0x09, 0x6b, 0xfe, 0x26, 0x97,
-	0x58, 0x12, 0xb7, 0x2a, 0xf2, 0xa0, 0xc4, 0x02, 0xa7, 0xd5, 0x22, 0x01, 0xad, 0x18, 0x42, 0xed,
-	0x6b, 0x19, 0xd4, 0x6e, 0x4a, 0x88, 0x75, 0xdf, 0x75, 0xec, 0xbd, 0x78, 0x8d, 0x8a, 0x4c, 0x71,
-	0xa4, 0x03, 0x2d, 0xc1, 0x44, 0xd0, 0xf5, 0xa4, 0xa0, 0xba, 0xaf, 0x1f, 0x51, 0xe2, 0x13, 0x38,
-	0x64, 0xdc, 0xe9, 0x57, 0xa7, 0x64, 0x0c, 0x09, 0x29, 0x38, 0x1e, 0x85, 0x5c, 0x00, 0xdb, 0x6f,
-	0xb7, 0x7d, 0x8f, 0x2f, 0x40, 0xb9, 0xc1, 0xa5, 0xd1, 0x26, 0xdd, 0x88, 0xc6, 0xc7, 0xfe, 0x1c,
-	0xd3, 0xb0, 0x86, 0x6f, 0x5e, 0x85, 0xd9, 0x01, 0xa7, 0x41, 0x17, 0xa1, 0xec, 0x5a, 0x94, 0xbd,
-	0x25, 0xf7, 0x57, 0xf8, 0x42, 0x3e, 0xbe, 0x8b, 0xd7, 0x62, 0x16, 0xd6, 0xe5, 0xcc, 0x3f, 0x1b,
-	0x30, 0x21, 0xc0, 0xbe, 0x0e, 0x6f, 0x7a, 0x27, 0xe9, 0x4d, 0xcf, 0x64, 0xd8, 0xd6, 0x03, 0xfc,
-	0x08, 0xa0, 0x24, 0x57, 0xe1, 0xb7, 0xcc, 0x8f, 0xc7, 0x94, 0x4f, 0xad, 0xf9, 0xad, 0x30, 0xd4,
-	0x2f, 0xc2, 0x84, 0xed, 0x7b, 0xcc, 0x72, 0x3c, 0x12, 0xa8, 0xbb, 0x7b, 0x36, 0xdc, 0xe3, 0x46,
-	0xc8, 0xc0, 0xb1, 0x0c, 0xbf, 0xe9, 0x9b, 0xbe, 0xeb, 0xfa, 0x1f, 0x08, 0x8f, 0x28, 0xc5, 0x67,
-	0xf2, 0xb2, 0xa0, 0x62, 0xc5, 0x45, 0xe7, 0xa0, 0xd4, 0xe1, 0x11, 0xc4, 0x57, 0xc7, 0xbf, 0x14,
-	0x1b, 0x60, 0x5d, 0xd1, 0x71, 0x24, 0x81, 0x9e, 0x85, 0x49, 0xea, 0x78, 0x36, 0xd9, 0x20, 0xb6,
-	0xef, 0x6d, 0x53, 0x11, 0xb4, 0xf3, 0xf5, 0x99, 0xfd, 0x7e, 0x75, 0x72, 0x43, 0xa3, 0xe3, 0x84,
-	0x14, 0x7a, 0x07, 0x26, 0xc4, 0xef, 0x4d, 0x47, 0x45, 0xea, 0xf2, 0x85, 0xa7, 0xee, 0x72, 0x5b,
-	0xf8, 0x90, 0xfa, 0x49, 0xbe, 0xca, 0x8d, 0x10, 0x01, 0xc7, 0x60, 0xe8, 0x02, 0x00, 0x4f, 0xb5,
-	0x28, 0xb3, 0xda, 0x1d, 0x2a, 0xe2, 0x77, 0x29, 0xf6, 0xbe, 0xcd, 0x88, 0x83, 0x35, 0x29, 0xf4,
-	0x14, 0x4c, 0x30, 0xcb, 0x71, 0xd7, 0x1c, 0x8f, 0x50, 0x11, 0xa9, 0xf3, 0x52, 0xc1, 0x66, 0x48,
-	0xc4, 0x31, 0x1f, 0xd5, 0x00, 0x5c, 0xa7, 0xed, 0xb0, 0xfa, 0x1e, 0x23, 0x54, 0x44, 0xe2, 0x7c,
-	0x7d, 0x8a, 0x83, 0xaf, 0x45, 0x54, 0xac, 0x49, 0x70, 0xb3, 0x7b, 0xfe, 0x07, 0x96, 0xc3, 0x2a,
-	0x13, 0x49, 0xb3, 0x5f, 0xf7, 0xdf, 0xb6, 0x1c, 0x86, 0x15, 0x17, 0x3d, 0x06, 0x45, 0xb5, 0xc8,
-	0x0a, 0x08, 0xd0, 0x32, 0x4f, 0x7a, 0x42, 0x0f, 0x0f, 0x79, 0xe6, 0x2f, 0xc2, 0x28, 0x73, 0xa3,
-	0xcb, 0x3a, 0x5d, 0x86, 0x56, 0x20, 0xc7, 0x7c, 0xe5, 0xd9, 0x4f, 0xdf, 0x4d, 0x7c, 0xc1, 0xa4,
-	0x49, 0x02, 0xe2, 0xd9, 0xa4, 0x5e, 0xd8, 0xef, 0x57, 0x73, 0x9b, 0x3e, 0xce, 0x31, 0x1f, 0x6d,
-	0x01, 0x74, 0xba, 0x74, 0x67, 0x83, 0xd8, 0x01, 0x61, 0x2a, 0xb0, 0x5c, 0x38, 0x1c, 0x6e, 0xcd,
-	0xb7, 0x2d, 0x37, 0x8d, 0x29, 0x2c, 0xb1, 0x1e, 0x21, 0x61, 0x0d, 0x15, 0xf9, 0x50, 0x76, 0xda,
-	0x56, 0x8b, 0xac, 0x59, 0x5b, 0xc4, 0xe5, 0xbe, 0x95, 0x1f, 0xfd, 0x4e, 0x59, 0x8d, 0x00, 0xe2,
-	0x9b, 0x20, 0xa6, 0x51, 0xac, 0x6b, 0x30, 0xbf, 0x69, 0xc0, 0x9c, 0xb0, 0xd5, 0xba, 0x4f, 0x99,
-	0x4c, 0x86, 0xc4, 0x75, 0xfc, 0x18, 0x14, 0xf9, 0xdd, 0x63, 0x79, 0xdb, 0xe2, 0x36, 0x9e, 0x90,
-	0xa6, 0x6e, 0x48, 0x12, 0x0e, 0x79, 0xe8, 0x41, 0x18, 0xb3, 0x82, 0x96, 0x3c, 0xda, 0x13, 0xf5,
-	0x12, 0x8f, 0x91, 0x4b, 0x41, 0x8b, 0x62, 0x41, 0xe5, 0xfb, 0x4a, 0xed, 0xc0, 0xe9, 0x0c, 0x24,
-	0xb8, 0x1b, 0x82, 0x8a, 0x15, 0xd7, 0xfc, 0x72, 0x1c, 0x26, 0xf5, 0x54, 0xfd, 0x18, 0xf3, 0x82,
-	0x26, 0x94, 0xc2, 0xd4, 0x4f, 0x6d, 0xe1, 0x4b, 0xa3, 0x59, 0x57, 0xe6, 0x84, 0x58, 0x61, 0xd4,
-	0x27, 0xf9, 0x99, 0x0f, 0x7f, 0xe1, 0x08, 0x1b, 0xf9, 0x30, 0xa3, 0x42, 0x0d, 0xd9, 0xae, 0xef,
-	0x09, 0xf3, 0xab, 0x08, 0x31, 0xa2, 0x07, 0x9e, 0xda, 0xef, 0x57, 0x67, 0x36, 0x53, 0x50, 0x78,
-	0x00, 0x1c, 0xbd, 0x01, 0x63, 0xcd, 0xc0, 0x6f, 0x8b, 0xcb, 0x65, 0x64, 0x25, 0x62, 0xe3, 0x2e,
-	0x07, 0x7e, 0x1b, 0x0b, 0x10, 0x64, 0x43, 0x61, 0x4b, 0xa4, 0xc1, 0xea, 0xe2, 0x19, 0x35, 0xb9,
-	0x49, 0xa7, 0xd0, 0x75, 0xe0, 0xbb, 0x2e, 0xc9, 0x58, 0x41, 0xa3, 0xf3, 0xc9, 0xd8, 0x55, 0x10,
-	0x27, 0x7a, 0xfa, 0xb0, 0xb8, 0x85, 0x1a, 0x90, 0x27, 0x5e, 0xaf, 0x52, 0x14, 0xc7, 0xe2, 0xd1,
-	0xc3, 0xd7, 0xb8, 0xe2, 0xf5, 0xde, 0xb2, 0x82, 0x7a, 0x59, 0xb9, 0x43, 0x7e, 0xc5, 0xeb, 0x61,
-	0x3e, 0x1a, 0xf5, 0xa0, 0xac, 0x59, 0xaf, 0x52, 0x12, 0x60, 0xaf, 0x66, 0x4f, 0x36, 0x1a, 0x56,
-	0x97, 0x92, 0xf8, 0xa8, 0x69, 0x7b, 0x85, 0x75, 0x45, 0xe6, 0x0f, 0xc7, 0xd5, 0xb5, 0xa4, 0xca,
-	0x8a, 0x67, 0x60, 0x8c, 0xed, 0x75, 0xc2, 0xa2, 0xa2, 0x1a, 0xe6, 0x98, 0x9b, 0x7b, 0x1d, 0x72,
-	0xa7, 0x5f, 0x9d, 0xd6, 0x44, 0x39, 0x09, 0x0b, 0x61, 0x6d, 0x67, 0x72, 0xc7, 0xb7, 0x33, 0x35,
-	0x80, 0x6d, 0xdf, 0xde, 0x25, 0x41, 0x93, 0x17, 0x3d, 0xea, 0xec, 0xf2, 0x23, 0xb5, 0x1c, 0x51,
-	0xb1, 0x26, 0x81, 0xde, 0x86, 0x7c, 0xcb, 0x61, 0xca, 0xf5, 0x46, 0x3c, 0x4f, 0x57, 0x1c, 0xa6,
-	0x4f, 0xa7, 0xc8, 0xb7, 0xea, 0x8a, 0xc3, 0x30, 0x47, 0xe4, 0x25, 0x89, 0xb8, 0xac, 0x68, 0x65,
-	0x3c, 0x4b, 0x26, 0x2a, 0x4e, 0x86, 0x02, 0x8e, 0xee, 0x1e, 0x41, 0xa4, 0x58, 0x01, 0xf3, 0x60,
-	0xc8, 0xe3, 0x3f, 0xb9, 0xc5, 0x96, 0x9d, 0x40, 0x15, 0xb3, 0x5a, 0x2a, 0x16, 0x72, 0xb0, 0x26,
-	0x85, 0x76, 0x60, 0x92, 0x0a, 0x54, 0x15, 0x0b, 0x8a, 0x99, 0x63, 0x81, 0x4c, 0x02, 0x34, 0x2c,
-	0x9c, 0x40, 0x46, 0xef, 0x41, 0x91, 0x8a, 0xbf, 0x68, 0x36, 0x3f, 0x95, 0x30, 0xba, 0x81, 0xa3,
-	0x5e, 0x81, 0x64, 0x51, 0x1c, 0x2a, 0x30, 0xff, 0x19, 0x26, 0x85, 0x22, 0x00, 0x24, 0x93, 0x5b,
-	0xe3, 0x78, 0x93, 0xdb, 0xf4, 0x99, 0xcc, 0x7d, 0x5d, 0x67, 0xf2, 0x93, 0x42, 0x78, 0x26, 0x65,
-	0x3e, 0x7d, 0x1e, 0xc6, 0x3b, 0x3b, 0x16, 0x0d, 0x0f, 0xe5, 0x03, 0x61, 0xda, 0xb9, 0xce, 0x89,
-	0x77, 0xfa, 0x55, 0x90, 0xb1, 0x92, 0xff, 0xc2, 0x52, 0x52, 0x24, 0x99, 0x96, 0x67, 0x13, 0xd7,
-	0x25, 0xdb, 0x2a, 0x6d, 0x8c, 0x93, 0xcc, 0x90, 0x81, 0x63, 0x19, 0xf4, 0x1c, 0x14, 0x02, 0x62,
-	0x51, 0xdf, 0x53, 0x27, 0x6b, 0x21, 0xf4, 0x4c, 0x2c, 0xa8, 0x77, 0xb8, 0x47, 0xc8, 0x12, 0x50,
-	0xfc, 0xc6, 0x4a, 0x1a, 0x3d, 0x09, 0xc5, 0xf6, 0xe1, 0x6d, 0x9f, 0x90, 0x8f, 0x5a, 0x30, 0x45,
-	0x99, 0x15, 0xb0, 0x28, 0x99, 0xcb, 0x92, 0x40, 0xa2, 0xfd, 0x7e, 0x75, 0x6a, 0x23, 0x01, 0x83,
-	0x53, 0xb0, 0xa8, 0x07, 0x73, 0xb6, 0xdf, 0xee, 0xb8, 0x84, 0x27, 0xdc, 0xb1, 0xb6, 0xc2, 0xe8,
-	0xda, 0x4e, 0xef, 0xf7, 0xab, 0x73, 0x8d, 0x41, 0x2c, 0x3c, 0x4c, 0x01, 0x7a, 0x19, 0x4a, 0xdb,
-	0xdd, 0xc0, 0xe2, 0x44, 0x95, 0x8d, 0x3e, 0x1c, 0x26, 0xe0, 0xcb, 0x8a, 0x7e, 0xa7, 0x5f, 0x3d,
-	0xc9, 0x13, 0xd8, 0x5a, 0x48, 0xc0, 0xd1, 0x10, 0xb4, 0x05, 0xf3, 0xbe, 0xc8, 0x0d, 0xe5, 0x85,
-	0x26, 0x63, 0x6a, 0x78, 0x28, 0x55, 0xeb, 0xc8, 0x54, 0x80, 0xf3, 0x37, 0x0e, 0x94, 0xc4, 0x87,
-	0xa0, 0xa0, 0xff, 0x86, 0x82, 0x2d, 0x4a, 0x35, 0x91, 0xd4, 0x8e, 0x1c, 0x92, 0x41, 0x36, 0x02,
-	0x39, 0x00, 0x56, 0x40, 0xa8, 0x05, 0x05, 0xa9, 0x50, 0xa4, 0xbf, 0xd9, 0x0e, 0x88, 0x74, 0x2d,
-	0xb9, 0xa6, 0xf8, 0x52, 0x94, 0xbf, 0xb1, 0x82, 0x37, 0x3d, 0x55, 0x6b, 0xea, 0xc2, 0xe8, 0x5d,
-	0x2d, 0x8d, 0x5e, 0x3a, 0xa2, 0xe6, 0x4d, 0x5f, 0x4f, 0xad, 0xcd, 0x35, 0x95, 0x84, 0x26, 0x45,
-	0x78, 0x75, 0x2b, 0x6e, 0xe9, 0x65, 0xa7, 0x45, 0x28, 0x53, 0x67, 0x32, 0x99, 0xd3, 0x4a, 0x16,
-	0xd6, 0xe5, 0xcc, 0x7f, 0x8c, 0xc1, 0x49, 0x05, 0x17, 0x58, 0x8c, 0xb4, 0xf6, 0xd0, 0xc5, 0x44,
-	0xa8, 0x7d, 0x38, 0x15, 0x6a, 0x67, 0x13, 0xc2, 0x5a, 0xb0, 0xfd, 0x10, 0xa6, 0x64, 0x94, 0x0b,
-	0x79, 0x2a, 0xe8, 0x8e, 0xb8, 0x7a, 0xe9, 0x1e, 0x09, 0x25, 0xf2, 0x70, 0x2d, 0x27, 0xc0, 0x71,
-	0x4a, 0x19, 0x57, 0xaf, 0x82, 0x41, 0xa8, 0x3e, 0x9f, 0x45, 0xbd, 0xba, 0xf8, 0x07, 0xd5, 0x6f,
-	0x24, 0xc0, 0x71, 0x4a, 0x19, 0x57, 0x6f, 0x77, 0x29, 0xf3, 0xdb, 0x91, 0xfa, 0xb1, 0x2c, 0xea,
-	0x1b, 0x02, 0x63, 0x88, 0xfa, 0x46, 0x02, 0x1c, 0xa7, 0x94, 0xa1, 0xcf, 0x0c, 0x38, 0xfd, 0x1e,
-	0xf1, 0x76, 0x1d, 0x8f, 0xae, 0x3b, 0x1d, 0xe2, 0x3a, 0x5e, 0x6c, 0x07, 0x79, 0x9b, 0x5d, 0x1d,
-	0x6d, 0x22, 0x57, 0x93, 0x60, 0xc9, 0x19, 0x3d, 0xb0, 0xdf, 0xaf, 0x9e, 0xbe, 0x3a, 0x5c, 0x1d,
-	0x3e, 0x68, 0x1e, 0xe6, 0x1f, 0xf2, 0xea, 0xa0, 0xe8, 0x61, 0x47, 0xbf, 0xa8, 0x8d, 0xaf, 0xb8,
-	0xa8, 0x3f, 0x84, 0x29, 0xf1, 0xf5, 0xc2, 0xb1, 0xdf, 0x26, 0x5b, 0xaf, 0xfb, 0xfe, 0x6e, 0x36,
-	0x0f, 0xbb, 0x92, 0xc0, 0x90, 0xc1, 0x4f, 0xd8, 0x38, 0xc9, 0xc0, 0x29, 0x65, 0x68, 0x0f, 0x4e,
-	0x4a, 0x3d, 0xa1, 0x76, 0xe9, 0x60, 0xaf, 0x8d, 0x9c, 0xc2, 0xbd, 0x1e, 0x41, 0x48, 0xe5, 0xb3,
-	0xfb, 0xfd, 0xea, 0xc9, 0x04, 0x1d, 0x27, 0x35, 0xa1, 0x8f, 0x0c, 0x98, 0x11, 0x87, 0xb6, 0xb1,
-	0x63, 0x79, 0x2d, 0xb9, 0x1b, 0xca, 0xc1, 0x5e, 0xc9, 0x90, 0xe5, 0x49, 0x14, 0xa9, 0x5c, 0x94,
-	0x4c, 0xab, 0x29, 0x6c, 0x3c, 0xa0, 0xcd, 0xfc, 0x24, 0x0f, 0x68, 0xb0, 0x6b, 0x88, 0x9e, 0x4d,
-	0x5c, 0x16, 0x67, 0x53, 0x97, 0xc5, 0x8c, 0x3e, 0x42, 0xbb, 0x2b, 0x5a, 0x50, 0x90, 0xb3, 0xce,
-	0x56, 0x56, 0x2a, 0xb3, 0x28, 0xdc, 0x61, 0xf6, 0x53, 0xf0, 0x3c, 0x25, 0x54, 0xbb, 0xa8, 0x76,
-	0xeb, 0x68, 0x9a, 0x86, 0xb9, 0x49, 0xa8, 0x00, 0x51, 0x75, 0x01, 0x4b, 0xab, 0xa9, 0xed, 0x79,
-	0x2d, 0xf3, 0xf6, 0x84, 0x3a, 0xa7, 0xa3, 0xeb, 0x5b, 0xd2, 0xb1, 0xae, 0xc5, 0xfc, 0xb4, 0x08,
-	0x5a, 0x9a, 0x88, 0x5e, 0x81, 0x29, 0x4a, 0x82, 0x9e, 0x63, 0x93, 0x25, 0xdb, 0xf6, 0xbb, 0x5e,
-	0x18, 0x07, 0xa2, 0x4f, 0x3b, 0x1b, 0x09, 0x2e, 0x4e, 0x49, 0x8b, 0xcf, 0x1a, 0xe2, 0x62, 0x53,
-	0x1b, 0x93, 0xe9, 0xb3, 0x46, 0xaa, 0x86, 0x50, 0x4d, 0x00, 0x05, 0x9c, 0x68, 0x2a, 0xe4, 0x8f,
-	0xb1, 0xa9, 0xe0, 0x40, 0x89, 0x26, 0xef, 0xe2, 0x17, 0x33, 0xc5, 0x61, 0x75, 0xe7, 0x45, 0x3d,
-	0xcb, 0xe8, 0xa2, 0x8b, 0xe0, 0xb9, 0xd5, 0x54, 0xaa, 0x31, 0x9e, 0xd9, 0x6a, 0x87, 0x27, 0x19,
-	0xc8, 0x86, 0x89, 0x80, 0x48, 0x0b, 0x52, 0x95, 0x31, 0x7e, 0x45, 0x09, 0x85, 0x95, 0x38, 0x26,
-	0xef, 0x77, 0x9d, 0x80, 0xb4, 0x89, 0xc7, 0x68, 0x9c, 0x6c, 0x87, 0x5c, 0x8a, 0x63, 0x5c, 0xd4,
-	0x05, 0xe8, 0x44, 0x9d, 0x2d, 0x55, 0xa8, 0x65, 0x49, 0x5e, 0x92, 0xed, 0xb1, 0xb8, 0x9e, 0x89,
-	0xe9, 0x58, 0x53, 0x84, 0xfe, 0x07, 0xce, 0xc4, 0x69, 0xeb, 0x32, 0xb1, 0xb6, 0x45, 0xd8, 0x50,
-	0xfd, 0x5f, 0xd9, 0x10, 0x7d, 0x68, 0xbf, 0x5f, 0x3d, 0xd3, 0x38, 0x48, 0x08, 0x1f, 0x3c, 0x1e,
-	0xdd, 0x82, 0x49, 0xcf, 0xdf, 0x26, 0x1b, 0xc4, 0x25, 0x36, 0xf3, 0x03, 0x95, 0x5f, 0xd6, 0x47,
-	0x5b, 0x95, 0xec, 0x91, 0x5b, 0xee, 0x75, 0x0d, 0x49, 0x96, 0xa3, 0x3a, 0x05, 0x27, 0x34, 0x99,
-	0xbf, 0x1b, 0x83, 0xb9, 0x21, 0xf1, 0x1c, 0xdd, 0x50, 0xcd, 0xa7, 0x4c, 0x3d, 0xd6, 0xe8, 0xeb,
-	0x9a, 0xd6, 0x80, 0x12, 0xbd, 0x56, 0xd7, 0xbd, 0x57, 0xbd, 0xd6, 0x10, 0x09, 0x6b, 0xa8, 0x61,
-	0x33, 0x29, 0x7f, 0xa4, 0x66, 0xd2, 0x55, 0x40, 0xe4, 0x56, 0xc7, 0xa7, 0x44, 0xe5, 0x72, 0xfc,
-	0x5f, 0xd9, 0x09, 0x29, 0xd5, 0xe7, 0x95, 0x34, 0x5a, 0x19, 0x90, 0xc0, 0x43, 0x46, 0xf1, 0x4a,
-	0xb2, 0xe9, 0x07, 0x36, 0xe1, 0xf3, 0x15, 0xc7, 0x4e, 0xab, 0x24, 0x2f, 0x87, 0x0c, 0x1c, 0xcb,
-	0x20, 0x3b, 0xee, 0x0e, 0x14, 0xb2, 0x74, 0x8a, 0xa5, 0x21, 0x84, 0x43, 0x1f, 0xd8, 0x16, 0x40,
-	0x4b, 0x30, 0x2d, 0x06, 0x2d, 0xad, 0xaf, 0x86, 0xad, 0x3a, 0xf9, 0xa5, 0xfe, 0xb4, 0x1a, 0x22,
-	0x3b, 0x55, 0x31, 0x1b, 0xa7, 0xe5, 0xcd, 0x9f, 0xe7, 0x61, 0x6e, 0x48, 0x12, 0x1c, 0xf5, 0x2c,
-	0x8d, 0x7b, 0xd1, 0xb3, 0xfc, 0x3a, 0x5c, 0xe6, 0x49, 0x28, 0x7a, 0x7e, 0xc3, 0xb2, 0x77, 0x88,
-	0xfa, 0xec, 0x13, 0x99, 0xed, 0xba, 0x24, 0xe3, 0x90, 0x1f, 0x7a, 0xd7, 0xd8, 0x91, 0xbc, 0x6b,
-	0x64, 0x8f, 0x78, 0x25, 0xac, 0x58, 0x9a, 0x8e, 0x4b, 0xd6, 0x2d, 0xb6, 0xa3, 0x3a, 0x5a, 0x51,
-	0xb0, 0x5c, 0x4e, 0x70, 0x71, 0x4a, 0xda, 0xfc, 0xa9, 0x01, 0x73, 0x43, 0x92, 0xc9, 0x44, 0x84,
-	0x33, 0x8e, 0x31, 0xc2, 0x3d, 0x0e, 0x05, 0x1a, 0x6f, 0xa0, 0xfe, 0xc5, 0x40, 0x6e, 0x86, 0xe2,
-	0x9a, 0xb7, 0x07, 0xe6, 0xb9, 0xd2, 0x23, 0x1e, 0xcb, 0xd6, 0x53, 0x5d, 0x97, 0xed, 0x4b, 0xe9,
-	0x32, 0x17, 0x47, 0xce, 0x7d, 0x57, 0xbd, 0xa6, 0x9f, 0xea, 0x5b, 0xde, 0x8b, 0xab, 0xc5, 0xfc,
-	0xa3, 0x01, 0x53, 0xc9, 0xee, 0x28, 0x7a, 0x08, 0xf2, 0xdd, 0xc0, 0x51, 0xab, 0x8b, 0x46, 0xbc,
-	0x89, 0x57, 0x31, 0xa7, 0x73, 0x76, 0x40, 0x9a, 0xca, 0x74, 0x11, 0x1b, 0x93, 0x26, 0xe6, 0x74,
-	0xd4, 0x81, 0x72, 0x27, 0xf0, 0x6f, 0xed, 0xc9, 0xae, 0x42, 0xb6, 0x57, 0x1e, 0xeb, 0x31, 0x40,
-	0x5c, 0x89, 0x6b, 0x44, 0xac, 0xab, 0x30, 0x7f, 0x62, 0x00, 0x1a, 0xac, 0x0e, 0xfe, 0xed, 0xbc,
-	0xe9, 0xfb, 0x39, 0x28, 0xaa, 0x8d, 0x44, 0xdf, 0x80, 0xa9, 0x56, 0xc2, 0xe8, 0xd9, 0x66, 0x98,
-	0x6a, 0x6b, 0x47, 0xe7, 0x2f, 0x49, 0xc7, 0x29, 0x5d, 0xe8, 0x63, 0x03, 0x66, 0x5b, 0x0e, 0x4b,
-	0xae, 0x2f, 0x5b, 0xab, 0xff, 0x4a, 0x1a, 0xa6, 0x7e, 0x46, 0x4d, 0x62, 0x76, 0x80, 0x85, 0x07,
-	0x95, 0x9a, 0x7f, 0xca, 0xc1, 0xa0, 0x20, 0x37, 0xa9, 0x2d, 0x73, 0x29, 0x63, 0xe8, 0x9b, 0x35,
-	0xc5, 0xe5, 0xe5, 0x90, 0x25, 0x1e, 0x7d, 0x65, 0x9b, 0xbc, 0xd4, 0xda, 0xf0, 0x3d, 0x16, 0xf8,
-	0xee, 0x9b, 0x94, 0x04, 0xda, 0xa3, 0x2b, 0x01, 0x8b, 0x15, 0x3c, 0xea, 0xc0, 0x84, 0x1d, 0xbe,
-	0xe1, 0xca, 0xf6, 0x14, 0x67, 0x50, 0x97, 0xf6, 0x48, 0x40, 0x21, 0xe3, 0x58, 0xc9, 0x08, 0x7d,
-	0x58, 0xf3, 0x07, 0x06, 0xcc, 0xa4, 0xcb, 0x53, 0x3e, 0x5e, 0x94, 0x3b, 0xab, 0xcb, 0xe9, 0xf6,
-	0xc0, 0xaa, 0x24, 0xe3, 0x90, 0x8f, 0x36, 0xa1, 0xc8, 0x63, 0x1b, 0x56, 0x87, 0x7a, 0xe4, 0x18,
-	0x29, 0x3e, 0xda, 0x5e, 0x96, 0x08, 0x38, 0x84, 0x32, 0x7f, 0x6b, 0x00, 0x1a, 0xac, 0xca, 0xd0,
-	0x3a, 0x9c, 0x72, 0x2d, 0xca, 0xa2, 0x5e, 0xf9, 0x6a, 0x62, 0x92, 0x0f, 0xaa, 0x49, 0x9e, 0x5a,
-	0x1b, 0x22, 0x83, 0x87, 0x8e, 0x8c, 0xe2, 0x7b, 0xee, 0x1e, 0xc4, 0x77, 0x73, 0x03, 0x20, 0xfe,
-	0x8a, 0x8d, 0xce, 0xc2, 0x98, 0x67, 0xb5, 0xc3, 0x8b, 0x3e, 0x4a, 0x21, 0xc5, 0x5b, 0x41, 0xc1,
-	0x41, 0x8f, 0xc0, 0x78, 0xcf, 0x72, 0xbb, 0xe1, 0x63, 0xcc, 0xe8, 0x05, 0xc9, 0x5b, 0x9c, 0x88,
-	0x25, 0xcf, 0xfc, 0x59, 0x0e, 0xca, 0xda, 0x57, 0xa2, 0xe3, 0x48, 0x64, 0xc7, 0x3b, 0x16, 0xdb,
-	0x09, 0x1f, 0xbf, 0xbc, 0x9c, 0xf9, 0x03, 0x16, 0x0f, 0xcf, 0xf1, 0x22, 0xf8, 0x2f, 0x8a, 0x25,
-	0x74, 0x2a, 0xf3, 0xc9, 0x1f, 0x47, 0xe6, 0x63, 0x7e, 0xdb, 0x80, 0xe9, 0xd4, 0x6c, 0xd0, 0x05,
-	0x00, 0x1a, 0xfd, 0x52, 0x3b, 0x11, 0x15, 0x46, 0xb1, 0x1c, 0xd6, 0xa4, 0x44, 0x82, 0x42, 0x28,
-	0x73, 0x3c, 0xd1, 0x88, 0x5f, 0x76, 0x02, 0xb5, 0x3d, 0x71, 0x82, 0x92, 0xe0, 0xe2, 0x94, 0xb4,
-	0xf9, 0xa9, 0x01, 0x0f, 0x1e, 0xd6, 0xc8, 0xe3, 0xe9, 0xaa, 0xea, 0xd6, 0x45, 0x29, 0x90, 0x91,
-	0x4c, 0x57, 0xaf, 0x26, 0xd9, 0x38, 0x2d, 0x8f, 0x2e, 0x42, 0x59, 0x23, 0xa9, 0x09, 0x46, 0xc1,
-	0x4e, 0x1b, 0x8e, 0x75, 0x39, 0xf3, 0x2f, 0x06, 0x9c, 0x1a, 0x56, 0x55, 0xa1, 0x20, 0x7c, 0x00,
-	0x25, 0xdf, 0xb5, 0x5d, 0x3b, 0x7a, 0xa1, 0x56, 0x13, 0xcf, 0xa0, 0x56, 0x3c, 0x16, 0xec, 0x0d,
-	0x7f, 0x1a, 0x35, 0x7f, 0x09, 0x20, 0x96, 0x41, 0x33, 0x90, 0xdf, 0x25, 0x7b, 0xd2, 0x10, 0x98,
-	0xff, 0x89, 0x4e, 0x25, 0x4e, 0x87, 0x3a, 0x0e, 0x2f, 0xe4, 0x2e, 0x19, 0x2f, 0x94, 0x3e, 0xfd,
-	0x71, 0xf5, 0xc4, 0x47, 0x7f, 0x3b, 0x7b, 0xc2, 0xfc, 0x9e, 0x01, 0x7a, 0x68, 0x47, 0x4f, 0xc1,
-	0xc4, 0x0e, 0x63, 0x1d, 0x41, 0x52, 0xdf, 0xae, 0xc4, 0x1b, 0xa0, 0xd7, 0x37, 0x37, 0xd7, 0x05,
-	0x11, 0xc7, 0x7c, 0x54, 0x03, 0xe0, 0x3f, 0xa8, 0x94, 0x1e, 0x8b, 0xbf, 0x21, 0x73, 0xe9, 0x0d,
-	0x29, 0xae, 0x49, 0xa0, 0xc7, 0x78, 0x6a, 0x2d, 0x85, 0xe5, 0xb3, 0xe4, 0xb2, 0x4c, 0xab, 0xa5,
-	0x64, 0xc8, 0x33, 0x7f, 0x65, 0xc0, 0xec, 0xc0, 0x47, 0x4d, 0x74, 0x33, 0x0a, 0xf4, 0x46, 0x76,
-	0xef, 0x1f, 0x9e, 0x1c, 0x1c, 0xd9, 0x63, 0x7f, 0x69, 0x00, 0xc4, 0x85, 0x16, 0x72, 0x61, 0x52,
-	0x02, 0x27, 0xb2, 0x8b, 0x2c, 0x13, 0x3e, 0xa5, 0x26, 0x30, 0xb9, 0xa1, 0xe1, 0xe1, 0x04, 0x3a,
-	0x2f, 0x20, 0xda, 0x7e, 0xd7, 0x63, 0xe2, 0x1c, 0xe4, 0x92, 0x2f, 0xe0, 0xae, 0x85, 0x0c, 0x1c,
-	0xcb, 0x98, 0xdf, 0x19, 0x87, 0xb9, 0x21, 0x1f, 0x0c, 0xfe, 0x83, 0x2b, 0xfc, 0x27, 0xa1, 0x28,
-	0x9f, 0x29, 0xd1, 0x74, 0xb8, 0x97, 0xaf, 0x98, 0x78, 0xa9, 0x2c, 0xff, 0x40, 0xe7, 0xa1, 0xec,
-	0x78, 0xb6, 0x6c, 0x4c, 0x59, 0x61, 0xc1, 0x26, 0x9b, 0x9d, 0x31, 0x19, 0xeb, 0x32, 0xc9, 0x0a,
-	0xaf, 0x70, 0x57, 0x35, 0xff, 0xa4, 0xfa, 0xaf, 0x12, 0xf2, 0x51, 0x51, 0x31, 0xcb, 0x86, 0x88,
-	0x3e, 0x0f, 0xd6, 0x60, 0x70, 0x02, 0x14, 0x7d, 0xcb, 0x80, 0x19, 0x45, 0x58, 0x0a, 0x98, 0xd3,
-	0xb4, 0xec, 0xe8, 0x01, 0xc2, 0x11, 0x23, 0x58, 0x45, 0x2d, 0x6e, 0x06, 0xa7, 0xe0, 0xf1, 0x80,
-	0x42, 0xf3, 0x26, 0xcc, 0x0e, 0xe4, 0x66, 0x77, 0x17, 0xf8, 0x89, 0xf8, 0x2f, 0x00, 0xa9, 0xc0,
-	0x2f, 0x5f, 0xfe, 0x4b, 0x9e, 0xf9, 0x99, 0x01, 0x53, 0xa9, 0xd4, 0x36, 0x53, 0xed, 0x78, 0x53,
-	0xaf, 0x1d, 0x8f, 0x9c, 0xa1, 0x27, 0xaa, 0x48, 0xb3, 0x09, 0x53, 0xc9, 0x66, 0xbd, 0x56, 0xd0,
-	0x18, 0x87, 0x15, 0x34, 0xe8, 0x1c, 0x94, 0x2c, 0xd7, 0xf5, 0x3f, 0x58, 0xf1, 0x7a, 0xea, 0x49,
-	0x42, 0xd4, 0xeb, 0x5d, 0x52, 0x74, 0x1c, 0x49, 0xd4, 0x1f, 0xfd, 0xfc, 0xf6, 0xc2, 0x89, 0x2f,
-	0x6e, 0x2f, 0x9c, 0xf8, 0xeb, 0xed, 0x85, 0x13, 0x1f, 0xed, 0x2f, 0x18, 0x9f, 0xef, 0x2f, 0x18,
-	0x5f, 0xec, 0x2f, 0x18, 0x7f, 0xdf, 0x5f, 0x30, 0xbe, 0xfb, 0xe5, 0xc2, 0x89, 0x9b, 0xb9, 0xde,
-	0xf9, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x09, 0x07, 0xcc, 0x40, 0xb8, 0x34, 0x00, 0x00,
+	// 3926 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe4, 0x5b, 0x4b, 0x6c, 0x24, 0xc7,
+	0x79, 0xde, 0x9e, 0x21, 0x87, 0x33, 0xff, 0x70, 0xf9, 0x28, 0xee, 0x6a, 0x7b, 0x57, 0x6b, 0xce,
+	0xba, 0xfd, 0x80, 0x05, 0x4b, 0xc3, 0xec, 0xda, 0x72, 0xe4, 0x87, 0x14, 0x73, 0x48, 0xae, 0xc4,
+	0x15, 0x77, 0x97, 0xf9, 0x87, 0xab, 0x57, 0x1e, 0x46, 0xb3, 0xa7, 0x38, 0x6c, 0x71, 0xa6, 0x7b,
+	0xdc, 0xdd, 0x43, 0x8b, 0x46, 0x0c, 0x28, 0x31, 0x02, 0x38, 0x80, 0x81, 0x24, 0xb6, 0x83, 0xe8,
+	0x96, 0xf8, 0x10, 0x5f, 0x72, 0x08, 0x82, 0xe4, 0x60, 0xc0, 0x97, 0x24, 0xc8, 0x41, 0xa7, 0x40,
+	0xc7, 0x1c, 0x82, 0x41, 0x96, 0x39, 0x04, 0xc8, 0x2d, 0x87, 0xe4, 0xc0, 0x43, 0x10, 0xd4, 0xa3,
+	0xbb, 0xab, 0x7a, 0x9a, 0xd4, 0x4e, 0x73, 0xa9, 0x24, 0xc8, 0x85, 0x60, 0xff, 0xff, 0x5f, 0xdf,
+	0x5f, 0xcf, 0xbf, 0xfe, 0x47, 0x0d, 0x7c, 0xa3, 0xeb, 0x46, 0xfb, 0xc3, 0xdd, 0xa6, 0xe3, 0xf7,
+	0x57, 0xfc, 0x01, 0xf5, 0xc2, 0x7d, 0x77, 0x2f, 0x5a, 0xf1, 0x03, 0xb7, 0xeb, 0x7a, 0x2b, 0x83,
+	0x83, 0xee, 0xca, 0xee, 0xd0, 0xed, 0x75, 0x56, 0xec, 0x81, 0xbb, 0x72, 0x78, 0x7b, 0xa5, 0x4b,
+	0x3d, 0x1a, 0xd8, 0x11, 0xed, 0x34, 0x07, 0x81, 0x1f, 0xf9, 0xe4, 0xf9, 0xb4, 0x75, 0x33, 0x69,
+	0xdd, 0x14, 0xad, 0x9b, 0x83, 0x83, 0x6e, 0x93, 0xb7, 0x6e, 0xda, 0x03, 0xb7, 0x79, 0x78, 0xfb,
+	0xc6, 0x0b, 0x8a, 0xae, 0xae, 0xdf, 0xf5, 0x57, 0x38, 0xc8, 0xee, 0x70, 0x8f, 0x7f, 0xf1, 0x0f,
+	0xfe, 0x9f, 0x00, 0xbf, 0xf1, 0xe2, 0xc1, 0x4b, 0x61, 0xd3, 0xf5, 0x57, 0x0e, 0x86, 0xbb, 0x34,
+	0xf0, 0x68, 0x44, 0x43, 0xde, 0x21, 0xd6, 0x95, 0xa1, 0x77, 0x48, 0x83, 0xd0, 0xf5, 0x3d, 0xda,
+	0xc9, 0xf6, 0xe9, 0xc6, 0xf3, 0xa7, 0x37, 0x1b, 0x1f, 0xc1, 0x8d, 0x17, 0xf2, 0xa5, 0x83, 0xa1,
+	0x17, 0xb9, 0x7d, 0x3a, 0x26, 0x7e, 0x3b, 0x5f, 0x7c, 0x18, 0xb9, 0xbd, 0x15, 0xd7, 0x8b, 0xc2,
+	0x28, 0xc8, 0x36, 0xb1, 0xfe, 0xce, 0x80, 0xab, 0xab, 0x9d, 0x8e, 0x1b, 0xb9, 0xbe, 0x67, 0xf7,
+	0x5a, 0x6c, 0x42, 0x1e, 0x0e, 0xa3, 0xc1, 0x30, 0x22, 0x1b, 0x50, 0x8a, 0x7c, 0xd3, 0xb8, 0x65,
+	0x7c, 0xa1, 0x7e, 0xe7, 0x85, 0xa6, 0x40, 0x6e, 0xa6, 0xc8, 0x7c, 0x02, 0xc5, 0xd4, 0x35, 0x1f,
+	0xee, 0xbe, 0x4b, 0x9d, 0x08, 0xe9, 0x1e, 0x0d, 0xa8, 0xe7, 0xd0, 0x56, 0xe5, 0x78, 0xd4, 0x28,
+	0xed, 0xf8, 0x58, 0x8a, 0x7c, 0xb2, 0x0b, 0x30, 0x18, 0x86, 0xfb, 0x6d, 0xea, 0x04, 0x34, 0x32,
+	0x4b, 0x1c, 0xee, 0xce, 0xd9, 0x70, 0x5b, 0xbe, 0x63, 0xf7, 0xb2, 0x98, 0x73, 0xc7, 0xa3, 0x06,
+	0x6c, 0x27, 0x48, 0xa8, 0xa0, 0x5a, 0x3f, 0x9f, 0x82, 0xeb, 0x2d, 0xd7, 0xb3, 0x83, 0x23, 0x3e,
+	0x00, 0xa4, 0xdf, 0x1e, 0xd2, 0x30, 0x7a, 0x38, 0x60, 0x83, 0x0a, 0xc9, 0x5b, 0x50, 0xed, 0xd3,
+	0xc8, 0xee, 0xd8, 0x91, 0x2d, 0x87, 0xf3, 0x85, 0x27, 0x19, 0xce, 0x7d, 0x1a, 0xd9, 0x2d, 0xf2,
+	0xe1, 0xa8, 0x71, 0x89, 0x69, 0x4e, 0x69, 0x98, 0xa0, 0x91, 0xcf, 0x43, 0xc5, 0x0e, 0xef, 0xba,
+	0x3d, 0xca, 0xc7, 0x55, 0x6b, 0xcd, 0x49, 0xe9, 0xca, 0x2a, 0xa7, 0xa2, 0xe4, 0x92, 0xaf, 0xc0,
+	0x5c, 0x40, 0x0f, 0x5d, 0xb6, 0x25, 0xd6, 0xfc, 0x7e, 0xdf, 0x8d, 0xcc, 0xb2, 0x2e, 0x2f, 0xa8,
+	0x98, 0x91, 0x22, 0x5f, 0x85, 0xf9, 0x98, 0x72, 0x9f, 0x86, 0xa1, 0xdd, 0xa5, 0xe6, 0x14, 0x6f,
+	0x38, 0x2f, 0x1b, 0xce, 0x48, 0x32, 0x66, 0xe5, 0x48, 0x0b, 0x48, 0x4c, 0x5a, 0x1d, 0x46, 0xfb,
+	0x7e, 0xf0, 0xc0, 0xee, 0x53, 0x73, 0x9a, 0xb7, 0x4e, 0x06, 0x95, 0x72, 0x30, 0x47, 0x9a, 0x6c,
+	0xc0, 0x92, 0x4e, 0xdd, 0xe8, 0xdb, 0x6e, 0xcf, 0xac, 0x70, 0x90, 0x25, 0x09, 0x52, 0x57, 0x58,
+	0x98, 0x27, 0x4f, 0x5e, 0x87, 0xab, 0xfa, 0xb8, 0x22, 0x2a, 0x7a, 0x33, 0xc3, 0x81, 0xae, 0x4a,
+	0xa0, 0xcb, 0x1a, 0x13, 0xf3, 0xdb, 0x90, 0x07, 0xf0, 0xcc, 0x18, 0x43, 0x74, 0xab, 0xca, 0xd1,
+	0x9e, 0x91, 0x68, 0x73, 0x3a, 0x17, 0x4f, 0x69, 0x65, 0x7d, 0x1d, 0x16, 0x95, 0x9d, 0xd3, 0xf6,
+	0x87, 0x81, 0x43, 0x95, 0x75, 0x35, 0xce, 0x5a, 0x57, 0xeb, 0x4f, 0x4a, 0x30, 0xcd, 0xdb, 0x5d,
+	0xe0, 0x1e, 0x7b, 0x1b, 0xa6, 0xc2, 0x01, 0x75, 0xe4, 0xc9, 0xf9, 0xe5, 0xe6, 0x24, 0x36, 0xad,
+	0x29, 0x06, 0x35, 0xa0, 0x4e, 0x6b, 0x56, 0x2a, 0x99, 0x62, 0x5f, 0xc8, 0x21, 0x89, 0x0d, 0x95,
+	0x30, 0xb2, 0xa3, 0x61, 0xc8, 0xb7, 0x63, 0xfd, 0xce, 0x57, 0x8b, 0x80, 0x73, 0x80, 0x74, 0x86,
+	0xc4, 0x37, 0x4a, 0x60, 0xeb, 0x2f, 0x4b, 0x50, 0xe7, 0x72, 0x6b, 0xbe, 0xb7, 0xe7, 0x76, 0x2f,
+	0x70, 0x9e, 0xbe, 0xa5, 0xcd, 0xd3, 0xcb, 0x05, 0x86, 0x22, 0xba, 0x78, 0xea, 0x6c, 0x75, 0x33,
+	0xb3, 0xf5, 0x2b, 0xc5, 0x55, 0x9c, 0x3d, 0x67, 0x1f, 0x19, 0x30, 0xaf, 0x48, 0x6f, 0xb9, 0x61,
+	0x44, 0x7e, 0x63, 0x6c, 0xde, 0x56, 0xce, 0x98, 0x37, 0xe5, 0x02, 0x6a, 0xb2, 0xe6, 0x7c, 0xfa,
+	0x16, 0xa4, 0xba, 0x6a, 0x4c, 0x51, 0x26, 0xef, 0x37, 0x61, 0xda, 0x8d, 0x68, 0x3f, 0x34, 0x4b,
+	0xb7, 0xca, 0x05, 0x37, 0x82, 0xe8, 0x6c, 0xeb, 0xb2, 0xd4, 0x32, 0xbd, 0xc9, 0xf0, 0x50, 0xc0,
+	0x5a, 0xff, 0x39, 0xa5, 0x0d, 0x89, 0xcd, 0x2a, 0xf1, 0xa0, 0x1a, 0x05, 0x6e, 0xb7, 0x4b, 0x83,
+	0xd0, 0x34, 0xb8, 0xda, 0x6f, 0x16, 0x50, 0xbb, 0x23, 0x20, 0xb6, 0xfd, 0x9e, 0xeb, 0x1c, 0xa5,
+	0x63, 0x94, 0xe4, 0x10, 0x13, 0x1d, 0x64, 0x15, 0x6a, 0xc1, 0xd0, 0x13, 0x82, 0xd2, 0x5e, 0x7f,
+	0x46, 0x8a, 0xd7, 0x30, 0x66, 0x9c, 0x8c, 0x1a, 0x73, 0xe2, 0x0e, 0x89, 0x29, 0x98, 0xb6, 0x22,
+	0x3d, 0x00, 0xc7, 0xef, 0xf7, 0x7d, 0x8f, 0x0d, 0x40, 0x6e, 0x83, 0x97, 0x26, 0xeb, 0xf4, 0x5a,
+	0xd2, 0x3e, 0xdd, 0xcf, 0x29, 0x0d, 0x15, 0x7c, 0xf2, 0xdb, 0x06, 0xcc, 0x8b, 0x2d, 0x81, 0x74,
+	0xe0, 0x07, 0x91, 0xeb, 0x75, 0xb9, 0xf9, 0xaf, 0xdf, 0x69, 0x15, 0x3e, 0xa8, 0x09, 0x52, 0x6b,
+	0xe9, 0x78, 0xd4, 0x98, 0xcf, 0x10, 0x31, 0xab, 0x8f, 0x74, 0xe0, 0x66, 0x38, 0x74, 0x1c, 0x1a,
+	0x86, 0x7b, 0x43, 0xe1, 0x1d, 0x84, 0xaf, 0xb9, 0x61, 0xe4, 0x07, 0x47, 0x5b, 0x2e, 0xbb, 0xc7,
+	0xd8, 0x85, 0x32, 0xdd, 0xba, 0x75, 0x3c, 0x6a, 0xdc, 0x6c, 0x9f, 0x21, 0x87, 0x67, 0xa2, 0x90,
+	0xb7, 0xc0, 0xdc, 0xb3, 0xdd, 0x1e, 0xed, 0xe4, 0x68, 0xa8, 0x70, 0x0d, 0x37, 0x8f, 0x47, 0x0d,
+	0xf3, 0xee, 0x29, 0x32, 0x78, 0x6a, 0x6b, 0xeb, 0x1e, 0x2c, 0x8e, 0x1d, 0x3c, 0xf2, 0x22, 0xd4,
+	0x7b, 0x76, 0x18, 0xbd, 0x21, 0xce, 0x08, 0x3f, 0x4f, 0xe5, 0xf4, 0x3e, 0xdb, 0x4a, 0x59, 0xa8,
+	0xca, 0x59, 0x7f, 0x6f, 0x40, 0x8d, 0x83, 0x7d, 0x12, 0x27, 0xf2, 0x2d, 0xfd, 0x44, 0x7e, 0xa9,
+	0xc0, 0x8a, 0x9f, 0x72, 0x16, 0x01, 0xaa, 0x62, 0x14, 0x7e, 0xd7, 0xfa, 0x41, 0x7c, 0x2e, 0xb7,
+	0xfc, 0x6e, 0xec, 0x2e, 0xad, 0x40, 0xcd, 0xf1, 0xbd, 0xc8, 0x76, 0x3d, 0x1a, 0xc8, 0xfb, 0x6f,
+	0x31, 0x3e, 0x27, 0x6b, 0x31, 0x03, 0x53, 0x19, 0x76, 0x5b, 0xee, 0xf9, 0xbd, 0x9e, 0xff, 0x1d,
+	0x7e, 0xaa, 0xaa, 0xa9, 0x5d, 0xbb, 0xcb, 0xa9, 0x28, 0xb9, 0xe4, 0x79, 0xa8, 0x0e, 0xd8, 0x2d,
+	0xec, 0x4b, 0x13, 0x5a, 0x4d, 0x27, 0x60, 0x5b, 0xd2, 0x31, 0x91, 0x20, 0x5f, 0x86, 0xd9, 0xd0,
+	0xf5, 0x1c, 0xda, 0xa6, 0x8e, 0xef, 0x75, 0x42, 0xbe, 0xf3, 0xcb, 0xad, 0x85, 0xe3, 0x51, 0x63,
+	0xb6, 0xad, 0xd0, 0x51, 0x93, 0x22, 0x6f, 0x41, 0x8d, 0x7f, 0xef, 0xb8, 0xd2, 0xdb, 0xa9, 0xdf,
+	0xf9, 0xe2, 0x13, 0x2e, 0x0b, 0x6b, 0xd2, 0xba, 0xcc, 0x46, 0xd9, 0x8e, 0x11, 0x30, 0x05, 0x23,
+	0x77, 0x00, 0x98, 0xcf, 0x1d, 0x46, 0x76, 0x7f, 0x10, 0xf2, 0x5d, 0x59, 0x4d, 0x4f, 0xf0, 0x4e,
+	0xc2, 0x41, 0x45, 0x8a, 0x7c, 0x11, 0x6a, 0x91, 0xed, 0xf6, 0xb6, 0x5c, 0x8f, 0x86, 0xdc, 0xdb,
+	0x29, 0x0b, 0x05, 0x3b, 0x31, 0x11, 0x53, 0x3e, 0x69, 0x02, 0xf4, 0xd8, 0x9e, 0x6d, 0x1d, 0x45,
+	0x34, 0xe4, 0xde, 0x4c, 0x59, 0x38, 0xbd, 0x5b, 0x09, 0x15, 0x15, 0x09, 0x36, 0xed, 0x9e, 0xff,
+	0x1d, 0xdb, 0x8d, 0xcc, 0x9a, 0x3e, 0xed, 0x0f, 0xfc, 0x37, 0x6d, 0x37, 0x42, 0xc9, 0x25, 0x9f,
+	0x83, 0x19, 0x39, 0x48, 0x13, 0x38, 0x68, 0x9d, 0x39, 0x8e, 0xf1, 0x0e, 0x8f, 0x79, 0xd6, 0xcf,
+	0xcb, 0x50, 0xff, 0xbf, 0xe9, 0xfe, 0x13, 0x1f, 0xea, 0x6e, 0xdf, 0xee, 0xd2, 0x2d, 0x7b, 0x97,
+	0xf6, 0xd8, 0xde, 0x2a, 0x4f, 0x6e, 0x97, 0x37, 0x13, 0x80, 0xd4, 0x12, 0xa4, 0xb4, 0x10, 0x55,
+	0x0d, 0xe4, 0x87, 0x06, 0x2c, 0xda, 0x49, 0xd0, 0x24, 0x26, 0x8c, 0xed, 0x50, 0xa6, 0x77, 0x6d,
+	0x32, 0xbd, 0xb9, 0xb1, 0x57, 0xeb, 0xba, 0xec, 0xc2, 0xe2, 0x6a, 0x56, 0x0b, 0x8e, 0x2b, 0xb6,
+	0x7e, 0xc7, 0x80, 0x25, 0xde, 0x7a, 0xdb, 0x0f, 0x23, 0xe1, 0xdf, 0xf2, 0x0b, 0xe4, 0x73, 0x30,
+	0xc3, 0xae, 0x13, 0xdb, 0xeb, 0xf0, 0x0b, 0xb6, 0x26, 0x56, 0x7e, 0x4d, 0x90, 0x30, 0xe6, 0x91,
+	0x9b, 0x30, 0x65, 0x07, 0x5d, 0x61, 0x69, 0x6a, 0xad, 0x2a, 0x73, 0x7b, 0x56, 0x83, 0x6e, 0x88,
+	0x9c, 0xca, 0xb6, 0x59, 0xe8, 0x04, 0xee, 0x60, 0x2c, 0x66, 0x69, 0x73, 0x2a, 0x4a, 0xae, 0x75,
+	0x52, 0x81, 0x59, 0x35, 0xfa, 0xba, 0x40, 0x57, 0x6f, 0x0f, 0xaa, 0xb1, 0x37, 0x2f, 0x77, 0xd4,
+	0x37, 0x26, 0x9b, 0x74, 0xe1, 0xe6, 0xa3, 0xc4, 0x68, 0xcd, 0x32, 0x13, 0x14, 0x7f, 0x61, 0x82,
+	0x4d, 0x7c, 0x58, 0x90, 0xde, 0x03, 0xed, 0xb4, 0x8e, 0xf8, 0x6e, 0x30, 0xcb, 0x45, 0x0e, 0xc4,
+	0x95, 0xe3, 0x51, 0x63, 0x61, 0x27, 0x03, 0x85, 0x63, 0xe0, 0xe4, 0x75, 0x98, 0xda, 0x0b, 0xfc,
+	0xbe, 0x39, 0x55, 0x44, 0x09, 0x5f, 0xb8, 0xbb, 0x81, 0xdf, 0x47, 0x0e, 0x42, 0x1c, 0xa8, 0xec,
+	0xf2, 0xc8, 0xc6, 0x9c, 0x2e, 0xe4, 0xaf, 0x66, 0xa3, 0xa2, 0x16, 0xb0, 0x55, 0x17, 0x64, 0x94,
+	0xd0, 0xe4, 0xb6, 0x7e, 0x95, 0x56, 0xb8, 0x81, 0x99, 0x3f, 0xeb, 0x1a, 0x25, 0x6b, 0x50, 0xa6,
+	0xde, 0xa1, 0x39, 0xc3, 0x4f, 0xcb, 0x67, 0xcf, 0x1e, 0xe3, 0x86, 0x77, 0xf8, 0x86, 0x1d, 0xb4,
+	0xea, 0x72, 0x3b, 0x94, 0x37, 0xbc, 0x43, 0x64, 0xad, 0xc9, 0x21, 0xd4, 0x95, 0xd9, 0x33, 0xab,
+	0xb7, 0xca, 0x05, 0x46, 0xa8, 0xf8, 0x8f, 0x6b, 0xf6, 0x30, 0xa4, 0xe9, 0xc9, 0x57, 0xd6, 0x0a,
+	0x55, 0x45, 0xe4, 0x27, 0x06, 0x5c, 0xed, 0xf8, 0xce, 0x01, 0x0d, 0xda, 0x51, 0x60, 0x47, 0xb4,
+	0x7b, 0x24, 0xaf, 0x4d, 0x6e, 0x84, 0x27, 0x3e, 0xfd, 0xeb, 0x79, 0x50, 0xad, 0xeb, 0xc7, 0xa3,
+	0xc6, 0xd5, 0x5c, 0x16, 0xe6, 0x2b, 0xb7, 0xfe, 0xa8, 0x02, 0x75, 0x65, 0xa9, 0xc8, 0x97, 0x60,
+	0x2a, 0x3a, 0x1a, 0xc4, 0xe1, 0x6b, 0x23, 0x8e, 0x66, 0x76, 0x8e, 0x06, 0xf4, 0x64, 0xd4, 0x98,
+	0x57, 0x44, 0x19, 0x09, 0xb9, 0xb0, 0xb2, 0x61, 0x4a, 0x17, 0xb7, 0x61, 0x9a, 0x00, 0x62, 0x08,
+	0x7b, 0x6e, 0x4f, 0x9c, 0xa6, 0x9a, 0xb0, 0xed, 0xeb, 0x09, 0x15, 0x15, 0x09, 0xf2, 0x26, 0x94,
+	0xbb, 0x6e, 0x64, 0x4e, 0x15, 0x39, 0xe6, 0xaf, 0xba, 0x91, 0xda, 0x9d, 0x19, 0xb6, 0x83, 0x5e,
+	0x75, 0x23, 0x64, 0x88, 0x2c, 0xf8, 0xe5, 0x26, 0x3d, 0x34, 0xa7, 0x8b, 0xc4, 0x3c, 0xfc, 0xc0,
+	0x4a, 0xe0, 0xc4, 0x24, 0x72, 0x62, 0x88, 0x12, 0x98, 0xb9, 0x0c, 0xcc, 0x4b, 0xa2, 0xef, 0x45,
+	0xeb, 0x6e, 0x20, 0xd3, 0x26, 0x8a, 0xd3, 0x1f, 0x73, 0x50, 0x91, 0x22, 0xfb, 0x30, 0x1b, 0x72,
+	0x54, 0x79, 0x63, 0xce, 0x14, 0xbe, 0x31, 0x85, 0xab, 0xa4, 0x60, 0xa1, 0x86, 0x4c, 0xde, 0x85,
+	0x99, 0x90, 0xff, 0x17, 0x16, 0x3b, 0x3e, 0x02, 0x46, 0x9d, 0xe0, 0x24, 0x2b, 0x25, 0x58, 0x21,
+	0xc6, 0x0a, 0xc8, 0x21, 0x9f, 0x89, 0x3d, 0xb7, 0x7b, 0xdf, 0x1e, 0xb0, 0xa3, 0x52, 0x9e, 0x3c,
+	0x88, 0x59, 0x8b, 0xdb, 0xab, 0x1a, 0xd5, 0xd9, 0x94, 0xe8, 0xa8, 0x68, 0xb2, 0xfe, 0x3d, 0x76,
+	0xd9, 0xf9, 0x7d, 0xa8, 0x87, 0x6f, 0xc6, 0x05, 0x87, 0x6f, 0x19, 0x13, 0x55, 0xfa, 0x84, 0x4c,
+	0x94, 0xf5, 0x1f, 0x89, 0x2d, 0x10, 0xd1, 0xce, 0x6d, 0x98, 0x1e, 0xec, 0xdb, 0x61, 0x6c, 0x0c,
+	0x9e, 0x8d, 0x83, 0x82, 0x6d, 0x46, 0x3c, 0x19, 0x35, 0x40, 0xb8, 0x0e, 0xec, 0x0b, 0x85, 0x24,
+	0x0f, 0x01, 0x6c, 0xcf, 0xa1, 0xbd, 0x1e, 0xed, 0x48, 0xa7, 0x3e, 0x0d, 0x01, 0x62, 0x06, 0xa6,
+	0x32, 0xe4, 0x2b, 0x50, 0x09, 0xa8, 0x1d, 0xfa, 0x9e, 0x3c, 0xd1, 0xcb, 0xf1, 0x89, 0x40, 0x4e,
+	0x3d, 0x61, 0x3b, 0x51, 0x46, 0x96, 0xec, 0x1b, 0xa5, 0x34, 0x79, 0x0e, 0x66, 0xfa, 0x67, 0x27,
+	0x36, 0x63, 0x3e, 0xe9, 0xc2, 0x5c, 0x18, 0xd9, 0x41, 0x94, 0xb8, 0xda, 0x45, 0xdc, 0x7b, 0xc2,
+	0x32, 0x83, 0x6d, 0x0d, 0x06, 0x33, 0xb0, 0xe4, 0x10, 0x96, 0x1c, 0xbf, 0x3f, 0xe8, 0x51, 0x66,
+	0x5a, 0x53, 0x6d, 0x95, 0xc9, 0xb5, 0x5d, 0x3b, 0x1e, 0x35, 0x96, 0xd6, 0xc6, 0xb1, 0x30, 0x4f,
+	0x01, 0x79, 0x19, 0xaa, 0x9d, 0x61, 0x60, 0x33, 0xa2, 0x8c, 0x15, 0x3e, 0x1d, 0x87, 0x47, 0xeb,
+	0x92, 0x7e, 0x32, 0x6a, 0x5c, 0x66, 0xe1, 0x45, 0x33, 0x26, 0x60, 0xd2, 0x84, 0xec, 0xc2, 0x0d,
+	0x9f, 0xfb, 0x83, 0xc2, 0x90, 0x0a, 0x17, 0x23, 0x36, 0x06, 0x32, 0x39, 0x6a, 0x49, 0xc0, 0x1b,
+	0x0f, 0x4f, 0x95, 0xc4, 0x33, 0x50, 0xc8, 0xaf, 0x42, 0x45, 0x1c, 0x2e, 0xb3, 0x56, 0xc4, 0x43,
+	0x01, 0x91, 0xea, 0x66, 0x00, 0x28, 0x81, 0x58, 0x56, 0x4d, 0x28, 0xe4, 0xc1, 0x49, 0xb1, 0x03,
+	0x22, 0xb6, 0x96, 0x74, 0x9d, 0x13, 0x63, 0x2c, 0xbe, 0x51, 0xc2, 0x93, 0x6f, 0xf1, 0xf4, 0x1d,
+	0xb3, 0xf7, 0xf5, 0x5b, 0xe5, 0xc9, 0x33, 0xa9, 0x6d, 0xd6, 0x76, 0xd3, 0xdb, 0xf3, 0xb5, 0xb4,
+	0x1d, 0xb7, 0xf6, 0x02, 0xd6, 0xfa, 0x99, 0x01, 0xcf, 0x2a, 0xdd, 0xc9, 0x7a, 0xee, 0x64, 0x1b,
+	0xae, 0x74, 0xf2, 0x96, 0x46, 0x1c, 0xcb, 0x9b, 0x12, 0xf5, 0x4a, 0xee, 0xa2, 0xe4, 0xb6, 0x64,
+	0x79, 0x0c, 0x7e, 0xd3, 0xac, 0xbb, 0x5d, 0x1a, 0x46, 0x32, 0xa7, 0xa5, 0x47, 0x2f, 0x82, 0x85,
+	0xaa, 0x9c, 0xf5, 0xfd, 0x12, 0x2c, 0x2a, 0x1d, 0x95, 0xdd, 0x7b, 0x5b, 0x89, 0xf7, 0x56, 0xcf,
+	0xb9, 0x08, 0x3b, 0xbe, 0x16, 0x03, 0xfe, 0x28, 0x37, 0x5c, 0x12, 0x06, 0x71, 0xb3, 0xb0, 0xaa,
+	0xec, 0x04, 0x4f, 0x18, 0x34, 0xfd, 0x30, 0x0e, 0x9a, 0xf4, 0x8e, 0x67, 0x27, 0xd5, 0x78, 0xb2,
+	0x49, 0x25, 0xaf, 0xc0, 0x1c, 0xff, 0x6c, 0xbb, 0xdf, 0xa5, 0x22, 0x82, 0x2f, 0xf1, 0x33, 0x9c,
+	0xd4, 0x23, 0x36, 0x35, 0x2e, 0x66, 0xa4, 0xad, 0x1f, 0x97, 0xe0, 0x4a, 0x5e, 0x9e, 0x8e, 0x6c,
+	0xb1, 0xac, 0x89, 0x7f, 0xe8, 0x76, 0x92, 0x6c, 0xcc, 0x2f, 0xa5, 0x59, 0x13, 0x41, 0x3f, 0x19,
+	0x35, 0x6e, 0xe6, 0xb5, 0x8d, 0xf9, 0x98, 0x20, 0xf0, 0xca, 0xc6, 0xc0, 0x7d, 0x84, 0x5b, 0x63,
+	0x15, 0xab, 0xed, 0xcd, 0x47, 0xb8, 0x85, 0x92, 0x4b, 0xde, 0x81, 0x8a, 0xb8, 0xbb, 0xcd, 0x72,
+	0x61, 0x07, 0x24, 0x3d, 0x28, 0xc2, 0xfd, 0x90, 0x88, 0xcc, 0xe8, 0x4b, 0x87, 0x27, 0x6b, 0xf4,
+	0xa5, 0x4f, 0x84, 0x31, 0xdf, 0xfa, 0xd7, 0x29, 0xb8, 0x2c, 0x47, 0x26, 0x1c, 0x5e, 0xf2, 0xa2,
+	0xe6, 0xd9, 0x7e, 0x3a, 0xe3, 0xd9, 0x2e, 0x6a, 0xc2, 0x8a, 0x6f, 0xfb, 0x3d, 0x98, 0xd3, 0x3d,
+	0x67, 0xb3, 0x54, 0x64, 0xa7, 0x8b, 0xc3, 0xa9, 0x29, 0x11, 0x77, 0x8a, 0xee, 0xad, 0x63, 0x46,
+	0x19, 0x53, 0x2f, 0x7d, 0xaf, 0x58, 0x7d, 0xb9, 0x88, 0x7a, 0xe9, 0xf5, 0x8c, 0xab, 0x6f, 0x6b,
+	0xe0, 0x98, 0x51, 0xc6, 0xd4, 0x3b, 0xc3, 0x30, 0xf2, 0xfb, 0x89, 0xfa, 0xa9, 0x22, 0xea, 0xd7,
+	0x38, 0x46, 0x8e, 0xfa, 0x35, 0x0d, 0x1c, 0x33, 0xca, 0xc8, 0x4f, 0x0d, 0xb8, 0xf6, 0x2e, 0xf5,
+	0x0e, 0x5c, 0x2f, 0xdc, 0x76, 0x07, 0xb4, 0xe7, 0x7a, 0xe9, 0x3c, 0x88, 0x4b, 0xfc, 0xde, 0x64,
+	0x1d, 0xb9, 0xa7, 0x83, 0xe9, 0x3d, 0x7a, 0xf6, 0x78, 0xd4, 0xb8, 0x76, 0x2f, 0x5f, 0x1d, 0x9e,
+	0xd6, 0x0f, 0xeb, 0x17, 0x65, 0x69, 0x14, 0x55, 0x6f, 0x4b, 0xf5, 0x4f, 0x8c, 0x8f, 0xf1, 0x4f,
+	0xbe, 0x07, 0x73, 0xbc, 0xb6, 0xee, 0x3a, 0x6f, 0xd2, 0xdd, 0xd7, 0x7c, 0xff, 0xa0, 0xd8, 0x0e,
+	0x7b, 0x55, 0xc3, 0x10, 0x3e, 0x1f, 0x9f, 0x63, 0x9d, 0x81, 0x19, 0x65, 0xe4, 0x08, 0x2e, 0x0b,
+	0x3d, 0xb1, 0x76, 0xb1, 0xc1, 0xbe, 0x39, 0x71, 0xc4, 0xf4, 0xda, 0x70, 0x57, 0x53, 0xbe, 0xc8,
+	0x4a, 0xb3, 0x1a, 0x1d, 0x75, 0x4d, 0xe4, 0x7d, 0x03, 0x16, 0xb8, 0x35, 0x5b, 0xdb, 0xb7, 0xbd,
+	0xae, 0x58, 0x0d, 0xb9, 0xc1, 0x5e, 0x29, 0x10, 0x54, 0x09, 0x14, 0xa1, 0x9c, 0x27, 0x4e, 0x36,
+	0x33, 0xd8, 0x38, 0xa6, 0xcd, 0xfa, 0x49, 0x19, 0xc8, 0x78, 0x39, 0x88, 0x7c, 0x59, 0x33, 0x16,
+	0xb7, 0x32, 0xc6, 0x62, 0x41, 0x6d, 0xa1, 0xd8, 0x8a, 0x2e, 0x54, 0x44, 0xaf, 0x8b, 0x25, 0x97,
+	0xe4, 0xb4, 0x48, 0xdc, 0xbc, 0xf9, 0x93, 0xf0, 0x2c, 0x02, 0x93, 0xab, 0x68, 0x96, 0x9f, 0x82,
+	0xa6, 0xbc, 0x6d, 0x12, 0x2b, 0x20, 0x21, 0xd4, 0x95, 0x59, 0x33, 0xa7, 0x8a, 0xec, 0x0e, 0x65,
+	0x21, 0x62, 0x9d, 0xf3, 0xc9, 0xa5, 0x28, 0xe8, 0xa8, 0x6a, 0xb1, 0x3e, 0x98, 0x01, 0x25, 0x3a,
+	0x62, 0x77, 0x64, 0x48, 0x83, 0x43, 0xd7, 0xa1, 0xab, 0x8e, 0xe3, 0x0f, 0xbd, 0xf8, 0x76, 0x4d,
+	0xee, 0xc8, 0xb6, 0xc6, 0xc5, 0x8c, 0x34, 0xaf, 0x57, 0x73, 0xc3, 0x26, 0x17, 0xa6, 0x50, 0xbd,
+	0x3a, 0x13, 0xb2, 0x8b, 0x6f, 0x94, 0xc0, 0x5a, 0x6a, 0xb1, 0x7c, 0x81, 0xa9, 0x45, 0x17, 0xaa,
+	0xa1, 0x6e, 0x8b, 0xbf, 0x5e, 0x64, 0x30, 0xb1, 0xcd, 0x4b, 0x0a, 0x29, 0x31, 0x05, 0x13, 0x78,
+	0x36, 0x6b, 0xd2, 0xc3, 0x9e, 0x2e, 0x3c, 0x6b, 0x1f, 0xe3, 0x5b, 0x3b, 0x50, 0x0b, 0xa8, 0x98,
+	0xc1, 0xd0, 0xac, 0x3c, 0x89, 0xc3, 0x80, 0x52, 0x9c, 0x25, 0x8b, 0xdd, 0x80, 0xf6, 0xa9, 0x17,
+	0x85, 0x69, 0x8c, 0x19, 0x73, 0x43, 0x4c, 0x71, 0xc9, 0x10, 0x60, 0x90, 0xe4, 0xb7, 0xcd, 0x99,
+	0x22, 0xc6, 0x35, 0x27, 0x49, 0x9e, 0x86, 0xf1, 0x29, 0x1d, 0x15, 0x45, 0xe4, 0xd7, 0xe0, 0x7a,
+	0x1a, 0xad, 0xad, 0x53, 0xbb, 0xc3, 0xaf, 0x0d, 0x59, 0x94, 0x12, 0x55, 0x9a, 0x4f, 0x1d, 0x8f,
+	0x1a, 0xd7, 0xd7, 0x4e, 0x13, 0xc2, 0xd3, 0xdb, 0x93, 0xf7, 0x60, 0xd6, 0xf3, 0x3b, 0xb4, 0x4d,
+	0x7b, 0xd4, 0x89, 0xfc, 0x40, 0x86, 0x55, 0x13, 0x66, 0x46, 0x44, 0x12, 0xd0, 0xee, 0x3d, 0x50,
+	0x90, 0x44, 0xf6, 0x47, 0xa5, 0xa0, 0xa6, 0xc9, 0xfa, 0x85, 0x01, 0x57, 0xf2, 0x52, 0x2a, 0x6c,
+	0x2d, 0x93, 0x04, 0x8a, 0x69, 0x3c, 0xc9, 0x5a, 0xe6, 0x3a, 0x7f, 0x6a, 0xc9, 0x50, 0x80, 0x61,
+	0x8a, 0xcb, 0x2c, 0x41, 0x87, 0x86, 0x91, 0xeb, 0xf1, 0xd8, 0x95, 0x65, 0xc7, 0x4a, 0xba, 0x25,
+	0x58, 0xd7, 0xb8, 0x98, 0x91, 0xb6, 0xfe, 0x7a, 0x0a, 0x96, 0x72, 0xbc, 0x11, 0xf2, 0x50, 0x26,
+	0xd0, 0x0b, 0x95, 0xad, 0x92, 0x47, 0x1f, 0x4a, 0x12, 0x9d, 0x97, 0xaf, 0x7a, 0xbd, 0xa7, 0x55,
+	0xbe, 0xea, 0xf5, 0xd2, 0xf2, 0x55, 0xfc, 0x7f, 0x9c, 0x10, 0x2f, 0x9f, 0x2b, 0x21, 0x7e, 0x0f,
+	0x08, 0x7d, 0x6f, 0xe0, 0x87, 0x54, 0x7a, 0xa2, 0xec, 0xaf, 0xf0, 0xaf, 0xab, 0xad, 0x1b, 0x52,
+	0x9a, 0x6c, 0x8c, 0x49, 0x60, 0x4e, 0x2b, 0x96, 0xfe, 0xd9, 0xf3, 0x03, 0x87, 0xb2, 0xfe, 0x9a,
+	0xd3, 0x7a, 0xfa, 0xe7, 0x6e, 0xcc, 0xc0, 0x54, 0x86, 0x38, 0x69, 0x2a, 0xb1, 0x52, 0xa4, 0xf8,
+	0x26, 0x26, 0x82, 0x1f, 0xc7, 0xd3, 0x73, 0x88, 0xab, 0x30, 0xcf, 0x1b, 0xad, 0x6e, 0x6f, 0xc6,
+	0xe5, 0x06, 0xf1, 0x80, 0xec, 0x9a, 0x6c, 0x32, 0xdf, 0xd2, 0xd9, 0x98, 0x95, 0xb7, 0xfe, 0xab,
+	0x0c, 0x4b, 0x39, 0x2e, 0x3c, 0x79, 0xfd, 0x3c, 0xdb, 0xa6, 0xfa, 0x3f, 0xb0, 0x65, 0x9e, 0x83,
+	0x19, 0xcf, 0x5f, 0xb3, 0x9d, 0x7d, 0x2a, 0x2b, 0xe9, 0xc9, 0xb4, 0x3d, 0x10, 0x64, 0x8c, 0xf9,
+	0xf1, 0xee, 0x9a, 0x3a, 0xd7, 0xee, 0x9a, 0x78, 0x47, 0xbc, 0x02, 0x73, 0x69, 0x12, 0x7f, 0xdb,
+	0x8e, 0xf6, 0xcd, 0x4a, 0xe6, 0x80, 0x6b, 0x5c, 0xcc, 0x48, 0x93, 0x47, 0x50, 0x13, 0x8b, 0xc7,
+	0x0a, 0x93, 0x93, 0x94, 0x8a, 0x92, 0x6e, 0xb5, 0xe2, 0xe6, 0x98, 0x22, 0x59, 0x1e, 0xe4, 0xd7,
+	0x55, 0x74, 0x7d, 0xc6, 0x53, 0xd3, 0xf7, 0x67, 0x06, 0x2c, 0xe5, 0x78, 0xf4, 0x9a, 0x9b, 0x61,
+	0x5c, 0xa0, 0x9b, 0xf1, 0xf9, 0x24, 0x8c, 0xcf, 0x84, 0xfb, 0x7a, 0x48, 0x6e, 0x3d, 0x1e, 0xeb,
+	0xe7, 0xc6, 0x21, 0xf5, 0xa2, 0x62, 0x75, 0xa4, 0x6d, 0x51, 0xb2, 0x11, 0x3b, 0xff, 0xc5, 0x89,
+	0x03, 0x10, 0x9e, 0x64, 0xd3, 0x6b, 0x35, 0x4f, 0xc3, 0x42, 0x5a, 0x7f, 0x63, 0xc0, 0x9c, 0x5e,
+	0x11, 0x22, 0x9f, 0x82, 0xf2, 0x30, 0x70, 0xe5, 0xe8, 0x92, 0x16, 0x8f, 0x70, 0x13, 0x19, 0x9d,
+	0xb1, 0x03, 0xba, 0x67, 0x96, 0x74, 0x36, 0xd2, 0x3d, 0x64, 0x74, 0x32, 0x80, 0xfa, 0x20, 0xf0,
+	0xdf, 0x3b, 0x12, 0x37, 0x5c, 0xb1, 0x37, 0x94, 0xdb, 0x29, 0x40, 0x9a, 0x64, 0x52, 0x88, 0xa8,
+	0xaa, 0xb0, 0xfe, 0xb8, 0x04, 0x64, 0x3c, 0x44, 0xfb, 0xdf, 0xb6, 0x9b, 0xc8, 0xb7, 0xa1, 0xce,
+	0x6c, 0x95, 0x7c, 0x08, 0x60, 0x96, 0x8b, 0x84, 0x82, 0xdb, 0x29, 0x80, 0x08, 0x05, 0x79, 0xa4,
+	0xa1, 0x50, 0x51, 0xd5, 0x61, 0xfd, 0xa8, 0x04, 0x33, 0x72, 0xef, 0x90, 0xdf, 0x82, 0xb9, 0xae,
+	0xb6, 0xce, 0xc5, 0x26, 0x25, 0x53, 0x3d, 0x4c, 0x2c, 0x97, 0x4e, 0xc7, 0x8c, 0x2e, 0xf2, 0x03,
+	0x03, 0x16, 0xbb, 0x6e, 0xa4, 0x4f, 0x69, 0xb1, 0x8a, 0xea, 0xab, 0x59, 0x98, 0x34, 0xc5, 0x39,
+	0xc6, 0xc2, 0x71, 0xa5, 0xd6, 0xdf, 0x96, 0x60, 0x5c, 0x90, 0xad, 0xa2, 0x23, 0x7c, 0x68, 0x23,
+	0xf7, 0x11, 0xba, 0xe4, 0xb2, 0x30, 0xd8, 0xe6, 0xaf, 0xb8, 0x8b, 0x75, 0x5e, 0x68, 0x65, 0x19,
+	0xbd, 0xc0, 0xef, 0x3d, 0x0a, 0x69, 0xa0, 0xe4, 0x1a, 0x39, 0x2c, 0x4a, 0x78, 0x32, 0x80, 0x9a,
+	0x50, 0x19, 0xd1, 0xc0, 0x2c, 0x3f, 0x1d, 0x5d, 0x8a, 0xfb, 0x29, 0x91, 0x31, 0x55, 0x32, 0x41,
+	0xd9, 0xc9, 0xfa, 0xb1, 0x01, 0x0b, 0xd9, 0xb4, 0x04, 0x6b, 0xcf, 0xc3, 0xdc, 0xcd, 0xf5, 0x6c,
+	0x5a, 0x68, 0x53, 0x90, 0x31, 0xe6, 0x93, 0x1d, 0x98, 0x61, 0x5e, 0x01, 0x4a, 0x3b, 0x32, 0xb1,
+	0x77, 0xc1, 0x9f, 0xec, 0xdc, 0x15, 0x08, 0x18, 0x43, 0x59, 0x7f, 0x65, 0x00, 0x19, 0x8f, 0xc6,
+	0x59, 0x89, 0x81, 0xbd, 0xb4, 0x48, 0x4a, 0x83, 0x9b, 0x5a, 0x27, 0x93, 0x12, 0xc3, 0x56, 0x8e,
+	0x0c, 0xe6, 0xb6, 0x4c, 0x3c, 0xa3, 0xd2, 0x53, 0xf0, 0x8c, 0xac, 0x36, 0x40, 0xfa, 0xa4, 0x8a,
+	0xdc, 0x82, 0x29, 0x8f, 0xfd, 0x0a, 0x40, 0x74, 0x2e, 0x71, 0xbe, 0xf9, 0xe3, 0x7f, 0xce, 0x21,
+	0x9f, 0x81, 0xe9, 0x43, 0xbb, 0x37, 0x8c, 0x7f, 0x5d, 0x91, 0x3c, 0x67, 0x7c, 0x83, 0x11, 0x51,
+	0xf0, 0xac, 0x9f, 0x95, 0xa0, 0xae, 0x14, 0xe3, 0x2f, 0x22, 0x04, 0x98, 0x1e, 0xd8, 0xd1, 0x7e,
+	0x5c, 0xb0, 0x78, 0xb9, 0xf0, 0x3b, 0x01, 0xe6, 0xd8, 0xa4, 0x83, 0x60, 0x5f, 0x21, 0x0a, 0xe8,
+	0x8c, 0xcf, 0x58, 0xbe, 0x08, 0x9f, 0xd1, 0xfa, 0x5d, 0x03, 0xe6, 0x33, 0xbd, 0x61, 0x2f, 0x14,
+	0xc2, 0xe4, 0x4b, 0xae, 0x44, 0x12, 0x10, 0xa7, 0x72, 0xa8, 0x48, 0x9d, 0x3b, 0x76, 0xfb, 0xc0,
+	0x80, 0x9b, 0x67, 0x25, 0x70, 0x99, 0xa3, 0x2f, 0xb3, 0xb4, 0x89, 0xf3, 0x68, 0xe8, 0x8e, 0xfe,
+	0x3d, 0x9d, 0x8d, 0x59, 0x79, 0x56, 0xc4, 0x51, 0x48, 0xd9, 0xca, 0x98, 0xd2, 0x1c, 0x55, 0x39,
+	0xeb, 0x1f, 0x0c, 0xb8, 0x92, 0x17, 0x4d, 0x93, 0x20, 0x7e, 0x8d, 0x2b, 0x5c, 0xc3, 0xfb, 0xe7,
+	0x0f, 0xd0, 0x9b, 0xfc, 0x4d, 0xee, 0x86, 0x17, 0x05, 0x47, 0xf9, 0xef, 0x74, 0x6f, 0xbc, 0x04,
+	0x90, 0xca, 0x90, 0x05, 0x28, 0x1f, 0xd0, 0x23, 0x31, 0x11, 0xc8, 0xfe, 0x25, 0x57, 0xb4, 0xd3,
+	0x21, 0x8f, 0xc3, 0xd7, 0x4a, 0x2f, 0x19, 0x5f, 0xab, 0x7e, 0xf0, 0xa7, 0x8d, 0x4b, 0xef, 0xff,
+	0xd3, 0xad, 0x4b, 0xd6, 0x1f, 0x1a, 0xa0, 0x7a, 0x13, 0xec, 0x41, 0xea, 0x7e, 0x14, 0x0d, 0x38,
+	0x49, 0x96, 0xea, 0xf9, 0x83, 0xd4, 0xd7, 0x76, 0x76, 0xb6, 0x39, 0x11, 0x53, 0x3e, 0x7b, 0xaa,
+	0xc3, 0x3e, 0x42, 0x21, 0x3d, 0x95, 0x3e, 0xd5, 0x61, 0xd2, 0x6d, 0x21, 0xae, 0x48, 0xb0, 0xe7,
+	0x86, 0x9e, 0x2f, 0x84, 0xc5, 0xef, 0x8c, 0xea, 0x22, 0x20, 0x11, 0x92, 0x31, 0xcf, 0xfa, 0x7d,
+	0x03, 0x9e, 0x51, 0xee, 0x71, 0x99, 0x93, 0xe1, 0xf9, 0xda, 0xd5, 0x24, 0x55, 0x25, 0x16, 0xfc,
+	0x39, 0x3d, 0xdf, 0x74, 0x32, 0x6a, 0x5c, 0x53, 0x5a, 0x0a, 0xa2, 0x68, 0x9a, 0xa4, 0xa2, 0xee,
+	0x00, 0xd8, 0xec, 0xb5, 0xf1, 0x5d, 0x3f, 0x38, 0x08, 0xe5, 0xdb, 0x85, 0xf4, 0xf7, 0x4e, 0x09,
+	0x07, 0x15, 0x29, 0xeb, 0x6d, 0x58, 0xc8, 0xba, 0x1b, 0xfc, 0x75, 0xed, 0xb0, 0xbf, 0x2b, 0x8b,
+	0x6e, 0x65, 0xe5, 0x75, 0x2d, 0xa7, 0xa2, 0xe4, 0x7e, 0x8c, 0x8f, 0x68, 0xfd, 0x85, 0x01, 0x8b,
+	0x63, 0x0f, 0x65, 0x94, 0xea, 0x9a, 0xf1, 0xd4, 0xab, 0x6b, 0xe7, 0x3d, 0x9e, 0x7f, 0x6e, 0x00,
+	0xa4, 0xf1, 0x38, 0xe9, 0xc1, 0xac, 0x00, 0xd6, 0x5c, 0xa9, 0x22, 0x1d, 0xbe, 0x22, 0x3b, 0x30,
+	0xdb, 0x56, 0xf0, 0x50, 0x43, 0x67, 0x71, 0x66, 0x9f, 0xa5, 0x7a, 0xf9, 0xa1, 0x2f, 0xe9, 0x6f,
+	0xcf, 0xef, 0xc7, 0x0c, 0x4c, 0x65, 0xac, 0xdf, 0x9b, 0x86, 0xa5, 0x9c, 0xaa, 0xd8, 0xff, 0xe3,
+	0x44, 0xd0, 0x73, 0x30, 0x23, 0x5e, 0xe4, 0x86, 0x59, 0xdf, 0x46, 0x3c, 0xd8, 0x65, 0x19, 0x15,
+	0xf1, 0x0f, 0x7b, 0xbc, 0xe9, 0x7a, 0x8e, 0xc8, 0xbe, 0xda, 0x71, 0x5c, 0x2f, 0x32, 0xfa, 0x29,
+	0x19, 0x55, 0x19, 0x3d, 0x11, 0x50, 0x79, 0xa2, 0xd4, 0xd0, 0xac, 0xfc, 0xb5, 0xaa, 0x78, 0x3f,
+	0x3b, 0x53, 0x64, 0x41, 0x78, 0x32, 0x13, 0x15, 0x18, 0xd4, 0x40, 0xc9, 0xf7, 0x0d, 0x58, 0x90,
+	0x84, 0xd5, 0x20, 0x72, 0xf7, 0x6c, 0x27, 0x79, 0xd4, 0x76, 0xce, 0xeb, 0xda, 0x94, 0x83, 0x5b,
+	0xc0, 0x0c, 0x3c, 0x8e, 0x29, 0xb4, 0xde, 0x81, 0xc5, 0x31, 0x47, 0xf4, 0xc9, 0xbc, 0x1c, 0xca,
+	0x7f, 0xc0, 0x98, 0xf1, 0x72, 0xc4, 0xef, 0x16, 0x05, 0xcf, 0xfa, 0xa9, 0x01, 0x73, 0x19, 0x3f,
+	0xbe, 0x50, 0x6c, 0xfe, 0x8e, 0x1a, 0x9b, 0x9f, 0x3b, 0x1c, 0xd1, 0xa2, 0x74, 0xeb, 0xdf, 0x0c,
+	0xa8, 0x25, 0xcf, 0x64, 0xc8, 0x0b, 0xda, 0xc0, 0xaf, 0xab, 0x03, 0x3f, 0x19, 0x35, 0x84, 0xa0,
+	0x32, 0x0b, 0xbf, 0x0e, 0xb5, 0xe4, 0x1d, 0x96, 0x59, 0x9a, 0xfc, 0xad, 0x55, 0xb2, 0x0b, 0x93,
+	0xd7, 0x5d, 0x98, 0x02, 0xf2, 0xb7, 0x37, 0xf2, 0xa1, 0xd4, 0x7d, 0xb7, 0xd7, 0x73, 0x43, 0x99,
+	0xbf, 0x2f, 0x73, 0xdb, 0x9e, 0xbe, 0xbd, 0xc9, 0x91, 0xc1, 0xdc, 0x96, 0xd6, 0xb1, 0x01, 0x73,
+	0x7a, 0xfd, 0x4d, 0x09, 0x8f, 0x8d, 0x33, 0xc3, 0xe3, 0xe7, 0xa1, 0xca, 0x2f, 0x9f, 0x0d, 0xef,
+	0x50, 0x5e, 0x50, 0x49, 0xf9, 0x66, 0x55, 0xd2, 0x31, 0x91, 0x20, 0xdf, 0x85, 0x59, 0x25, 0xd0,
+	0x8d, 0x7f, 0x7c, 0xb8, 0x5e, 0x38, 0x9a, 0x56, 0xee, 0x5b, 0x71, 0xae, 0x14, 0x5e, 0x88, 0x9a,
+	0xae, 0xd6, 0x67, 0x3f, 0x7c, 0xbc, 0x7c, 0xe9, 0xa3, 0xc7, 0xcb, 0x97, 0xfe, 0xf1, 0xf1, 0xf2,
+	0xa5, 0xf7, 0x8f, 0x97, 0x8d, 0x0f, 0x8f, 0x97, 0x8d, 0x8f, 0x8e, 0x97, 0x8d, 0x7f, 0x3e, 0x5e,
+	0x36, 0xfe, 0xe0, 0x5f, 0x96, 0x2f, 0xbd, 0x53, 0x3a, 0xbc, 0xfd, 0xdf, 0x03, 0x00, 0x26, 0x1c,
+	0x2f, 0xed, 0xa5, 0x3f, 0x00, 0x00,
 }
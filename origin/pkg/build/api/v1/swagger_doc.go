@@ -5,6 +5,16 @@ package v1
 // by hack/update-generated-swagger-descriptions.sh and should be run after a full build of OpenShift.
 // ==== DO NOT EDIT THIS FILE MANUALLY ====
 
+var map_AdditionalBuildOutput = map[string]string{
+	"":           "AdditionalBuildOutput describes a further location the output of a build is pushed to.",
+	"to":         "to defines the location to push the output of this build to. Kind must be one of 'ImageStreamTag' or 'DockerImage'. The tag may reference the variables ${SOURCE_COMMIT}, ${SOURCE_REF} and ${BUILD_NUMBER}, which are expanded by the builder once the source revision is known.",
+	"pushSecret": "pushSecret is the name of a Secret that would be used for setting up the authentication for pushing to to.",
+}
+
+func (AdditionalBuildOutput) SwaggerDoc() map[string]string {
+	return map_AdditionalBuildOutput
+}
+
 var map_BinaryBuildRequestOptions = map[string]string{
 	"":                        "BinaryBuildRequestOptions are the options required to fully speficy a binary build request",
 	"metadata":                "metadata for BinaryBuildRequestOptions.",
@@ -63,9 +73,12 @@ func (BuildConfigList) SwaggerDoc() map[string]string {
 }
 
 var map_BuildConfigSpec = map[string]string{
-	"":          "BuildConfigSpec describes when and how builds are created",
-	"triggers":  "triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
-	"runPolicy": "RunPolicy describes how the new build created from this build configuration will be scheduled for execution. This is optional, if not specified we default to \"Serial\".",
+	"":                             "BuildConfigSpec describes when and how builds are created",
+	"triggers":                     "triggers determine how new Builds can be launched from a BuildConfig. If no triggers are defined, a new build can only occur as a result of an explicit client build creation.",
+	"runPolicy":                    "RunPolicy describes how the new build created from this build configuration will be scheduled for execution. This is optional, if not specified we default to \"Serial\".",
+	"statusReporting":              "statusReporting configures reporting the status of builds as commit statuses to the service hosting the source repository. Optional.",
+	"successfulBuildsHistoryLimit": "successfulBuildsHistoryLimit is the number of old successful builds to retain. Older builds are deleted when a build completes. If not specified, all successful builds are retained.",
+	"failedBuildsHistoryLimit":     "failedBuildsHistoryLimit is the number of old failed, errored and cancelled builds to retain. Older builds are deleted when a build completes. If not specified, all failed builds are retained.",
 }
 
 func (BuildConfigSpec) SwaggerDoc() map[string]string {
@@ -118,10 +131,11 @@ func (BuildLogOptions) SwaggerDoc() map[string]string {
 }
 
 var map_BuildOutput = map[string]string{
-	"":            "BuildOutput is input to a build strategy and describes the Docker image that the strategy should produce.",
-	"to":          "to defines an optional location to push the output of this build to. Kind must be one of 'ImageStreamTag' or 'DockerImage'. This value will be used to look up a Docker image repository to push to. In the case of an ImageStreamTag, the ImageStreamTag will be looked for in the namespace of the build unless Namespace is specified.",
-	"pushSecret":  "PushSecret is the name of a Secret that would be used for setting up the authentication for executing the Docker push to authentication enabled Docker Registry (or Docker Hub).",
-	"imageLabels": "imageLabels define a list of labels that are applied to the resulting image. If there are multiple labels with the same name then the last one in the list is used.",
+	"":                  "BuildOutput is input to a build strategy and describes the Docker image that the strategy should produce.",
+	"to":                "to defines an optional location to push the output of this build to. Kind must be one of 'ImageStreamTag' or 'DockerImage'. This value will be used to look up a Docker image repository to push to. In the case of an ImageStreamTag, the ImageStreamTag will be looked for in the namespace of the build unless Namespace is specified.",
+	"pushSecret":        "PushSecret is the name of a Secret that would be used for setting up the authentication for executing the Docker push to authentication enabled Docker Registry (or Docker Hub).",
+	"imageLabels":       "imageLabels define a list of labels that are applied to the resulting image. If there are multiple labels with the same name then the last one in the list is used.",
+	"additionalOutputs": "additionalOutputs is a list of further locations the resulting image is pushed to once it has been pushed to to. The build fails if any of these pushes fails.",
 }
 
 func (BuildOutput) SwaggerDoc() map[string]string {
@@ -140,15 +154,16 @@ func (BuildPostCommitSpec) SwaggerDoc() map[string]string {
 }
 
 var map_BuildRequest = map[string]string{
-	"":                 "BuildRequest is the resource used to pass parameters to build generator",
-	"metadata":         "metadata for BuildRequest.",
-	"revision":         "revision is the information from the source for a specific repo snapshot.",
-	"triggeredByImage": "triggeredByImage is the Image that triggered this build.",
-	"from":             "from is the reference to the ImageStreamTag that triggered the build.",
-	"binary":           "binary indicates a request to build from a binary provided to the builder",
-	"lastVersion":      "lastVersion (optional) is the LastVersion of the BuildConfig that was used to generate the build. If the BuildConfig in the generator doesn't match, a build will not be generated.",
-	"env":              "env contains additional environment variables you want to pass into a builder container",
-	"triggeredBy":      "triggeredBy describes which triggers started the most recent update to the build configuration and contains information about those triggers.",
+	"":                      "BuildRequest is the resource used to pass parameters to build generator",
+	"metadata":              "metadata for BuildRequest.",
+	"revision":              "revision is the information from the source for a specific repo snapshot.",
+	"triggeredByImage":      "triggeredByImage is the Image that triggered this build.",
+	"from":                  "from is the reference to the ImageStreamTag that triggered the build.",
+	"binary":                "binary indicates a request to build from a binary provided to the builder",
+	"lastVersion":           "lastVersion (optional) is the LastVersion of the BuildConfig that was used to generate the build. If the BuildConfig in the generator doesn't match, a build will not be generated.",
+	"env":                   "env contains additional environment variables you want to pass into a builder container",
+	"triggeredBy":           "triggeredBy describes which triggers started the most recent update to the build configuration and contains information about those triggers.",
+	"dockerStrategyOptions": "dockerStrategyOptions will override the corresponding options of the Docker strategy of the build config.",
 }
 
 func (BuildRequest) SwaggerDoc() map[string]string {
@@ -165,6 +180,7 @@ var map_BuildSource = map[string]string{
 	"contextDir":   "contextDir specifies the sub-directory where the source code for the application exists. This allows to have buildable sources in directory other than root of repository.",
 	"sourceSecret": "sourceSecret is the name of a Secret that would be used for setting up the authentication for cloning private repository. The secret contains valid credentials for remote repository, where the data's key represent the authentication method to be used and value is the base64 encoded credentials. Supported auth methods are: ssh-privatekey.",
 	"secrets":      "secrets represents a list of secrets and their destinations that will be used only for the build.",
+	"configMaps":   "configMaps represents a list of configMaps and their destinations that will be used for the build.",
 }
 
 func (BuildSource) SwaggerDoc() map[string]string {
@@ -192,15 +208,27 @@ var map_BuildStatus = map[string]string{
 	"outputDockerImageReference": "outputDockerImageReference contains a reference to the Docker image that will be built by this build. Its value is computed from Build.Spec.Output.To, and should include the registry address, so that it can be used to push and pull the image.",
 	"config":                     "config is an ObjectReference to the BuildConfig this Build is based on.",
 	"output":                     "output describes the Docker image the build has produced.",
+	"stages":                     "stages describes the stages the build went through, in the order they started, with the time each of them took.",
 }
 
 func (BuildStatus) SwaggerDoc() map[string]string {
 	return map_BuildStatus
 }
 
+var map_BuildStatusAdditionalOutput = map[string]string{
+	"":                     "BuildStatusAdditionalOutput describes the status of the built image with regards to one of the additional outputs of the build.",
+	"dockerImageReference": "dockerImageReference is the reference, with any templates expanded, the built image was pushed to.",
+	"imageDigest":          "imageDigest is the digest of the image pushed to dockerImageReference. It may not be set even if the push completes successfully.",
+}
+
+func (BuildStatusAdditionalOutput) SwaggerDoc() map[string]string {
+	return map_BuildStatusAdditionalOutput
+}
+
 var map_BuildStatusOutput = map[string]string{
-	"":   "BuildStatusOutput contains the status of the built image.",
-	"to": "to describes the status of the built image being pushed to a registry.",
+	"":                  "BuildStatusOutput contains the status of the built image.",
+	"to":                "to describes the status of the built image being pushed to a registry.",
+	"additionalOutputs": "additionalOutputs describes the status of the built image being pushed to each of the additional outputs of the build, in the order they were pushed.",
 }
 
 func (BuildStatusOutput) SwaggerDoc() map[string]string {
@@ -208,14 +236,27 @@ func (BuildStatusOutput) SwaggerDoc() map[string]string {
 }
 
 var map_BuildStatusOutputTo = map[string]string{
-	"":            "BuildStatusOutputTo describes the status of the built image with regards to image registry to which it was supposed to be pushed.",
-	"imageDigest": "imageDigest is the digest of the built Docker image. The digest uniquely identifies the image in the registry to which it was pushed.\n\nPlease note that this field may not always be set even if the push completes successfully - e.g. when the registry returns no digest or returns it in a format that the builder doesn't understand.",
+	"":               "BuildStatusOutputTo describes the status of the built image with regards to image registry to which it was supposed to be pushed.",
+	"imageDigest":    "imageDigest is the digest of the built Docker image. The digest uniquely identifies the image in the registry to which it was pushed.\n\nPlease note that this field may not always be set even if the push completes successfully - e.g. when the registry returns no digest or returns it in a format that the builder doesn't understand.",
+	"imageSizeBytes": "imageSizeBytes is the uncompressed size of the built image, as reported by the Docker daemon that built it.",
 }
 
 func (BuildStatusOutputTo) SwaggerDoc() map[string]string {
 	return map_BuildStatusOutputTo
 }
 
+var map_BuildStatusReporting = map[string]string{
+	"":         "BuildStatusReporting describes how the status of builds is reported to the service hosting the source repository.",
+	"provider": "provider is the service the statuses are posted to: GitHub or GitLab.",
+	"apiURL":   "apiURL is the base URL of the provider API. If empty, the API of the public service is used.",
+	"secret":   "secret refers to a secret holding an API token allowed to set commit statuses under the \"token\" key.",
+	"context":  "context is the name the statuses are posted under. If empty, \"openshift/<namespace>/<build config name>\" is used.",
+}
+
+func (BuildStatusReporting) SwaggerDoc() map[string]string {
+	return map_BuildStatusReporting
+}
+
 var map_BuildStrategy = map[string]string{
 	"":                        "BuildStrategy contains the details of how to perform a build.",
 	"type":                    "type is the kind of build strategy.",
@@ -270,6 +311,16 @@ func (CommonSpec) SwaggerDoc() map[string]string {
 	return map_CommonSpec
 }
 
+var map_ConfigMapBuildSource = map[string]string{
+	"":               "ConfigMapBuildSource describes a configmap and its destination directory that will be used only at the build time. The content of the configmap referenced here will be copied into the destination directory instead of mounting.",
+	"configMap":      "configMap is a reference to an existing configmap that you want to use in your build.",
+	"destinationDir": "destinationDir is the directory where the files from the configmap should be available for the build time. For the Source build strategy, these will be copied into the source directory before the assemble script runs. For the Docker build strategy, these will be copied into the build directory, where the Dockerfile is located, so users can ADD or COPY them during docker build.",
+}
+
+func (ConfigMapBuildSource) SwaggerDoc() map[string]string {
+	return map_ConfigMapBuildSource
+}
+
 var map_CustomBuildStrategy = map[string]string{
 	"":                   "CustomBuildStrategy defines input parameters specific to Custom build.",
 	"from":               "from is reference to an DockerImage, ImageStreamTag, or ImageStreamImage from which the docker image should be pulled",
@@ -293,12 +344,22 @@ var map_DockerBuildStrategy = map[string]string{
 	"env":            "env contains additional environment variables you want to pass into a builder container",
 	"forcePull":      "forcePull describes if the builder should pull the images from registry prior to building.",
 	"dockerfilePath": "dockerfilePath is the path of the Dockerfile that will be used to build the Docker image, relative to the root of the context (contextDir).",
+	"buildArgs":      "buildArgs contains build arguments that will be resolved in the Dockerfile.  See https://docs.docker.com/engine/reference/builder/#/arg for more details.",
 }
 
 func (DockerBuildStrategy) SwaggerDoc() map[string]string {
 	return map_DockerBuildStrategy
 }
 
+var map_DockerStrategyOptions = map[string]string{
+	"":          "DockerStrategyOptions contains extra strategy options for Docker builds.",
+	"buildArgs": "buildArgs contains build arguments that will be resolved in the Dockerfile. Arguments with the same name as those of the strategy replace them.",
+}
+
+func (DockerStrategyOptions) SwaggerDoc() map[string]string {
+	return map_DockerStrategyOptions
+}
+
 var map_GenericWebHookCause = map[string]string{
 	"":         "GenericWebHookCause holds information about a generic WebHook that triggered a build.",
 	"revision": "revision is an optional field that stores the git source revision information of the generic webhook trigger when it is available.",
@@ -331,9 +392,10 @@ func (GitBuildSource) SwaggerDoc() map[string]string {
 }
 
 var map_GitHubWebHookCause = map[string]string{
-	"":         "GitHubWebHookCause has information about a GitHub webhook that triggered a build.",
-	"revision": "revision is the git revision information of the trigger.",
-	"secret":   "secret is the obfuscated webhook secret that triggered a build.",
+	"":            "GitHubWebHookCause has information about a GitHub webhook that triggered a build.",
+	"revision":    "revision is the git revision information of the trigger.",
+	"secret":      "secret is the obfuscated webhook secret that triggered a build.",
+	"pullRequest": "pullRequest describes the pull request the build was triggered for, if any.",
 }
 
 func (GitHubWebHookCause) SwaggerDoc() map[string]string {
@@ -432,6 +494,26 @@ func (ProxyConfig) SwaggerDoc() map[string]string {
 	return map_ProxyConfig
 }
 
+var map_PullRequestBuildPolicy = map[string]string{
+	"":           "PullRequestBuildPolicy describes how pull requests are built. Pull requests are built like any other build of the build config, so the code of the pull request can read the source secret, the build secrets and the pull secret of the build config. For that reason pull requests opened from forks of the repository are ignored unless allowForks is set.",
+	"output":     "output determines what is done with the image built from a pull request: None does not push it, Tag pushes it to the \"pr-<number>\" tag of the output of the build config. Defaults to None.",
+	"allowForks": "allowForks enables builds of pull requests opened from forks of the repository. Only set it if everyone able to open a pull request may read the secrets used by the build config.",
+}
+
+func (PullRequestBuildPolicy) SwaggerDoc() map[string]string {
+	return map_PullRequestBuildPolicy
+}
+
+var map_PullRequestCause = map[string]string{
+	"":       "PullRequestCause describes the pull request (or GitLab merge request) a build was triggered for.",
+	"number": "number is the number of the pull request.",
+	"ref":    "ref is the Git reference of the head of the pull request, which is built in place of the reference of the build config.",
+}
+
+func (PullRequestCause) SwaggerDoc() map[string]string {
+	return map_PullRequestCause
+}
+
 var map_SecretBuildSource = map[string]string{
 	"":               "SecretBuildSource describes a secret and its destination directory that will be used only at the build time. The content of the secret referenced here will be copied into the destination directory instead of mounting.",
 	"secret":         "secret is a reference to an existing secret that you want to use in your build.",
@@ -488,10 +570,22 @@ func (SourceRevision) SwaggerDoc() map[string]string {
 	return map_SourceRevision
 }
 
+var map_StageInfo = map[string]string{
+	"":                     "StageInfo describes a stage of a build.",
+	"name":                 "name identifies the stage.",
+	"startTime":            "startTime is the time the stage started.",
+	"durationMilliseconds": "durationMilliseconds is the time the stage took.",
+}
+
+func (StageInfo) SwaggerDoc() map[string]string {
+	return map_StageInfo
+}
+
 var map_WebHookTrigger = map[string]string{
-	"":         "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
-	"secret":   "secret used to validate requests.",
-	"allowEnv": "allowEnv determines whether the webhook can set environment variables; can only be set to true for GenericWebHook.",
+	"":             "WebHookTrigger is a trigger that gets invoked using a webhook type of post",
+	"secret":       "secret used to validate requests.",
+	"allowEnv":     "allowEnv determines whether the webhook can set environment variables; can only be set to true for GenericWebHook.",
+	"pullRequests": "pullRequests enables builds of pull requests (and GitLab merge requests) targeting the branch of the build config. Can only be set for GitHubWebHook.",
 }
 
 func (WebHookTrigger) SwaggerDoc() map[string]string {
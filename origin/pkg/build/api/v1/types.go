@@ -124,6 +124,20 @@ type GitHubWebHookCause struct {
 
 	// secret is the obfuscated webhook secret that triggered a build.
 	Secret string `json:"secret,omitempty" protobuf:"bytes,2,opt,name=secret"`
+
+	// pullRequest describes the pull request the build was triggered for, if any.
+	PullRequest *PullRequestCause `json:"pullRequest,omitempty" protobuf:"bytes,3,opt,name=pullRequest"`
+}
+
+// PullRequestCause describes the pull request (or GitLab merge request) a
+// build was triggered for.
+type PullRequestCause struct {
+	// number is the number of the pull request.
+	Number int64 `json:"number" protobuf:"varint,1,opt,name=number"`
+
+	// ref is the Git reference of the head of the pull request, which is
+	// built in place of the reference of the build config.
+	Ref string `json:"ref,omitempty" protobuf:"bytes,2,opt,name=ref"`
 }
 
 // ImageChangeCause contains information about the image that triggered a
@@ -176,6 +190,10 @@ type BuildStatus struct {
 
 	// output describes the Docker image the build has produced.
 	Output BuildStatusOutput `json:"output,omitempty" protobuf:"bytes,10,opt,name=output"`
+
+	// stages describes the stages the build went through, in the order they
+	// started, with the time each of them took.
+	Stages []StageInfo `json:"stages,omitempty" protobuf:"bytes,11,rep,name=stages"`
 }
 
 // BuildPhase represents the status of a build at a point in time.
@@ -215,6 +233,10 @@ type StatusReason string
 type BuildStatusOutput struct {
 	// to describes the status of the built image being pushed to a registry.
 	To *BuildStatusOutputTo `json:"to,omitempty" protobuf:"bytes,1,opt,name=to"`
+
+	// additionalOutputs describes the status of the built image being pushed to
+	// each of the additional outputs of the build, in the order they were pushed.
+	AdditionalOutputs []BuildStatusAdditionalOutput `json:"additionalOutputs,omitempty" protobuf:"bytes,2,rep,name=additionalOutputs"`
 }
 
 // BuildStatusOutputTo describes the status of the built image with regards to
@@ -227,6 +249,58 @@ type BuildStatusOutputTo struct {
 	// completes successfully - e.g. when the registry returns no digest or
 	// returns it in a format that the builder doesn't understand.
 	ImageDigest string `json:"imageDigest,omitempty" protobuf:"bytes,1,opt,name=imageDigest"`
+
+	// imageSizeBytes is the uncompressed size of the built image, as reported
+	// by the Docker daemon that built it.
+	ImageSizeBytes int64 `json:"imageSizeBytes,omitempty" protobuf:"varint,2,opt,name=imageSizeBytes"`
+}
+
+// BuildStatusAdditionalOutput describes the status of the built image with
+// regards to one of the additional outputs of the build.
+type BuildStatusAdditionalOutput struct {
+	// dockerImageReference is the reference, with any templates expanded, the
+	// built image was pushed to.
+	DockerImageReference string `json:"dockerImageReference,omitempty" protobuf:"bytes,1,opt,name=dockerImageReference"`
+
+	// imageDigest is the digest of the image pushed to dockerImageReference. It
+	// may not be set even if the push completes successfully.
+	ImageDigest string `json:"imageDigest,omitempty" protobuf:"bytes,2,opt,name=imageDigest"`
+}
+
+// StageName identifies a stage of a build.
+type StageName string
+
+// Valid values for StageName.
+const (
+	// StageFetchInputs fetches the source and the other inputs of the build.
+	StageFetchInputs StageName = "FetchInputs"
+
+	// StagePullImages pulls the images the build starts from. Source builds
+	// only pull their builder image in this stage; the image of a previous
+	// build and the runtime image they may use are pulled in StageBuild.
+	StagePullImages StageName = "PullImages"
+
+	// StageBuild assembles and commits the image.
+	StageBuild StageName = "Build"
+
+	// StagePostCommit runs the post commit hook of the build.
+	StagePostCommit StageName = "PostCommit"
+
+	// StagePushImage pushes the image to its output and to any additional
+	// outputs of the build.
+	StagePushImage StageName = "PushImage"
+)
+
+// StageInfo describes a stage of a build.
+type StageInfo struct {
+	// name identifies the stage.
+	Name StageName `json:"name,omitempty" protobuf:"bytes,1,opt,name=name,casttype=StageName"`
+
+	// startTime is the time the stage started.
+	StartTime unversioned.Time `json:"startTime,omitempty" protobuf:"bytes,2,opt,name=startTime"`
+
+	// durationMilliseconds is the time the stage took.
+	DurationMilliseconds int64 `json:"durationMilliseconds,omitempty" protobuf:"varint,3,opt,name=durationMilliseconds"`
 }
 
 // BuildSourceType is the type of SCM used.
@@ -290,6 +364,10 @@ type BuildSource struct {
 	// secrets represents a list of secrets and their destinations that will
 	// be used only for the build.
 	Secrets []SecretBuildSource `json:"secrets,omitempty" protobuf:"bytes,8,rep,name=secrets"`
+
+	// configMaps represents a list of configMaps and their destinations that will
+	// be used for the build.
+	ConfigMaps []ConfigMapBuildSource `json:"configMaps,omitempty" protobuf:"bytes,9,rep,name=configMaps"`
 }
 
 // ImageSource is used to describe build source that will be extracted from an image. A reference of
@@ -340,6 +418,24 @@ type SecretBuildSource struct {
 	DestinationDir string `json:"destinationDir,omitempty" protobuf:"bytes,2,opt,name=destinationDir"`
 }
 
+// ConfigMapBuildSource describes a configmap and its destination directory that will be
+// used only at the build time. The content of the configmap referenced here will
+// be copied into the destination directory instead of mounting.
+type ConfigMapBuildSource struct {
+	// configMap is a reference to an existing configmap that you want to use in your
+	// build.
+	ConfigMap kapi.LocalObjectReference `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+
+	// destinationDir is the directory where the files from the configmap should be
+	// available for the build time.
+	// For the Source build strategy, these will be copied into the source directory
+	// before the assemble script runs.
+	// For the Docker build strategy, these will be copied into the build
+	// directory, where the Dockerfile is located, so users can ADD or COPY them
+	// during docker build.
+	DestinationDir string `json:"destinationDir,omitempty" protobuf:"bytes,2,opt,name=destinationDir"`
+}
+
 // BinaryBuildSource describes a binary file to be used for the Docker and Source build strategies,
 // where the file will be extracted and used as the build source.
 type BinaryBuildSource struct {
@@ -505,6 +601,10 @@ type DockerBuildStrategy struct {
 	// dockerfilePath is the path of the Dockerfile that will be used to build the Docker image,
 	// relative to the root of the context (contextDir).
 	DockerfilePath string `json:"dockerfilePath,omitempty" protobuf:"bytes,6,opt,name=dockerfilePath"`
+
+	// buildArgs contains build arguments that will be resolved in the Dockerfile.  See
+	// https://docs.docker.com/engine/reference/builder/#/arg for more details.
+	BuildArgs []kapi.EnvVar `json:"buildArgs,omitempty" protobuf:"bytes,7,rep,name=buildArgs"`
 }
 
 // SourceBuildStrategy defines input parameters specific to an Source build.
@@ -663,6 +763,23 @@ type BuildOutput struct {
 	// imageLabels define a list of labels that are applied to the resulting image. If there
 	// are multiple labels with the same name then the last one in the list is used.
 	ImageLabels []ImageLabel `json:"imageLabels,omitempty" protobuf:"bytes,3,rep,name=imageLabels"`
+
+	// additionalOutputs is a list of further locations the resulting image is pushed to
+	// once it has been pushed to to. The build fails if any of these pushes fails.
+	AdditionalOutputs []AdditionalBuildOutput `json:"additionalOutputs,omitempty" protobuf:"bytes,4,rep,name=additionalOutputs"`
+}
+
+// AdditionalBuildOutput describes a further location the output of a build is pushed to.
+type AdditionalBuildOutput struct {
+	// to defines the location to push the output of this build to. Kind must be one of
+	// 'ImageStreamTag' or 'DockerImage'. The tag may reference the variables
+	// ${SOURCE_COMMIT}, ${SOURCE_REF} and ${BUILD_NUMBER}, which are expanded by the
+	// builder once the source revision is known.
+	To *kapi.ObjectReference `json:"to,omitempty" protobuf:"bytes,1,opt,name=to"`
+
+	// pushSecret is the name of a Secret that would be used for setting up the
+	// authentication for pushing to to.
+	PushSecret *kapi.LocalObjectReference `json:"pushSecret,omitempty" protobuf:"bytes,2,opt,name=pushSecret"`
 }
 
 // ImageLabel represents a label applied to the resulting image.
@@ -704,8 +821,53 @@ type BuildConfigSpec struct {
 
 	// CommonSpec is the desired build specification
 	CommonSpec `json:",inline" protobuf:"bytes,3,opt,name=commonSpec"`
+
+	// statusReporting configures reporting the status of builds as commit
+	// statuses to the service hosting the source repository. Optional.
+	StatusReporting *BuildStatusReporting `json:"statusReporting,omitempty" protobuf:"bytes,4,opt,name=statusReporting"`
+
+	// successfulBuildsHistoryLimit is the number of old successful builds to
+	// retain. Older builds are deleted when a build completes. If not
+	// specified, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int32 `json:"successfulBuildsHistoryLimit,omitempty" protobuf:"varint,5,opt,name=successfulBuildsHistoryLimit"`
+
+	// failedBuildsHistoryLimit is the number of old failed, errored and
+	// cancelled builds to retain. Older builds are deleted when a build
+	// completes. If not specified, all failed builds are retained.
+	FailedBuildsHistoryLimit *int32 `json:"failedBuildsHistoryLimit,omitempty" protobuf:"varint,6,opt,name=failedBuildsHistoryLimit"`
+}
+
+// BuildStatusReporting describes how the status of builds is reported to the
+// service hosting the source repository.
+type BuildStatusReporting struct {
+	// provider is the service the statuses are posted to: GitHub or GitLab.
+	Provider BuildStatusReportingProvider `json:"provider" protobuf:"bytes,1,opt,name=provider,casttype=BuildStatusReportingProvider"`
+
+	// apiURL is the base URL of the provider API. If empty, the API of the
+	// public service is used.
+	APIURL string `json:"apiURL,omitempty" protobuf:"bytes,2,opt,name=apiURL"`
+
+	// secret refers to a secret holding an API token allowed to set commit
+	// statuses under the "token" key.
+	Secret kapi.LocalObjectReference `json:"secret" protobuf:"bytes,3,opt,name=secret"`
+
+	// context is the name the statuses are posted under. If empty,
+	// "openshift/<namespace>/<build config name>" is used.
+	Context string `json:"context,omitempty" protobuf:"bytes,4,opt,name=context"`
 }
 
+// BuildStatusReportingProvider is the type of service build statuses are
+// reported to.
+type BuildStatusReportingProvider string
+
+const (
+	// BuildStatusReportingGitHub reports statuses with the GitHub commit status API.
+	BuildStatusReportingGitHub BuildStatusReportingProvider = "GitHub"
+
+	// BuildStatusReportingGitLab reports statuses with the GitLab commit status API.
+	BuildStatusReportingGitLab BuildStatusReportingProvider = "GitLab"
+)
+
 // BuildRunPolicy defines the behaviour of how the new builds are executed
 // from the existing build configuration.
 type BuildRunPolicy string
@@ -739,8 +901,43 @@ type WebHookTrigger struct {
 	// allowEnv determines whether the webhook can set environment variables; can only
 	// be set to true for GenericWebHook.
 	AllowEnv bool `json:"allowEnv,omitempty" protobuf:"varint,2,opt,name=allowEnv"`
+
+	// pullRequests enables builds of pull requests (and GitLab merge requests)
+	// targeting the branch of the build config. Can only be set for
+	// GitHubWebHook.
+	PullRequests *PullRequestBuildPolicy `json:"pullRequests,omitempty" protobuf:"bytes,3,opt,name=pullRequests"`
+}
+
+// PullRequestBuildPolicy describes how pull requests are built. Pull requests
+// are built like any other build of the build config, so the code of the pull
+// request can read the source secret, the build secrets and the pull secret of
+// the build config. For that reason pull requests opened from forks of the
+// repository are ignored unless allowForks is set.
+type PullRequestBuildPolicy struct {
+	// output determines what is done with the image built from a pull request:
+	// None does not push it, Tag pushes it to the "pr-<number>" tag of the
+	// output of the build config. Defaults to None.
+	Output PullRequestOutputPolicy `json:"output,omitempty" protobuf:"bytes,1,opt,name=output,casttype=PullRequestOutputPolicy"`
+
+	// allowForks enables builds of pull requests opened from forks of the
+	// repository. Only set it if everyone able to open a pull request may read
+	// the secrets used by the build config.
+	AllowForks bool `json:"allowForks,omitempty" protobuf:"varint,2,opt,name=allowForks"`
 }
 
+// PullRequestOutputPolicy determines what is done with the image built from a
+// pull request.
+type PullRequestOutputPolicy string
+
+const (
+	// PullRequestOutputNone does not push the image built from a pull request.
+	PullRequestOutputNone PullRequestOutputPolicy = "None"
+
+	// PullRequestOutputTag pushes the image built from a pull request to the
+	// "pr-<number>" tag of the output of the build config.
+	PullRequestOutputTag PullRequestOutputPolicy = "Tag"
+)
+
 // ImageChangeTrigger allows builds to be triggered when an ImageStream changes
 type ImageChangeTrigger struct {
 	// lastTriggeredImageID is used internally by the ImageChangeController to save last
@@ -866,6 +1063,17 @@ type BuildRequest struct {
 	// triggeredBy describes which triggers started the most recent update to the
 	// build configuration and contains information about those triggers.
 	TriggeredBy []BuildTriggerCause `json:"triggeredBy" protobuf:"bytes,8,rep,name=triggeredBy"`
+
+	// dockerStrategyOptions will override the corresponding options of the Docker
+	// strategy of the build config.
+	DockerStrategyOptions *DockerStrategyOptions `json:"dockerStrategyOptions,omitempty" protobuf:"bytes,9,opt,name=dockerStrategyOptions"`
+}
+
+// DockerStrategyOptions contains extra strategy options for Docker builds.
+type DockerStrategyOptions struct {
+	// buildArgs contains build arguments that will be resolved in the Dockerfile. Arguments
+	// with the same name as those of the strategy replace them.
+	BuildArgs []kapi.EnvVar `json:"buildArgs,omitempty" protobuf:"bytes,1,rep,name=buildArgs"`
 }
 
 // BinaryBuildRequestOptions are the options required to fully speficy a binary build request
@@ -23,6 +23,8 @@ func init() {
 // Public to allow building arbitrary schemes.
 func RegisterConversions(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedConversionFuncs(
+		Convert_v1_AdditionalBuildOutput_To_api_AdditionalBuildOutput,
+		Convert_api_AdditionalBuildOutput_To_v1_AdditionalBuildOutput,
 		Convert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		Convert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions,
 		Convert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
@@ -55,10 +57,14 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_BuildSpec_To_v1_BuildSpec,
 		Convert_v1_BuildStatus_To_api_BuildStatus,
 		Convert_api_BuildStatus_To_v1_BuildStatus,
+		Convert_v1_BuildStatusAdditionalOutput_To_api_BuildStatusAdditionalOutput,
+		Convert_api_BuildStatusAdditionalOutput_To_v1_BuildStatusAdditionalOutput,
 		Convert_v1_BuildStatusOutput_To_api_BuildStatusOutput,
 		Convert_api_BuildStatusOutput_To_v1_BuildStatusOutput,
 		Convert_v1_BuildStatusOutputTo_To_api_BuildStatusOutputTo,
 		Convert_api_BuildStatusOutputTo_To_v1_BuildStatusOutputTo,
+		Convert_v1_BuildStatusReporting_To_api_BuildStatusReporting,
+		Convert_api_BuildStatusReporting_To_v1_BuildStatusReporting,
 		Convert_v1_BuildStrategy_To_api_BuildStrategy,
 		Convert_api_BuildStrategy_To_v1_BuildStrategy,
 		Convert_v1_BuildTriggerCause_To_api_BuildTriggerCause,
@@ -67,10 +73,14 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy,
 		Convert_v1_CommonSpec_To_api_CommonSpec,
 		Convert_api_CommonSpec_To_v1_CommonSpec,
+		Convert_v1_ConfigMapBuildSource_To_api_ConfigMapBuildSource,
+		Convert_api_ConfigMapBuildSource_To_v1_ConfigMapBuildSource,
 		Convert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		Convert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy,
 		Convert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy,
 		Convert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy,
+		Convert_v1_DockerStrategyOptions_To_api_DockerStrategyOptions,
+		Convert_api_DockerStrategyOptions_To_v1_DockerStrategyOptions,
 		Convert_v1_GenericWebHookCause_To_api_GenericWebHookCause,
 		Convert_api_GenericWebHookCause_To_v1_GenericWebHookCause,
 		Convert_v1_GenericWebHookEvent_To_api_GenericWebHookEvent,
@@ -97,6 +107,10 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy,
 		Convert_v1_ProxyConfig_To_api_ProxyConfig,
 		Convert_api_ProxyConfig_To_v1_ProxyConfig,
+		Convert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy,
+		Convert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy,
+		Convert_v1_PullRequestCause_To_api_PullRequestCause,
+		Convert_api_PullRequestCause_To_v1_PullRequestCause,
 		Convert_v1_SecretBuildSource_To_api_SecretBuildSource,
 		Convert_api_SecretBuildSource_To_v1_SecretBuildSource,
 		Convert_v1_SecretSpec_To_api_SecretSpec,
@@ -107,11 +121,65 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_SourceControlUser_To_v1_SourceControlUser,
 		Convert_v1_SourceRevision_To_api_SourceRevision,
 		Convert_api_SourceRevision_To_v1_SourceRevision,
+		Convert_v1_StageInfo_To_api_StageInfo,
+		Convert_api_StageInfo_To_v1_StageInfo,
 		Convert_v1_WebHookTrigger_To_api_WebHookTrigger,
 		Convert_api_WebHookTrigger_To_v1_WebHookTrigger,
 	)
 }
 
+func autoConvert_v1_AdditionalBuildOutput_To_api_AdditionalBuildOutput(in *AdditionalBuildOutput, out *api.AdditionalBuildOutput, s conversion.Scope) error {
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(pkg_api.ObjectReference)
+		if err := api_v1.Convert_v1_ObjectReference_To_api_ObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.To = nil
+	}
+	if in.PushSecret != nil {
+		in, out := &in.PushSecret, &out.PushSecret
+		*out = new(pkg_api.LocalObjectReference)
+		if err := api_v1.Convert_v1_LocalObjectReference_To_api_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
+	return nil
+}
+
+func Convert_v1_AdditionalBuildOutput_To_api_AdditionalBuildOutput(in *AdditionalBuildOutput, out *api.AdditionalBuildOutput, s conversion.Scope) error {
+	return autoConvert_v1_AdditionalBuildOutput_To_api_AdditionalBuildOutput(in, out, s)
+}
+
+func autoConvert_api_AdditionalBuildOutput_To_v1_AdditionalBuildOutput(in *api.AdditionalBuildOutput, out *AdditionalBuildOutput, s conversion.Scope) error {
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(api_v1.ObjectReference)
+		if err := api_v1.Convert_api_ObjectReference_To_v1_ObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.To = nil
+	}
+	if in.PushSecret != nil {
+		in, out := &in.PushSecret, &out.PushSecret
+		*out = new(api_v1.LocalObjectReference)
+		if err := api_v1.Convert_api_LocalObjectReference_To_v1_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PushSecret = nil
+	}
+	return nil
+}
+
+func Convert_api_AdditionalBuildOutput_To_v1_AdditionalBuildOutput(in *api.AdditionalBuildOutput, out *AdditionalBuildOutput, s conversion.Scope) error {
+	return autoConvert_api_AdditionalBuildOutput_To_v1_AdditionalBuildOutput(in, out, s)
+}
+
 func autoConvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions(in *BinaryBuildRequestOptions, out *api.BinaryBuildRequestOptions, s conversion.Scope) error {
 	if err := api_v1.Convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
@@ -286,6 +354,17 @@ func autoConvert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *BuildConfigSpec,
 	if err := Convert_v1_CommonSpec_To_api_CommonSpec(&in.CommonSpec, &out.CommonSpec, s); err != nil {
 		return err
 	}
+	if in.StatusReporting != nil {
+		in, out := &in.StatusReporting, &out.StatusReporting
+		*out = new(api.BuildStatusReporting)
+		if err := Convert_v1_BuildStatusReporting_To_api_BuildStatusReporting(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.StatusReporting = nil
+	}
+	out.SuccessfulBuildsHistoryLimit = (*int32)(unsafe.Pointer(in.SuccessfulBuildsHistoryLimit))
+	out.FailedBuildsHistoryLimit = (*int32)(unsafe.Pointer(in.FailedBuildsHistoryLimit))
 	return nil
 }
 
@@ -309,6 +388,17 @@ func autoConvert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *api.BuildConfigSp
 	if err := Convert_api_CommonSpec_To_v1_CommonSpec(&in.CommonSpec, &out.CommonSpec, s); err != nil {
 		return err
 	}
+	if in.StatusReporting != nil {
+		in, out := &in.StatusReporting, &out.StatusReporting
+		*out = new(BuildStatusReporting)
+		if err := Convert_api_BuildStatusReporting_To_v1_BuildStatusReporting(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.StatusReporting = nil
+	}
+	out.SuccessfulBuildsHistoryLimit = (*int32)(unsafe.Pointer(in.SuccessfulBuildsHistoryLimit))
+	out.FailedBuildsHistoryLimit = (*int32)(unsafe.Pointer(in.FailedBuildsHistoryLimit))
 	return nil
 }
 
@@ -446,6 +536,17 @@ func autoConvert_v1_BuildOutput_To_api_BuildOutput(in *BuildOutput, out *api.Bui
 		out.PushSecret = nil
 	}
 	out.ImageLabels = *(*[]api.ImageLabel)(unsafe.Pointer(&in.ImageLabels))
+	if in.AdditionalOutputs != nil {
+		in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+		*out = make([]api.AdditionalBuildOutput, len(*in))
+		for i := range *in {
+			if err := Convert_v1_AdditionalBuildOutput_To_api_AdditionalBuildOutput(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalOutputs = nil
+	}
 	return nil
 }
 
@@ -469,6 +570,17 @@ func autoConvert_api_BuildOutput_To_v1_BuildOutput(in *api.BuildOutput, out *Bui
 		out.PushSecret = nil
 	}
 	out.ImageLabels = *(*[]ImageLabel)(unsafe.Pointer(&in.ImageLabels))
+	if in.AdditionalOutputs != nil {
+		in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+		*out = make([]AdditionalBuildOutput, len(*in))
+		for i := range *in {
+			if err := Convert_api_AdditionalBuildOutput_To_v1_AdditionalBuildOutput(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalOutputs = nil
+	}
 	return nil
 }
 
@@ -553,6 +665,15 @@ func autoConvert_v1_BuildRequest_To_api_BuildRequest(in *BuildRequest, out *api.
 	} else {
 		out.TriggeredBy = nil
 	}
+	if in.DockerStrategyOptions != nil {
+		in, out := &in.DockerStrategyOptions, &out.DockerStrategyOptions
+		*out = new(api.DockerStrategyOptions)
+		if err := Convert_v1_DockerStrategyOptions_To_api_DockerStrategyOptions(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DockerStrategyOptions = nil
+	}
 	return nil
 }
 
@@ -615,6 +736,15 @@ func autoConvert_api_BuildRequest_To_v1_BuildRequest(in *api.BuildRequest, out *
 	} else {
 		out.TriggeredBy = nil
 	}
+	if in.DockerStrategyOptions != nil {
+		in, out := &in.DockerStrategyOptions, &out.DockerStrategyOptions
+		*out = new(DockerStrategyOptions)
+		if err := Convert_api_DockerStrategyOptions_To_v1_DockerStrategyOptions(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DockerStrategyOptions = nil
+	}
 	return nil
 }
 
@@ -659,6 +789,17 @@ func autoConvert_v1_BuildSource_To_api_BuildSource(in *BuildSource, out *api.Bui
 	} else {
 		out.Secrets = nil
 	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]api.ConfigMapBuildSource, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ConfigMapBuildSource_To_api_ConfigMapBuildSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ConfigMaps = nil
+	}
 	return nil
 }
 
@@ -702,6 +843,17 @@ func autoConvert_api_BuildSource_To_v1_BuildSource(in *api.BuildSource, out *Bui
 	} else {
 		out.Secrets = nil
 	}
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]ConfigMapBuildSource, len(*in))
+		for i := range *in {
+			if err := Convert_api_ConfigMapBuildSource_To_v1_ConfigMapBuildSource(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ConfigMaps = nil
+	}
 	return nil
 }
 
@@ -770,6 +922,7 @@ func autoConvert_v1_BuildStatus_To_api_BuildStatus(in *BuildStatus, out *api.Bui
 	if err := Convert_v1_BuildStatusOutput_To_api_BuildStatusOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Stages = *(*[]api.StageInfo)(unsafe.Pointer(&in.Stages))
 	return nil
 }
 
@@ -798,6 +951,7 @@ func autoConvert_api_BuildStatus_To_v1_BuildStatus(in *api.BuildStatus, out *Bui
 	if err := Convert_api_BuildStatusOutput_To_v1_BuildStatusOutput(&in.Output, &out.Output, s); err != nil {
 		return err
 	}
+	out.Stages = *(*[]StageInfo)(unsafe.Pointer(&in.Stages))
 	return nil
 }
 
@@ -805,8 +959,29 @@ func Convert_api_BuildStatus_To_v1_BuildStatus(in *api.BuildStatus, out *BuildSt
 	return autoConvert_api_BuildStatus_To_v1_BuildStatus(in, out, s)
 }
 
+func autoConvert_v1_BuildStatusAdditionalOutput_To_api_BuildStatusAdditionalOutput(in *BuildStatusAdditionalOutput, out *api.BuildStatusAdditionalOutput, s conversion.Scope) error {
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
+	return nil
+}
+
+func Convert_v1_BuildStatusAdditionalOutput_To_api_BuildStatusAdditionalOutput(in *BuildStatusAdditionalOutput, out *api.BuildStatusAdditionalOutput, s conversion.Scope) error {
+	return autoConvert_v1_BuildStatusAdditionalOutput_To_api_BuildStatusAdditionalOutput(in, out, s)
+}
+
+func autoConvert_api_BuildStatusAdditionalOutput_To_v1_BuildStatusAdditionalOutput(in *api.BuildStatusAdditionalOutput, out *BuildStatusAdditionalOutput, s conversion.Scope) error {
+	out.DockerImageReference = in.DockerImageReference
+	out.ImageDigest = in.ImageDigest
+	return nil
+}
+
+func Convert_api_BuildStatusAdditionalOutput_To_v1_BuildStatusAdditionalOutput(in *api.BuildStatusAdditionalOutput, out *BuildStatusAdditionalOutput, s conversion.Scope) error {
+	return autoConvert_api_BuildStatusAdditionalOutput_To_v1_BuildStatusAdditionalOutput(in, out, s)
+}
+
 func autoConvert_v1_BuildStatusOutput_To_api_BuildStatusOutput(in *BuildStatusOutput, out *api.BuildStatusOutput, s conversion.Scope) error {
 	out.To = (*api.BuildStatusOutputTo)(unsafe.Pointer(in.To))
+	out.AdditionalOutputs = *(*[]api.BuildStatusAdditionalOutput)(unsafe.Pointer(&in.AdditionalOutputs))
 	return nil
 }
 
@@ -816,6 +991,7 @@ func Convert_v1_BuildStatusOutput_To_api_BuildStatusOutput(in *BuildStatusOutput
 
 func autoConvert_api_BuildStatusOutput_To_v1_BuildStatusOutput(in *api.BuildStatusOutput, out *BuildStatusOutput, s conversion.Scope) error {
 	out.To = (*BuildStatusOutputTo)(unsafe.Pointer(in.To))
+	out.AdditionalOutputs = *(*[]BuildStatusAdditionalOutput)(unsafe.Pointer(&in.AdditionalOutputs))
 	return nil
 }
 
@@ -825,6 +1001,7 @@ func Convert_api_BuildStatusOutput_To_v1_BuildStatusOutput(in *api.BuildStatusOu
 
 func autoConvert_v1_BuildStatusOutputTo_To_api_BuildStatusOutputTo(in *BuildStatusOutputTo, out *api.BuildStatusOutputTo, s conversion.Scope) error {
 	out.ImageDigest = in.ImageDigest
+	out.ImageSizeBytes = in.ImageSizeBytes
 	return nil
 }
 
@@ -834,6 +1011,7 @@ func Convert_v1_BuildStatusOutputTo_To_api_BuildStatusOutputTo(in *BuildStatusOu
 
 func autoConvert_api_BuildStatusOutputTo_To_v1_BuildStatusOutputTo(in *api.BuildStatusOutputTo, out *BuildStatusOutputTo, s conversion.Scope) error {
 	out.ImageDigest = in.ImageDigest
+	out.ImageSizeBytes = in.ImageSizeBytes
 	return nil
 }
 
@@ -841,6 +1019,34 @@ func Convert_api_BuildStatusOutputTo_To_v1_BuildStatusOutputTo(in *api.BuildStat
 	return autoConvert_api_BuildStatusOutputTo_To_v1_BuildStatusOutputTo(in, out, s)
 }
 
+func autoConvert_v1_BuildStatusReporting_To_api_BuildStatusReporting(in *BuildStatusReporting, out *api.BuildStatusReporting, s conversion.Scope) error {
+	out.Provider = api.BuildStatusReportingProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := api_v1.Convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_v1_BuildStatusReporting_To_api_BuildStatusReporting(in *BuildStatusReporting, out *api.BuildStatusReporting, s conversion.Scope) error {
+	return autoConvert_v1_BuildStatusReporting_To_api_BuildStatusReporting(in, out, s)
+}
+
+func autoConvert_api_BuildStatusReporting_To_v1_BuildStatusReporting(in *api.BuildStatusReporting, out *BuildStatusReporting, s conversion.Scope) error {
+	out.Provider = BuildStatusReportingProvider(in.Provider)
+	out.APIURL = in.APIURL
+	if err := api_v1.Convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.Context = in.Context
+	return nil
+}
+
+func Convert_api_BuildStatusReporting_To_v1_BuildStatusReporting(in *api.BuildStatusReporting, out *BuildStatusReporting, s conversion.Scope) error {
+	return autoConvert_api_BuildStatusReporting_To_v1_BuildStatusReporting(in, out, s)
+}
+
 func autoConvert_v1_BuildStrategy_To_api_BuildStrategy(in *BuildStrategy, out *api.BuildStrategy, s conversion.Scope) error {
 	// INFO: in.Type opted out of conversion generation
 	if in.DockerStrategy != nil {
@@ -1088,6 +1294,30 @@ func Convert_api_CommonSpec_To_v1_CommonSpec(in *api.CommonSpec, out *CommonSpec
 	return autoConvert_api_CommonSpec_To_v1_CommonSpec(in, out, s)
 }
 
+func autoConvert_v1_ConfigMapBuildSource_To_api_ConfigMapBuildSource(in *ConfigMapBuildSource, out *api.ConfigMapBuildSource, s conversion.Scope) error {
+	if err := api_v1.Convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.ConfigMap, &out.ConfigMap, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func Convert_v1_ConfigMapBuildSource_To_api_ConfigMapBuildSource(in *ConfigMapBuildSource, out *api.ConfigMapBuildSource, s conversion.Scope) error {
+	return autoConvert_v1_ConfigMapBuildSource_To_api_ConfigMapBuildSource(in, out, s)
+}
+
+func autoConvert_api_ConfigMapBuildSource_To_v1_ConfigMapBuildSource(in *api.ConfigMapBuildSource, out *ConfigMapBuildSource, s conversion.Scope) error {
+	if err := api_v1.Convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.ConfigMap, &out.ConfigMap, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func Convert_api_ConfigMapBuildSource_To_v1_ConfigMapBuildSource(in *api.ConfigMapBuildSource, out *ConfigMapBuildSource, s conversion.Scope) error {
+	return autoConvert_api_ConfigMapBuildSource_To_v1_ConfigMapBuildSource(in, out, s)
+}
+
 func autoConvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy(in *CustomBuildStrategy, out *api.CustomBuildStrategy, s conversion.Scope) error {
 	if err := api_v1.Convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
 		return err
@@ -1207,6 +1437,17 @@ func autoConvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy(in *DockerBui
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		in, out := &in.BuildArgs, &out.BuildArgs
+		*out = make([]pkg_api.EnvVar, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_v1_EnvVar_To_api_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -1243,6 +1484,17 @@ func autoConvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy(in *api.Docke
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildArgs != nil {
+		in, out := &in.BuildArgs, &out.BuildArgs
+		*out = make([]api_v1.EnvVar, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_api_EnvVar_To_v1_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
 	return nil
 }
 
@@ -1250,6 +1502,44 @@ func Convert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy(in *api.DockerBui
 	return autoConvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy(in, out, s)
 }
 
+func autoConvert_v1_DockerStrategyOptions_To_api_DockerStrategyOptions(in *DockerStrategyOptions, out *api.DockerStrategyOptions, s conversion.Scope) error {
+	if in.BuildArgs != nil {
+		in, out := &in.BuildArgs, &out.BuildArgs
+		*out = make([]pkg_api.EnvVar, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_v1_EnvVar_To_api_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
+	return nil
+}
+
+func Convert_v1_DockerStrategyOptions_To_api_DockerStrategyOptions(in *DockerStrategyOptions, out *api.DockerStrategyOptions, s conversion.Scope) error {
+	return autoConvert_v1_DockerStrategyOptions_To_api_DockerStrategyOptions(in, out, s)
+}
+
+func autoConvert_api_DockerStrategyOptions_To_v1_DockerStrategyOptions(in *api.DockerStrategyOptions, out *DockerStrategyOptions, s conversion.Scope) error {
+	if in.BuildArgs != nil {
+		in, out := &in.BuildArgs, &out.BuildArgs
+		*out = make([]api_v1.EnvVar, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_api_EnvVar_To_v1_EnvVar(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.BuildArgs = nil
+	}
+	return nil
+}
+
+func Convert_api_DockerStrategyOptions_To_v1_DockerStrategyOptions(in *api.DockerStrategyOptions, out *DockerStrategyOptions, s conversion.Scope) error {
+	return autoConvert_api_DockerStrategyOptions_To_v1_DockerStrategyOptions(in, out, s)
+}
+
 func autoConvert_v1_GenericWebHookCause_To_api_GenericWebHookCause(in *GenericWebHookCause, out *api.GenericWebHookCause, s conversion.Scope) error {
 	if in.Revision != nil {
 		in, out := &in.Revision, &out.Revision
@@ -1380,6 +1670,7 @@ func autoConvert_v1_GitHubWebHookCause_To_api_GitHubWebHookCause(in *GitHubWebHo
 		out.Revision = nil
 	}
 	out.Secret = in.Secret
+	out.PullRequest = (*api.PullRequestCause)(unsafe.Pointer(in.PullRequest))
 	return nil
 }
 
@@ -1398,6 +1689,7 @@ func autoConvert_api_GitHubWebHookCause_To_v1_GitHubWebHookCause(in *api.GitHubW
 		out.Revision = nil
 	}
 	out.Secret = in.Secret
+	out.PullRequest = (*PullRequestCause)(unsafe.Pointer(in.PullRequest))
 	return nil
 }
 
@@ -1662,6 +1954,46 @@ func Convert_api_ProxyConfig_To_v1_ProxyConfig(in *api.ProxyConfig, out *ProxyCo
 	return autoConvert_api_ProxyConfig_To_v1_ProxyConfig(in, out, s)
 }
 
+func autoConvert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in *PullRequestBuildPolicy, out *api.PullRequestBuildPolicy, s conversion.Scope) error {
+	out.Output = api.PullRequestOutputPolicy(in.Output)
+	out.AllowForks = in.AllowForks
+	return nil
+}
+
+func Convert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in *PullRequestBuildPolicy, out *api.PullRequestBuildPolicy, s conversion.Scope) error {
+	return autoConvert_v1_PullRequestBuildPolicy_To_api_PullRequestBuildPolicy(in, out, s)
+}
+
+func autoConvert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy(in *api.PullRequestBuildPolicy, out *PullRequestBuildPolicy, s conversion.Scope) error {
+	out.Output = PullRequestOutputPolicy(in.Output)
+	out.AllowForks = in.AllowForks
+	return nil
+}
+
+func Convert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy(in *api.PullRequestBuildPolicy, out *PullRequestBuildPolicy, s conversion.Scope) error {
+	return autoConvert_api_PullRequestBuildPolicy_To_v1_PullRequestBuildPolicy(in, out, s)
+}
+
+func autoConvert_v1_PullRequestCause_To_api_PullRequestCause(in *PullRequestCause, out *api.PullRequestCause, s conversion.Scope) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
+func Convert_v1_PullRequestCause_To_api_PullRequestCause(in *PullRequestCause, out *api.PullRequestCause, s conversion.Scope) error {
+	return autoConvert_v1_PullRequestCause_To_api_PullRequestCause(in, out, s)
+}
+
+func autoConvert_api_PullRequestCause_To_v1_PullRequestCause(in *api.PullRequestCause, out *PullRequestCause, s conversion.Scope) error {
+	out.Number = in.Number
+	out.Ref = in.Ref
+	return nil
+}
+
+func Convert_api_PullRequestCause_To_v1_PullRequestCause(in *api.PullRequestCause, out *PullRequestCause, s conversion.Scope) error {
+	return autoConvert_api_PullRequestCause_To_v1_PullRequestCause(in, out, s)
+}
+
 func autoConvert_v1_SecretBuildSource_To_api_SecretBuildSource(in *SecretBuildSource, out *api.SecretBuildSource, s conversion.Scope) error {
 	if err := api_v1.Convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
 		return err
@@ -1829,9 +2161,32 @@ func autoConvert_api_SourceRevision_To_v1_SourceRevision(in *api.SourceRevision,
 	return nil
 }
 
+func autoConvert_v1_StageInfo_To_api_StageInfo(in *StageInfo, out *api.StageInfo, s conversion.Scope) error {
+	out.Name = api.StageName(in.Name)
+	out.StartTime = in.StartTime
+	out.DurationMilliseconds = in.DurationMilliseconds
+	return nil
+}
+
+func Convert_v1_StageInfo_To_api_StageInfo(in *StageInfo, out *api.StageInfo, s conversion.Scope) error {
+	return autoConvert_v1_StageInfo_To_api_StageInfo(in, out, s)
+}
+
+func autoConvert_api_StageInfo_To_v1_StageInfo(in *api.StageInfo, out *StageInfo, s conversion.Scope) error {
+	out.Name = StageName(in.Name)
+	out.StartTime = in.StartTime
+	out.DurationMilliseconds = in.DurationMilliseconds
+	return nil
+}
+
+func Convert_api_StageInfo_To_v1_StageInfo(in *api.StageInfo, out *StageInfo, s conversion.Scope) error {
+	return autoConvert_api_StageInfo_To_v1_StageInfo(in, out, s)
+}
+
 func autoConvert_v1_WebHookTrigger_To_api_WebHookTrigger(in *WebHookTrigger, out *api.WebHookTrigger, s conversion.Scope) error {
 	out.Secret = in.Secret
 	out.AllowEnv = in.AllowEnv
+	out.PullRequests = (*api.PullRequestBuildPolicy)(unsafe.Pointer(in.PullRequests))
 	return nil
 }
 
@@ -1842,6 +2197,7 @@ func Convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *WebHookTrigger, out *ap
 func autoConvert_api_WebHookTrigger_To_v1_WebHookTrigger(in *api.WebHookTrigger, out *WebHookTrigger, s conversion.Scope) error {
 	out.Secret = in.Secret
 	out.AllowEnv = in.AllowEnv
+	out.PullRequests = (*PullRequestBuildPolicy)(unsafe.Pointer(in.PullRequests))
 	return nil
 }
 
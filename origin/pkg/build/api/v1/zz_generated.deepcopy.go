@@ -20,6 +20,7 @@ func init() {
 // to allow building arbitrary schemes.
 func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedDeepCopyFuncs(
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_AdditionalBuildOutput, InType: reflect.TypeOf(&AdditionalBuildOutput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BinaryBuildRequestOptions, InType: reflect.TypeOf(&BinaryBuildRequestOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BinaryBuildSource, InType: reflect.TypeOf(&BinaryBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_Build, InType: reflect.TypeOf(&Build{})},
@@ -36,14 +37,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildSource, InType: reflect.TypeOf(&BuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildSpec, InType: reflect.TypeOf(&BuildSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildStatus, InType: reflect.TypeOf(&BuildStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildStatusAdditionalOutput, InType: reflect.TypeOf(&BuildStatusAdditionalOutput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildStatusOutput, InType: reflect.TypeOf(&BuildStatusOutput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildStatusOutputTo, InType: reflect.TypeOf(&BuildStatusOutputTo{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildStatusReporting, InType: reflect.TypeOf(&BuildStatusReporting{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildStrategy, InType: reflect.TypeOf(&BuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildTriggerCause, InType: reflect.TypeOf(&BuildTriggerCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_BuildTriggerPolicy, InType: reflect.TypeOf(&BuildTriggerPolicy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_CommonSpec, InType: reflect.TypeOf(&CommonSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ConfigMapBuildSource, InType: reflect.TypeOf(&ConfigMapBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_CustomBuildStrategy, InType: reflect.TypeOf(&CustomBuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_DockerBuildStrategy, InType: reflect.TypeOf(&DockerBuildStrategy{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_DockerStrategyOptions, InType: reflect.TypeOf(&DockerStrategyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_GenericWebHookCause, InType: reflect.TypeOf(&GenericWebHookCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_GenericWebHookEvent, InType: reflect.TypeOf(&GenericWebHookEvent{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_GitBuildSource, InType: reflect.TypeOf(&GitBuildSource{})},
@@ -57,15 +62,40 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageSourcePath, InType: reflect.TypeOf(&ImageSourcePath{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_JenkinsPipelineBuildStrategy, InType: reflect.TypeOf(&JenkinsPipelineBuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProxyConfig, InType: reflect.TypeOf(&ProxyConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PullRequestBuildPolicy, InType: reflect.TypeOf(&PullRequestBuildPolicy{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_PullRequestCause, InType: reflect.TypeOf(&PullRequestCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SecretBuildSource, InType: reflect.TypeOf(&SecretBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SecretSpec, InType: reflect.TypeOf(&SecretSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SourceBuildStrategy, InType: reflect.TypeOf(&SourceBuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SourceControlUser, InType: reflect.TypeOf(&SourceControlUser{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_SourceRevision, InType: reflect.TypeOf(&SourceRevision{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_StageInfo, InType: reflect.TypeOf(&StageInfo{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_WebHookTrigger, InType: reflect.TypeOf(&WebHookTrigger{})},
 	)
 }
 
+func DeepCopy_v1_AdditionalBuildOutput(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*AdditionalBuildOutput)
+		out := out.(*AdditionalBuildOutput)
+		if in.To != nil {
+			in, out := &in.To, &out.To
+			*out = new(api_v1.ObjectReference)
+			**out = **in
+		} else {
+			out.To = nil
+		}
+		if in.PushSecret != nil {
+			in, out := &in.PushSecret, &out.PushSecret
+			*out = new(api_v1.LocalObjectReference)
+			**out = **in
+		} else {
+			out.PushSecret = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_v1_BinaryBuildRequestOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*BinaryBuildRequestOptions)
@@ -168,6 +198,27 @@ func DeepCopy_v1_BuildConfigSpec(in interface{}, out interface{}, c *conversion.
 		if err := DeepCopy_v1_CommonSpec(&in.CommonSpec, &out.CommonSpec, c); err != nil {
 			return err
 		}
+		if in.StatusReporting != nil {
+			in, out := &in.StatusReporting, &out.StatusReporting
+			*out = new(BuildStatusReporting)
+			**out = **in
+		} else {
+			out.StatusReporting = nil
+		}
+		if in.SuccessfulBuildsHistoryLimit != nil {
+			in, out := &in.SuccessfulBuildsHistoryLimit, &out.SuccessfulBuildsHistoryLimit
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.SuccessfulBuildsHistoryLimit = nil
+		}
+		if in.FailedBuildsHistoryLimit != nil {
+			in, out := &in.FailedBuildsHistoryLimit, &out.FailedBuildsHistoryLimit
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.FailedBuildsHistoryLimit = nil
+		}
 		return nil
 	}
 }
@@ -287,6 +338,17 @@ func DeepCopy_v1_BuildOutput(in interface{}, out interface{}, c *conversion.Clon
 		} else {
 			out.ImageLabels = nil
 		}
+		if in.AdditionalOutputs != nil {
+			in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+			*out = make([]AdditionalBuildOutput, len(*in))
+			for i := range *in {
+				if err := DeepCopy_v1_AdditionalBuildOutput(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.AdditionalOutputs = nil
+		}
 		return nil
 	}
 }
@@ -381,6 +443,15 @@ func DeepCopy_v1_BuildRequest(in interface{}, out interface{}, c *conversion.Clo
 		} else {
 			out.TriggeredBy = nil
 		}
+		if in.DockerStrategyOptions != nil {
+			in, out := &in.DockerStrategyOptions, &out.DockerStrategyOptions
+			*out = new(DockerStrategyOptions)
+			if err := DeepCopy_v1_DockerStrategyOptions(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.DockerStrategyOptions = nil
+		}
 		return nil
 	}
 }
@@ -441,6 +512,15 @@ func DeepCopy_v1_BuildSource(in interface{}, out interface{}, c *conversion.Clon
 		} else {
 			out.Secrets = nil
 		}
+		if in.ConfigMaps != nil {
+			in, out := &in.ConfigMaps, &out.ConfigMaps
+			*out = make([]ConfigMapBuildSource, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.ConfigMaps = nil
+		}
 		return nil
 	}
 }
@@ -501,6 +581,27 @@ func DeepCopy_v1_BuildStatus(in interface{}, out interface{}, c *conversion.Clon
 		if err := DeepCopy_v1_BuildStatusOutput(&in.Output, &out.Output, c); err != nil {
 			return err
 		}
+		if in.Stages != nil {
+			in, out := &in.Stages, &out.Stages
+			*out = make([]StageInfo, len(*in))
+			for i := range *in {
+				if err := DeepCopy_v1_StageInfo(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Stages = nil
+		}
+		return nil
+	}
+}
+
+func DeepCopy_v1_BuildStatusAdditionalOutput(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BuildStatusAdditionalOutput)
+		out := out.(*BuildStatusAdditionalOutput)
+		out.DockerImageReference = in.DockerImageReference
+		out.ImageDigest = in.ImageDigest
 		return nil
 	}
 }
@@ -516,6 +617,15 @@ func DeepCopy_v1_BuildStatusOutput(in interface{}, out interface{}, c *conversio
 		} else {
 			out.To = nil
 		}
+		if in.AdditionalOutputs != nil {
+			in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+			*out = make([]BuildStatusAdditionalOutput, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.AdditionalOutputs = nil
+		}
 		return nil
 	}
 }
@@ -525,6 +635,19 @@ func DeepCopy_v1_BuildStatusOutputTo(in interface{}, out interface{}, c *convers
 		in := in.(*BuildStatusOutputTo)
 		out := out.(*BuildStatusOutputTo)
 		out.ImageDigest = in.ImageDigest
+		out.ImageSizeBytes = in.ImageSizeBytes
+		return nil
+	}
+}
+
+func DeepCopy_v1_BuildStatusReporting(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BuildStatusReporting)
+		out := out.(*BuildStatusReporting)
+		out.Provider = in.Provider
+		out.APIURL = in.APIURL
+		out.Secret = in.Secret
+		out.Context = in.Context
 		return nil
 	}
 }
@@ -616,14 +739,18 @@ func DeepCopy_v1_BuildTriggerPolicy(in interface{}, out interface{}, c *conversi
 		if in.GitHubWebHook != nil {
 			in, out := &in.GitHubWebHook, &out.GitHubWebHook
 			*out = new(WebHookTrigger)
-			**out = **in
+			if err := DeepCopy_v1_WebHookTrigger(*in, *out, c); err != nil {
+				return err
+			}
 		} else {
 			out.GitHubWebHook = nil
 		}
 		if in.GenericWebHook != nil {
 			in, out := &in.GenericWebHook, &out.GenericWebHook
 			*out = new(WebHookTrigger)
-			**out = **in
+			if err := DeepCopy_v1_WebHookTrigger(*in, *out, c); err != nil {
+				return err
+			}
 		} else {
 			out.GenericWebHook = nil
 		}
@@ -689,6 +816,16 @@ func DeepCopy_v1_CommonSpec(in interface{}, out interface{}, c *conversion.Clone
 	}
 }
 
+func DeepCopy_v1_ConfigMapBuildSource(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ConfigMapBuildSource)
+		out := out.(*ConfigMapBuildSource)
+		out.ConfigMap = in.ConfigMap
+		out.DestinationDir = in.DestinationDir
+		return nil
+	}
+}
+
 func DeepCopy_v1_CustomBuildStrategy(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*CustomBuildStrategy)
@@ -760,6 +897,36 @@ func DeepCopy_v1_DockerBuildStrategy(in interface{}, out interface{}, c *convers
 		}
 		out.ForcePull = in.ForcePull
 		out.DockerfilePath = in.DockerfilePath
+		if in.BuildArgs != nil {
+			in, out := &in.BuildArgs, &out.BuildArgs
+			*out = make([]api_v1.EnvVar, len(*in))
+			for i := range *in {
+				if err := api_v1.DeepCopy_v1_EnvVar(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.BuildArgs = nil
+		}
+		return nil
+	}
+}
+
+func DeepCopy_v1_DockerStrategyOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*DockerStrategyOptions)
+		out := out.(*DockerStrategyOptions)
+		if in.BuildArgs != nil {
+			in, out := &in.BuildArgs, &out.BuildArgs
+			*out = make([]api_v1.EnvVar, len(*in))
+			for i := range *in {
+				if err := api_v1.DeepCopy_v1_EnvVar(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.BuildArgs = nil
+		}
 		return nil
 	}
 }
@@ -838,6 +1005,13 @@ func DeepCopy_v1_GitHubWebHookCause(in interface{}, out interface{}, c *conversi
 			out.Revision = nil
 		}
 		out.Secret = in.Secret
+		if in.PullRequest != nil {
+			in, out := &in.PullRequest, &out.PullRequest
+			*out = new(PullRequestCause)
+			**out = **in
+		} else {
+			out.PullRequest = nil
+		}
 		return nil
 	}
 }
@@ -982,6 +1156,26 @@ func DeepCopy_v1_ProxyConfig(in interface{}, out interface{}, c *conversion.Clon
 	}
 }
 
+func DeepCopy_v1_PullRequestBuildPolicy(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PullRequestBuildPolicy)
+		out := out.(*PullRequestBuildPolicy)
+		out.Output = in.Output
+		out.AllowForks = in.AllowForks
+		return nil
+	}
+}
+
+func DeepCopy_v1_PullRequestCause(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PullRequestCause)
+		out := out.(*PullRequestCause)
+		out.Number = in.Number
+		out.Ref = in.Ref
+		return nil
+	}
+}
+
 func DeepCopy_v1_SecretBuildSource(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*SecretBuildSource)
@@ -1080,12 +1274,30 @@ func DeepCopy_v1_SourceRevision(in interface{}, out interface{}, c *conversion.C
 	}
 }
 
+func DeepCopy_v1_StageInfo(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*StageInfo)
+		out := out.(*StageInfo)
+		out.Name = in.Name
+		out.StartTime = in.StartTime.DeepCopy()
+		out.DurationMilliseconds = in.DurationMilliseconds
+		return nil
+	}
+}
+
 func DeepCopy_v1_WebHookTrigger(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*WebHookTrigger)
 		out := out.(*WebHookTrigger)
 		out.Secret = in.Secret
 		out.AllowEnv = in.AllowEnv
+		if in.PullRequests != nil {
+			in, out := &in.PullRequests, &out.PullRequests
+			*out = new(PullRequestBuildPolicy)
+			**out = **in
+		} else {
+			out.PullRequests = nil
+		}
 		return nil
 	}
 }
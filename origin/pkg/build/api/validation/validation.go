@@ -150,6 +150,17 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) field.ErrorList {
 
 	allErrs = append(allErrs, validateCommonSpec(&config.Spec.CommonSpec, specPath)...)
 
+	if config.Spec.StatusReporting != nil {
+		allErrs = append(allErrs, validateStatusReporting(config.Spec.StatusReporting, &config.Spec.Source, specPath.Child("statusReporting"))...)
+	}
+
+	if config.Spec.SuccessfulBuildsHistoryLimit != nil {
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(int64(*config.Spec.SuccessfulBuildsHistoryLimit), specPath.Child("successfulBuildsHistoryLimit"))...)
+	}
+	if config.Spec.FailedBuildsHistoryLimit != nil {
+		allErrs = append(allErrs, validation.ValidateNonnegativeField(int64(*config.Spec.FailedBuildsHistoryLimit), specPath.Child("failedBuildsHistoryLimit"))...)
+	}
+
 	return allErrs
 }
 
@@ -162,7 +173,11 @@ func ValidateBuildConfigUpdate(config *buildapi.BuildConfig, older *buildapi.Bui
 
 // ValidateBuildRequest validates a BuildRequest object
 func ValidateBuildRequest(request *buildapi.BuildRequest) field.ErrorList {
-	return validation.ValidateObjectMeta(&request.ObjectMeta, true, kpath.ValidatePathSegmentName, field.NewPath("metadata"))
+	allErrs := validation.ValidateObjectMeta(&request.ObjectMeta, true, kpath.ValidatePathSegmentName, field.NewPath("metadata"))
+	if request.DockerStrategyOptions != nil {
+		allErrs = append(allErrs, ValidateStrategyEnv(request.DockerStrategyOptions.BuildArgs, field.NewPath("dockerStrategyOptions", "buildArgs"))...)
+	}
+	return allErrs
 }
 
 func validateCommonSpec(spec *buildapi.CommonSpec, fldPath *field.Path) field.ErrorList {
@@ -233,6 +248,8 @@ func validateSource(input *buildapi.BuildSource, isCustomStrategy, isDockerStrat
 
 	allErrs = append(allErrs, validateSecrets(input.Secrets, isDockerStrategy, fldPath.Child("secrets"))...)
 
+	allErrs = append(allErrs, validateConfigMaps(input.ConfigMaps, fldPath.Child("configMaps"))...)
+
 	allErrs = append(allErrs, validateSecretRef(input.SourceSecret, fldPath.Child("sourceSecret"))...)
 
 	if len(input.ContextDir) != 0 {
@@ -304,6 +321,26 @@ func validateSecrets(secrets []buildapi.SecretBuildSource, isDockerStrategy bool
 	return allErrs
 }
 
+// validateConfigMaps validates the configMaps of a build source. Unlike secrets, configMaps are
+// copied into the build directory for all strategies, so their destination has to be relative.
+func validateConfigMaps(configMaps []buildapi.ConfigMapBuildSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, c := range configMaps {
+		if len(c.ConfigMap.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("configMap"), ""))
+		} else if reasons := validation.ValidateConfigMapName(c.ConfigMap.Name, false); len(reasons) != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("configMap"), c, "must be valid configMap name"))
+		}
+		if strings.HasPrefix(path.Clean(c.DestinationDir), "..") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("destinationDir"), c.DestinationDir, "destination dir cannot start with '..'"))
+		}
+		if filepath.IsAbs(c.DestinationDir) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("destinationDir"), c.DestinationDir, "destinationDir has to be a relative path"))
+		}
+	}
+	return allErrs
+}
+
 func validateImageSource(imageSource buildapi.ImageSource, fldPath *field.Path) field.ErrorList {
 	allErrs := validateFromImageReference(&imageSource.From, fldPath.Child("from"))
 	if imageSource.PullSecret != nil {
@@ -437,6 +474,41 @@ func validateOutput(output *buildapi.BuildOutput, fldPath *field.Path) field.Err
 	allErrs = append(allErrs, validateSecretRef(output.PushSecret, fldPath.Child("pushSecret"))...)
 	allErrs = append(allErrs, ValidateImageLabels(output.ImageLabels, fldPath.Child("imageLabels"))...)
 
+	if len(output.AdditionalOutputs) > 0 && (output.To == nil || len(output.To.Name) == 0) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("to"), "must be set when additionalOutputs are specified"))
+	}
+	for i, additional := range output.AdditionalOutputs {
+		allErrs = append(allErrs, validateAdditionalOutput(&additional, fldPath.Child("additionalOutputs").Index(i))...)
+	}
+
+	return allErrs
+}
+
+// sampleOutputVariables are used to check that the names of additional outputs
+// are valid once the builder expands them.
+var sampleOutputVariables = map[string]string{
+	buildutil.OutputVariableSourceCommit: "0123456789abcdef0123456789abcdef01234567",
+	buildutil.OutputVariableSourceRef:    "master",
+	buildutil.OutputVariableBuildNumber:  "1",
+}
+
+func validateAdditionalOutput(output *buildapi.AdditionalBuildOutput, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if output.To == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("to"), ""))
+	} else {
+		name, err := buildutil.ExpandOutputName(output.To.Name, sampleOutputVariables)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("to", "name"), output.To.Name, err.Error()))
+		} else {
+			to := *output.To
+			to.Name = name
+			allErrs = append(allErrs, validateToImageReference(&to, fldPath.Child("to"))...)
+		}
+	}
+	allErrs = append(allErrs, validateSecretRef(output.PushSecret, fldPath.Child("pushSecret"))...)
+
 	return allErrs
 }
 
@@ -495,6 +567,8 @@ func validateDockerStrategy(strategy *buildapi.DockerBuildStrategy, fldPath *fie
 
 	allErrs = append(allErrs, ValidateStrategyEnv(strategy.Env, fldPath.Child("env"))...)
 
+	allErrs = append(allErrs, ValidateStrategyEnv(strategy.BuildArgs, fldPath.Child("buildArgs"))...)
+
 	return allErrs
 }
 
@@ -606,6 +680,30 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy, buildFrom *kapi.Objec
 	return allErrs
 }
 
+func validateStatusReporting(reporting *buildapi.BuildStatusReporting, source *buildapi.BuildSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch reporting.Provider {
+	case buildapi.BuildStatusReportingGitHub, buildapi.BuildStatusReportingGitLab:
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("provider"), ""))
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("provider"), reporting.Provider,
+			[]string{string(buildapi.BuildStatusReportingGitHub), string(buildapi.BuildStatusReportingGitLab)}))
+	}
+	if len(reporting.APIURL) != 0 {
+		if u, err := url.Parse(reporting.APIURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("apiURL"), reporting.APIURL, "must be an http or https URL"))
+		}
+	}
+	if len(reporting.Secret.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secret", "name"), ""))
+	}
+	if source.Git == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "build status can only be reported for builds from a Git repository"))
+	}
+	return allErrs
+}
+
 func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path, isGeneric bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(webHook.Secret) == 0 {
@@ -614,6 +712,17 @@ func validateWebHook(webHook *buildapi.WebHookTrigger, fldPath *field.Path, isGe
 	if !isGeneric && webHook.AllowEnv {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("allowEnv"), webHook, "git webhooks cannot allow env vars"))
 	}
+	if webHook.PullRequests != nil {
+		if isGeneric {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("pullRequests"), webHook.PullRequests, "generic webhooks cannot build pull requests"))
+		}
+		switch webHook.PullRequests.Output {
+		case "", buildapi.PullRequestOutputNone, buildapi.PullRequestOutputTag:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("pullRequests", "output"), webHook.PullRequests.Output,
+				[]string{string(buildapi.PullRequestOutputNone), string(buildapi.PullRequestOutputTag)}))
+		}
+	}
 	return allErrs
 }
 
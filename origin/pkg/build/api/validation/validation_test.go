@@ -480,6 +480,149 @@ func TestBuildConfigValidationFailureRequiredName(t *testing.T) {
 	}
 }
 
+func TestBuildConfigStatusReporting(t *testing.T) {
+	tests := []struct {
+		name      string
+		reporting buildapi.BuildStatusReporting
+		noGit     bool
+		field     string
+	}{
+		{
+			name:      "valid",
+			reporting: buildapi.BuildStatusReporting{Provider: buildapi.BuildStatusReportingGitHub, Secret: kapi.LocalObjectReference{Name: "token"}},
+		},
+		{
+			name:      "valid with api url",
+			reporting: buildapi.BuildStatusReporting{Provider: buildapi.BuildStatusReportingGitLab, APIURL: "https://gitlab.example.com/api/v4", Secret: kapi.LocalObjectReference{Name: "token"}},
+		},
+		{
+			name:      "missing provider",
+			reporting: buildapi.BuildStatusReporting{Secret: kapi.LocalObjectReference{Name: "token"}},
+			field:     "spec.statusReporting.provider",
+		},
+		{
+			name:      "unknown provider",
+			reporting: buildapi.BuildStatusReporting{Provider: "Bitbucket", Secret: kapi.LocalObjectReference{Name: "token"}},
+			field:     "spec.statusReporting.provider",
+		},
+		{
+			name:      "invalid api url",
+			reporting: buildapi.BuildStatusReporting{Provider: buildapi.BuildStatusReportingGitHub, APIURL: "api.github.com", Secret: kapi.LocalObjectReference{Name: "token"}},
+			field:     "spec.statusReporting.apiURL",
+		},
+		{
+			name:      "missing secret",
+			reporting: buildapi.BuildStatusReporting{Provider: buildapi.BuildStatusReportingGitHub},
+			field:     "spec.statusReporting.secret.name",
+		},
+		{
+			name:      "no git source",
+			reporting: buildapi.BuildStatusReporting{Provider: buildapi.BuildStatusReportingGitHub, Secret: kapi.LocalObjectReference{Name: "token"}},
+			noGit:     true,
+			field:     "spec.statusReporting",
+		},
+	}
+	for _, test := range tests {
+		reporting := test.reporting
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: buildapi.BuildRunPolicySerial,
+				CommonSpec: buildapi.CommonSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+				StatusReporting: &reporting,
+			},
+		}
+		if test.noGit {
+			dockerfile := "FROM something"
+			buildConfig.Spec.Source = buildapi.BuildSource{Dockerfile: &dockerfile}
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		switch {
+		case len(test.field) == 0 && len(errors) != 0:
+			t.Errorf("%s: unexpected validation errors %v", test.name, errors)
+		case len(test.field) != 0 && (len(errors) != 1 || errors[0].Field != test.field):
+			t.Errorf("%s: expected a single error on %s, got %v", test.name, test.field, errors)
+		}
+	}
+}
+
+func TestBuildConfigHistoryLimits(t *testing.T) {
+	zero, positive, negative := int32(0), int32(5), int32(-1)
+	tests := []struct {
+		name       string
+		successful *int32
+		failed     *int32
+		field      string
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:       "valid",
+			successful: &positive,
+			failed:     &zero,
+		},
+		{
+			name:       "negative successful limit",
+			successful: &negative,
+			field:      "spec.successfulBuildsHistoryLimit",
+		},
+		{
+			name:   "negative failed limit",
+			failed: &negative,
+			field:  "spec.failedBuildsHistoryLimit",
+		},
+	}
+	for _, test := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: buildapi.BuildRunPolicySerial,
+				CommonSpec: buildapi.CommonSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+				SuccessfulBuildsHistoryLimit: test.successful,
+				FailedBuildsHistoryLimit:     test.failed,
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		switch {
+		case len(test.field) == 0 && len(errors) != 0:
+			t.Errorf("%s: unexpected validation errors %v", test.name, errors)
+		case len(test.field) != 0 && (len(errors) != 1 || errors[0].Field != test.field):
+			t.Errorf("%s: expected a single error on %s, got %v", test.name, test.field, errors)
+		}
+	}
+}
+
 func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -794,6 +937,10 @@ func TestValidateBuildRequest(t *testing.T) {
 	testCases := map[string]*buildapi.BuildRequest{
 		string(field.ErrorTypeRequired) + "metadata.namespace": {ObjectMeta: kapi.ObjectMeta{Name: "requestName"}},
 		string(field.ErrorTypeRequired) + "metadata.name":      {ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault}},
+		string(field.ErrorTypeInvalid) + "dockerStrategyOptions.buildArgs[0].name": {
+			ObjectMeta:            kapi.ObjectMeta{Name: "requestName", Namespace: kapi.NamespaceDefault},
+			DockerStrategyOptions: &buildapi.DockerStrategyOptions{BuildArgs: []kapi.EnvVar{{Name: "invalid-name", Value: "a"}}},
+		},
 	}
 
 	for desc, tc := range testCases {
@@ -1126,6 +1273,38 @@ func TestValidateSource(t *testing.T) {
 				},
 			},
 		},
+		// 22
+		{
+			t:    field.ErrorTypeRequired,
+			path: "configMaps[0].configMap",
+			source: &buildapi.BuildSource{
+				Dockerfile: &dockerfile,
+				ConfigMaps: []buildapi.ConfigMapBuildSource{
+					{DestinationDir: "config"},
+				},
+			},
+		},
+		// 23
+		{
+			t:    field.ErrorTypeInvalid,
+			path: "configMaps[0].destinationDir",
+			source: &buildapi.BuildSource{
+				Dockerfile: &dockerfile,
+				ConfigMaps: []buildapi.ConfigMapBuildSource{
+					{ConfigMap: kapi.LocalObjectReference{Name: "settings"}, DestinationDir: "../config"},
+				},
+			},
+		},
+		// 24
+		{
+			source: &buildapi.BuildSource{
+				Dockerfile: &dockerfile,
+				ConfigMaps: []buildapi.ConfigMapBuildSource{
+					{ConfigMap: kapi.LocalObjectReference{Name: "settings"}, DestinationDir: ".m2"},
+				},
+			},
+			ok: true,
+		},
 	}
 	for i, tc := range errorCases {
 		errors := validateSource(tc.source, false, false, false, nil)
@@ -2415,6 +2594,26 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*field.Error{field.Invalid(field.NewPath("github", "allowEnv"), "", "")},
 		},
+		"GitHub trigger with unknown pull request output": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					PullRequests: &buildapi.PullRequestBuildPolicy{Output: "Push"},
+				},
+			},
+			expected: []*field.Error{field.NotSupported(field.NewPath("github", "pullRequests", "output"), "", nil)},
+		},
+		"Generic trigger with pull requests": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GenericWebHookBuildTriggerType,
+				GenericWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					PullRequests: &buildapi.PullRequestBuildPolicy{},
+				},
+			},
+			expected: []*field.Error{field.Invalid(field.NewPath("generic", "pullRequests"), "", "")},
+		},
 		"Generic trigger with no generic webhook": {
 			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.GenericWebHookBuildTriggerType},
 			expected: []*field.Error{field.Required(field.NewPath("generic"), "")},
@@ -2449,6 +2648,15 @@ func TestValidateTrigger(t *testing.T) {
 				},
 			},
 		},
+		"valid GitHub trigger with pull requests": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitHubWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret:       "secret101",
+					PullRequests: &buildapi.PullRequestBuildPolicy{Output: buildapi.PullRequestOutputTag},
+				},
+			},
+		},
 		"valid Generic trigger": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.GenericWebHookBuildTriggerType,
@@ -2518,6 +2726,102 @@ func TestValidateToImageReference(t *testing.T) {
 	}
 }
 
+func TestValidateAdditionalOutputs(t *testing.T) {
+	to := &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:latest"}
+	tests := []struct {
+		name   string
+		output buildapi.BuildOutput
+		t      field.ErrorType
+		path   string
+	}{
+		{
+			name: "templated tags",
+			output: buildapi.BuildOutput{
+				To: to,
+				AdditionalOutputs: []buildapi.AdditionalBuildOutput{
+					{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:${SOURCE_COMMIT}"}},
+					{
+						To:         &kapi.ObjectReference{Kind: "DockerImage", Name: "partner.example.com/app:${SOURCE_REF}-${BUILD_NUMBER}"},
+						PushSecret: &kapi.LocalObjectReference{Name: "partner"},
+					},
+				},
+			},
+		},
+		{
+			name: "missing to",
+			output: buildapi.BuildOutput{
+				AdditionalOutputs: []buildapi.AdditionalBuildOutput{
+					{To: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:v1"}},
+				},
+			},
+			t:    field.ErrorTypeRequired,
+			path: "to",
+		},
+		{
+			name: "missing additional to",
+			output: buildapi.BuildOutput{
+				To:                to,
+				AdditionalOutputs: []buildapi.AdditionalBuildOutput{{}},
+			},
+			t:    field.ErrorTypeRequired,
+			path: "additionalOutputs[0].to",
+		},
+		{
+			name: "unknown variable",
+			output: buildapi.BuildOutput{
+				To: to,
+				AdditionalOutputs: []buildapi.AdditionalBuildOutput{
+					{To: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:${GIT_TAG}"}},
+				},
+			},
+			t:    field.ErrorTypeInvalid,
+			path: "additionalOutputs[0].to.name",
+		},
+		{
+			name: "invalid reference",
+			output: buildapi.BuildOutput{
+				To: to,
+				AdditionalOutputs: []buildapi.AdditionalBuildOutput{
+					{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app"}},
+				},
+			},
+			t:    field.ErrorTypeInvalid,
+			path: "additionalOutputs[0].to.name",
+		},
+		{
+			name: "invalid push secret",
+			output: buildapi.BuildOutput{
+				To: to,
+				AdditionalOutputs: []buildapi.AdditionalBuildOutput{
+					{
+						To:         &kapi.ObjectReference{Kind: "DockerImage", Name: "registry/ns/app:v1"},
+						PushSecret: &kapi.LocalObjectReference{},
+					},
+				},
+			},
+			t:    field.ErrorTypeRequired,
+			path: "additionalOutputs[0].pushSecret.name",
+		},
+	}
+
+	for _, test := range tests {
+		errs := validateOutput(&test.output, nil)
+		if len(test.path) == 0 {
+			if len(errs) > 0 {
+				t.Errorf("%s: unexpected errors: %v", test.name, errs)
+			}
+			continue
+		}
+		if len(errs) != 1 {
+			t.Errorf("%s: expected one error, got %v", test.name, errs)
+			continue
+		}
+		if errs[0].Type != test.t || errs[0].Field != test.path {
+			t.Errorf("%s: expected %s error on %s, got %v", test.name, test.t, test.path, errs[0])
+		}
+	}
+}
+
 func TestValidateStrategyEnvVars(t *testing.T) {
 	tests := []struct {
 		env         []kapi.EnvVar
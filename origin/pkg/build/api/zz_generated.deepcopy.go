@@ -20,6 +20,7 @@ func init() {
 // to allow building arbitrary schemes.
 func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedDeepCopyFuncs(
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_AdditionalBuildOutput, InType: reflect.TypeOf(&AdditionalBuildOutput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BinaryBuildRequestOptions, InType: reflect.TypeOf(&BinaryBuildRequestOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BinaryBuildSource, InType: reflect.TypeOf(&BinaryBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_Build, InType: reflect.TypeOf(&Build{})},
@@ -36,14 +37,18 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildSource, InType: reflect.TypeOf(&BuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildSpec, InType: reflect.TypeOf(&BuildSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildStatus, InType: reflect.TypeOf(&BuildStatus{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildStatusAdditionalOutput, InType: reflect.TypeOf(&BuildStatusAdditionalOutput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildStatusOutput, InType: reflect.TypeOf(&BuildStatusOutput{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildStatusOutputTo, InType: reflect.TypeOf(&BuildStatusOutputTo{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildStatusReporting, InType: reflect.TypeOf(&BuildStatusReporting{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildStrategy, InType: reflect.TypeOf(&BuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildTriggerCause, InType: reflect.TypeOf(&BuildTriggerCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_BuildTriggerPolicy, InType: reflect.TypeOf(&BuildTriggerPolicy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_CommonSpec, InType: reflect.TypeOf(&CommonSpec{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ConfigMapBuildSource, InType: reflect.TypeOf(&ConfigMapBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_CustomBuildStrategy, InType: reflect.TypeOf(&CustomBuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_DockerBuildStrategy, InType: reflect.TypeOf(&DockerBuildStrategy{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_DockerStrategyOptions, InType: reflect.TypeOf(&DockerStrategyOptions{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_GenericWebHookCause, InType: reflect.TypeOf(&GenericWebHookCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_GenericWebHookEvent, InType: reflect.TypeOf(&GenericWebHookEvent{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_GitBuildSource, InType: reflect.TypeOf(&GitBuildSource{})},
@@ -58,15 +63,40 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageSourcePath, InType: reflect.TypeOf(&ImageSourcePath{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_JenkinsPipelineBuildStrategy, InType: reflect.TypeOf(&JenkinsPipelineBuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ProxyConfig, InType: reflect.TypeOf(&ProxyConfig{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PullRequestBuildPolicy, InType: reflect.TypeOf(&PullRequestBuildPolicy{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_PullRequestCause, InType: reflect.TypeOf(&PullRequestCause{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SecretBuildSource, InType: reflect.TypeOf(&SecretBuildSource{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SecretSpec, InType: reflect.TypeOf(&SecretSpec{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SourceBuildStrategy, InType: reflect.TypeOf(&SourceBuildStrategy{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SourceControlUser, InType: reflect.TypeOf(&SourceControlUser{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_SourceRevision, InType: reflect.TypeOf(&SourceRevision{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_StageInfo, InType: reflect.TypeOf(&StageInfo{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_WebHookTrigger, InType: reflect.TypeOf(&WebHookTrigger{})},
 	)
 }
 
+func DeepCopy_api_AdditionalBuildOutput(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*AdditionalBuildOutput)
+		out := out.(*AdditionalBuildOutput)
+		if in.To != nil {
+			in, out := &in.To, &out.To
+			*out = new(pkg_api.ObjectReference)
+			**out = **in
+		} else {
+			out.To = nil
+		}
+		if in.PushSecret != nil {
+			in, out := &in.PushSecret, &out.PushSecret
+			*out = new(pkg_api.LocalObjectReference)
+			**out = **in
+		} else {
+			out.PushSecret = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_api_BinaryBuildRequestOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*BinaryBuildRequestOptions)
@@ -169,6 +199,27 @@ func DeepCopy_api_BuildConfigSpec(in interface{}, out interface{}, c *conversion
 		if err := DeepCopy_api_CommonSpec(&in.CommonSpec, &out.CommonSpec, c); err != nil {
 			return err
 		}
+		if in.StatusReporting != nil {
+			in, out := &in.StatusReporting, &out.StatusReporting
+			*out = new(BuildStatusReporting)
+			**out = **in
+		} else {
+			out.StatusReporting = nil
+		}
+		if in.SuccessfulBuildsHistoryLimit != nil {
+			in, out := &in.SuccessfulBuildsHistoryLimit, &out.SuccessfulBuildsHistoryLimit
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.SuccessfulBuildsHistoryLimit = nil
+		}
+		if in.FailedBuildsHistoryLimit != nil {
+			in, out := &in.FailedBuildsHistoryLimit, &out.FailedBuildsHistoryLimit
+			*out = new(int32)
+			**out = **in
+		} else {
+			out.FailedBuildsHistoryLimit = nil
+		}
 		return nil
 	}
 }
@@ -288,6 +339,17 @@ func DeepCopy_api_BuildOutput(in interface{}, out interface{}, c *conversion.Clo
 		} else {
 			out.ImageLabels = nil
 		}
+		if in.AdditionalOutputs != nil {
+			in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+			*out = make([]AdditionalBuildOutput, len(*in))
+			for i := range *in {
+				if err := DeepCopy_api_AdditionalBuildOutput(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.AdditionalOutputs = nil
+		}
 		return nil
 	}
 }
@@ -382,6 +444,15 @@ func DeepCopy_api_BuildRequest(in interface{}, out interface{}, c *conversion.Cl
 		} else {
 			out.TriggeredBy = nil
 		}
+		if in.DockerStrategyOptions != nil {
+			in, out := &in.DockerStrategyOptions, &out.DockerStrategyOptions
+			*out = new(DockerStrategyOptions)
+			if err := DeepCopy_api_DockerStrategyOptions(*in, *out, c); err != nil {
+				return err
+			}
+		} else {
+			out.DockerStrategyOptions = nil
+		}
 		return nil
 	}
 }
@@ -441,6 +512,15 @@ func DeepCopy_api_BuildSource(in interface{}, out interface{}, c *conversion.Clo
 		} else {
 			out.Secrets = nil
 		}
+		if in.ConfigMaps != nil {
+			in, out := &in.ConfigMaps, &out.ConfigMaps
+			*out = make([]ConfigMapBuildSource, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.ConfigMaps = nil
+		}
 		return nil
 	}
 }
@@ -501,6 +581,27 @@ func DeepCopy_api_BuildStatus(in interface{}, out interface{}, c *conversion.Clo
 		if err := DeepCopy_api_BuildStatusOutput(&in.Output, &out.Output, c); err != nil {
 			return err
 		}
+		if in.Stages != nil {
+			in, out := &in.Stages, &out.Stages
+			*out = make([]StageInfo, len(*in))
+			for i := range *in {
+				if err := DeepCopy_api_StageInfo(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Stages = nil
+		}
+		return nil
+	}
+}
+
+func DeepCopy_api_BuildStatusAdditionalOutput(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BuildStatusAdditionalOutput)
+		out := out.(*BuildStatusAdditionalOutput)
+		out.DockerImageReference = in.DockerImageReference
+		out.ImageDigest = in.ImageDigest
 		return nil
 	}
 }
@@ -516,6 +617,15 @@ func DeepCopy_api_BuildStatusOutput(in interface{}, out interface{}, c *conversi
 		} else {
 			out.To = nil
 		}
+		if in.AdditionalOutputs != nil {
+			in, out := &in.AdditionalOutputs, &out.AdditionalOutputs
+			*out = make([]BuildStatusAdditionalOutput, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.AdditionalOutputs = nil
+		}
 		return nil
 	}
 }
@@ -525,6 +635,19 @@ func DeepCopy_api_BuildStatusOutputTo(in interface{}, out interface{}, c *conver
 		in := in.(*BuildStatusOutputTo)
 		out := out.(*BuildStatusOutputTo)
 		out.ImageDigest = in.ImageDigest
+		out.ImageSizeBytes = in.ImageSizeBytes
+		return nil
+	}
+}
+
+func DeepCopy_api_BuildStatusReporting(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*BuildStatusReporting)
+		out := out.(*BuildStatusReporting)
+		out.Provider = in.Provider
+		out.APIURL = in.APIURL
+		out.Secret = in.Secret
+		out.Context = in.Context
 		return nil
 	}
 }
@@ -615,14 +738,18 @@ func DeepCopy_api_BuildTriggerPolicy(in interface{}, out interface{}, c *convers
 		if in.GitHubWebHook != nil {
 			in, out := &in.GitHubWebHook, &out.GitHubWebHook
 			*out = new(WebHookTrigger)
-			**out = **in
+			if err := DeepCopy_api_WebHookTrigger(*in, *out, c); err != nil {
+				return err
+			}
 		} else {
 			out.GitHubWebHook = nil
 		}
 		if in.GenericWebHook != nil {
 			in, out := &in.GenericWebHook, &out.GenericWebHook
 			*out = new(WebHookTrigger)
-			**out = **in
+			if err := DeepCopy_api_WebHookTrigger(*in, *out, c); err != nil {
+				return err
+			}
 		} else {
 			out.GenericWebHook = nil
 		}
@@ -688,6 +815,16 @@ func DeepCopy_api_CommonSpec(in interface{}, out interface{}, c *conversion.Clon
 	}
 }
 
+func DeepCopy_api_ConfigMapBuildSource(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ConfigMapBuildSource)
+		out := out.(*ConfigMapBuildSource)
+		out.ConfigMap = in.ConfigMap
+		out.DestinationDir = in.DestinationDir
+		return nil
+	}
+}
+
 func DeepCopy_api_CustomBuildStrategy(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*CustomBuildStrategy)
@@ -759,6 +896,36 @@ func DeepCopy_api_DockerBuildStrategy(in interface{}, out interface{}, c *conver
 		}
 		out.ForcePull = in.ForcePull
 		out.DockerfilePath = in.DockerfilePath
+		if in.BuildArgs != nil {
+			in, out := &in.BuildArgs, &out.BuildArgs
+			*out = make([]pkg_api.EnvVar, len(*in))
+			for i := range *in {
+				if err := pkg_api.DeepCopy_api_EnvVar(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.BuildArgs = nil
+		}
+		return nil
+	}
+}
+
+func DeepCopy_api_DockerStrategyOptions(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*DockerStrategyOptions)
+		out := out.(*DockerStrategyOptions)
+		if in.BuildArgs != nil {
+			in, out := &in.BuildArgs, &out.BuildArgs
+			*out = make([]pkg_api.EnvVar, len(*in))
+			for i := range *in {
+				if err := pkg_api.DeepCopy_api_EnvVar(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.BuildArgs = nil
+		}
 		return nil
 	}
 }
@@ -836,6 +1003,13 @@ func DeepCopy_api_GitHubWebHookCause(in interface{}, out interface{}, c *convers
 			out.Revision = nil
 		}
 		out.Secret = in.Secret
+		if in.PullRequest != nil {
+			in, out := &in.PullRequest, &out.PullRequest
+			*out = new(PullRequestCause)
+			**out = **in
+		} else {
+			out.PullRequest = nil
+		}
 		return nil
 	}
 }
@@ -1003,6 +1177,26 @@ func DeepCopy_api_ProxyConfig(in interface{}, out interface{}, c *conversion.Clo
 	}
 }
 
+func DeepCopy_api_PullRequestBuildPolicy(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PullRequestBuildPolicy)
+		out := out.(*PullRequestBuildPolicy)
+		out.Output = in.Output
+		out.AllowForks = in.AllowForks
+		return nil
+	}
+}
+
+func DeepCopy_api_PullRequestCause(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*PullRequestCause)
+		out := out.(*PullRequestCause)
+		out.Number = in.Number
+		out.Ref = in.Ref
+		return nil
+	}
+}
+
 func DeepCopy_api_SecretBuildSource(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*SecretBuildSource)
@@ -1100,12 +1294,30 @@ func DeepCopy_api_SourceRevision(in interface{}, out interface{}, c *conversion.
 	}
 }
 
+func DeepCopy_api_StageInfo(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*StageInfo)
+		out := out.(*StageInfo)
+		out.Name = in.Name
+		out.StartTime = in.StartTime.DeepCopy()
+		out.DurationMilliseconds = in.DurationMilliseconds
+		return nil
+	}
+}
+
 func DeepCopy_api_WebHookTrigger(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*WebHookTrigger)
 		out := out.(*WebHookTrigger)
 		out.Secret = in.Secret
 		out.AllowEnv = in.AllowEnv
+		if in.PullRequests != nil {
+			in, out := &in.PullRequests, &out.PullRequests
+			*out = new(PullRequestBuildPolicy)
+			**out = **in
+		} else {
+			out.PullRequests = nil
+		}
 		return nil
 	}
 }
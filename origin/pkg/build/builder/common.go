@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
 	"github.com/openshift/kubernetes/pkg/client/retry"
 	utilruntime "github.com/openshift/kubernetes/pkg/util/runtime"
 
@@ -14,8 +15,11 @@ import (
 	"github.com/openshift/github.com/fsouza/go-dockerclient"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/generate/git"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	utilglog "github.com/openshift/origin/pkg/util/glog"
 )
 
@@ -42,6 +46,7 @@ type KeyValue struct {
 type GitClient interface {
 	CloneWithOptions(dir string, url string, args ...string) error
 	Checkout(dir string, ref string) error
+	FetchRef(dir string, ref string) error
 	SubmoduleUpdate(dir string, init, recursive bool) error
 	TimedListRemote(timeout time.Duration, url string, args ...string) (string, string, error)
 	GetInfo(location string) (*git.SourceInfo, []error)
@@ -197,6 +202,8 @@ func retryBuildStatusUpdate(build *api.Build, client client.BuildInterface, sour
 		latestBuild.Status.Reason = build.Status.Reason
 		latestBuild.Status.Message = build.Status.Message
 		latestBuild.Status.Output.To = build.Status.Output.To
+		latestBuild.Status.Output.AdditionalOutputs = build.Status.Output.AdditionalOutputs
+		latestBuild.Status.Stages = build.Status.Stages
 
 		if _, err := client.UpdateDetails(latestBuild); err != nil {
 			return err
@@ -205,6 +212,74 @@ func retryBuildStatusUpdate(build *api.Build, client client.BuildInterface, sour
 	})
 }
 
+// recordStage appends a stage that started at startTime and ends now to the
+// stages of the build.
+func recordStage(build *api.Build, name api.StageName, startTime time.Time) {
+	build.Status.Stages = append(build.Status.Stages, api.StageInfo{
+		Name:                 name,
+		StartTime:            unversioned.NewTime(startTime),
+		DurationMilliseconds: int64(time.Since(startTime) / time.Millisecond),
+	})
+}
+
+// recordImageSize records the size of image, as reported by the Docker daemon,
+// in the output status of the build.
+func recordImageSize(dockerClient DockerClient, build *api.Build, image string) {
+	info, err := dockerClient.InspectImage(image)
+	if err != nil {
+		glog.V(0).Infof("warning: Failed to inspect image %s: %v", image, err)
+		return
+	}
+	// older daemons report the size of the top layer only as Size
+	size := info.VirtualSize
+	if size == 0 {
+		size = info.Size
+	}
+	if build.Status.Output.To == nil {
+		build.Status.Output.To = &api.BuildStatusOutputTo{}
+	}
+	build.Status.Output.To.ImageSizeBytes = size
+}
+
+// pushAdditionalOutputs tags image with the name of each additional output of
+// the build and pushes it there, recording the digests of the pushed images in
+// the build status. Variables referenced from the names of the outputs are
+// expanded first.
+func pushAdditionalOutputs(dockerClient DockerClient, build *api.Build, image string) error {
+	vars := buildutil.OutputVariables(build)
+	build.Status.Output.AdditionalOutputs = nil
+	for i, output := range build.Spec.Output.AdditionalOutputs {
+		name, err := buildutil.ExpandOutputName(output.To.Name, vars)
+		if err != nil {
+			return fmt.Errorf("invalid additional output %s: %v", output.To.Name, err)
+		}
+		if _, err := imageapi.ParseDockerImageReference(name); err != nil {
+			return fmt.Errorf("additional output %s expands to an invalid image reference %q: %v", output.To.Name, name, err)
+		}
+		if err := tagImage(dockerClient, image, name); err != nil {
+			return err
+		}
+
+		authConfig, authPresent := dockercfg.NewHelper().GetDockerAuth(
+			name,
+			fmt.Sprintf("%s%d", dockercfg.PushAdditionalAuthType, i),
+		)
+		if authPresent {
+			glog.V(4).Infof("Authenticating Docker push with user %q", authConfig.Username)
+		}
+		glog.V(0).Infof("\nPushing image %s ...", name)
+		digest, err := pushImage(dockerClient, name, authConfig)
+		if err != nil {
+			return fmt.Errorf("failed to push image to additional output %s: %v", name, reportPushFailure(err, authPresent, authConfig))
+		}
+		build.Status.Output.AdditionalOutputs = append(build.Status.Output.AdditionalOutputs, api.BuildStatusAdditionalOutput{
+			DockerImageReference: name,
+			ImageDigest:          digest,
+		})
+	}
+	return nil
+}
+
 func handleBuildStatusUpdate(build *api.Build, client client.BuildInterface, sourceRev *api.SourceRevision) {
 	if updateErr := retryBuildStatusUpdate(build, client, sourceRev); updateErr != nil {
 		utilruntime.HandleError(fmt.Errorf("error occurred while updating the build status: %v", updateErr))
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/openshift/github.com/fsouza/go-dockerclient"
 	kapi "github.com/openshift/kubernetes/pkg/api"
 
 	"github.com/openshift/origin/pkg/build/api"
@@ -112,3 +113,74 @@ func TestContainerName(t *testing.T) {
 		t.Errorf("got %v, want %v", got, want)
 	}
 }
+
+func TestPushAdditionalOutputs(t *testing.T) {
+	tests := []struct {
+		name   string
+		ref    string
+		commit string
+		output string
+		expect string
+		err    bool
+	}{
+		{
+			name:   "ref",
+			ref:    "feature/login",
+			commit: "1575a90c",
+			output: "registry:5000/ns/app:${SOURCE_REF}",
+			expect: "registry:5000/ns/app:feature-login",
+		},
+		{
+			name:   "default branch",
+			commit: "1575a90c",
+			output: "registry:5000/ns/app:${SOURCE_REF}",
+			expect: "registry:5000/ns/app:1575a90c",
+		},
+		{
+			name:   "no commit",
+			output: "registry:5000/ns/app:${SOURCE_COMMIT}",
+			err:    true,
+		},
+	}
+	for _, test := range tests {
+		build := &api.Build{
+			Spec: api.BuildSpec{
+				CommonSpec: api.CommonSpec{
+					Source: api.BuildSource{Git: &api.GitBuildSource{URI: "github.com/openshift/sample-app", Ref: test.ref}},
+					Output: api.BuildOutput{
+						AdditionalOutputs: []api.AdditionalBuildOutput{
+							{To: &kapi.ObjectReference{Kind: "DockerImage", Name: test.output}},
+						},
+					},
+					Revision: &api.SourceRevision{Git: &api.GitSourceRevision{Commit: test.commit}},
+				},
+			},
+		}
+		client := NewFakeDockerClient()
+		err := pushAdditionalOutputs(client, build, "sample-app")
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			if len(client.callLog) > 0 || client.pushImageCalled {
+				t.Errorf("%s: expected no image to be tagged or pushed, got %v", test.name, client.callLog)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if len(client.callLog) != 1 {
+			t.Errorf("%s: expected the image to be tagged once, got %v", test.name, client.callLog)
+			continue
+		}
+		opts := client.callLog[0].args[1].(docker.TagImageOptions)
+		if name := opts.Repo + ":" + opts.Tag; name != test.expect {
+			t.Errorf("%s: expected the image to be tagged %s, got %s", test.name, test.expect, name)
+		}
+		if len(build.Status.Output.AdditionalOutputs) != 1 || build.Status.Output.AdditionalOutputs[0].DockerImageReference != test.expect {
+			t.Errorf("%s: expected %s in the build status, got %#v", test.name, test.expect, build.Status.Output.AdditionalOutputs)
+		}
+	}
+}
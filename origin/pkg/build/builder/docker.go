@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	dockercmd "github.com/openshift/github.com/docker/docker/builder/dockerfile/command"
 	"github.com/openshift/github.com/docker/docker/builder/dockerfile/parser"
@@ -66,7 +67,9 @@ func (d *DockerBuilder) Build() error {
 	if err != nil {
 		return err
 	}
+	startTime := time.Now()
 	sourceInfo, err := fetchSource(d.dockerClient, buildDir, d.build, initialURLCheckTimeout, os.Stdin, d.gitClient)
+	recordStage(d.build, api.StageFetchInputs, startTime)
 	if err != nil {
 		switch err.(type) {
 		case contextDirNotFoundError:
@@ -87,6 +90,7 @@ func (d *DockerBuilder) Build() error {
 		glog.V(4).Infof("Setting build revision with details %#v", sourceInfo)
 		revision := updateBuildRevision(d.build, sourceInfo)
 		handleBuildStatusUpdate(d.build, d.client, revision)
+		d.build.Spec.Revision = revision
 	}
 	if err = d.addBuildParameters(buildDir, sourceInfo); err != nil {
 		return err
@@ -107,6 +111,7 @@ func (d *DockerBuilder) Build() error {
 	if len(imageNames) == 0 {
 		return fmt.Errorf("no FROM image in Dockerfile")
 	}
+	startTime = time.Now()
 	for _, imageName := range imageNames {
 		if imageName == "scratch" {
 			glog.V(4).Infof("\nSkipping image \"scratch\"")
@@ -128,6 +133,7 @@ func (d *DockerBuilder) Build() error {
 			)
 			glog.V(0).Infof("\nPulling image %s ...", imageName)
 			if err = pullImage(d.dockerClient, imageName, pullAuthConfig); err != nil {
+				recordStage(d.build, api.StagePullImages, startTime)
 				d.build.Status.Phase = api.BuildPhaseFailed
 				d.build.Status.Reason = api.StatusReasonPullBuilderImageFailed
 				d.build.Status.Message = api.StatusMessagePullBuilderImageFailed
@@ -137,7 +143,12 @@ func (d *DockerBuilder) Build() error {
 		}
 	}
 
-	if err = d.dockerBuild(buildDir, buildTag, d.build.Spec.Source.Secrets); err != nil {
+	recordStage(d.build, api.StagePullImages, startTime)
+
+	startTime = time.Now()
+	err = d.dockerBuild(buildDir, buildTag, d.build.Spec.Source.Secrets)
+	recordStage(d.build, api.StageBuild, startTime)
+	if err != nil {
 		d.build.Status.Phase = api.BuildPhaseFailed
 		d.build.Status.Reason = api.StatusReasonDockerBuildFailed
 		d.build.Status.Message = api.StatusMessageDockerBuildFailed
@@ -146,7 +157,10 @@ func (d *DockerBuilder) Build() error {
 	}
 
 	cname := containerName("docker", d.build.Name, d.build.Namespace, "post-commit")
-	if err := execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, buildTag, cname); err != nil {
+	startTime = time.Now()
+	err = execPostCommitHook(d.dockerClient, d.build.Spec.PostCommit, buildTag, cname)
+	recordStage(d.build, api.StagePostCommit, startTime)
+	if err != nil {
 		d.build.Status.Phase = api.BuildPhaseFailed
 		d.build.Status.Reason = api.StatusReasonPostCommitHookFailed
 		d.build.Status.Message = api.StatusMessagePostCommitHookFailed
@@ -158,6 +172,7 @@ func (d *DockerBuilder) Build() error {
 		if err := tagImage(d.dockerClient, buildTag, pushTag); err != nil {
 			return err
 		}
+		recordImageSize(d.dockerClient, d.build, pushTag)
 	}
 
 	if err := removeImage(d.dockerClient, buildTag); err != nil {
@@ -174,8 +189,10 @@ func (d *DockerBuilder) Build() error {
 			glog.V(4).Infof("Authenticating Docker push with user %q", pushAuthConfig.Username)
 		}
 		glog.V(0).Infof("\nPushing image %s ...", pushTag)
+		startTime = time.Now()
 		digest, err := pushImage(d.dockerClient, pushTag, pushAuthConfig)
 		if err != nil {
+			recordStage(d.build, api.StagePushImage, startTime)
 			d.build.Status.Phase = api.BuildPhaseFailed
 			d.build.Status.Reason = api.StatusReasonPushImageToRegistryFailed
 			d.build.Status.Message = api.StatusMessagePushImageToRegistryFailed
@@ -183,13 +200,28 @@ func (d *DockerBuilder) Build() error {
 			return reportPushFailure(err, authPresent, pushAuthConfig)
 		}
 		if len(digest) > 0 {
-			d.build.Status.Output.To = &api.BuildStatusOutputTo{
-				ImageDigest: digest,
+			if d.build.Status.Output.To == nil {
+				d.build.Status.Output.To = &api.BuildStatusOutputTo{}
 			}
+			d.build.Status.Output.To.ImageDigest = digest
 			handleBuildStatusUpdate(d.build, d.client, nil)
 		}
 		glog.V(0).Infof("Push successful")
+
+		if len(d.build.Spec.Output.AdditionalOutputs) > 0 {
+			if err := pushAdditionalOutputs(d.dockerClient, d.build, pushTag); err != nil {
+				recordStage(d.build, api.StagePushImage, startTime)
+				d.build.Status.Phase = api.BuildPhaseFailed
+				d.build.Status.Reason = api.StatusReasonPushAdditionalOutputFailed
+				d.build.Status.Message = api.StatusMessagePushAdditionalOutputFailed
+				handleBuildStatusUpdate(d.build, d.client, nil)
+				return err
+			}
+			glog.V(0).Infof("Push to additional outputs successful")
+		}
+		recordStage(d.build, api.StagePushImage, startTime)
 	}
+	handleBuildStatusUpdate(d.build, d.client, nil)
 	return nil
 }
 
@@ -323,6 +355,7 @@ func (d *DockerBuilder) setupPullSecret() (*docker.AuthConfigurations, error) {
 func (d *DockerBuilder) dockerBuild(dir string, tag string, secrets []api.SecretBuildSource) error {
 	var noCache bool
 	var forcePull bool
+	var buildArgs []docker.BuildArg
 	dockerfilePath := defaultDockerfilePath
 	if d.build.Spec.Strategy.DockerStrategy != nil {
 		if d.build.Spec.Source.ContextDir != "" {
@@ -333,6 +366,9 @@ func (d *DockerBuilder) dockerBuild(dir string, tag string, secrets []api.Secret
 		}
 		noCache = d.build.Spec.Strategy.DockerStrategy.NoCache
 		forcePull = d.build.Spec.Strategy.DockerStrategy.ForcePull
+		for _, arg := range d.build.Spec.Strategy.DockerStrategy.BuildArgs {
+			buildArgs = append(buildArgs, docker.BuildArg{Name: arg.Name, Value: arg.Value})
+		}
 	}
 	auth, err := d.setupPullSecret()
 	if err != nil {
@@ -341,6 +377,9 @@ func (d *DockerBuilder) dockerBuild(dir string, tag string, secrets []api.Secret
 	if err := d.copySecrets(secrets, dir); err != nil {
 		return err
 	}
+	if err := copyConfigMaps(d.build.Spec.Source.ConfigMaps, dir); err != nil {
+		return err
+	}
 
 	opts := docker.BuildImageOptions{
 		Name:           tag,
@@ -349,6 +388,7 @@ func (d *DockerBuilder) dockerBuild(dir string, tag string, secrets []api.Secret
 		Dockerfile:     dockerfilePath,
 		NoCache:        noCache,
 		Pull:           forcePull,
+		BuildArgs:      buildArgs,
 	}
 	if d.cgLimits != nil {
 		opts.Memory = d.cgLimits.MemoryLimitBytes
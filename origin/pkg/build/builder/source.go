@@ -17,6 +17,7 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
+	"github.com/openshift/origin/pkg/build/controller/strategy"
 	"github.com/openshift/origin/pkg/generate/git"
 	"github.com/openshift/source-to-image/pkg/tar"
 )
@@ -247,6 +248,15 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 	if usingRef {
 		commit := gitSource.Ref
 
+		// refs outside of branches and tags, like the refs of pull requests, are not cloned and
+		// need to be fetched explicitly
+		if isFetchedRef(gitSource.Ref) {
+			if err := gitClient.FetchRef(dir, gitSource.Ref); err != nil {
+				return true, err
+			}
+			commit = "FETCH_HEAD"
+		}
+
 		if usingRevision {
 			commit = revision.Git.Commit
 		}
@@ -272,6 +282,33 @@ func extractGitSource(gitClient GitClient, gitSource *api.GitBuildSource, revisi
 	return true, nil
 }
 
+// copyConfigMaps copies all files from the directories where the configMaps are
+// mounted in the builder pod to their destination directories within dir.
+func copyConfigMaps(configMaps []api.ConfigMapBuildSource, dir string) error {
+	for _, c := range configMaps {
+		dstDir := filepath.Join(dir, c.DestinationDir)
+		if err := os.MkdirAll(dstDir, 0777); err != nil {
+			return err
+		}
+		srcDir := filepath.Join(strategy.ConfigMapBuildSourceBaseMountPath, c.ConfigMap.Name)
+		glog.V(3).Infof("Copying files from the build config map %q to %q", c.ConfigMap.Name, filepath.Clean(c.DestinationDir))
+		// dereference the symlinks of the atomically updated config map volume
+		out, err := exec.Command("cp", "-vrfL", srcDir+"/.", dstDir+"/").Output()
+		if err != nil {
+			glog.V(4).Infof("Config map %q failed to copy: %q", c.ConfigMap.Name, string(out))
+			return err
+		}
+		// See what is copied where when debugging.
+		glog.V(5).Infof(string(out))
+	}
+	return nil
+}
+
+// isFetchedRef returns true if ref is a fully qualified ref which is neither a branch nor a tag.
+func isFetchedRef(ref string) bool {
+	return strings.HasPrefix(ref, "refs/") && !strings.HasPrefix(ref, "refs/heads/") && !strings.HasPrefix(ref, "refs/tags/")
+}
+
 func copyImageSource(dockerClient DockerClient, containerID, sourceDir, destDir string, tarHelper tar.Tar) error {
 	// Setup destination directory
 	fi, err := os.Stat(destDir)
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	s2iapi "github.com/openshift/source-to-image/pkg/api"
 	"github.com/openshift/source-to-image/pkg/api/describe"
@@ -114,8 +115,10 @@ func (s *S2IBuilder) Build() error {
 	pushTag := s.build.Status.OutputDockerImageReference
 
 	// fetch source
+	startTime := time.Now()
 	sourceInfo, err := fetchSource(s.dockerClient, srcDir, s.build, initialURLCheckTimeout, os.Stdin, s.gitClient)
 	if err != nil {
+		recordStage(s.build, api.StageFetchInputs, startTime)
 		switch err.(type) {
 		case contextDirNotFoundError:
 			s.build.Status.Phase = api.BuildPhaseFailed
@@ -129,6 +132,17 @@ func (s *S2IBuilder) Build() error {
 		handleBuildStatusUpdate(s.build, s.client, nil)
 		return err
 	}
+	// config maps are copied into the source tree since, unlike secrets, they
+	// remain part of the application image.
+	err = copyConfigMaps(s.build.Spec.Source.ConfigMaps, filepath.Join(srcDir, s.build.Spec.Source.ContextDir))
+	recordStage(s.build, api.StageFetchInputs, startTime)
+	if err != nil {
+		s.build.Status.Phase = api.BuildPhaseFailed
+		s.build.Status.Reason = api.StatusReasonFetchSourceFailed
+		s.build.Status.Message = api.StatusMessageFetchSourceFailed
+		handleBuildStatusUpdate(s.build, s.client, nil)
+		return err
+	}
 	contextDir := ""
 	if len(s.build.Spec.Source.ContextDir) > 0 {
 		contextDir = filepath.Clean(s.build.Spec.Source.ContextDir)
@@ -153,6 +167,7 @@ func (s *S2IBuilder) Build() error {
 		s2iSourceInfo = &sourceInfo.SourceInfo
 		revision := updateBuildRevision(s.build, sourceInfo)
 		handleBuildStatusUpdate(s.build, s.client, revision)
+		s.build.Spec.Revision = revision
 	}
 
 	injections := s2iapi.VolumeList{}
@@ -260,7 +275,10 @@ func (s *S2IBuilder) Build() error {
 	}
 
 	glog.V(4).Infof("Creating a new S2I builder with build config: %#v\n", describe.Config(config))
+	// creating the builder pulls the builder image
+	startTime = time.Now()
 	builder, buildInfo, err := s.builder.Builder(config, s2ibuild.Overrides{Downloader: nil})
+	recordStage(s.build, api.StagePullImages, startTime)
 	if err != nil {
 		s.build.Status.Phase = api.BuildPhaseFailed
 		s.build.Status.Reason, s.build.Status.Message = convertS2IFailureType(
@@ -272,7 +290,9 @@ func (s *S2IBuilder) Build() error {
 	}
 
 	glog.V(4).Infof("Starting S2I build from %s/%s BuildConfig ...", s.build.Namespace, s.build.Name)
+	startTime = time.Now()
 	result, err := builder.Build(config)
+	recordStage(s.build, api.StageBuild, startTime)
 	if err != nil {
 		s.build.Status.Phase = api.BuildPhaseFailed
 		s.build.Status.Reason, s.build.Status.Message = convertS2IFailureType(
@@ -285,7 +305,10 @@ func (s *S2IBuilder) Build() error {
 	}
 
 	cName := containerName("s2i", s.build.Name, s.build.Namespace, "post-commit")
-	if err = execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, buildTag, cName); err != nil {
+	startTime = time.Now()
+	err = execPostCommitHook(s.dockerClient, s.build.Spec.PostCommit, buildTag, cName)
+	recordStage(s.build, api.StagePostCommit, startTime)
+	if err != nil {
 		s.build.Status.Phase = api.BuildPhaseFailed
 		s.build.Status.Reason = api.StatusReasonPostCommitHookFailed
 		s.build.Status.Message = api.StatusMessagePostCommitHookFailed
@@ -297,6 +320,7 @@ func (s *S2IBuilder) Build() error {
 		if err = tagImage(s.dockerClient, buildTag, pushTag); err != nil {
 			return err
 		}
+		recordImageSize(s.dockerClient, s.build, pushTag)
 	}
 
 	if err = removeImage(s.dockerClient, buildTag); err != nil {
@@ -315,8 +339,10 @@ func (s *S2IBuilder) Build() error {
 			glog.V(3).Infof("No push secret provided")
 		}
 		glog.V(0).Infof("\nPushing image %s ...", pushTag)
+		startTime = time.Now()
 		digest, err := pushImage(s.dockerClient, pushTag, pushAuthConfig)
 		if err != nil {
+			recordStage(s.build, api.StagePushImage, startTime)
 			s.build.Status.Phase = api.BuildPhaseFailed
 			s.build.Status.Reason = api.StatusReasonPushImageToRegistryFailed
 			s.build.Status.Message = api.StatusMessagePushImageToRegistryFailed
@@ -324,13 +350,28 @@ func (s *S2IBuilder) Build() error {
 			return reportPushFailure(err, authPresent, pushAuthConfig)
 		}
 		if len(digest) > 0 {
-			s.build.Status.Output.To = &api.BuildStatusOutputTo{
-				ImageDigest: digest,
+			if s.build.Status.Output.To == nil {
+				s.build.Status.Output.To = &api.BuildStatusOutputTo{}
 			}
+			s.build.Status.Output.To.ImageDigest = digest
 			handleBuildStatusUpdate(s.build, s.client, nil)
 		}
 		glog.V(0).Infof("Push successful")
+
+		if len(s.build.Spec.Output.AdditionalOutputs) > 0 {
+			if err := pushAdditionalOutputs(s.dockerClient, s.build, pushTag); err != nil {
+				recordStage(s.build, api.StagePushImage, startTime)
+				s.build.Status.Phase = api.BuildPhaseFailed
+				s.build.Status.Reason = api.StatusReasonPushAdditionalOutputFailed
+				s.build.Status.Message = api.StatusMessagePushAdditionalOutputFailed
+				handleBuildStatusUpdate(s.build, s.client, nil)
+				return err
+			}
+			glog.V(0).Infof("Push to additional outputs successful")
+		}
+		recordStage(s.build, api.StagePushImage, startTime)
 	}
+	handleBuildStatusUpdate(s.build, s.client, nil)
 	return nil
 }
 
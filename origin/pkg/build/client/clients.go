@@ -47,6 +47,11 @@ type BuildLister interface {
 	List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error)
 }
 
+// BuildDeleter provides methods for deleting existing Builds.
+type BuildDeleter interface {
+	Delete(namespace, name string) error
+}
+
 // OSClientBuildClient deletes build create and update operations to the OpenShift client interface
 type OSClientBuildClient struct {
 	Client osclient.Interface
@@ -68,6 +73,11 @@ func (c OSClientBuildClient) List(namespace string, opts kapi.ListOptions) (*bui
 	return c.Client.Builds(namespace).List(opts)
 }
 
+// Delete deletes a build using the OpenShift client.
+func (c OSClientBuildClient) Delete(namespace, name string) error {
+	return c.Client.Builds(namespace).Delete(name)
+}
+
 // BuildCloner provides methods for cloning builds
 type BuildCloner interface {
 	Clone(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error)
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/golang/glog"
 
@@ -19,6 +20,9 @@ import (
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	"github.com/openshift/origin/pkg/build/controller/policy"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
+	buildmetrics "github.com/openshift/origin/pkg/build/metrics"
+	buildprune "github.com/openshift/origin/pkg/build/prune"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
@@ -27,7 +31,10 @@ import (
 type BuildController struct {
 	BuildUpdater      buildclient.BuildUpdater
 	BuildLister       buildclient.BuildLister
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
 	PodManager        podManager
+	LogArchiver       *BuildLogArchiver
 	BuildStrategy     BuildStrategy
 	ImageStreamClient imageStreamClient
 	Recorder          record.EventRecorder
@@ -47,6 +54,10 @@ type podManager interface {
 	GetPod(namespace, name string) (*kapi.Pod, error)
 }
 
+type podLogStreamer interface {
+	StreamPodLogs(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error)
+}
+
 type imageStreamClient interface {
 	GetImageStream(namespace, name string) (*imageapi.ImageStream, error)
 }
@@ -66,6 +77,8 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 			return fmt.Errorf("Failed to get pod for build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 	} else {
+		// keep the log of the build up to its cancellation, it is copied while the pod terminates
+		bc.LogArchiver.Archive(build, pod)
 		err := bc.PodManager.DeletePod(build.Namespace, pod)
 		if err != nil && !errors.IsNotFound(err) {
 			return fmt.Errorf("Couldn't delete build pod %s/%s: %v", build.Namespace, pod.Name, err)
@@ -85,6 +98,7 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	glog.V(4).Infof("Build %s/%s was successfully cancelled.", build.Namespace, build.Name)
 
 	handleBuildCompletion(build, bc.RunPolicies)
+	pruneBuildHistory(build, bc.BuildConfigGetter, bc.BuildLister, bc.BuildDeleter)
 
 	return nil
 }
@@ -181,6 +195,18 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 			Name: ref,
 		}
 	}
+	for i, output := range build.Spec.Output.AdditionalOutputs {
+		ref, err := bc.resolveImageReference(build, output.To)
+		if err != nil {
+			build.Status.Reason = buildapi.StatusReasonInvalidOutputReference
+			build.Status.Message = buildapi.StatusMessageInvalidOutputRef
+			return err
+		}
+		buildCopy.Spec.Output.AdditionalOutputs[i].To = &kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: ref,
+		}
+	}
 
 	// Invoke the strategy to get a build pod.
 	podSpec, err := bc.BuildStrategy.CreateBuildPod(buildCopy)
@@ -234,7 +260,13 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 // resolveOutputDockerImageReference returns a reference to a Docker image
 // computed from the buid.Spec.Output.To reference.
 func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Build) (string, error) {
-	outputTo := build.Spec.Output.To
+	return bc.resolveImageReference(build, build.Spec.Output.To)
+}
+
+// resolveImageReference returns a reference to a Docker image computed from
+// an output reference of the build. The tag of the reference is left as is, so
+// any variables it references are expanded by the builder.
+func (bc *BuildController) resolveImageReference(build *buildapi.Build, outputTo *kapi.ObjectReference) (string, error) {
 	if outputTo == nil || outputTo.Name == "" {
 		return "", nil
 	}
@@ -278,11 +310,15 @@ func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Bui
 
 // BuildPodController watches pods running builds and manages the build state
 type BuildPodController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
-	SecretClient kcoreclient.SecretsGetter
-	PodManager   podManager
-	RunPolicies  []policy.RunPolicy
+	BuildStore        cache.Store
+	BuildUpdater      buildclient.BuildUpdater
+	BuildLister       buildclient.BuildLister
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
+	SecretClient      kcoreclient.SecretsGetter
+	PodManager        podManager
+	LogArchiver       *BuildLogArchiver
+	RunPolicies       []policy.RunPolicy
 }
 
 // HandlePod updates the state of the build based on the pod state
@@ -299,6 +335,12 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 
 	build := obj.(*buildapi.Build)
 
+	// the log of a build whose pod is being deleted is archived while the pod
+	// still exists
+	if pod.DeletionTimestamp != nil && !buildutil.IsBuildComplete(build) {
+		bc.LogArchiver.Archive(build, pod)
+	}
+
 	nextStatus := build.Status.Phase
 	currentReason := build.Status.Reason
 
@@ -374,6 +416,12 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 
 		if buildutil.IsBuildComplete(build) {
 			handleBuildCompletion(build, bc.RunPolicies)
+			buildmetrics.ObserveCompletedBuild(build)
+			switch pod.Status.Phase {
+			case kapi.PodSucceeded, kapi.PodFailed:
+				bc.LogArchiver.Archive(build, pod)
+			}
+			pruneBuildHistory(build, bc.BuildConfigGetter, bc.BuildLister, bc.BuildDeleter)
 		}
 	}
 	return nil
@@ -434,17 +482,25 @@ func (bc *BuildPodDeleteController) HandleBuildPodDeletion(pod *kapi.Pod) error
 }
 
 // BuildDeleteController watches for builds being deleted and cleans up associated pods
+// and archived logs
 type BuildDeleteController struct {
 	PodManager podManager
+	LogArchive logarchive.Archive
 }
 
-// HandleBuildDeletion deletes a build pod if the corresponding build has been deleted
+// HandleBuildDeletion deletes a build pod and the archived build log if the
+// corresponding build has been deleted
 func (bc *BuildDeleteController) HandleBuildDeletion(build *buildapi.Build) error {
 	glog.V(4).Infof("Handling deletion of build %s", build.Name)
 	if build.Spec.Strategy.JenkinsPipelineStrategy != nil {
 		glog.V(4).Infof("Ignoring build with jenkins pipeline strategy")
 		return nil
 	}
+	if bc.LogArchive != nil {
+		if err := bc.LogArchive.Delete(build.Namespace, build.Name); err != nil {
+			glog.Errorf("failed to delete the archived log of build %s/%s: %v", build.Namespace, build.Name, err)
+		}
+	}
 	podName := buildapi.GetBuildPodName(build)
 	pod, err := bc.PodManager.GetPod(build.Namespace, podName)
 	if err != nil && !errors.IsNotFound(err) {
@@ -514,3 +570,52 @@ func handleBuildCompletion(build *buildapi.Build, runPolicies []policy.RunPolicy
 		glog.Errorf("failed to run policy on completed build: %v", err)
 	}
 }
+
+// pruneBuildHistory deletes the oldest completed builds of the build config the
+// given build was created from once there are more of them than the history
+// limits of the build config allow.
+func pruneBuildHistory(build *buildapi.Build, configGetter buildclient.BuildConfigGetter, lister buildclient.BuildLister, deleter buildclient.BuildDeleter) {
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return
+	}
+	buildConfig, err := configGetter.Get(build.Namespace, bcName)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			glog.Errorf("unable to get build config %s/%s to prune its builds: %v", build.Namespace, bcName, err)
+		}
+		return
+	}
+	keepComplete, keepFailed := -1, -1
+	if limit := buildConfig.Spec.SuccessfulBuildsHistoryLimit; limit != nil {
+		keepComplete = int(*limit)
+	}
+	if limit := buildConfig.Spec.FailedBuildsHistoryLimit; limit != nil {
+		keepFailed = int(*limit)
+	}
+	if keepComplete < 0 && keepFailed < 0 {
+		return
+	}
+
+	builds, err := buildutil.BuildConfigBuilds(lister, build.Namespace, bcName, nil)
+	if err != nil {
+		glog.Errorf("unable to list builds of build config %s/%s to prune them: %v", build.Namespace, bcName, err)
+		return
+	}
+	buildPtrs := make([]*buildapi.Build, len(builds.Items))
+	for i := range builds.Items {
+		buildPtrs[i] = &builds.Items[i]
+	}
+	dataSet := buildprune.NewDataSet([]*buildapi.BuildConfig{buildConfig}, buildPtrs)
+	prunable, err := buildprune.NewPerBuildConfigResolver(dataSet, keepComplete, keepFailed).Resolve()
+	if err != nil {
+		glog.Errorf("unable to determine builds of build config %s/%s to prune: %v", build.Namespace, bcName, err)
+		return
+	}
+	for _, b := range prunable {
+		glog.V(4).Infof("Pruning build %s/%s exceeding the history limits of build config %s", b.Namespace, b.Name, bcName)
+		if err := deleter.Delete(b.Namespace, b.Name); err != nil && !errors.IsNotFound(err) {
+			glog.Errorf("failed to prune build %s/%s: %v", b.Namespace, b.Name, err)
+		}
+	}
+}
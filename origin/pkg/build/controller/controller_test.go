@@ -2,8 +2,13 @@ package controller
 
 import (
 	"errors"
+	"io"
+	"io/ioutil"
+	"os"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	kapi "github.com/openshift/kubernetes/pkg/api"
 	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
@@ -14,6 +19,7 @@ import (
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	"github.com/openshift/origin/pkg/build/controller/policy"
 	buildtest "github.com/openshift/origin/pkg/build/controller/test"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
@@ -35,6 +41,34 @@ func (ec *errBuildUpdater) Update(namespace string, build *buildapi.Build) error
 	return errors.New("UpdateBuild error!")
 }
 
+type fakeBuildLister struct {
+	builds []buildapi.Build
+}
+
+func (f *fakeBuildLister) List(namespace string, opts kapi.ListOptions) (*buildapi.BuildList, error) {
+	return &buildapi.BuildList{Items: f.builds}, nil
+}
+
+type fakeBuildDeleter struct {
+	deleted []string
+}
+
+func (f *fakeBuildDeleter) Delete(namespace, name string) error {
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+type fakeBuildConfigGetter struct {
+	buildConfig *buildapi.BuildConfig
+}
+
+func (f *fakeBuildConfigGetter) Get(namespace, name string) (*buildapi.BuildConfig, error) {
+	if f.buildConfig == nil {
+		return nil, kerrors.NewNotFound(buildapi.Resource("buildconfigs"), name)
+	}
+	return f.buildConfig, nil
+}
+
 type okStrategy struct {
 	build *buildapi.Build
 }
@@ -154,6 +188,8 @@ func mockBuildController() *BuildController {
 	return &BuildController{
 		BuildUpdater:      &okBuildUpdater{},
 		BuildLister:       &okBuildLister{},
+		BuildDeleter:      &fakeBuildDeleter{},
+		BuildConfigGetter: &fakeBuildConfigGetter{},
 		PodManager:        &okPodManager{},
 		BuildStrategy:     &okStrategy{},
 		ImageStreamClient: &okImageStreamClient{},
@@ -164,9 +200,12 @@ func mockBuildController() *BuildController {
 
 func mockBuildPodController(build *buildapi.Build) *BuildPodController {
 	return &BuildPodController{
-		BuildStore:   buildtest.NewFakeBuildStore(build),
-		BuildUpdater: &okBuildUpdater{},
-		PodManager:   &okPodManager{},
+		BuildStore:        buildtest.NewFakeBuildStore(build),
+		BuildUpdater:      &okBuildUpdater{},
+		BuildLister:       &okBuildLister{},
+		BuildDeleter:      &fakeBuildDeleter{},
+		BuildConfigGetter: &fakeBuildConfigGetter{},
+		PodManager:        &okPodManager{},
 	}
 }
 
@@ -437,6 +476,49 @@ func TestHandleBuild(t *testing.T) {
 	}
 }
 
+func TestHandleBuildAdditionalOutputs(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{
+		To: &kapi.ObjectReference{Kind: "DockerImage", Name: "repository/data"},
+		AdditionalOutputs: []buildapi.AdditionalBuildOutput{
+			{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:${SOURCE_COMMIT}"}},
+			{To: &kapi.ObjectReference{Kind: "DockerImage", Name: "partner.example.com/data:${BUILD_NUMBER}"}},
+		},
+	})
+	ctrl := mockBuildController()
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhasePending {
+		t.Errorf("expected %s, got %s", buildapi.BuildPhasePending, build.Status.Phase)
+	}
+	if build.Spec.Output.AdditionalOutputs[0].To.Kind != "ImageStreamTag" {
+		t.Errorf("build.Spec mutated: %#v", build.Spec.Output)
+	}
+
+	outputs := ctrl.BuildStrategy.(*okStrategy).build.Spec.Output.AdditionalOutputs
+	expected := []string{"image/repo:${SOURCE_COMMIT}", "partner.example.com/data:${BUILD_NUMBER}"}
+	for i, name := range expected {
+		if to := outputs[i].To; to.Kind != "DockerImage" || to.Name != name {
+			t.Errorf("expected additional output %d to be DockerImage %s, got %#v", i, name, to)
+		}
+	}
+
+	build = mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{
+		To: &kapi.ObjectReference{Kind: "DockerImage", Name: "repository/data"},
+		AdditionalOutputs: []buildapi.AdditionalBuildOutput{
+			{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "foo:latest"}},
+		},
+	})
+	ctrl = mockBuildController()
+	ctrl.ImageStreamClient = &errNotFoundImageStreamClient{}
+	if err := ctrl.HandleBuild(build); err == nil {
+		t.Errorf("expected an error for an unresolvable additional output")
+	}
+	if build.Status.Reason != buildapi.StatusReasonInvalidOutputReference {
+		t.Errorf("expected reason %s, got %s", buildapi.StatusReasonInvalidOutputReference, build.Status.Reason)
+	}
+}
+
 func TestHandlePod(t *testing.T) {
 	type handlePodTest struct {
 		matchID             bool
@@ -700,6 +782,210 @@ func TestCancelBuild(t *testing.T) {
 	}
 }
 
+func TestPruneBuildHistory(t *testing.T) {
+	build := func(name string, phase buildapi.BuildPhase, age int) buildapi.Build {
+		b := mockBuild(phase, buildapi.BuildOutput{})
+		b.Name = name
+		b.CreationTimestamp = unversioned.NewTime(time.Now().Add(-time.Duration(age) * time.Hour))
+		b.Status.Config = &kapi.ObjectReference{Name: "test-bc", Namespace: "namespace"}
+		return *b
+	}
+	builds := []buildapi.Build{
+		build("test-bc-1", buildapi.BuildPhaseComplete, 7),
+		build("test-bc-2", buildapi.BuildPhaseFailed, 6),
+		build("test-bc-3", buildapi.BuildPhaseComplete, 5),
+		build("test-bc-4", buildapi.BuildPhaseCancelled, 4),
+		build("test-bc-5", buildapi.BuildPhaseComplete, 3),
+		build("test-bc-6", buildapi.BuildPhaseError, 2),
+		build("test-bc-7", buildapi.BuildPhaseRunning, 1),
+	}
+	limit := func(i int32) *int32 { return &i }
+
+	tests := []struct {
+		name       string
+		successful *int32
+		failed     *int32
+		noConfig   bool
+		expected   []string
+	}{
+		{
+			name: "no limits",
+		},
+		{
+			name:     "missing build config",
+			noConfig: true,
+		},
+		{
+			name:       "successful limit",
+			successful: limit(1),
+			expected:   []string{"test-bc-3", "test-bc-1"},
+		},
+		{
+			name:     "failed limit",
+			failed:   limit(2),
+			expected: []string{"test-bc-2"},
+		},
+		{
+			name:       "both limits",
+			successful: limit(0),
+			failed:     limit(0),
+			expected:   []string{"test-bc-5", "test-bc-3", "test-bc-1", "test-bc-6", "test-bc-4", "test-bc-2"},
+		},
+		{
+			name:       "limits not reached",
+			successful: limit(3),
+			failed:     limit(3),
+		},
+	}
+	for _, test := range tests {
+		getter := &fakeBuildConfigGetter{}
+		if !test.noConfig {
+			getter.buildConfig = &buildapi.BuildConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "test-bc", Namespace: "namespace"},
+				Spec: buildapi.BuildConfigSpec{
+					SuccessfulBuildsHistoryLimit: test.successful,
+					FailedBuildsHistoryLimit:     test.failed,
+				},
+			}
+		}
+		deleter := &fakeBuildDeleter{}
+		completed := build("test-bc-8", buildapi.BuildPhaseComplete, 0)
+		pruneBuildHistory(&completed, getter, &fakeBuildLister{builds: builds}, deleter)
+		if !reflect.DeepEqual(deleter.deleted, test.expected) {
+			t.Errorf("%s: expected builds %v to be pruned, got %v", test.name, test.expected, deleter.deleted)
+		}
+	}
+}
+
+type fakePodLogStreamer struct {
+	log string
+	err error
+}
+
+func (f *fakePodLogStreamer) StreamPodLogs(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error) {
+	if f.err != nil {
+		err := f.err
+		f.err = nil
+		return nil, err
+	}
+	return ioutil.NopCloser(strings.NewReader(f.log)), nil
+}
+
+func archivedLog(t *testing.T, archive logarchive.Archive, build *buildapi.Build) string {
+	log, err := archive.Get(build.Namespace, build.Name)
+	if err == logarchive.ErrNotArchived {
+		return ""
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer log.Close()
+	data, err := ioutil.ReadAll(log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(data)
+}
+
+func TestBuildLogArchiving(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildlogs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	archive := logarchive.NewDirectoryArchive(dir)
+	streamer := &fakePodLogStreamer{log: "cancelled\n"}
+
+	archiver := NewBuildLogArchiver(archive, streamer)
+
+	// the log of a cancelled build is archived
+	build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	ctrl := mockBuildController()
+	ctrl.LogArchiver = archiver
+	if err := ctrl.CancelBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if log := archivedLog(t, archive, build); log != "" {
+		t.Errorf("expected the log to be archived in the background, got %q", log)
+	}
+	archiver.processNext()
+	if log := archivedLog(t, archive, build); log != "cancelled\n" {
+		t.Errorf("expected the log of the cancelled build to be archived, got %q", log)
+	}
+
+	// the log of a build whose pod is being deleted is archived
+	streamer.log = "deleted\n"
+	build = mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	podCtrl := mockBuildPodController(build)
+	podCtrl.LogArchiver = archiver
+	pod := mockPod(kapi.PodRunning, 0)
+	now := unversioned.Now()
+	pod.DeletionTimestamp = &now
+	if err := podCtrl.HandlePod(pod); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	archiver.processNext()
+	if log := archivedLog(t, archive, build); log != "deleted\n" {
+		t.Errorf("expected the log of the build with a deleted pod to be archived, got %q", log)
+	}
+
+	// the archived log is removed with the build
+	deleteCtrl := BuildDeleteController{
+		PodManager: &customPodManager{
+			GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
+				return nil, kerrors.NewNotFound(kapi.Resource("pods"), name)
+			},
+		},
+		LogArchive: archive,
+	}
+	if err := deleteCtrl.HandleBuildDeletion(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if log := archivedLog(t, archive, build); log != "" {
+		t.Errorf("expected the archived log of the deleted build to be removed, got %q", log)
+	}
+}
+
+func TestBuildLogArchiverRetries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildlogs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	archive := logarchive.NewDirectoryArchive(dir)
+	streamer := &fakePodLogStreamer{log: "complete\n", err: errors.New("node unreachable")}
+	archiver := NewBuildLogArchiver(archive, streamer)
+
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	archiver.Archive(build, mockPod(kapi.PodSucceeded, 0))
+	archiver.processNext()
+	if log := archivedLog(t, archive, build); log != "" {
+		t.Fatalf("expected nothing to be archived after a failure, got %q", log)
+	}
+	if archiver.queue.NumRequeues(buildLogKey{namespace: build.Namespace, build: build.Name, pod: mockPod(kapi.PodSucceeded, 0).Name}) != 1 {
+		t.Errorf("expected the failed copy to be retried")
+	}
+	archiver.processNext()
+	if log := archivedLog(t, archive, build); log != "complete\n" {
+		t.Errorf("expected the log to be archived on retry, got %q", log)
+	}
+
+	// the log of a pod that is gone is given up on
+	streamer.err = kerrors.NewNotFound(kapi.Resource("pods"), "gone")
+	archiver.Archive(build, mockPod(kapi.PodSucceeded, 0))
+	archiver.processNext()
+	if archiver.queue.Len() != 0 {
+		t.Errorf("expected the log of a deleted pod not to be retried")
+	}
+
+	// nothing is scheduled without an archive
+	disabled := NewBuildLogArchiver(nil, streamer)
+	disabled.Archive(build, mockPod(kapi.PodSucceeded, 0))
+	if disabled.queue.Len() != 0 {
+		t.Errorf("expected no log to be scheduled without an archive")
+	}
+}
+
 type customPodManager struct {
 	CreatePodFunc func(namespace string, pod *kapi.Pod) (*kapi.Pod, error)
 	DeletePodFunc func(namespace string, pod *kapi.Pod) error
@@ -721,7 +1007,7 @@ func (c *customPodManager) GetPod(namespace, name string) (*kapi.Pod, error) {
 func TestHandleHandleBuildDeletionOK(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{
 				Labels:      map[string]string{buildapi.BuildLabel: buildapi.LabelValue(build.Name)},
@@ -747,7 +1033,7 @@ func TestHandleHandlePipelineBuildDeletionOK(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
 	build.Spec.Strategy.JenkinsPipelineStrategy = &buildapi.JenkinsPipelineBuildStrategy{}
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{
 				Labels:      map[string]string{buildapi.BuildLabel: buildapi.LabelValue(build.Name)},
@@ -772,7 +1058,7 @@ func TestHandleHandlePipelineBuildDeletionOK(t *testing.T) {
 func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{
 				Labels:      map[string]string{buildapi.BuildLabel: buildapi.LabelValue(build.Name)},
@@ -796,7 +1082,7 @@ func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 
 func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, errors.New("random")
 		},
@@ -811,7 +1097,7 @@ func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, kerrors.NewNotFound(kapi.Resource("Pod"), name)
 		},
@@ -833,7 +1119,7 @@ func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{}, nil
 		},
@@ -854,7 +1140,7 @@ func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 
 func TestHandleHandleBuildDeletionDeletePodError(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{
 				Labels:      map[string]string{buildapi.BuildLabel: buildapi.LabelValue(build.Name)},
@@ -2,6 +2,7 @@ package factory
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/golang/glog"
@@ -25,7 +26,9 @@ import (
 	buildclient "github.com/openshift/origin/pkg/build/client"
 	buildcontroller "github.com/openshift/origin/pkg/build/controller"
 	"github.com/openshift/origin/pkg/build/controller/policy"
+	"github.com/openshift/origin/pkg/build/controller/statusreport"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	osclient "github.com/openshift/origin/pkg/client"
 	oscache "github.com/openshift/origin/pkg/client/cache"
@@ -39,6 +42,8 @@ const (
 	// If it hasn't synced, to avoid a hot loop, we'll wait this long between checks.
 	storeSyncedPollPeriod = 100 * time.Millisecond
 	maxRetries            = 60
+	// logArchiveWorkers is the number of build logs copied to the archive at the same time.
+	logArchiveWorkers = 2
 )
 
 // limitedLogAndRetry stops retrying after maxTimeout, failing the build.
@@ -74,11 +79,16 @@ type BuildControllerFactory struct {
 	KubeClient          kclientset.Interface
 	BuildUpdater        buildclient.BuildUpdater
 	BuildLister         buildclient.BuildLister
+	BuildDeleter        buildclient.BuildDeleter
+	BuildConfigGetter   buildclient.BuildConfigGetter
 	DockerBuildStrategy *strategy.DockerBuildStrategy
 	SourceBuildStrategy *strategy.SourceBuildStrategy
 	CustomBuildStrategy *strategy.CustomBuildStrategy
 	BuildDefaults       builddefaults.BuildDefaults
 	BuildOverrides      buildoverrides.BuildOverrides
+	// LogArchive is where the logs of cancelled builds are archived and the
+	// logs of deleted builds are removed from, if set.
+	LogArchive logarchive.Archive
 
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
@@ -93,11 +103,17 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 	eventBroadcaster.StartRecordingToSink(&kcoreclient.EventSinkImpl{Interface: factory.KubeClient.Core().Events("")})
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	logArchiver := buildcontroller.NewBuildLogArchiver(factory.LogArchive, client)
+	go logArchiver.Run(logArchiveWorkers, factory.Stop)
+
 	buildController := &buildcontroller.BuildController{
 		BuildUpdater:      factory.BuildUpdater,
 		BuildLister:       factory.BuildLister,
+		BuildDeleter:      factory.BuildDeleter,
+		BuildConfigGetter: factory.BuildConfigGetter,
 		ImageStreamClient: client,
 		PodManager:        client,
+		LogArchiver:       logArchiver,
 		RunPolicies:       policy.GetAllRunPolicies(factory.BuildLister, factory.BuildUpdater),
 		BuildStrategy: &typeBasedFactoryStrategy{
 			DockerBuildStrategy: factory.DockerBuildStrategy,
@@ -146,6 +162,7 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 
 	buildDeleteController := &buildcontroller.BuildDeleteController{
 		PodManager: client,
+		LogArchive: factory.LogArchive,
 	}
 
 	return &controller.RetryController{
@@ -169,10 +186,14 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 
 // BuildPodControllerFactory construct BuildPodController objects
 type BuildPodControllerFactory struct {
-	OSClient     osclient.Interface
-	KubeClient   kclientset.Interface
-	BuildLister  buildclient.BuildLister
-	BuildUpdater buildclient.BuildUpdater
+	OSClient          osclient.Interface
+	KubeClient        kclientset.Interface
+	BuildLister       buildclient.BuildLister
+	BuildUpdater      buildclient.BuildUpdater
+	BuildDeleter      buildclient.BuildDeleter
+	BuildConfigGetter buildclient.BuildConfigGetter
+	// LogArchive is where the logs of completed builds are archived, if set.
+	LogArchive logarchive.Archive
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 
@@ -210,12 +231,19 @@ func (factory *BuildPodControllerFactory) Create() controller.RunnableController
 	cache.NewReflector(&podLW{client: factory.KubeClient}, &kapi.Pod{}, queue, 2*time.Minute).RunUntil(factory.Stop)
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	logArchiver := buildcontroller.NewBuildLogArchiver(factory.LogArchive, client)
+	go logArchiver.Run(logArchiveWorkers, factory.Stop)
+
 	buildPodController := &buildcontroller.BuildPodController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
-		SecretClient: factory.KubeClient.Core(),
-		PodManager:   client,
-		RunPolicies:  policy.GetAllRunPolicies(factory.BuildLister, factory.BuildUpdater),
+		BuildStore:        factory.buildStore,
+		BuildUpdater:      factory.BuildUpdater,
+		BuildLister:       factory.BuildLister,
+		BuildDeleter:      factory.BuildDeleter,
+		BuildConfigGetter: factory.BuildConfigGetter,
+		SecretClient:      factory.KubeClient.Core(),
+		PodManager:        client,
+		LogArchiver:       logArchiver,
+		RunPolicies:       policy.GetAllRunPolicies(factory.BuildLister, factory.BuildUpdater),
 	}
 
 	return &controller.RetryController{
@@ -338,6 +366,66 @@ func (factory *ImageChangeControllerFactory) waitForSyncedStores() {
 	}
 }
 
+// BuildStatusReportControllerFactory constructs BuildStatusReportController objects
+type BuildStatusReportControllerFactory struct {
+	OSClient                osclient.Interface
+	KubeClient              kclientset.Interface
+	BuildConfigLister       *oscache.StoreToBuildConfigListerImpl
+	BuildConfigListerSynced func() bool
+
+	// ConsoleURL and MasterPublicURL are used to link reported build statuses to the build logs.
+	ConsoleURL      string
+	MasterPublicURL string
+
+	// Stop may be set to allow controllers created by this factory to be terminated.
+	Stop <-chan struct{}
+}
+
+// Create constructs a BuildStatusReportController
+func (factory *BuildStatusReportControllerFactory) Create() controller.RunnableController {
+	queue := cache.NewResyncableFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(&buildLW{client: factory.OSClient}, &buildapi.Build{}, queue, 5*time.Minute).RunUntil(factory.Stop)
+
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&kcoreclient.EventSinkImpl{Interface: factory.KubeClient.Core().Events("")})
+
+	statusReportController := &buildcontroller.BuildStatusReportController{
+		BuildClient:       factory.OSClient,
+		SecretClient:      factory.KubeClient.Core(),
+		Recorder:          eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-status-report-controller"}),
+		BuildConfigLister: factory.BuildConfigLister,
+		NewReporter:       statusreport.NewReporter,
+		ConsoleURL:        factory.ConsoleURL,
+		MasterPublicURL:   factory.MasterPublicURL,
+	}
+
+	// Wait for the bc store to sync, otherwise builds of reporting configs are skipped until the next resync.
+	factory.waitForSyncedStores()
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			retryFunc("BuildStatusReport", statusreport.IsPermanent),
+			flowcontrol.NewTokenBucketRateLimiter(1, 10)),
+		Handle: func(obj interface{}) error {
+			return statusReportController.HandleBuild(obj.(*buildapi.Build))
+		},
+	}
+}
+
+func (factory *BuildStatusReportControllerFactory) waitForSyncedStores() {
+	for !factory.BuildConfigListerSynced() {
+		glog.V(4).Infof("Waiting for the bc caches to sync before starting the build status report controller worker")
+		select {
+		case <-time.After(storeSyncedPollPeriod):
+		case <-factory.Stop:
+			return
+		}
+	}
+}
+
 type BuildConfigControllerFactory struct {
 	Client                  osclient.Interface
 	KubeClient              kclientset.Interface
@@ -649,6 +737,11 @@ func (c ControllerClient) GetPod(namespace, name string) (*kapi.Pod, error) {
 	return c.KubeClient.Core().Pods(namespace).Get(name)
 }
 
+// StreamPodLogs streams the logs of a pod using the Kubernetes client.
+func (c ControllerClient) StreamPodLogs(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error) {
+	return c.KubeClient.Core().Pods(namespace).GetLogs(name, opts).Stream()
+}
+
 // GetImageStream retrieves an image repository by namespace and name
 func (c ControllerClient) GetImageStream(namespace, name string) (*imageapi.ImageStream, error) {
 	return c.Client.ImageStreams(namespace).Get(name)
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/errors"
+	utilruntime "github.com/openshift/kubernetes/pkg/util/runtime"
+	"github.com/openshift/kubernetes/pkg/util/wait"
+	"github.com/openshift/kubernetes/pkg/util/workqueue"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
+)
+
+// maxLogArchiveRetries is how many times copying the log of a build is retried
+// before the log is given up on.
+const maxLogArchiveRetries = 10
+
+// BuildLogArchiver copies the logs of build pods to the build log archive in
+// the background, so that a large log or a slow archive doesn't hold up the
+// handling of builds. Failed copies are retried with a backoff as long as the
+// pod exists.
+type BuildLogArchiver struct {
+	archive  logarchive.Archive
+	streamer podLogStreamer
+	queue    workqueue.RateLimitingInterface
+}
+
+// buildLogKey identifies the pod whose log is archived as the log of a build.
+type buildLogKey struct {
+	namespace string
+	build     string
+	pod       string
+}
+
+// NewBuildLogArchiver returns an archiver copying the logs read from streamer
+// to archive. If archive is nil, no log is archived.
+func NewBuildLogArchiver(archive logarchive.Archive, streamer podLogStreamer) *BuildLogArchiver {
+	return &BuildLogArchiver{
+		archive:  archive,
+		streamer: streamer,
+		queue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "buildlogs"),
+	}
+}
+
+// Archive schedules the log of the pod of a build to be copied to the archive.
+func (a *BuildLogArchiver) Archive(build *buildapi.Build, pod *kapi.Pod) {
+	if a == nil || a.archive == nil {
+		return
+	}
+	a.queue.Add(buildLogKey{namespace: build.Namespace, build: build.Name, pod: pod.Name})
+}
+
+// Run copies the scheduled logs with the given number of workers until stopCh
+// is closed.
+func (a *BuildLogArchiver) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer a.queue.ShutDown()
+
+	if a.archive == nil {
+		return
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(a.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (a *BuildLogArchiver) worker() {
+	for a.processNext() {
+	}
+}
+
+// processNext copies the next scheduled log. It returns false once the queue
+// is shut down.
+func (a *BuildLogArchiver) processNext() bool {
+	item, quit := a.queue.Get()
+	if quit {
+		return false
+	}
+	defer a.queue.Done(item)
+
+	key := item.(buildLogKey)
+	err := a.archiveLog(key)
+	switch {
+	case err == nil:
+		glog.V(4).Infof("Archived the log of build %s/%s", key.namespace, key.build)
+		a.queue.Forget(item)
+	case errors.IsNotFound(err):
+		glog.V(4).Infof("Pod %s/%s of build %s is gone, its log can't be archived", key.namespace, key.pod, key.build)
+		a.queue.Forget(item)
+	case a.queue.NumRequeues(item) < maxLogArchiveRetries:
+		glog.V(4).Infof("Retrying to archive the log of build %s/%s: %v", key.namespace, key.build, err)
+		a.queue.AddRateLimited(item)
+	default:
+		utilruntime.HandleError(fmt.Errorf("failed to archive the log of build %s/%s: %v", key.namespace, key.build, err))
+		a.queue.Forget(item)
+	}
+	return true
+}
+
+func (a *BuildLogArchiver) archiveLog(key buildLogKey) error {
+	opts := logarchive.ArchiveLogOptions
+	log, err := a.streamer.StreamPodLogs(key.namespace, key.pod, &opts)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+	return a.archive.Put(key.namespace, key.build, log)
+}
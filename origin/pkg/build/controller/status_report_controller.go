@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/errors"
+	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"github.com/openshift/kubernetes/pkg/client/record"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/controller/statusreport"
+	osclient "github.com/openshift/origin/pkg/client"
+	oscache "github.com/openshift/origin/pkg/client/cache"
+)
+
+// BuildStatusReportController reports the status of builds as commit statuses to the service
+// hosting their source repository, for build configs that enable status reporting.
+type BuildStatusReportController struct {
+	BuildClient  osclient.BuildsNamespacer
+	SecretClient kcoreclient.SecretsGetter
+	Recorder     record.EventRecorder
+
+	// BuildConfigLister is the shared build config cache. Every build is handled again on each
+	// resync, so the build config is read from the cache rather than from the API server.
+	BuildConfigLister *oscache.StoreToBuildConfigListerImpl
+
+	// NewReporter returns the reporter for a build config.
+	NewReporter func(reporting *buildapi.BuildStatusReporting, token string) (statusreport.Reporter, error)
+
+	// ConsoleURL is the public URL of the web console. If set, statuses link to the build logs in the
+	// console, otherwise to the log endpoint of the API at MasterPublicURL.
+	ConsoleURL      string
+	MasterPublicURL string
+}
+
+// reportedState maps a build phase to the state reported to the hosting service.
+var reportedState = map[buildapi.BuildPhase]statusreport.State{
+	buildapi.BuildPhaseNew:       statusreport.StatePending,
+	buildapi.BuildPhasePending:   statusreport.StatePending,
+	buildapi.BuildPhaseRunning:   statusreport.StateRunning,
+	buildapi.BuildPhaseComplete:  statusreport.StateSuccess,
+	buildapi.BuildPhaseFailed:    statusreport.StateFailure,
+	buildapi.BuildPhaseError:     statusreport.StateError,
+	buildapi.BuildPhaseCancelled: statusreport.StateCancelled,
+}
+
+// HandleBuild posts the status of the build if it changed since it was last reported. The reported
+// state is recorded in an annotation on the build, so a status is posted once per state even
+// across controller restarts.
+func (c *BuildStatusReportController) HandleBuild(build *buildapi.Build) error {
+	state, ok := reportedState[build.Status.Phase]
+	if !ok || build.Annotations[buildapi.BuildReportedStatusAnnotation] == string(state) {
+		return nil
+	}
+	if build.Status.Config == nil || build.Spec.Source.Git == nil {
+		return nil
+	}
+	// the commit is known up front for builds triggered by a webhook, and is filled in once the
+	// source is cloned otherwise
+	if build.Spec.Revision == nil || build.Spec.Revision.Git == nil || len(build.Spec.Revision.Git.Commit) == 0 {
+		return nil
+	}
+
+	bc, err := c.BuildConfigLister.BuildConfigs(build.Namespace).Get(build.Status.Config.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	reporting := bc.Spec.StatusReporting
+	if reporting == nil {
+		return nil
+	}
+
+	glog.V(4).Infof("Reporting status %s of build %s/%s for commit %s", state, build.Namespace, build.Name, build.Spec.Revision.Git.Commit)
+	if err := c.report(build, bc, reporting, state); err != nil {
+		if !statusreport.IsPermanent(err) {
+			return err
+		}
+		// retrying will not help, so the state is recorded as handled to avoid posting again on
+		// every resync
+		c.Recorder.Eventf(build, kapi.EventTypeWarning, "BuildStatusReportFailed", "Unable to report the build status: %v", err)
+	}
+
+	latest, err := c.BuildClient.Builds(build.Namespace).Get(build.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if latest.Annotations == nil {
+		latest.Annotations = make(map[string]string)
+	}
+	latest.Annotations[buildapi.BuildReportedStatusAnnotation] = string(state)
+	_, err = c.BuildClient.Builds(build.Namespace).Update(latest)
+	return err
+}
+
+func (c *BuildStatusReportController) report(build *buildapi.Build, bc *buildapi.BuildConfig, reporting *buildapi.BuildStatusReporting, state statusreport.State) error {
+	secret, err := c.SecretClient.Secrets(build.Namespace).Get(reporting.Secret.Name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &statusreport.PermanentError{Reason: fmt.Sprintf("secret %q not found", reporting.Secret.Name)}
+		}
+		return err
+	}
+	token := strings.TrimSpace(string(secret.Data[buildapi.BuildStatusReportingSecretTokenKey]))
+	if len(token) == 0 {
+		return &statusreport.PermanentError{Reason: fmt.Sprintf("secret %q has no %q key", reporting.Secret.Name, buildapi.BuildStatusReportingSecretTokenKey)}
+	}
+	reporter, err := c.NewReporter(reporting, token)
+	if err != nil {
+		return err
+	}
+
+	context := reporting.Context
+	if len(context) == 0 {
+		context = fmt.Sprintf("openshift/%s/%s", bc.Namespace, bc.Name)
+	}
+	return reporter.Report(build.Spec.Source.Git.URI, build.Spec.Revision.Git.Commit, statusreport.Status{
+		State:       state,
+		TargetURL:   c.buildURL(build),
+		Description: describeState(build.Name, state),
+		Context:     context,
+	})
+}
+
+// buildURL returns a link to the logs of the build.
+func (c *BuildStatusReportController) buildURL(build *buildapi.Build) string {
+	if len(c.ConsoleURL) > 0 {
+		return fmt.Sprintf("%s/project/%s/browse/builds/%s/%s?tab=logs", strings.TrimSuffix(c.ConsoleURL, "/"), build.Namespace, build.Status.Config.Name, build.Name)
+	}
+	if len(c.MasterPublicURL) > 0 {
+		return fmt.Sprintf("%s/oapi/v1/namespaces/%s/builds/%s/log", strings.TrimSuffix(c.MasterPublicURL, "/"), build.Namespace, build.Name)
+	}
+	return ""
+}
+
+func describeState(name string, state statusreport.State) string {
+	switch state {
+	case statusreport.StatePending:
+		return fmt.Sprintf("Build %s is pending", name)
+	case statusreport.StateRunning:
+		return fmt.Sprintf("Build %s is running", name)
+	case statusreport.StateSuccess:
+		return fmt.Sprintf("Build %s succeeded", name)
+	case statusreport.StateFailure:
+		return fmt.Sprintf("Build %s failed", name)
+	case statusreport.StateCancelled:
+		return fmt.Sprintf("Build %s was cancelled", name)
+	default:
+		return fmt.Sprintf("Build %s encountered an error", name)
+	}
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/client/cache"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"github.com/openshift/kubernetes/pkg/client/record"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/controller/statusreport"
+	oscache "github.com/openshift/origin/pkg/client/cache"
+	"github.com/openshift/origin/pkg/client/testclient"
+)
+
+type fakeReporter struct {
+	uri, commit string
+	statuses    []statusreport.Status
+	err         error
+}
+
+func (r *fakeReporter) Report(uri, commit string, status statusreport.Status) error {
+	r.uri, r.commit = uri, commit
+	r.statuses = append(r.statuses, status)
+	return r.err
+}
+
+func mockStatusReportBuild(phase buildapi.BuildPhase, reported string) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "app-1",
+			Namespace:   "ns",
+			Annotations: map[string]string{buildapi.BuildReportedStatusAnnotation: reported},
+		},
+		Spec: buildapi.BuildSpec{
+			CommonSpec: buildapi.CommonSpec{
+				Source:   buildapi.BuildSource{Git: &buildapi.GitBuildSource{URI: "https://github.com/openshift/ruby-hello-world"}},
+				Revision: &buildapi.SourceRevision{Git: &buildapi.GitSourceRevision{Commit: "abc123"}},
+			},
+		},
+		Status: buildapi.BuildStatus{
+			Phase:  phase,
+			Config: &kapi.ObjectReference{Name: "app"},
+		},
+	}
+}
+
+func TestHandleBuildStatusReport(t *testing.T) {
+	bc := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "app", Namespace: "ns"},
+		Spec: buildapi.BuildConfigSpec{
+			StatusReporting: &buildapi.BuildStatusReporting{
+				Provider: buildapi.BuildStatusReportingGitHub,
+				Secret:   kapi.LocalObjectReference{Name: "github"},
+			},
+		},
+	}
+	plain := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "plain", Namespace: "ns"},
+	}
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{Name: "github", Namespace: "ns"},
+		Data:       map[string][]byte{"token": []byte("secret\n")},
+	}
+
+	tests := []struct {
+		name     string
+		build    *buildapi.Build
+		err      error
+		reported statusreport.State
+		updated  bool
+	}{
+		{
+			name:     "new state",
+			build:    mockStatusReportBuild(buildapi.BuildPhaseComplete, "running"),
+			reported: statusreport.StateSuccess,
+			updated:  true,
+		},
+		{
+			name:  "already reported",
+			build: mockStatusReportBuild(buildapi.BuildPhaseRunning, "running"),
+		},
+		{
+			name: "unknown commit",
+			build: func() *buildapi.Build {
+				build := mockStatusReportBuild(buildapi.BuildPhasePending, "")
+				build.Spec.Revision = nil
+				return build
+			}(),
+		},
+		{
+			name: "config without status reporting",
+			build: func() *buildapi.Build {
+				build := mockStatusReportBuild(buildapi.BuildPhaseComplete, "")
+				build.Status.Config.Name = "plain"
+				return build
+			}(),
+		},
+		{
+			name: "config not found",
+			build: func() *buildapi.Build {
+				build := mockStatusReportBuild(buildapi.BuildPhaseComplete, "")
+				build.Status.Config.Name = "deleted"
+				return build
+			}(),
+		},
+		{
+			name:     "permanent error",
+			build:    mockStatusReportBuild(buildapi.BuildPhaseFailed, ""),
+			err:      &statusreport.PermanentError{Reason: "bad credentials"},
+			reported: statusreport.StateFailure,
+			updated:  true,
+		},
+	}
+	for _, test := range tests {
+		oc := testclient.NewSimpleFake(test.build)
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		indexer.Add(bc)
+		indexer.Add(plain)
+		reporter := &fakeReporter{err: test.err}
+		var token string
+		c := &BuildStatusReportController{
+			BuildClient:       oc,
+			SecretClient:      fake.NewSimpleClientset(secret).Core(),
+			Recorder:          &record.FakeRecorder{},
+			BuildConfigLister: &oscache.StoreToBuildConfigListerImpl{Indexer: indexer},
+			NewReporter: func(reporting *buildapi.BuildStatusReporting, secretToken string) (statusreport.Reporter, error) {
+				token = secretToken
+				return reporter, nil
+			},
+			ConsoleURL: "https://master/console/",
+		}
+
+		if err := c.HandleBuild(test.build); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if len(test.reported) == 0 {
+			if len(reporter.statuses) != 0 {
+				t.Errorf("%s: expected no status to be reported, got %#v", test.name, reporter.statuses)
+			}
+		} else {
+			if len(reporter.statuses) != 1 {
+				t.Fatalf("%s: expected one status to be reported, got %#v", test.name, reporter.statuses)
+			}
+			status := reporter.statuses[0]
+			if status.State != test.reported || status.Context != "openshift/ns/app" || status.TargetURL != "https://master/console/project/ns/browse/builds/app/app-1?tab=logs" {
+				t.Errorf("%s: unexpected status: %#v", test.name, status)
+			}
+			if token != "secret" || reporter.commit != "abc123" || reporter.uri != "https://github.com/openshift/ruby-hello-world" {
+				t.Errorf("%s: unexpected report of %s at %s with token %q", test.name, reporter.commit, reporter.uri, token)
+			}
+		}
+
+		var updated *buildapi.Build
+		for _, action := range oc.Actions() {
+			if action.GetVerb() == "update" {
+				updated = action.(core.UpdateAction).GetObject().(*buildapi.Build)
+			}
+		}
+		if !test.updated && len(oc.Actions()) != 0 {
+			t.Errorf("%s: expected no API calls, got %#v", test.name, oc.Actions())
+		}
+		if test.updated != (updated != nil) {
+			t.Errorf("%s: expected update %t, got %#v", test.name, test.updated, updated)
+		}
+		if updated != nil && updated.Annotations[buildapi.BuildReportedStatusAnnotation] != string(test.reported) {
+			t.Errorf("%s: expected the reported state to be recorded, got %#v", test.name, updated.Annotations)
+		}
+	}
+}
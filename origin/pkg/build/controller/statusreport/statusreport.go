@@ -0,0 +1,213 @@
+package statusreport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// State is the state of a build as reported to the source hosting provider.
+type State string
+
+const (
+	StatePending   State = "pending"
+	StateRunning   State = "running"
+	StateSuccess   State = "success"
+	StateFailure   State = "failure"
+	StateError     State = "error"
+	StateCancelled State = "cancelled"
+)
+
+const (
+	// DefaultGitHubAPIURL is the API of github.com.
+	DefaultGitHubAPIURL = "https://api.github.com"
+	// DefaultGitLabAPIURL is the API of gitlab.com.
+	DefaultGitLabAPIURL = "https://gitlab.com/api/v4"
+
+	requestTimeout = 30 * time.Second
+)
+
+// Status is a commit status.
+type Status struct {
+	State       State
+	TargetURL   string
+	Description string
+	Context     string
+}
+
+// Reporter posts commit statuses to a source hosting provider.
+type Reporter interface {
+	// Report sets the status of a commit in the repository identified by the Git URI.
+	Report(uri, commit string, status Status) error
+}
+
+// PermanentError is returned when posting a status failed in a way that will not change by
+// retrying, like a rejected token or an unknown repository.
+type PermanentError struct {
+	Reason string
+}
+
+func (e *PermanentError) Error() string {
+	return fmt.Sprintf("unable to report build status: %s", e.Reason)
+}
+
+// IsPermanent returns true if err is a PermanentError.
+func IsPermanent(err error) bool {
+	_, ok := err.(*PermanentError)
+	return ok
+}
+
+// NewReporter returns a Reporter for the provider configured on a build config, authenticating
+// with the given API token.
+func NewReporter(reporting *buildapi.BuildStatusReporting, token string) (Reporter, error) {
+	client := &http.Client{Timeout: requestTimeout}
+	switch reporting.Provider {
+	case buildapi.BuildStatusReportingGitHub:
+		return &gitHubReporter{apiURL: apiURL(reporting.APIURL, DefaultGitHubAPIURL), token: token, client: client}, nil
+	case buildapi.BuildStatusReportingGitLab:
+		return &gitLabReporter{apiURL: apiURL(reporting.APIURL, DefaultGitLabAPIURL), token: token, client: client}, nil
+	default:
+		return nil, &PermanentError{Reason: fmt.Sprintf("unsupported provider %q", reporting.Provider)}
+	}
+}
+
+func apiURL(configured, defaultURL string) string {
+	if len(configured) == 0 {
+		return defaultURL
+	}
+	return strings.TrimSuffix(configured, "/")
+}
+
+// repositoryPath returns the path of a repository on its hosting service, for instance
+// "openshift/origin" for both https://github.com/openshift/origin.git and
+// git@github.com:openshift/origin.git.
+func repositoryPath(uri string) (string, error) {
+	var path string
+	if strings.Contains(uri, "://") {
+		u, err := url.Parse(uri)
+		if err != nil {
+			return "", &PermanentError{Reason: fmt.Sprintf("invalid repository %q: %v", uri, err)}
+		}
+		path = u.Path
+	} else if i := strings.Index(uri, ":"); i != -1 {
+		// scp-like syntax, user@host:path
+		path = uri[i+1:]
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if strings.Count(path, "/") < 1 {
+		return "", &PermanentError{Reason: fmt.Sprintf("unable to determine the repository from %q", uri)}
+	}
+	return path, nil
+}
+
+type gitHubReporter struct {
+	apiURL string
+	token  string
+	client *http.Client
+}
+
+type gitHubStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Context     string `json:"context,omitempty"`
+}
+
+// Report creates a status with the GitHub API. GitHub has no running or cancelled states, so they are
+// reported as pending and error.
+func (r *gitHubReporter) Report(uri, commit string, status Status) error {
+	path, err := repositoryPath(uri)
+	if err != nil {
+		return err
+	}
+	state := string(status.State)
+	switch status.State {
+	case StateRunning:
+		state = string(StatePending)
+	case StateCancelled:
+		state = string(StateError)
+	}
+	body := gitHubStatus{
+		State:       state,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Context:     status.Context,
+	}
+	endpoint := fmt.Sprintf("%s/repos/%s/statuses/%s", r.apiURL, path, commit)
+	return post(r.client, endpoint, map[string]string{"Authorization": "token " + r.token}, body)
+}
+
+type gitLabReporter struct {
+	apiURL string
+	token  string
+	client *http.Client
+}
+
+type gitLabStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url,omitempty"`
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+// Report creates a status with the GitLab API. Projects are addressed by their URL encoded path.
+func (r *gitLabReporter) Report(uri, commit string, status Status) error {
+	path, err := repositoryPath(uri)
+	if err != nil {
+		return err
+	}
+	state := string(status.State)
+	switch status.State {
+	case StateFailure, StateError:
+		state = "failed"
+	case StateCancelled:
+		state = "canceled"
+	}
+	body := gitLabStatus{
+		State:       state,
+		TargetURL:   status.TargetURL,
+		Description: status.Description,
+		Name:        status.Context,
+	}
+	endpoint := fmt.Sprintf("%s/projects/%s/statuses/%s", r.apiURL, strings.Replace(path, "/", "%2F", -1), commit)
+	return post(r.client, endpoint, map[string]string{"PRIVATE-TOKEN": r.token}, body)
+}
+
+// post sends a JSON body to the URL. Client errors other than rate limiting are permanent.
+func post(client *http.Client, endpoint string, headers map[string]string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(data))
+	if err != nil {
+		return &PermanentError{Reason: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+	reason := fmt.Sprintf("%s returned %d: %s", req.URL.Host, resp.StatusCode, strings.TrimSpace(string(message)))
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return &PermanentError{Reason: reason}
+	}
+	return fmt.Errorf("unable to report build status: %s", reason)
+}
@@ -0,0 +1,113 @@
+package statusreport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider buildapi.BuildStatusReportingProvider
+		uri      string
+		state    State
+		path     string
+		header   string
+		body     map[string]string
+	}{
+		{
+			name:     "github",
+			provider: buildapi.BuildStatusReportingGitHub,
+			uri:      "https://github.com/openshift/ruby-hello-world.git",
+			state:    StateSuccess,
+			path:     "/repos/openshift/ruby-hello-world/statuses/abc123",
+			header:   "Authorization",
+			body:     map[string]string{"state": "success", "target_url": "https://console/build", "description": "done", "context": "openshift/ns/bc"},
+		},
+		{
+			name:     "github running",
+			provider: buildapi.BuildStatusReportingGitHub,
+			uri:      "git@github.com:openshift/ruby-hello-world.git",
+			state:    StateRunning,
+			path:     "/repos/openshift/ruby-hello-world/statuses/abc123",
+			header:   "Authorization",
+			body:     map[string]string{"state": "pending", "target_url": "https://console/build", "description": "done", "context": "openshift/ns/bc"},
+		},
+		{
+			name:     "gitlab",
+			provider: buildapi.BuildStatusReportingGitLab,
+			uri:      "https://gitlab.com/group/subgroup/project",
+			state:    StateFailure,
+			path:     "/projects/group%2Fsubgroup%2Fproject/statuses/abc123",
+			header:   "PRIVATE-TOKEN",
+			body:     map[string]string{"state": "failed", "target_url": "https://console/build", "description": "done", "name": "openshift/ns/bc"},
+		},
+	}
+	for _, test := range tests {
+		var path, header string
+		body := map[string]string{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			path = req.URL.EscapedPath()
+			header = req.Header.Get(test.header)
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("%s: unable to decode request: %v", test.name, err)
+			}
+			w.WriteHeader(http.StatusCreated)
+		}))
+
+		reporter, err := NewReporter(&buildapi.BuildStatusReporting{Provider: test.provider, APIURL: server.URL + "/"}, "secret")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		err = reporter.Report(test.uri, "abc123", Status{State: test.state, TargetURL: "https://console/build", Description: "done", Context: "openshift/ns/bc"})
+		server.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if path != test.path {
+			t.Errorf("%s: expected path %s, got %s", test.name, test.path, path)
+		}
+		if header != "secret" && header != "token secret" {
+			t.Errorf("%s: expected the token in %s, got %q", test.name, test.header, header)
+		}
+		if len(body) != len(test.body) {
+			t.Errorf("%s: expected body %v, got %v", test.name, test.body, body)
+		}
+		for k, v := range test.body {
+			if body[k] != v {
+				t.Errorf("%s: expected %s=%q, got %q", test.name, k, v, body[k])
+			}
+		}
+	}
+}
+
+func TestReportErrors(t *testing.T) {
+	code := http.StatusUnauthorized
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(code)
+	}))
+	defer server.Close()
+
+	reporter, err := NewReporter(&buildapi.BuildStatusReporting{Provider: buildapi.BuildStatusReportingGitHub, APIURL: server.URL}, "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := reporter.Report("https://github.com/openshift/origin", "abc123", Status{State: StatePending}); !IsPermanent(err) {
+		t.Errorf("expected a rejected token to be permanent, got %v", err)
+	}
+	code = http.StatusServiceUnavailable
+	if err := reporter.Report("https://github.com/openshift/origin", "abc123", Status{State: StatePending}); err == nil || IsPermanent(err) {
+		t.Errorf("expected an unavailable server to be retried, got %v", err)
+	}
+	if err := reporter.Report("https://github.com/origin", "abc123", Status{State: StatePending}); !IsPermanent(err) {
+		t.Errorf("expected a repository without an owner to be permanent, got %v", err)
+	}
+	if _, err := NewReporter(&buildapi.BuildStatusReporting{Provider: "Bitbucket"}, "secret"); !IsPermanent(err) {
+		t.Errorf("expected an unknown provider to be permanent, got %v", err)
+	}
+}
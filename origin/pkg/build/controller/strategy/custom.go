@@ -114,6 +114,7 @@ func (bs *CustomBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	}
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
 	setupSecrets(pod, build.Spec.Source.Secrets)
+	setupConfigMaps(pod, build.Spec.Source.ConfigMaps)
 	setupAdditionalSecrets(pod, build.Spec.Strategy.CustomStrategy.Secrets)
 	return pod, nil
 }
@@ -77,8 +77,10 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 
 	setupDockerSocket(pod)
 	setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret, build.Spec.Source.Images)
+	setupAdditionalPushSecrets(pod, build.Spec.Output.AdditionalOutputs)
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
 	setupSecrets(pod, build.Spec.Source.Secrets)
+	setupConfigMaps(pod, build.Spec.Source.ConfigMaps)
 
 	return pod, nil
 }
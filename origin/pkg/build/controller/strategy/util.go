@@ -17,12 +17,14 @@ import (
 
 const (
 	// dockerSocketPath is the default path for the Docker socket inside the builder container
-	dockerSocketPath               = "/var/run/docker.sock"
-	DockerPushSecretMountPath      = "/var/run/secrets/openshift.io/push"
-	DockerPullSecretMountPath      = "/var/run/secrets/openshift.io/pull"
-	SecretBuildSourceBaseMountPath = "/var/run/secrets/openshift.io/build"
-	SourceImagePullSecretMountPath = "/var/run/secrets/openshift.io/source-image"
-	sourceSecretMountPath          = "/var/run/secrets/openshift.io/source"
+	dockerSocketPath                  = "/var/run/docker.sock"
+	DockerPushSecretMountPath         = "/var/run/secrets/openshift.io/push"
+	DockerPullSecretMountPath         = "/var/run/secrets/openshift.io/pull"
+	AdditionalPushSecretMountPath     = "/var/run/secrets/openshift.io/push-additional"
+	SecretBuildSourceBaseMountPath    = "/var/run/secrets/openshift.io/build"
+	ConfigMapBuildSourceBaseMountPath = "/var/run/configs/openshift.io/build"
+	SourceImagePullSecretMountPath    = "/var/run/secrets/openshift.io/source-image"
+	sourceSecretMountPath             = "/var/run/secrets/openshift.io/source"
 )
 
 var whitelistEnvVarNames = []string{"BUILD_LOGLEVEL", "GIT_SSL_NO_VERIFY"}
@@ -121,6 +123,24 @@ func setupDockerSecrets(pod *kapi.Pod, pushSecret, pullSecret *kapi.LocalObjectR
 	}
 }
 
+// setupAdditionalPushSecrets mounts the push secrets of the additional outputs
+// of a build into the Pod running the build. The path each secret is mounted
+// at is passed in an environment variable suffixed with the index of the
+// output.
+func setupAdditionalPushSecrets(pod *kapi.Pod, outputs []buildapi.AdditionalBuildOutput) {
+	for i, output := range outputs {
+		if output.PushSecret == nil {
+			continue
+		}
+		mountPath := filepath.Join(AdditionalPushSecretMountPath, strconv.Itoa(i))
+		mountSecretVolume(pod, output.PushSecret.Name, mountPath, fmt.Sprintf("%s%d", "push-additional", i))
+		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, []kapi.EnvVar{
+			{Name: fmt.Sprintf("%s%d", dockercfg.PushAdditionalAuthType, i), Value: mountPath},
+		}...)
+		glog.V(3).Infof("%s will be used for docker push in %s", mountPath, pod.Name)
+	}
+}
+
 // setupSourceSecrets mounts SSH key used for accessing private SCM to clone
 // application source code during build.
 func setupSourceSecrets(pod *kapi.Pod, sourceSecret *kapi.LocalObjectReference) {
@@ -145,6 +165,28 @@ func setupSecrets(pod *kapi.Pod, secrets []buildapi.SecretBuildSource) {
 	}
 }
 
+// setupConfigMaps mounts the configMaps referenced by the ConfigMapBuildSource
+// into a builder container.
+func setupConfigMaps(pod *kapi.Pod, configMaps []buildapi.ConfigMapBuildSource) {
+	for _, c := range configMaps {
+		volumeName := namer.GetName(c.ConfigMap.Name, "build-configmap", kvalidation.DNS1123SubdomainMaxLength)
+		pod.Spec.Volumes = append(pod.Spec.Volumes, kapi.Volume{
+			Name: volumeName,
+			VolumeSource: kapi.VolumeSource{
+				ConfigMap: &kapi.ConfigMapVolumeSource{
+					LocalObjectReference: c.ConfigMap,
+				},
+			},
+		})
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, kapi.VolumeMount{
+			Name:      volumeName,
+			MountPath: filepath.Join(ConfigMapBuildSourceBaseMountPath, c.ConfigMap.Name),
+			ReadOnly:  true,
+		})
+		glog.V(3).Infof("%s will be used as a build config map in %s", c.ConfigMap.Name, ConfigMapBuildSourceBaseMountPath)
+	}
+}
+
 // addSourceEnvVars adds environment variables related to the source code
 // repository to builder container
 func addSourceEnvVars(source buildapi.BuildSource, output *[]kapi.EnvVar) {
@@ -1,11 +1,13 @@
 package strategy
 
 import (
+	"reflect"
 	"testing"
 
 	kapi "github.com/openshift/kubernetes/pkg/api"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
 )
 
 func TestSetupDockerSocketHostSocket(t *testing.T) {
@@ -147,3 +149,68 @@ func TestSetupDockerSecrets(t *testing.T) {
 		seenMountPath[m.Name] = true
 	}
 }
+
+func TestSetupAdditionalPushSecrets(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+	outputs := []buildapi.AdditionalBuildOutput{
+		{PushSecret: &kapi.LocalObjectReference{Name: "partner"}},
+		{},
+		{PushSecret: &kapi.LocalObjectReference{Name: "partner"}},
+	}
+
+	setupAdditionalPushSecrets(&pod, outputs)
+
+	if len(pod.Spec.Volumes) != 2 || len(pod.Spec.Containers[0].VolumeMounts) != 2 {
+		t.Fatalf("Expected 2 volumes and mounts, got: %#v", pod.Spec)
+	}
+	if pod.Spec.Volumes[0].Name == pod.Spec.Volumes[1].Name {
+		t.Errorf("Duplicate volume name %s", pod.Spec.Volumes[0].Name)
+	}
+	expected := []kapi.EnvVar{
+		{Name: dockercfg.PushAdditionalAuthType + "0", Value: AdditionalPushSecretMountPath + "/0"},
+		{Name: dockercfg.PushAdditionalAuthType + "2", Value: AdditionalPushSecretMountPath + "/2"},
+	}
+	if !reflect.DeepEqual(pod.Spec.Containers[0].Env, expected) {
+		t.Errorf("Expected env %#v, got %#v", expected, pod.Spec.Containers[0].Env)
+	}
+	for i, m := range pod.Spec.Containers[0].VolumeMounts {
+		if m.MountPath != expected[i].Value {
+			t.Errorf("Expected mount path %s, got %s", expected[i].Value, m.MountPath)
+		}
+	}
+}
+
+func TestSetupConfigMaps(t *testing.T) {
+	pod := kapi.Pod{
+		Spec: kapi.PodSpec{
+			Containers: []kapi.Container{
+				{},
+			},
+		},
+	}
+
+	// a secret and a config map of the same name must not share a volume
+	setupSecrets(&pod, []buildapi.SecretBuildSource{{Secret: kapi.LocalObjectReference{Name: "settings"}}})
+	setupConfigMaps(&pod, []buildapi.ConfigMapBuildSource{{ConfigMap: kapi.LocalObjectReference{Name: "settings"}, DestinationDir: ".m2"}})
+
+	if len(pod.Spec.Volumes) != 2 {
+		t.Fatalf("Expected 2 volumes, got: %#v", pod.Spec.Volumes)
+	}
+	if pod.Spec.Volumes[0].Name == pod.Spec.Volumes[1].Name {
+		t.Errorf("Duplicate volume name %s", pod.Spec.Volumes[0].Name)
+	}
+	configMap := pod.Spec.Volumes[1].ConfigMap
+	if configMap == nil || configMap.Name != "settings" {
+		t.Errorf("Expected a config map volume, got %#v", pod.Spec.Volumes[1])
+	}
+	mount := pod.Spec.Containers[0].VolumeMounts[1]
+	if mount.MountPath != "/var/run/configs/openshift.io/build/settings" || !mount.ReadOnly {
+		t.Errorf("Unexpected config map volume mount %#v", mount)
+	}
+}
@@ -201,8 +201,24 @@ func updateBuildEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) {
 		buildEnv = &strategy.CustomStrategy.Env
 	}
 
+	*buildEnv = mergeEnv(*buildEnv, env)
+}
+
+// updateBuildArgs updates the Docker strategy build arguments, replacing the
+// existing arguments with the same name with provided args
+func updateBuildArgs(strategy *buildapi.BuildStrategy, args []kapi.EnvVar) error {
+	if strategy.DockerStrategy == nil {
+		return fmt.Errorf("build arguments can only be specified for Docker builds")
+	}
+	strategy.DockerStrategy.BuildArgs = mergeEnv(strategy.DockerStrategy.BuildArgs, args)
+	return nil
+}
+
+// mergeEnv returns existing with the variables also defined in env removed,
+// followed by env
+func mergeEnv(existing, env []kapi.EnvVar) []kapi.EnvVar {
 	newEnv := []kapi.EnvVar{}
-	for _, e := range *buildEnv {
+	for _, e := range existing {
 		exists := false
 		for _, n := range env {
 			if e.Name == n.Name {
@@ -214,8 +230,7 @@ func updateBuildEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) {
 			newEnv = append(newEnv, e)
 		}
 	}
-	newEnv = append(newEnv, env...)
-	*buildEnv = newEnv
+	return append(newEnv, env...)
 }
 
 // Instantiate returns a new Build object based on a BuildRequest object
@@ -255,10 +270,18 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 	newBuild.Labels = policy.MergeMaps(request.Labels, newBuild.Labels)
 	// Copy build trigger information to the build object.
 	newBuild.Spec.TriggeredBy = request.TriggeredBy
+	if err := applyPullRequest(bc, newBuild); err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
 
 	if len(request.Env) > 0 {
 		updateBuildEnv(&newBuild.Spec.Strategy, request.Env)
 	}
+	if request.DockerStrategyOptions != nil && len(request.DockerStrategyOptions.BuildArgs) > 0 {
+		if err := updateBuildArgs(&newBuild.Spec.Strategy, request.DockerStrategyOptions.BuildArgs); err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+	}
 	glog.V(4).Infof("Build %s/%s has been generated from %s/%s BuildConfig", newBuild.Namespace, newBuild.ObjectMeta.Name, bc.Namespace, bc.ObjectMeta.Name)
 
 	// need to update the BuildConfig because LastVersion and possibly
@@ -276,6 +299,97 @@ func (g *BuildGenerator) Instantiate(ctx kapi.Context, request *buildapi.BuildRe
 	return g.createBuild(ctx, newBuild)
 }
 
+// applyPullRequest builds the source of the pull request which triggered the build, if any, and
+// applies the pull request output policy of the GitHub webhook triggers of the BuildConfig, so
+// that pull request builds do not overwrite the output of the branch they target.
+func applyPullRequest(bc *buildapi.BuildConfig, build *buildapi.Build) error {
+	var pr *buildapi.PullRequestCause
+	for _, cause := range build.Spec.TriggeredBy {
+		if cause.GitHubWebHook != nil && cause.GitHubWebHook.PullRequest != nil {
+			pr = cause.GitHubWebHook.PullRequest
+			break
+		}
+	}
+	if pr == nil {
+		return nil
+	}
+	if build.Spec.Source.Git == nil {
+		return fmt.Errorf("build config %s/%s has no Git source to build pull request %d from", bc.Namespace, bc.Name, pr.Number)
+	}
+	build.Spec.Source.Git.Ref = pr.Ref
+	if build.Labels == nil {
+		build.Labels = make(map[string]string)
+	}
+	build.Labels[buildapi.BuildPullRequestLabel] = strconv.FormatInt(pr.Number, 10)
+
+	output := buildapi.PullRequestOutputNone
+	for _, trigger := range bc.Spec.Triggers {
+		if trigger.GitHubWebHook != nil && trigger.GitHubWebHook.PullRequests != nil {
+			output = trigger.GitHubWebHook.PullRequests.Output
+			break
+		}
+	}
+	if output != buildapi.PullRequestOutputTag || build.Spec.Output.To == nil {
+		build.Spec.Output.To = nil
+		build.Spec.Output.PushSecret = nil
+		build.Spec.Output.AdditionalOutputs = nil
+		return nil
+	}
+	tag := fmt.Sprintf("pr-%d", pr.Number)
+	to, err := pullRequestOutput(build.Spec.Output.To, tag)
+	if err != nil {
+		return err
+	}
+	if to == nil {
+		build.Spec.Output.To = nil
+		build.Spec.Output.PushSecret = nil
+		build.Spec.Output.AdditionalOutputs = nil
+		return nil
+	}
+	build.Spec.Output.To = to
+
+	// additional outputs are retagged the same way, and dropped if they cannot be retagged
+	additional := []buildapi.AdditionalBuildOutput{}
+	for _, output := range build.Spec.Output.AdditionalOutputs {
+		if output.To == nil {
+			continue
+		}
+		to, err := pullRequestOutput(output.To, tag)
+		if err != nil || to == nil {
+			glog.V(4).Infof("Dropping additional output %q of pull request build: %v", output.To.Name, err)
+			continue
+		}
+		output.To = to
+		additional = append(additional, output)
+	}
+	if len(additional) == 0 {
+		additional = nil
+	}
+	build.Spec.Output.AdditionalOutputs = additional
+	return nil
+}
+
+// pullRequestOutput returns a copy of the output reference to with its tag replaced by the pull
+// request tag, or nil if the kind of the reference cannot be retagged.
+func pullRequestOutput(to *kapi.ObjectReference, tag string) (*kapi.ObjectReference, error) {
+	out := *to
+	switch to.Kind {
+	case "ImageStreamTag":
+		name, _, _ := imageapi.SplitImageStreamTag(to.Name)
+		out.Name = imageapi.JoinImageStreamTag(name, tag)
+	case "DockerImage":
+		ref, err := imageapi.ParseDockerImageReference(to.Name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output image %q: %v", to.Name, err)
+		}
+		ref.Tag, ref.ID = tag, ""
+		out.Name = ref.String()
+	default:
+		return nil, nil
+	}
+	return &out, nil
+}
+
 // checkBuildConfigLastVersion will return an error if the BuildConfig's LastVersion doesn't match the passed in lastVersion
 // when lastVersion is not nil
 func (g *BuildGenerator) checkLastVersion(bc *buildapi.BuildConfig, lastVersion *int64) error {
@@ -430,6 +544,12 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 		return nil, err
 	}
 	setBuildPushSecret(g.resolveImageSecret(ctx, builderSecrets, build.Spec.Output.To, bcCopy.Namespace), &build.Spec.Output)
+	for i := range build.Spec.Output.AdditionalOutputs {
+		output := &build.Spec.Output.AdditionalOutputs[i]
+		if output.PushSecret == nil {
+			output.PushSecret = g.resolveImageSecret(ctx, builderSecrets, output.To, bcCopy.Namespace)
+		}
+	}
 
 	// Resolve image source if present
 	if err = g.setBuildSourceImage(ctx, builderSecrets, bcCopy, &build.Spec.Source); err != nil {
@@ -1679,6 +1679,10 @@ func TestGenerateBuildFromConfigWithSecrets(t *testing.T) {
 		// Setup the BuildGenerator
 		strategy := mockDockerStrategyForDockerImage(imageName)
 		output := mockOutputWithImageName(imageName)
+		output.AdditionalOutputs = []buildapi.AdditionalBuildOutput{
+			{To: &kapi.ObjectReference{Kind: "DockerImage", Name: imageName + ":${SOURCE_REF}"}},
+			{To: &kapi.ObjectReference{Kind: "DockerImage", Name: imageName + ":v1"}, PushSecret: &kapi.LocalObjectReference{Name: "explicit"}},
+		}
 		generator := mockBuildGenerator()
 		bc := mocks.MockBuildConfig(source, strategy, output)
 		build, err := generator.generateBuildFromConfig(kapi.NewContext(), bc, revision, nil)
@@ -1697,6 +1701,12 @@ func TestGenerateBuildFromConfigWithSecrets(t *testing.T) {
 		if len(build.Spec.Strategy.DockerStrategy.PullSecret.Name) == 0 {
 			t.Errorf("Expected PullSecret for image %s to be set not empty", imageName)
 		}
+		if secret := build.Spec.Output.AdditionalOutputs[0].PushSecret; secret == nil || secret.Name != build.Spec.Output.PushSecret.Name {
+			t.Errorf("Expected PushSecret %s for the additional output of image %s, got %#v", build.Spec.Output.PushSecret.Name, imageName, secret)
+		}
+		if secret := build.Spec.Output.AdditionalOutputs[1].PushSecret; secret == nil || secret.Name != "explicit" {
+			t.Errorf("Expected the PushSecret of the additional output of image %s to be kept, got %#v", imageName, secret)
+		}
 		if err != nil {
 			t.Fatalf("Unexpected error %v", err)
 		}
@@ -1859,3 +1869,120 @@ func TestInstantiateBuildTriggerCauseGitHubWebHook(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyPullRequest(t *testing.T) {
+	tests := []struct {
+		name             string
+		output           buildapi.PullRequestOutputPolicy
+		to               *kapi.ObjectReference
+		additional       []buildapi.AdditionalBuildOutput
+		expect           *kapi.ObjectReference
+		expectAdditional []buildapi.AdditionalBuildOutput
+	}{
+		{
+			name:   "no output",
+			output: buildapi.PullRequestOutputNone,
+			to:     &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+		},
+		{
+			name:   "no output with additional outputs",
+			output: buildapi.PullRequestOutputNone,
+			to:     &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+			additional: []buildapi.AdditionalBuildOutput{
+				{To: &kapi.ObjectReference{Kind: "DockerImage", Name: "quay.io/ns/app:${SOURCE_COMMIT}"}},
+			},
+		},
+		{
+			name:   "tag with additional outputs",
+			output: buildapi.PullRequestOutputTag,
+			to:     &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+			additional: []buildapi.AdditionalBuildOutput{
+				{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "other:${BUILD_NUMBER}"}},
+				{To: &kapi.ObjectReference{Kind: "DockerImage", Name: "quay.io/ns/app:v1"}, PushSecret: &kapi.LocalObjectReference{Name: "quay"}},
+				{To: &kapi.ObjectReference{Kind: "ImageStream", Name: "unsupported"}},
+			},
+			expect: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:pr-4"},
+			expectAdditional: []buildapi.AdditionalBuildOutput{
+				{To: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "other:pr-4"}},
+				{To: &kapi.ObjectReference{Kind: "DockerImage", Name: "quay.io/ns/app:pr-4"}, PushSecret: &kapi.LocalObjectReference{Name: "quay"}},
+			},
+		},
+		{
+			name:   "image stream tag",
+			output: buildapi.PullRequestOutputTag,
+			to:     &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+			expect: &kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:pr-4"},
+		},
+		{
+			name:   "docker image",
+			output: buildapi.PullRequestOutputTag,
+			to:     &kapi.ObjectReference{Kind: "DockerImage", Name: "registry:5000/ns/app:v1"},
+			expect: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry:5000/ns/app:pr-4"},
+		},
+	}
+	for _, test := range tests {
+		bc := &buildapi.BuildConfig{
+			Spec: buildapi.BuildConfigSpec{
+				Triggers: []buildapi.BuildTriggerPolicy{
+					{
+						Type: buildapi.GitHubWebHookBuildTriggerType,
+						GitHubWebHook: &buildapi.WebHookTrigger{
+							Secret:       "secret",
+							PullRequests: &buildapi.PullRequestBuildPolicy{Output: test.output},
+						},
+					},
+				},
+			},
+		}
+		build := &buildapi.Build{
+			Spec: buildapi.BuildSpec{
+				CommonSpec: buildapi.CommonSpec{
+					Source: buildapi.BuildSource{Git: &buildapi.GitBuildSource{URI: "https://github.com/openshift/origin", Ref: "master"}},
+					Output: buildapi.BuildOutput{To: test.to, PushSecret: &kapi.LocalObjectReference{Name: "push"}, AdditionalOutputs: test.additional},
+				},
+				TriggeredBy: []buildapi.BuildTriggerCause{
+					{GitHubWebHook: &buildapi.GitHubWebHookCause{PullRequest: &buildapi.PullRequestCause{Number: 4, Ref: "refs/pull/4/head"}}},
+				},
+			},
+		}
+		if err := applyPullRequest(bc, build); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if build.Spec.Source.Git.Ref != "refs/pull/4/head" {
+			t.Errorf("%s: expected the pull request ref to be built, got %s", test.name, build.Spec.Source.Git.Ref)
+		}
+		if build.Labels[buildapi.BuildPullRequestLabel] != "4" {
+			t.Errorf("%s: expected the pull request label, got %v", test.name, build.Labels)
+		}
+		if !reflect.DeepEqual(build.Spec.Output.To, test.expect) {
+			t.Errorf("%s: expected output %#v, got %#v", test.name, test.expect, build.Spec.Output.To)
+		}
+		if test.expect == nil && build.Spec.Output.PushSecret != nil {
+			t.Errorf("%s: expected the push secret to be cleared", test.name)
+		}
+		if !reflect.DeepEqual(build.Spec.Output.AdditionalOutputs, test.expectAdditional) {
+			t.Errorf("%s: expected additional outputs %#v, got %#v", test.name, test.expectAdditional, build.Spec.Output.AdditionalOutputs)
+		}
+	}
+}
+
+func TestUpdateBuildArgs(t *testing.T) {
+	strategy := &buildapi.BuildStrategy{
+		DockerStrategy: &buildapi.DockerBuildStrategy{
+			BuildArgs: []kapi.EnvVar{{Name: "FOO", Value: "foo"}, {Name: "BAR", Value: "bar"}},
+		},
+	}
+	if err := updateBuildArgs(strategy, []kapi.EnvVar{{Name: "FOO", Value: "override"}, {Name: "BAZ", Value: "baz"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []kapi.EnvVar{{Name: "BAR", Value: "bar"}, {Name: "FOO", Value: "override"}, {Name: "BAZ", Value: "baz"}}
+	if !reflect.DeepEqual(strategy.DockerStrategy.BuildArgs, expected) {
+		t.Errorf("expected build args %#v, got %#v", expected, strategy.DockerStrategy.BuildArgs)
+	}
+
+	strategy = &buildapi.BuildStrategy{SourceStrategy: &buildapi.SourceBuildStrategy{}}
+	if err := updateBuildArgs(strategy, []kapi.EnvVar{{Name: "FOO", Value: "foo"}}); err == nil {
+		t.Errorf("expected an error for a non-Docker strategy")
+	}
+}
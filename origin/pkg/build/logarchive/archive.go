@@ -0,0 +1,170 @@
+// Package logarchive keeps the logs of completed builds after their build pods
+// are gone.
+package logarchive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// ErrNotArchived is returned when the log of a build is not in the archive.
+var ErrNotArchived = errors.New("the build log is not archived")
+
+// Archive stores the logs of completed builds.
+type Archive interface {
+	// Put stores the log of the named build, replacing any log archived for it
+	// before.
+	Put(namespace, name string, log io.Reader) error
+	// Get returns the archived log of the named build, or ErrNotArchived.
+	Get(namespace, name string) (io.ReadCloser, error)
+	// Delete removes the archived log of the named build. Deleting a log that
+	// is not archived is not an error.
+	Delete(namespace, name string) error
+}
+
+// directoryArchive stores build logs as files in a local directory, typically
+// backed by a persistent volume shared by the masters.
+type directoryArchive struct {
+	dir string
+}
+
+// NewDirectoryArchive returns an Archive storing build logs in dir.
+func NewDirectoryArchive(dir string) Archive {
+	return &directoryArchive{dir: dir}
+}
+
+func (a *directoryArchive) path(namespace, name string) string {
+	return filepath.Join(a.dir, namespace, name+".log")
+}
+
+func (a *directoryArchive) Put(namespace, name string, log io.Reader) error {
+	target := a.path(namespace, name)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	// write to a temporary file first so readers never see a partial log
+	f, err := ioutil.TempFile(filepath.Dir(target), "."+name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, log); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), target); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
+
+func (a *directoryArchive) Get(namespace, name string) (io.ReadCloser, error) {
+	f, err := os.Open(a.path(namespace, name))
+	if os.IsNotExist(err) {
+		return nil, ErrNotArchived
+	}
+	return f, err
+}
+
+func (a *directoryArchive) Delete(namespace, name string) error {
+	err := os.Remove(a.path(namespace, name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// ObjectStore is implemented by object storage services build logs can be
+// archived in.
+type ObjectStore interface {
+	// PutObject stores the content read from content under key.
+	PutObject(key string, content io.Reader) error
+	// GetObject returns the content stored under key, or ErrNotArchived if
+	// there is none.
+	GetObject(key string) (io.ReadCloser, error)
+	// DeleteObject removes the content stored under key, if any.
+	DeleteObject(key string) error
+}
+
+// ObjectStoreFactory creates an ObjectStore from the provider specific
+// parameters set in the master configuration.
+type ObjectStoreFactory func(parameters map[string]string) (ObjectStore, error)
+
+var (
+	objectStoresLock sync.Mutex
+	objectStores     = map[string]ObjectStoreFactory{}
+)
+
+// RegisterObjectStore makes an object store provider available under the given
+// name. It panics if a provider is registered twice under the same name.
+func RegisterObjectStore(provider string, factory ObjectStoreFactory) {
+	objectStoresLock.Lock()
+	defer objectStoresLock.Unlock()
+	if _, exists := objectStores[provider]; exists {
+		panic(fmt.Sprintf("build log object store %q was registered twice", provider))
+	}
+	objectStores[provider] = factory
+}
+
+// ObjectStoreProviders returns the names of the registered object store
+// providers.
+func ObjectStoreProviders() []string {
+	objectStoresLock.Lock()
+	defer objectStoresLock.Unlock()
+	providers := []string{}
+	for provider := range objectStores {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	return providers
+}
+
+// NewObjectStoreArchive returns an Archive storing build logs in the object
+// store of the named provider.
+func NewObjectStoreArchive(provider string, parameters map[string]string) (Archive, error) {
+	objectStoresLock.Lock()
+	factory, ok := objectStores[provider]
+	objectStoresLock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown build log object store %q", provider)
+	}
+	store, err := factory(parameters)
+	if err != nil {
+		return nil, err
+	}
+	return &objectStoreArchive{store: store}, nil
+}
+
+// objectStoreArchive stores build logs as objects keyed by the namespace and
+// name of the build.
+type objectStoreArchive struct {
+	store ObjectStore
+}
+
+func (a *objectStoreArchive) key(namespace, name string) string {
+	return path.Join(namespace, name+".log")
+}
+
+func (a *objectStoreArchive) Put(namespace, name string, log io.Reader) error {
+	return a.store.PutObject(a.key(namespace, name), log)
+}
+
+func (a *objectStoreArchive) Get(namespace, name string) (io.ReadCloser, error) {
+	return a.store.GetObject(a.key(namespace, name))
+}
+
+func (a *objectStoreArchive) Delete(namespace, name string) error {
+	return a.store.DeleteObject(a.key(namespace, name))
+}
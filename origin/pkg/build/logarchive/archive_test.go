@@ -0,0 +1,110 @@
+package logarchive
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testArchive(t *testing.T, archive Archive) {
+	if _, err := archive.Get("ns", "build-1"); err != ErrNotArchived {
+		t.Fatalf("expected ErrNotArchived for a missing log, got %v", err)
+	}
+	for _, content := range []string{"first\n", "second\n"} {
+		if err := archive.Put("ns", "build-1", strings.NewReader(content)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		log, err := archive.Get("ns", "build-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		data, err := ioutil.ReadAll(log)
+		log.Close()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(data) != content {
+			t.Errorf("expected archived log %q, got %q", content, string(data))
+		}
+	}
+	if _, err := archive.Get("other", "build-1"); err != ErrNotArchived {
+		t.Errorf("expected ErrNotArchived for a build in another namespace, got %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := archive.Delete("ns", "build-1"); err != nil {
+			t.Fatalf("unexpected error deleting the log: %v", err)
+		}
+		if _, err := archive.Get("ns", "build-1"); err != ErrNotArchived {
+			t.Errorf("expected ErrNotArchived for a deleted log, got %v", err)
+		}
+	}
+}
+
+func TestDirectoryArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildlogs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	testArchive(t, NewDirectoryArchive(dir))
+}
+
+type fakeObjectStore struct {
+	objects map[string][]byte
+}
+
+func (s *fakeObjectStore) PutObject(key string, content io.Reader) error {
+	data, err := ioutil.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	s.objects[key] = data
+	return nil
+}
+
+func (s *fakeObjectStore) GetObject(key string) (io.ReadCloser, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, ErrNotArchived
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *fakeObjectStore) DeleteObject(key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+func TestObjectStoreArchive(t *testing.T) {
+	var parameters map[string]string
+	store := &fakeObjectStore{objects: map[string][]byte{}}
+	RegisterObjectStore("fake", func(p map[string]string) (ObjectStore, error) {
+		parameters = p
+		return store, nil
+	})
+	if providers := ObjectStoreProviders(); !reflect.DeepEqual(providers, []string{"fake", "s3"}) {
+		t.Errorf("unexpected providers: %v", providers)
+	}
+
+	if _, err := NewObjectStoreArchive("unknown", nil); err == nil {
+		t.Errorf("expected an error for an unknown provider")
+	}
+	archive, err := NewObjectStoreArchive("fake", map[string]string{"bucket": "logs"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parameters["bucket"] != "logs" {
+		t.Errorf("expected the parameters to be passed to the provider, got %v", parameters)
+	}
+	testArchive(t, archive)
+	if err := archive.Put("ns", "build-1", strings.NewReader("log\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := store.objects["ns/build-1.log"]; !ok {
+		t.Errorf("expected the log to be stored under ns/build-1.log, got %v", store.objects)
+	}
+}
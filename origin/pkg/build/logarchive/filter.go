@@ -0,0 +1,95 @@
+package logarchive
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+)
+
+// ArchiveLogOptions are the options the log of a build pod is requested with
+// to be archived. Every line is timestamped so that Filter can honor the time
+// based options of later requests for the archived log.
+var ArchiveLogOptions = kapi.PodLogOptions{Timestamps: true}
+
+// Filter copies an archived build log from in to out, applying the options a
+// request for the log of the build pod would have applied. The log is expected
+// to have been archived with ArchiveLogOptions.
+func Filter(in io.Reader, out io.Writer, opts *kapi.PodLogOptions, now time.Time) error {
+	var since time.Time
+	if opts.SinceTime != nil {
+		since = opts.SinceTime.Time
+	}
+	if opts.SinceSeconds != nil {
+		since = now.Add(-time.Duration(*opts.SinceSeconds) * time.Second)
+	}
+	limit := int64(-1)
+	if opts.LimitBytes != nil {
+		limit = *opts.LimitBytes
+	}
+
+	write := func(line string) error {
+		if limit == 0 {
+			return nil
+		}
+		if limit > 0 && int64(len(line)) > limit {
+			line = line[:limit]
+		}
+		if limit > 0 {
+			limit -= int64(len(line))
+		}
+		_, err := io.WriteString(out, line)
+		return err
+	}
+
+	var tail []string
+	reader := bufio.NewReader(in)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			timestamp, content := splitTimestamp(line)
+			if timestamp.IsZero() || !timestamp.Before(since) {
+				if !opts.Timestamps {
+					line = content
+				}
+				if opts.TailLines != nil {
+					tail = append(tail, line)
+					if int64(len(tail)) > *opts.TailLines {
+						tail = tail[1:]
+					}
+				} else if err := write(line); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, line := range tail {
+		if err := write(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitTimestamp splits the RFC3339 timestamp the container runtime prefixes
+// log lines with from their content. A zero time is returned for lines without
+// a timestamp.
+func splitTimestamp(line string) (time.Time, string) {
+	i := strings.IndexByte(line, ' ')
+	if i < 0 {
+		return time.Time{}, line
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, line[:i])
+	if err != nil {
+		return time.Time{}, line
+	}
+	return timestamp, line[i+1:]
+}
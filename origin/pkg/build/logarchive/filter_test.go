@@ -0,0 +1,83 @@
+package logarchive
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+)
+
+func TestFilter(t *testing.T) {
+	log := strings.Join([]string{
+		"2016-10-06T10:00:00.000000000Z Cloning \"https://github.com/openshift/ruby-hello-world\" ...",
+		"2016-10-06T10:00:30.500000000Z Step 1 : FROM centos/ruby-22-centos7",
+		"2016-10-06T10:01:00.000000000Z Step 2 : RUN bundle install",
+		"continued without a timestamp",
+		"2016-10-06T10:02:00.000000000Z Push successful",
+		"",
+	}, "\n")
+	now := time.Date(2016, 10, 6, 10, 2, 30, 0, time.UTC)
+	int64Ptr := func(i int64) *int64 { return &i }
+	since := unversioned.NewTime(time.Date(2016, 10, 6, 10, 1, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		opts     kapi.PodLogOptions
+		expected string
+	}{
+		{
+			name: "no options",
+			expected: "Cloning \"https://github.com/openshift/ruby-hello-world\" ...\n" +
+				"Step 1 : FROM centos/ruby-22-centos7\n" +
+				"Step 2 : RUN bundle install\n" +
+				"continued without a timestamp\n" +
+				"Push successful\n",
+		},
+		{
+			name:     "timestamps",
+			opts:     kapi.PodLogOptions{Timestamps: true, TailLines: int64Ptr(1)},
+			expected: "2016-10-06T10:02:00.000000000Z Push successful\n",
+		},
+		{
+			name: "tail",
+			opts: kapi.PodLogOptions{TailLines: int64Ptr(2)},
+			expected: "continued without a timestamp\n" +
+				"Push successful\n",
+		},
+		{
+			name: "since time",
+			opts: kapi.PodLogOptions{SinceTime: &since},
+			expected: "Step 2 : RUN bundle install\n" +
+				"continued without a timestamp\n" +
+				"Push successful\n",
+		},
+		{
+			name:     "since seconds",
+			opts:     kapi.PodLogOptions{SinceSeconds: int64Ptr(60)},
+			expected: "continued without a timestamp\nPush successful\n",
+		},
+		{
+			name:     "limit bytes",
+			opts:     kapi.PodLogOptions{LimitBytes: int64Ptr(10)},
+			expected: "Cloning \"h",
+		},
+		{
+			name:     "tail and limit bytes",
+			opts:     kapi.PodLogOptions{TailLines: int64Ptr(1), LimitBytes: int64Ptr(4)},
+			expected: "Push",
+		},
+	}
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		if err := Filter(strings.NewReader(log), out, &test.opts, now); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if out.String() != test.expected {
+			t.Errorf("%s: expected\n%q\ngot\n%q", test.name, test.expected, out.String())
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package logarchive
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+
+	"github.com/openshift/github.com/aws/aws-sdk-go/aws"
+	"github.com/openshift/github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/openshift/github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/openshift/github.com/aws/aws-sdk-go/aws/session"
+	"github.com/openshift/github.com/aws/aws-sdk-go/service/s3"
+)
+
+func init() {
+	RegisterObjectStore("s3", newS3ObjectStore)
+}
+
+// s3ObjectStore stores build logs in an Amazon S3 bucket, or a bucket of a
+// service implementing the S3 API. It accepts the parameters:
+//
+//	bucket:          the name of the bucket, required
+//	region:          the region of the bucket, required
+//	regionendpoint:  the endpoint of an S3 compatible service
+//	accesskey:       the access key, taken from the environment or the
+//	                 instance profile if not set
+//	secretkey:       the secret key belonging to accesskey
+//	prefix:          a prefix prepended to the keys of the logs
+//	secure:          "false" to connect over plain HTTP
+type s3ObjectStore struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func newS3ObjectStore(parameters map[string]string) (ObjectStore, error) {
+	for _, required := range []string{"bucket", "region"} {
+		if len(parameters[required]) == 0 {
+			return nil, fmt.Errorf("the s3 build log object store requires the %q parameter", required)
+		}
+	}
+	accessKey, secretKey := parameters["accesskey"], parameters["secretkey"]
+	if (len(accessKey) == 0) != (len(secretKey) == 0) {
+		return nil, fmt.Errorf("the s3 build log object store requires both or neither of the \"accesskey\" and \"secretkey\" parameters")
+	}
+	secure := true
+	if value, ok := parameters["secure"]; ok {
+		var err error
+		if secure, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("invalid \"secure\" parameter %q: %v", value, err)
+		}
+	}
+
+	config := aws.NewConfig().WithRegion(parameters["region"]).WithDisableSSL(!secure)
+	if len(accessKey) > 0 {
+		config = config.WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, ""))
+	}
+	if endpoint := parameters["regionendpoint"]; len(endpoint) > 0 {
+		config = config.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+
+	return &s3ObjectStore{
+		client: s3.New(session.New(config)),
+		bucket: parameters["bucket"],
+		prefix: parameters["prefix"],
+	}, nil
+}
+
+func (s *s3ObjectStore) key(key string) *string {
+	return aws.String(path.Join(s.prefix, key))
+}
+
+func (s *s3ObjectStore) PutObject(key string, content io.Reader) error {
+	// the upload needs to know the size of the content and may retry it, so
+	// the log is spooled to a temporary file first
+	f, err := ioutil.TempFile("", "buildlog")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err := io.Copy(f, content); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		return err
+	}
+
+	_, err = s.client.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         s.key(key),
+		Body:        f,
+		ContentType: aws.String("text/plain"),
+	})
+	return err
+}
+
+func (s *s3ObjectStore) GetObject(key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    s.key(key),
+	})
+	if err != nil {
+		if s3Err, ok := err.(awserr.Error); ok && s3Err.Code() == "NoSuchKey" {
+			return nil, ErrNotArchived
+		}
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *s3ObjectStore) DeleteObject(key string) error {
+	_, err := s.client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    s.key(key),
+	})
+	return err
+}
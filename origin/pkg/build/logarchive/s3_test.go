@@ -0,0 +1,45 @@
+package logarchive
+
+import "testing"
+
+func TestNewS3ObjectStore(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		parameters map[string]string
+		expectErr  bool
+	}{
+		{
+			name:       "bucket and region",
+			parameters: map[string]string{"bucket": "logs", "region": "us-east-1"},
+		},
+		{
+			name:       "compatible service with credentials",
+			parameters: map[string]string{"bucket": "logs", "region": "us-east-1", "regionendpoint": "http://minio:9000", "accesskey": "key", "secretkey": "secret", "secure": "false"},
+		},
+		{
+			name:       "missing bucket",
+			parameters: map[string]string{"region": "us-east-1"},
+			expectErr:  true,
+		},
+		{
+			name:       "missing region",
+			parameters: map[string]string{"bucket": "logs"},
+			expectErr:  true,
+		},
+		{
+			name:       "access key without secret key",
+			parameters: map[string]string{"bucket": "logs", "region": "us-east-1", "accesskey": "key"},
+			expectErr:  true,
+		},
+		{
+			name:       "invalid secure",
+			parameters: map[string]string{"bucket": "logs", "region": "us-east-1", "secure": "maybe"},
+			expectErr:  true,
+		},
+	} {
+		_, err := newS3ObjectStore(test.parameters)
+		if test.expectErr != (err != nil) {
+			t.Errorf("%s: expected error %t, got %v", test.name, test.expectErr, err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Package metrics exports prometheus metrics about the builds completed in the
+// cluster.
+package metrics
+
+import (
+	"github.com/openshift/github.com/prometheus/client_golang/prometheus"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+var (
+	stageDurations = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openshift_build_stage_duration_seconds",
+			Help: "Duration distribution in seconds of the stages of completed builds, broken out by build strategy and stage.",
+			// Use buckets ranging from 1 second to about 68 minutes.
+			Buckets: prometheus.ExponentialBuckets(1, 2.0, 13),
+		},
+		[]string{"strategy", "stage"},
+	)
+	imageSizes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "openshift_build_image_size_bytes",
+			Help: "Size distribution in bytes of the images pushed by completed builds, broken out by build strategy.",
+			// Use buckets ranging from 16 MiB to 2 GiB.
+			Buckets: prometheus.ExponentialBuckets(16*1024*1024, 2.0, 8),
+		},
+		[]string{"strategy"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(stageDurations)
+	prometheus.MustRegister(imageSizes)
+}
+
+// ObserveCompletedBuild records the durations of the stages of a completed
+// build and the size of the image it pushed.
+func ObserveCompletedBuild(build *buildapi.Build) {
+	strategy := buildapi.StrategyType(build.Spec.Strategy)
+	for _, stage := range build.Status.Stages {
+		stageDurations.WithLabelValues(strategy, string(stage.Name)).Observe(float64(stage.DurationMilliseconds) / 1000)
+	}
+	if build.Status.Output.To != nil && build.Status.Output.To.ImageSizeBytes > 0 {
+		imageSizes.WithLabelValues(strategy).Observe(float64(build.Status.Output.To.ImageSizeBytes))
+	}
+}
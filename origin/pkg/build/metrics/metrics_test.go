@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/openshift/github.com/prometheus/client_golang/prometheus"
+	dto "github.com/openshift/github.com/prometheus/client_model/go"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+func sampleCount(t *testing.T, histogram prometheus.Histogram) uint64 {
+	metric := &dto.Metric{}
+	if err := histogram.Write(metric); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestObserveCompletedBuild(t *testing.T) {
+	build := &buildapi.Build{
+		Spec: buildapi.BuildSpec{
+			CommonSpec: buildapi.CommonSpec{
+				Strategy: buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}},
+			},
+		},
+		Status: buildapi.BuildStatus{
+			Stages: []buildapi.StageInfo{
+				{Name: buildapi.StageFetchInputs, DurationMilliseconds: 1500},
+				{Name: buildapi.StageBuild, DurationMilliseconds: 60000},
+			},
+			Output: buildapi.BuildStatusOutput{
+				To: &buildapi.BuildStatusOutputTo{ImageSizeBytes: 200 * 1024 * 1024},
+			},
+		},
+	}
+	ObserveCompletedBuild(build)
+
+	for _, stage := range []buildapi.StageName{buildapi.StageFetchInputs, buildapi.StageBuild} {
+		if count := sampleCount(t, stageDurations.WithLabelValues("Docker", string(stage))); count != 1 {
+			t.Errorf("expected one sample for stage %s, got %d", stage, count)
+		}
+	}
+	if count := sampleCount(t, stageDurations.WithLabelValues("Docker", string(buildapi.StagePushImage))); count != 0 {
+		t.Errorf("expected no sample for a stage the build did not go through, got %d", count)
+	}
+	if count := sampleCount(t, imageSizes.WithLabelValues("Docker")); count != 1 {
+		t.Errorf("expected one image size sample, got %d", count)
+	}
+}
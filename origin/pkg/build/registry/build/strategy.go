@@ -106,12 +106,16 @@ func (detailsStrategy) PrepareForUpdate(ctx kapi.Context, obj, old runtime.Objec
 	message := newBuild.Status.Message
 	reason := newBuild.Status.Reason
 	outputTo := newBuild.Status.Output.To
+	additionalOutputs := newBuild.Status.Output.AdditionalOutputs
+	stages := newBuild.Status.Stages
 	*newBuild = *oldBuild
 	newBuild.Status.Phase = phase
 	newBuild.Spec.Revision = revision
 	newBuild.Status.Reason = reason
 	newBuild.Status.Message = message
 	newBuild.Status.Output.To = outputTo
+	newBuild.Status.Output.AdditionalOutputs = additionalOutputs
+	newBuild.Status.Stages = stages
 }
 
 // Validates that an update is valid by ensuring that no Revision exists and that it's not getting updated to blank
@@ -3,22 +3,33 @@ package buildconfig
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/golang/glog"
+
 	kapi "github.com/openshift/kubernetes/pkg/api"
 	"github.com/openshift/kubernetes/pkg/api/errors"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
 	"github.com/openshift/origin/pkg/build/webhook"
 	"github.com/openshift/origin/pkg/util/rest"
 )
 
+// BuildListerUpdater lists and updates builds.
+type BuildListerUpdater interface {
+	client.BuildLister
+	client.BuildUpdater
+}
+
 // NewWebHookREST returns the webhook handler wrapped in a rest.WebHook object.
-func NewWebHookREST(registry Registry, instantiator client.BuildConfigInstantiator, plugins map[string]webhook.Plugin) *rest.WebHook {
+func NewWebHookREST(registry Registry, instantiator client.BuildConfigInstantiator, builds BuildListerUpdater, plugins map[string]webhook.Plugin) *rest.WebHook {
 	hook := &WebHook{
 		registry:     registry,
 		instantiator: instantiator,
+		builds:       builds,
 		plugins:      plugins,
 	}
 	return rest.NewWebHook(hook, false)
@@ -27,6 +38,7 @@ func NewWebHookREST(registry Registry, instantiator client.BuildConfigInstantiat
 type WebHook struct {
 	registry     Registry
 	instantiator client.BuildConfigInstantiator
+	builds       BuildListerUpdater
 	plugins      map[string]webhook.Plugin
 }
 
@@ -50,7 +62,24 @@ func (w *WebHook) ServeHTTP(writer http.ResponseWriter, req *http.Request, ctx k
 		return errors.NewUnauthorized(fmt.Sprintf("the webhook %q for %q did not accept your secret", hookType, name))
 	}
 
-	revision, envvars, proceed, err := plugin.Extract(config, secret, "", req)
+	var (
+		revision *buildapi.SourceRevision
+		envvars  []kapi.EnvVar
+		pr       *webhook.PullRequest
+		proceed  bool
+	)
+	if prPlugin, ok := plugin.(webhook.PullRequestPlugin); ok {
+		revision, envvars, pr, proceed, err = prPlugin.ExtractPullRequest(config, secret, "", req)
+	} else {
+		revision, envvars, proceed, err = plugin.Extract(config, secret, "", req)
+	}
+	if pr != nil {
+		// builds of earlier commits of the pull request are superseded by a new commit, and are no
+		// longer needed once it is closed
+		if cancelErr := w.cancelPullRequestBuilds(config, pr); cancelErr != nil {
+			return errors.NewInternalError(fmt.Errorf("could not cancel the builds of pull request %d: %v", pr.Number, cancelErr))
+		}
+	}
 	if !proceed {
 		switch err {
 		case webhook.ErrSecretMismatch, webhook.ErrHookNotEnabled:
@@ -66,6 +95,13 @@ func (w *WebHook) ServeHTTP(writer http.ResponseWriter, req *http.Request, ctx k
 	warning := err
 
 	buildTriggerCauses := generateBuildTriggerInfo(revision, hookType, secret)
+	if pr != nil {
+		for i := range buildTriggerCauses {
+			if buildTriggerCauses[i].GitHubWebHook != nil {
+				buildTriggerCauses[i].GitHubWebHook.PullRequest = &buildapi.PullRequestCause{Number: pr.Number, Ref: pr.Ref}
+			}
+		}
+	}
 	request := &buildapi.BuildRequest{
 		TriggeredBy: buildTriggerCauses,
 		ObjectMeta:  kapi.ObjectMeta{Name: name},
@@ -78,6 +114,27 @@ func (w *WebHook) ServeHTTP(writer http.ResponseWriter, req *http.Request, ctx k
 	return warning
 }
 
+// cancelPullRequestBuilds cancels the builds of the build config for a pull request which have not
+// completed yet.
+func (w *WebHook) cancelPullRequestBuilds(config *buildapi.BuildConfig, pr *webhook.PullRequest) error {
+	number := strconv.FormatInt(pr.Number, 10)
+	builds, err := buildutil.BuildConfigBuilds(w.builds, config.Namespace, config.Name, func(b buildapi.Build) bool {
+		return b.Labels[buildapi.BuildPullRequestLabel] == number && !buildutil.IsBuildComplete(&b) && !b.Status.Cancelled
+	})
+	if err != nil {
+		return err
+	}
+	for i := range builds.Items {
+		build := &builds.Items[i]
+		glog.V(4).Infof("Cancelling build %s/%s of pull request %d", build.Namespace, build.Name, pr.Number)
+		build.Status.Cancelled = true
+		if err := w.builds.Update(build.Namespace, build); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func generateBuildTriggerInfo(revision *buildapi.SourceRevision, hookType, secret string) (buildTriggerCauses []buildapi.BuildTriggerCause) {
 	hiddenSecret := fmt.Sprintf("%s***", secret[:(len(secret)/2)])
 	switch {
@@ -47,7 +47,7 @@ func (p *plugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *ht
 func newStorage() (*rest.WebHook, *buildConfigInstantiator, *test.BuildConfigRegistry) {
 	mockRegistry := &test.BuildConfigRegistry{}
 	bci := &buildConfigInstantiator{}
-	hook := NewWebHookREST(mockRegistry, bci, map[string]webhook.Plugin{
+	hook := NewWebHookREST(mockRegistry, bci, &fakeBuildClient{}, map[string]webhook.Plugin{
 		"ok": &plugin{Proceed: true},
 		"okenv": &plugin{
 			Env: []kapi.EnvVar{
@@ -244,6 +244,36 @@ func (*errPlugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 	return nil, []kapi.EnvVar{}, false, errors.New("Plugin error!")
 }
 
+type prPlugin struct {
+	pr      *webhook.PullRequest
+	proceed bool
+}
+
+func (p *prPlugin) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (*api.SourceRevision, []kapi.EnvVar, bool, error) {
+	return nil, nil, false, errors.New("Extract should not be called for pull request plugins")
+}
+
+func (p *prPlugin) ExtractPullRequest(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (*api.SourceRevision, []kapi.EnvVar, *webhook.PullRequest, bool, error) {
+	return &api.SourceRevision{Git: &api.GitSourceRevision{Commit: "abc123"}}, nil, p.pr, p.proceed, nil
+}
+
+type fakeBuildClient struct {
+	builds  []api.Build
+	updated []string
+}
+
+func (c *fakeBuildClient) List(namespace string, opts kapi.ListOptions) (*api.BuildList, error) {
+	return &api.BuildList{Items: c.builds}, nil
+}
+
+func (c *fakeBuildClient) Update(namespace string, build *api.Build) error {
+	if !build.Status.Cancelled {
+		return errors.New("expected the build to be cancelled")
+	}
+	c.updated = append(c.updated, build.Name)
+	return nil
+}
+
 var testBuildConfig = &api.BuildConfig{
 	ObjectMeta: kapi.ObjectMeta{Name: "build100"},
 	Spec: api.BuildConfigSpec{
@@ -277,7 +307,7 @@ var mockBuildStrategy = api.BuildStrategy{
 func TestParseUrlError(t *testing.T) {
 	bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
 	responder := &fakeResponder{}
-	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, map[string]webhook.Plugin{"github": github.New()}).
+	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, &fakeBuildClient{}, map[string]webhook.Plugin{"github": github.New()}).
 		Connect(kapi.NewDefaultContext(), "build100", &kapi.PodProxyOptions{Path: ""}, responder)
 	server := httptest.NewServer(handler)
 	defer server.Close()
@@ -295,7 +325,7 @@ func TestParseUrlError(t *testing.T) {
 func TestParseUrlOK(t *testing.T) {
 	bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
 	responder := &fakeResponder{}
-	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
+	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, &fakeBuildClient{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
 		Connect(kapi.NewDefaultContext(), "build100", &kapi.PodProxyOptions{Path: "secret101/pathplugin"}, responder)
 	server := httptest.NewServer(handler)
 	defer server.Close()
@@ -313,7 +343,7 @@ func TestParseUrlLong(t *testing.T) {
 	plugin := &pathPlugin{}
 	bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
 	responder := &fakeResponder{}
-	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, map[string]webhook.Plugin{"pathplugin": plugin}).
+	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, &fakeBuildClient{}, map[string]webhook.Plugin{"pathplugin": plugin}).
 		Connect(kapi.NewDefaultContext(), "build100", &kapi.PodProxyOptions{Path: "secret101/pathplugin/some/more/args"}, responder)
 	server := httptest.NewServer(handler)
 	defer server.Close()
@@ -331,7 +361,7 @@ func TestParseUrlLong(t *testing.T) {
 func TestInvokeWebhookMissingPlugin(t *testing.T) {
 	bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
 	responder := &fakeResponder{}
-	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
+	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, &fakeBuildClient{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
 		Connect(kapi.NewDefaultContext(), "build100", &kapi.PodProxyOptions{Path: "secret101/missingplugin"}, responder)
 	server := httptest.NewServer(handler)
 	defer server.Close()
@@ -349,7 +379,7 @@ func TestInvokeWebhookMissingPlugin(t *testing.T) {
 func TestInvokeWebhookErrorBuildConfigInstantiate(t *testing.T) {
 	bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
 	responder := &fakeResponder{}
-	handler, _ := NewWebHookREST(bcRegistry, &errorBuildConfigInstantiator{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
+	handler, _ := NewWebHookREST(bcRegistry, &errorBuildConfigInstantiator{}, &fakeBuildClient{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
 		Connect(kapi.NewDefaultContext(), "build100", &kapi.PodProxyOptions{Path: "secret101/pathplugin"}, responder)
 	server := httptest.NewServer(handler)
 	defer server.Close()
@@ -367,7 +397,7 @@ func TestInvokeWebhookErrorBuildConfigInstantiate(t *testing.T) {
 func TestInvokeWebhookErrorGetConfig(t *testing.T) {
 	bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
 	responder := &fakeResponder{}
-	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
+	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, &fakeBuildClient{}, map[string]webhook.Plugin{"pathplugin": &pathPlugin{}}).
 		Connect(kapi.NewDefaultContext(), "badbuild100", &kapi.PodProxyOptions{Path: "secret101/pathplugin"}, responder)
 	server := httptest.NewServer(handler)
 	defer server.Close()
@@ -387,7 +417,7 @@ func TestInvokeWebhookErrorGetConfig(t *testing.T) {
 func TestInvokeWebhookErrorCreateBuild(t *testing.T) {
 	bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
 	responder := &fakeResponder{}
-	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, map[string]webhook.Plugin{"errPlugin": &errPlugin{}}).
+	handler, _ := NewWebHookREST(bcRegistry, &okBuildConfigInstantiator{}, &fakeBuildClient{}, map[string]webhook.Plugin{"errPlugin": &errPlugin{}}).
 		Connect(kapi.NewDefaultContext(), "build100", &kapi.PodProxyOptions{Path: "secret101/errPlugin"}, responder)
 	server := httptest.NewServer(handler)
 	defer server.Close()
@@ -461,3 +491,75 @@ func TestGeneratedBuildTriggerInfoGitHubWebHook(t *testing.T) {
 		}
 	}
 }
+
+func TestInvokeWebhookPullRequest(t *testing.T) {
+	pullRequestBuild := func(name, number string, phase api.BuildPhase) api.Build {
+		return api.Build{
+			ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{api.BuildPullRequestLabel: number}},
+			Status:     api.BuildStatus{Phase: phase},
+		}
+	}
+	testCases := map[string]struct {
+		pr          *webhook.PullRequest
+		proceed     bool
+		cancelled   []string
+		instantiate bool
+	}{
+		"new commit": {
+			pr:          &webhook.PullRequest{Number: 4, Ref: "refs/pull/4/head"},
+			proceed:     true,
+			cancelled:   []string{"build100-1"},
+			instantiate: true,
+		},
+		"closed": {
+			pr:        &webhook.PullRequest{Number: 4, Ref: "refs/pull/4/head", Closed: true},
+			cancelled: []string{"build100-1"},
+		},
+		"push": {
+			proceed:     true,
+			instantiate: true,
+		},
+	}
+	for name, testCase := range testCases {
+		builds := &fakeBuildClient{builds: []api.Build{
+			pullRequestBuild("build100-1", "4", api.BuildPhaseRunning),
+			pullRequestBuild("build100-2", "4", api.BuildPhaseComplete),
+			pullRequestBuild("build100-3", "5", api.BuildPhasePending),
+		}}
+		instantiator := &buildConfigInstantiator{Build: &api.Build{}}
+		bcRegistry := &test.BuildConfigRegistry{BuildConfig: testBuildConfig}
+		responder := &fakeResponder{}
+		handler, _ := NewWebHookREST(bcRegistry, instantiator, builds, map[string]webhook.Plugin{"github": &prPlugin{pr: testCase.pr, proceed: testCase.proceed}}).
+			Connect(kapi.NewDefaultContext(), "build100", &kapi.PodProxyOptions{Path: "secret101/github"}, responder)
+		server := httptest.NewServer(handler)
+
+		_, err := http.Post(server.URL, "application/json", nil)
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if responder.err != nil {
+			t.Errorf("%s: unexpected error: %v", name, responder.err)
+		}
+		if !reflect.DeepEqual(builds.updated, testCase.cancelled) {
+			t.Errorf("%s: expected builds %v to be cancelled, got %v", name, testCase.cancelled, builds.updated)
+		}
+		if testCase.instantiate != (instantiator.Request != nil) {
+			t.Errorf("%s: expected instantiate %t, got %#v", name, testCase.instantiate, instantiator.Request)
+			continue
+		}
+		if instantiator.Request == nil {
+			continue
+		}
+		cause := instantiator.Request.TriggeredBy[0].GitHubWebHook
+		if testCase.pr == nil {
+			if cause != nil && cause.PullRequest != nil {
+				t.Errorf("%s: unexpected pull request cause %#v", name, cause.PullRequest)
+			}
+			continue
+		}
+		if cause == nil || cause.PullRequest == nil || cause.PullRequest.Number != testCase.pr.Number || cause.PullRequest.Ref != testCase.pr.Ref {
+			t.Errorf("%s: expected the pull request to be recorded in the cause, got %#v", name, cause)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package buildlog
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/golang/glog"
@@ -9,6 +10,7 @@ import (
 	kapi "github.com/openshift/kubernetes/pkg/api"
 	"github.com/openshift/kubernetes/pkg/api/errors"
 	"github.com/openshift/kubernetes/pkg/api/rest"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
 	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
 	kubeletclient "github.com/openshift/kubernetes/pkg/kubelet/client"
 	"github.com/openshift/kubernetes/pkg/registry/core/pod"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/api/validation"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 )
@@ -28,6 +31,8 @@ type REST struct {
 	PodGetter      pod.ResourceGetter
 	ConnectionInfo kubeletclient.ConnectionInfoGetter
 	Timeout        time.Duration
+	// Archive holds the logs of completed builds whose pods are gone, if set.
+	Archive logarchive.Archive
 }
 
 type podGetter struct {
@@ -47,13 +52,15 @@ const defaultTimeout time.Duration = 10 * time.Second
 // NewREST creates a new REST for BuildLog
 // Takes build registry and pod client to get necessary attributes to assemble
 // URL to which the request shall be redirected in order to get build logs.
-func NewREST(getter rest.Getter, watcher rest.Watcher, pn kcoreclient.PodsGetter, connectionInfo kubeletclient.ConnectionInfoGetter) *REST {
+// Logs of builds whose pods no longer exist are read from archive, if set.
+func NewREST(getter rest.Getter, watcher rest.Watcher, pn kcoreclient.PodsGetter, connectionInfo kubeletclient.ConnectionInfoGetter, archive logarchive.Archive) *REST {
 	return &REST{
 		Getter:         getter,
 		Watcher:        watcher,
 		PodGetter:      &podGetter{pn},
 		ConnectionInfo: connectionInfo,
 		Timeout:        defaultTimeout,
+		Archive:        archive,
 	}
 }
 
@@ -121,6 +128,9 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	location, transport, err := pod.LogLocation(r.PodGetter, r.ConnectionInfo, ctx, buildPodName, logOpts)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			if streamer, ok, err := r.archivedLog(build, logOpts); ok || err != nil {
+				return streamer, err
+			}
 			return nil, errors.NewNotFound(kapi.Resource("pod"), buildPodName)
 		}
 		return nil, errors.NewBadRequest(err.Error())
@@ -134,6 +144,46 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	}, nil
 }
 
+// archivedLog returns a streamer for the archived log of a build whose pod no
+// longer exists. False is returned if the log was not archived.
+func (r *REST) archivedLog(build *api.Build, logOpts *kapi.PodLogOptions) (runtime.Object, bool, error) {
+	if r.Archive == nil {
+		return nil, false, nil
+	}
+	log, err := r.Archive.Get(build.Namespace, build.Name)
+	if err == logarchive.ErrNotArchived {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.NewInternalError(fmt.Errorf("unable to read the archived log of build %s: %v", build.Name, err))
+	}
+	glog.V(4).Infof("Build %s/%s has no pod, serving its archived log", build.Namespace, build.Name)
+	return &archivedLogStreamer{log: log, opts: logOpts}, true, nil
+}
+
+// archivedLogStreamer streams an archived build log, applying the same options
+// the log of the build pod would have been streamed with.
+type archivedLogStreamer struct {
+	log  io.ReadCloser
+	opts *kapi.PodLogOptions
+}
+
+var _ rest.ResourceStreamer = &archivedLogStreamer{}
+
+func (s *archivedLogStreamer) GetObjectKind() unversioned.ObjectKind {
+	return unversioned.EmptyObjectKind
+}
+
+// InputStream returns a stream with the filtered contents of the archived log.
+func (s *archivedLogStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	reader, writer := io.Pipe()
+	go func() {
+		defer s.log.Close()
+		writer.CloseWithError(logarchive.Filter(s.log, writer, s.opts, time.Now()))
+	}()
+	return reader, false, "text/plain", nil
+}
+
 // NewGetOptions returns a new options object for build logs
 func (r *REST) NewGetOptions() (runtime.Object, bool, string) {
 	return &api.BuildLogOptions{}, false, ""
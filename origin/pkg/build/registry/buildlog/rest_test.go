@@ -2,13 +2,16 @@ package buildlog
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/errors"
 	"github.com/openshift/kubernetes/pkg/api/rest"
 	kubeletclient "github.com/openshift/kubernetes/pkg/kubelet/client"
 	genericrest "github.com/openshift/kubernetes/pkg/registry/generic/rest"
@@ -17,6 +20,7 @@ import (
 	"github.com/openshift/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry/test"
 )
 
@@ -330,3 +334,101 @@ func TestPreviousBuildLogs(t *testing.T) {
 		t.Fatalf("expected location:\n\t%s\ngot location:\n\t%s\n", exp, got)
 	}
 }
+
+type notFoundPodGetter struct{}
+
+func (p *notFoundPodGetter) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	return nil, errors.NewNotFound(kapi.Resource("pods"), name)
+}
+
+func TestArchivedBuildLogs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "buildlogs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	archive := logarchive.NewDirectoryArchive(dir)
+	log := "2016-10-06T10:00:00.000000000Z first line\n2016-10-06T10:01:00.000000000Z second line\n"
+	if err := archive.Put(kapi.NamespaceDefault, "archived", strings.NewReader(log)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := kapi.NewDefaultContext()
+	tailLines := int64(1)
+	tests := []struct {
+		name     string
+		build    string
+		archive  logarchive.Archive
+		opts     api.BuildLogOptions
+		expected string
+		notFound bool
+	}{
+		{
+			name:     "archived",
+			build:    "archived",
+			archive:  archive,
+			expected: "first line\nsecond line\n",
+		},
+		{
+			name:     "archived with options",
+			build:    "archived",
+			archive:  archive,
+			opts:     api.BuildLogOptions{TailLines: &tailLines, Timestamps: true},
+			expected: "2016-10-06T10:01:00.000000000Z second line\n",
+		},
+		{
+			name:     "not archived",
+			build:    "other",
+			archive:  archive,
+			notFound: true,
+		},
+		{
+			name:     "no archive",
+			build:    "archived",
+			notFound: true,
+		},
+	}
+	for _, tc := range tests {
+		build := mockBuild(api.BuildPhaseComplete, tc.build, 1)
+		build.Namespace = kapi.NamespaceDefault
+		storage := &REST{
+			Getter:         &test.BuildStorage{Build: build},
+			PodGetter:      &notFoundPodGetter{},
+			ConnectionInfo: &fakeConnectionInfoGetter{},
+			Timeout:        defaultTimeout,
+			Archive:        tc.archive,
+		}
+		obj, err := storage.Get(ctx, tc.build, &tc.opts)
+		if tc.notFound {
+			if !errors.IsNotFound(err) {
+				t.Errorf("%s: expected a not found error, got %v", tc.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		streamer, ok := obj.(rest.ResourceStreamer)
+		if !ok {
+			t.Errorf("%s: unexpected object: %#v", tc.name, obj)
+			continue
+		}
+		stream, _, contentType, err := streamer.InputStream("", "")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		data, err := ioutil.ReadAll(stream)
+		stream.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+		}
+		if contentType != "text/plain" {
+			t.Errorf("%s: unexpected content type %q", tc.name, contentType)
+		}
+		if string(data) != tc.expected {
+			t.Errorf("%s: expected log %q, got %q", tc.name, tc.expected, string(data))
+		}
+	}
+}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -18,6 +19,17 @@ const (
 	NoBuildLogsMessage = "No logs are available."
 )
 
+// Variables that may be referenced from the names of the additional outputs
+// of a build.
+const (
+	// OutputVariableSourceCommit expands to the commit hash of the built source.
+	OutputVariableSourceCommit = "SOURCE_COMMIT"
+	// OutputVariableSourceRef expands to the Git ref the source was built from.
+	OutputVariableSourceRef = "SOURCE_REF"
+	// OutputVariableBuildNumber expands to the sequential number of the build.
+	OutputVariableBuildNumber = "BUILD_NUMBER"
+)
+
 // GetBuildName returns name of the build pod.
 func GetBuildName(pod *kapi.Pod) string {
 	if pod == nil {
@@ -60,6 +72,58 @@ func BuildNumber(build *buildapi.Build) (int64, error) {
 	return 0, fmt.Errorf("build %s/%s does not have %s annotation", build.Namespace, build.Name, buildapi.BuildNumberAnnotation)
 }
 
+// OutputVariables returns the values of the variables that may be referenced
+// from the names of the additional outputs of the build. Characters that are
+// not valid in an image tag are replaced with dashes. A build of the default
+// branch has no ref, SOURCE_REF is the commit then.
+func OutputVariables(build *buildapi.Build) map[string]string {
+	vars := map[string]string{
+		OutputVariableSourceCommit: "",
+		OutputVariableSourceRef:    "",
+		OutputVariableBuildNumber:  build.Annotations[buildapi.BuildNumberAnnotation],
+	}
+	if build.Spec.Revision != nil && build.Spec.Revision.Git != nil {
+		vars[OutputVariableSourceCommit] = build.Spec.Revision.Git.Commit
+	}
+	if build.Spec.Source.Git != nil {
+		vars[OutputVariableSourceRef] = strings.Map(func(r rune) rune {
+			switch {
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+				return r
+			}
+			return '-'
+		}, build.Spec.Source.Git.Ref)
+	}
+	if len(vars[OutputVariableSourceRef]) == 0 {
+		vars[OutputVariableSourceRef] = vars[OutputVariableSourceCommit]
+	}
+	return vars
+}
+
+// ExpandOutputName replaces the ${VAR} references in the name of an additional
+// build output with the values from vars. It returns an error if name
+// references a variable that is not in vars or that has no value.
+func ExpandOutputName(name string, vars map[string]string) (string, error) {
+	unknown, empty := []string{}, []string{}
+	expanded := os.Expand(name, func(key string) string {
+		value, ok := vars[key]
+		switch {
+		case !ok:
+			unknown = append(unknown, key)
+		case len(value) == 0:
+			empty = append(empty, key)
+		}
+		return value
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("unknown variables: %s", strings.Join(unknown, ", "))
+	}
+	if len(empty) > 0 {
+		return "", fmt.Errorf("variables without a value: %s", strings.Join(empty, ", "))
+	}
+	return expanded, nil
+}
+
 // BuildRunPolicy returns the scheduling policy for the build based on the
 // "queued" label.
 func BuildRunPolicy(build *buildapi.Build) buildapi.BuildRunPolicy {
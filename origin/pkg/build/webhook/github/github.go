@@ -36,40 +36,115 @@ type pushEvent struct {
 	HeadCommit commit `json:"head_commit,omitempty"`
 }
 
+type user struct {
+	Login string `json:"login,omitempty"`
+}
+
+type repository struct {
+	FullName string `json:"full_name,omitempty"`
+}
+
+type pullRequestEvent struct {
+	Action      string `json:"action,omitempty"`
+	Number      int64  `json:"number,omitempty"`
+	PullRequest struct {
+		Title string `json:"title,omitempty"`
+		User  user   `json:"user,omitempty"`
+		Head  struct {
+			SHA  string      `json:"sha,omitempty"`
+			Repo *repository `json:"repo,omitempty"`
+		} `json:"head,omitempty"`
+		Base struct {
+			Ref  string      `json:"ref,omitempty"`
+			Repo *repository `json:"repo,omitempty"`
+		} `json:"base,omitempty"`
+		// Gogs sends the repositories of the pull request outside of head and base
+		HeadRepo *repository `json:"head_repo,omitempty"`
+		BaseRepo *repository `json:"base_repo,omitempty"`
+	} `json:"pull_request,omitempty"`
+}
+
+type mergeRequestEvent struct {
+	ObjectAttributes struct {
+		IID             int64  `json:"iid,omitempty"`
+		TargetBranch    string `json:"target_branch,omitempty"`
+		SourceProjectID int64  `json:"source_project_id,omitempty"`
+		TargetProjectID int64  `json:"target_project_id,omitempty"`
+		Action          string `json:"action,omitempty"`
+		LastCommit      struct {
+			ID      string `json:"id,omitempty"`
+			Message string `json:"message,omitempty"`
+			Author  struct {
+				Name  string `json:"name,omitempty"`
+				Email string `json:"email,omitempty"`
+			} `json:"author,omitempty"`
+		} `json:"last_commit,omitempty"`
+	} `json:"object_attributes,omitempty"`
+}
+
 // Extract services webhooks from github.com
 func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, envvars []kapi.EnvVar, proceed bool, err error) {
+	revision, envvars, _, proceed, err = p.ExtractPullRequest(buildCfg, secret, path, req)
+	return revision, envvars, proceed, err
+}
+
+// ExtractPullRequest services webhooks from github.com, including pull request events and
+// GitLab merge request events.
+func (p *WebHook) ExtractPullRequest(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, envvars []kapi.EnvVar, pr *webhook.PullRequest, proceed bool, err error) {
 	triggers, err := webhook.FindTriggerPolicy(api.GitHubWebHookBuildTriggerType, buildCfg)
 	if err != nil {
-		return revision, envvars, proceed, err
+		return revision, envvars, pr, proceed, err
 	}
 	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
 
-	if _, err = webhook.ValidateWebHookSecret(triggers, secret); err != nil {
-		return revision, envvars, proceed, err
+	trigger, err := webhook.ValidateWebHookSecret(triggers, secret)
+	if err != nil {
+		return revision, envvars, pr, proceed, err
 	}
 
 	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
 	if err = verifyRequest(req); err != nil {
-		return revision, envvars, proceed, err
+		return revision, envvars, pr, proceed, err
 	}
 	method := getEvent(req.Header)
-	if method != "ping" && method != "push" && method != "Push Hook" {
-		return revision, envvars, proceed, errors.NewBadRequest(fmt.Sprintf("Unknown X-GitHub-Event, X-Gogs-Event or X-Gitlab-Event %s", method))
+	switch method {
+	case "ping", "push", "Push Hook":
+	case "pull_request", "Merge Request Hook":
+		if trigger.PullRequests == nil {
+			glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Pull request builds are not enabled", buildCfg.Namespace, buildCfg.Name)
+			return revision, envvars, pr, proceed, webhook.NewWarning("pull request builds are not enabled for this webhook")
+		}
+	default:
+		return revision, envvars, pr, proceed, errors.NewBadRequest(fmt.Sprintf("Unknown X-GitHub-Event, X-Gogs-Event or X-Gitlab-Event %s", method))
 	}
 	if method == "ping" {
-		return revision, envvars, proceed, err
+		return revision, envvars, pr, proceed, err
 	}
 	body, err := ioutil.ReadAll(req.Body)
 	if err != nil {
-		return revision, envvars, proceed, errors.NewBadRequest(err.Error())
+		return revision, envvars, pr, proceed, errors.NewBadRequest(err.Error())
+	}
+	switch method {
+	case "pull_request", "Merge Request Hook":
+		if method == "pull_request" {
+			revision, pr, proceed, err = extractPullRequest(buildCfg, body)
+		} else {
+			revision, pr, proceed, err = extractMergeRequest(buildCfg, body)
+		}
+		// the code of a pull request from a fork would be built with the secrets of the build config
+		if pr != nil && pr.Fork && !trigger.PullRequests.AllowForks {
+			glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Pull request %d was opened from a fork", buildCfg.Namespace, buildCfg.Name, pr.Number)
+			return nil, envvars, nil, false, webhook.NewWarning("pull requests from forks are not built for this webhook")
+		}
+		return revision, envvars, pr, proceed, err
 	}
 	var event pushEvent
 	if err = json.Unmarshal(body, &event); err != nil {
-		return revision, envvars, proceed, errors.NewBadRequest(err.Error())
+		return revision, envvars, pr, proceed, errors.NewBadRequest(err.Error())
 	}
 	if !webhook.GitRefMatches(event.Ref, webhook.DefaultConfigRef, &buildCfg.Spec.Source) {
 		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg, event)
-		return revision, envvars, proceed, err
+		return revision, envvars, pr, proceed, err
 	}
 
 	revision = &api.SourceRevision{
@@ -80,7 +155,93 @@ func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *h
 			Message:   event.HeadCommit.Message,
 		},
 	}
-	return revision, envvars, true, err
+	return revision, envvars, pr, true, err
+}
+
+// extractPullRequest handles a GitHub (or Gogs) pull request event. Pull requests are built from
+// the pull request reference, which also holds the commits of pull requests from forks.
+func extractPullRequest(buildCfg *api.BuildConfig, body []byte) (*api.SourceRevision, *webhook.PullRequest, bool, error) {
+	var event pullRequestEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, nil, false, errors.NewBadRequest(err.Error())
+	}
+	if !webhook.GitRefMatches(event.PullRequest.Base.Ref, webhook.DefaultConfigRef, &buildCfg.Spec.Source) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Pull request %d targets '%s' which does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Number, event.PullRequest.Base.Ref)
+		return nil, nil, false, nil
+	}
+	pr := &webhook.PullRequest{
+		Number: event.Number,
+		Ref:    fmt.Sprintf("refs/pull/%d/head", event.Number),
+		Fork:   isFork(event),
+	}
+	switch event.Action {
+	case "opened", "reopened", "synchronize", "synchronized":
+	case "closed":
+		pr.Closed = true
+		return nil, pr, false, nil
+	default:
+		glog.V(4).Infof("Skipping build for BuildConfig %s/%s.  Ignoring action '%s' of pull request %d", buildCfg.Namespace, buildCfg.Name, event.Action, event.Number)
+		return nil, nil, false, nil
+	}
+	revision := &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit:  event.PullRequest.Head.SHA,
+			Author:  api.SourceControlUser{Name: event.PullRequest.User.Login},
+			Message: event.PullRequest.Title,
+		},
+	}
+	return revision, pr, true, nil
+}
+
+// isFork returns true unless the pull request was opened from a branch of the repository it targets.
+// The head repository is missing when the fork was deleted.
+func isFork(event pullRequestEvent) bool {
+	head, base := event.PullRequest.Head.Repo, event.PullRequest.Base.Repo
+	if head == nil && base == nil {
+		head, base = event.PullRequest.HeadRepo, event.PullRequest.BaseRepo
+	}
+	if head == nil || base == nil || len(head.FullName) == 0 {
+		return true
+	}
+	return head.FullName != base.FullName
+}
+
+// extractMergeRequest handles a GitLab merge request event.
+func extractMergeRequest(buildCfg *api.BuildConfig, body []byte) (*api.SourceRevision, *webhook.PullRequest, bool, error) {
+	var event mergeRequestEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, nil, false, errors.NewBadRequest(err.Error())
+	}
+	attrs := event.ObjectAttributes
+	if !webhook.GitRefMatches(attrs.TargetBranch, webhook.DefaultConfigRef, &buildCfg.Spec.Source) {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Merge request %d targets '%s' which does not match configuration", buildCfg.Namespace, buildCfg.Name, attrs.IID, attrs.TargetBranch)
+		return nil, nil, false, nil
+	}
+	pr := &webhook.PullRequest{
+		Number: attrs.IID,
+		Ref:    fmt.Sprintf("refs/merge-requests/%d/head", attrs.IID),
+		Fork:   attrs.SourceProjectID != attrs.TargetProjectID,
+	}
+	switch attrs.Action {
+	case "open", "reopen", "update":
+	case "close", "merge":
+		pr.Closed = true
+		return nil, pr, false, nil
+	default:
+		glog.V(4).Infof("Skipping build for BuildConfig %s/%s.  Ignoring action '%s' of merge request %d", buildCfg.Namespace, buildCfg.Name, attrs.Action, attrs.IID)
+		return nil, nil, false, nil
+	}
+	revision := &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit: attrs.LastCommit.ID,
+			Author: api.SourceControlUser{
+				Name:  attrs.LastCommit.Author.Name,
+				Email: attrs.LastCommit.Author.Email,
+			},
+			Message: attrs.LastCommit.Message,
+		},
+	}
+	return revision, pr, true, nil
 }
 
 func verifyRequest(req *http.Request) error {
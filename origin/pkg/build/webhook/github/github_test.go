@@ -2,6 +2,7 @@ package github
 
 import (
 	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -403,3 +404,173 @@ func TestExtractSkipsBuildForUnmatchedBranches(t *testing.T) {
 		t.Errorf("Expecting to not continue from this event because the branch is not for this buildConfig '%s'", context.buildCfg.Spec.Source.Git.Ref)
 	}
 }
+
+func enablePullRequests(buildCfg *api.BuildConfig) {
+	for _, trigger := range buildCfg.Spec.Triggers {
+		trigger.GitHubWebHook.PullRequests = &api.PullRequestBuildPolicy{Output: api.PullRequestOutputNone}
+	}
+}
+
+func TestExtractPullRequestDisabled(t *testing.T) {
+	context := setup(t, "pullrequestevent.json", "pull_request", "")
+
+	revision, _, pr, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err == nil || !strings.Contains(err.Error(), "not enabled") {
+		t.Errorf("Expected a warning that pull request builds are not enabled, got %v", err)
+	}
+	if proceed || revision != nil || pr != nil {
+		t.Errorf("Expected no build for a pull request, got %#v %#v", revision, pr)
+	}
+}
+
+func TestExtractPullRequest(t *testing.T) {
+	context := setup(t, "pullrequestevent.json", "pull_request", "")
+	enablePullRequests(context.buildCfg)
+
+	revision, _, pr, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git.Commit != "9bdc3a26ff933b32f3e558636b58aea86a69f051" || revision.Git.Message != "Add license" {
+		t.Errorf("Expecting the revision to contain the head commit of the pull request, got %#v", revision)
+	}
+	if pr == nil || pr.Number != 42 || pr.Ref != "refs/pull/42/head" || pr.Closed {
+		t.Errorf("Unexpected pull request %#v", pr)
+	}
+}
+
+func TestExtractClosedPullRequest(t *testing.T) {
+	context := setup(t, "pullrequestevent-closed.json", "pull_request", "")
+	enablePullRequests(context.buildCfg)
+
+	revision, _, pr, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if proceed || revision != nil {
+		t.Errorf("Expected no build for a closed pull request, got %#v", revision)
+	}
+	if pr == nil || pr.Number != 42 || !pr.Closed {
+		t.Errorf("Expected the pull request to be closed, got %#v", pr)
+	}
+}
+
+func TestExtractPullRequestSkipsUnmatchedBranches(t *testing.T) {
+	context := setup(t, "pullrequestevent.json", "pull_request", "wrongref")
+	enablePullRequests(context.buildCfg)
+
+	_, _, pr, proceed, _ := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if proceed || pr != nil {
+		t.Errorf("Expecting to not continue from a pull request targeting another branch, got %#v", pr)
+	}
+}
+
+func TestExtractGitLabMergeRequest(t *testing.T) {
+	context := setup(t, "mergerequestevent.json", "Merge Request Hook", "")
+	enablePullRequests(context.buildCfg)
+
+	revision, _, pr, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed {
+		t.Errorf("The 'proceed' return value should equal 'true' %t", proceed)
+	}
+	if revision == nil || revision.Git.Commit != "9bdc3a26ff933b32f3e558636b58aea86a69f051" || revision.Git.Author.Email != "anonUser@example.com" {
+		t.Errorf("Expecting the revision to contain the last commit of the merge request, got %#v", revision)
+	}
+	if pr == nil || pr.Number != 7 || pr.Ref != "refs/merge-requests/7/head" {
+		t.Errorf("Unexpected merge request %#v", pr)
+	}
+}
+
+func TestExtractPullRequestFromFork(t *testing.T) {
+	context := setup(t, "pullrequestevent-fork.json", "pull_request", "")
+	enablePullRequests(context.buildCfg)
+
+	revision, _, pr, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err == nil || !strings.Contains(err.Error(), "forks") {
+		t.Errorf("Expected a warning that pull requests from forks are not built, got %v", err)
+	}
+	if proceed || revision != nil || pr != nil {
+		t.Errorf("Expected no build for a pull request from a fork, got %#v %#v", revision, pr)
+	}
+
+	context = setup(t, "pullrequestevent-fork.json", "pull_request", "")
+	for _, trigger := range context.buildCfg.Spec.Triggers {
+		trigger.GitHubWebHook.PullRequests = &api.PullRequestBuildPolicy{Output: api.PullRequestOutputNone, AllowForks: true}
+	}
+
+	revision, _, pr, proceed, err = context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err != nil {
+		t.Fatalf("Error while extracting build info: %s", err)
+	}
+	if !proceed || revision == nil {
+		t.Errorf("Expected a build for a pull request from a fork when forks are allowed")
+	}
+	if pr == nil || pr.Number != 42 || !pr.Fork {
+		t.Errorf("Expected the pull request to be from a fork, got %#v", pr)
+	}
+}
+
+func TestIsFork(t *testing.T) {
+	tests := []struct {
+		name  string
+		event string
+		fork  bool
+	}{
+		{
+			name:  "same repository",
+			event: `{"pull_request":{"head":{"repo":{"full_name":"org/repo"}},"base":{"repo":{"full_name":"org/repo"}}}}`,
+		},
+		{
+			name:  "fork",
+			event: `{"pull_request":{"head":{"repo":{"full_name":"user/repo"}},"base":{"repo":{"full_name":"org/repo"}}}}`,
+			fork:  true,
+		},
+		{
+			name:  "deleted fork",
+			event: `{"pull_request":{"head":{"repo":null},"base":{"repo":{"full_name":"org/repo"}}}}`,
+			fork:  true,
+		},
+		{
+			name:  "gogs",
+			event: `{"pull_request":{"head_repo":{"full_name":"org/repo"},"base_repo":{"full_name":"org/repo"}}}`,
+		},
+		{
+			name:  "gogs fork",
+			event: `{"pull_request":{"head_repo":{"full_name":"user/repo"},"base_repo":{"full_name":"org/repo"}}}`,
+			fork:  true,
+		},
+		{
+			name:  "unknown repositories",
+			event: `{"pull_request":{}}`,
+			fork:  true,
+		},
+	}
+	for _, test := range tests {
+		var event pullRequestEvent
+		if err := json.Unmarshal([]byte(test.event), &event); err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if fork := isFork(event); fork != test.fork {
+			t.Errorf("%s: expected fork to be %t, got %t", test.name, test.fork, fork)
+		}
+	}
+}
+
+func TestExtractGitLabMergeRequestFromFork(t *testing.T) {
+	context := setup(t, "mergerequestevent-fork.json", "Merge Request Hook", "")
+	enablePullRequests(context.buildCfg)
+
+	_, _, pr, proceed, err := context.plugin.ExtractPullRequest(context.buildCfg, "secret101", context.path, context.req)
+	if err == nil || !strings.Contains(err.Error(), "forks") {
+		t.Errorf("Expected a warning that merge requests from forks are not built, got %v", err)
+	}
+	if proceed || pr != nil {
+		t.Errorf("Expected no build for a merge request from a fork, got %#v", pr)
+	}
+}
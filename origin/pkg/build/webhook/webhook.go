@@ -35,6 +35,29 @@ type Plugin interface {
 	Extract(buildCfg *buildapi.BuildConfig, secret, path string, req *http.Request) (*buildapi.SourceRevision, []kapi.EnvVar, bool, error)
 }
 
+// PullRequest describes the pull request (or merge request) a webhook event was
+// sent for.
+type PullRequest struct {
+	// Number is the number of the pull request.
+	Number int64
+	// Ref is the Git reference of the head of the pull request.
+	Ref string
+	// Closed is set when the pull request was closed or merged.
+	Closed bool
+	// Fork is set when the pull request was opened from another repository
+	// than the one it targets.
+	Fork bool
+}
+
+// PullRequestPlugin is implemented by plugins able to build pull requests.
+type PullRequestPlugin interface {
+	Plugin
+	// ExtractPullRequest behaves like Extract and additionally returns the pull
+	// request the event was sent for, or nil for other events. Builds are not
+	// triggered for closed pull requests.
+	ExtractPullRequest(buildCfg *buildapi.BuildConfig, secret, path string, req *http.Request) (*buildapi.SourceRevision, []kapi.EnvVar, *PullRequest, bool, error)
+}
+
 // GitRefMatches determines if the ref from a webhook event matches a build
 // configuration
 func GitRefMatches(eventRef, configRef string, buildSource *buildapi.BuildSource) bool {
@@ -20,6 +20,7 @@ type ProjectInterface interface {
 	Get(name string) (*projectapi.Project, error)
 	List(opts kapi.ListOptions) (*projectapi.ProjectList, error)
 	Watch(opts kapi.ListOptions) (watch.Interface, error)
+	Health(name string) (*projectapi.ProjectHealth, error)
 }
 
 type projects struct {
@@ -40,6 +41,13 @@ func (c *projects) Get(name string) (result *projectapi.Project, err error) {
 	return
 }
 
+// Health returns the result of analyzing the objects of a project for problems
+func (c *projects) Health(name string) (result *projectapi.ProjectHealth, err error) {
+	result = &projectapi.ProjectHealth{}
+	err = c.r.Get().Resource("projects").Name(name).SubResource("health").Do().Into(result)
+	return
+}
+
 // List returns all projects matching the label selector
 func (c *projects) List(opts kapi.ListOptions) (result *projectapi.ProjectList, err error) {
 	result = &projectapi.ProjectList{}
@@ -71,6 +71,7 @@ func (c *FakeImageStreams) Watch(opts kapi.ListOptions) (watch.Interface, error)
 func (c *FakeImageStreams) UpdateStatus(inObj *imageapi.ImageStream) (result *imageapi.ImageStream, err error) {
 	action := core.CreateActionImpl{}
 	action.Verb = "update"
+	action.Namespace = c.Namespace
 	action.Resource = imageStreamsResource
 	action.Subresource = "status"
 	action.Object = inObj
@@ -26,6 +26,17 @@ func (c *FakeProjects) Get(name string) (*projectapi.Project, error) {
 	return obj.(*projectapi.Project), err
 }
 
+func (c *FakeProjects) Health(name string) (*projectapi.ProjectHealth, error) {
+	action := core.NewRootGetAction(projectsResource, name)
+	action.Subresource = "health"
+	obj, err := c.Fake.Invokes(action, &projectapi.ProjectHealth{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*projectapi.ProjectHealth), err
+}
+
 func (c *FakeProjects) List(opts kapi.ListOptions) (*projectapi.ProjectList, error) {
 	obj, err := c.Fake.Invokes(core.NewRootListAction(projectsResource, opts), &projectapi.ProjectList{})
 	if obj == nil {
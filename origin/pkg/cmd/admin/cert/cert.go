@@ -7,12 +7,13 @@ import (
 	cmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
 
 	"github.com/openshift/origin/pkg/cmd/server/admin"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 )
 
 const CertRecommendedName = "ca"
 
 // NewCmdCert implements the OpenShift cli ca command
-func NewCmdCert(name, fullName string, out io.Writer, errout io.Writer) *cobra.Command {
+func NewCmdCert(name, fullName string, f *clientcmd.Factory, out io.Writer, errout io.Writer) *cobra.Command {
 	// Parent command to which all subcommands are added.
 	cmds := &cobra.Command{
 		Use:   name,
@@ -26,6 +27,9 @@ func NewCmdCert(name, fullName string, out io.Writer, errout io.Writer) *cobra.C
 	cmds.AddCommand(admin.NewCommandCreateServerCert(admin.CreateServerCertCommandName, fullName+" "+admin.CreateServerCertCommandName, out))
 	cmds.AddCommand(admin.NewCommandCreateSignerCert(admin.CreateSignerCertCommandName, fullName+" "+admin.CreateSignerCertCommandName, out))
 
+	cmds.AddCommand(admin.NewCommandCheckExpiry(f, admin.CheckExpiryCommandName, fullName+" "+admin.CheckExpiryCommandName, out))
+	cmds.AddCommand(admin.NewCommandRotateCerts(admin.RotateCertsCommandName, fullName+" "+admin.RotateCertsCommandName, out))
+
 	cmds.AddCommand(admin.NewCommandEncrypt(admin.EncryptCommandName, fullName+" "+admin.EncryptCommandName, out, errout))
 	cmds.AddCommand(admin.NewCommandDecrypt(admin.DecryptCommandName, fullName+" "+admin.DecryptCommandName, fullName+" "+admin.EncryptCommandName, out))
 
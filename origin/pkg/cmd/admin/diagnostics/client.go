@@ -7,6 +7,7 @@ import (
 	"github.com/openshift/kubernetes/pkg/util/sets"
 
 	clientdiags "github.com/openshift/origin/pkg/diagnostics/client"
+	"github.com/openshift/origin/pkg/diagnostics/log"
 	networkdiags "github.com/openshift/origin/pkg/diagnostics/network"
 	"github.com/openshift/origin/pkg/diagnostics/types"
 )
@@ -24,7 +25,7 @@ func (o DiagnosticsOptions) buildClientDiagnostics(rawConfig *clientcmdapi.Confi
 
 	osClient, kubeClient, clientErr := o.Factory.Clients()
 	if clientErr != nil {
-		o.Logger.Notice("CED0001", "Could not configure a client, so client diagnostics are limited to testing configuration and connection")
+		o.logEntry(log.Entry{ID: "CED0001", Level: log.NoticeLevel, Message: "Could not configure a client, so client diagnostics are limited to testing configuration and connection"})
 		available = sets.NewString(clientdiags.ConfigContextsName)
 	}
 
@@ -15,6 +15,7 @@ import (
 	osclientcmd "github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	clustdiags "github.com/openshift/origin/pkg/diagnostics/cluster"
 	agldiags "github.com/openshift/origin/pkg/diagnostics/cluster/aggregated_logging"
+	"github.com/openshift/origin/pkg/diagnostics/log"
 	"github.com/openshift/origin/pkg/diagnostics/types"
 )
 
@@ -49,7 +50,7 @@ func (o DiagnosticsOptions) buildClusterDiagnostics(rawConfig *clientcmdapi.Conf
 
 	clusterClient, kclusterClient, found, serverUrl, err := o.findClusterClients(rawConfig)
 	if !found {
-		o.Logger.Notice("CED1002", "Could not configure a client with cluster-admin permissions for the current server, so cluster diagnostics will be skipped")
+		o.logEntry(log.Entry{ID: "CED1002", Level: log.NoticeLevel, Message: "Could not configure a client with cluster-admin permissions for the current server, so cluster diagnostics will be skipped"})
 		return nil, true, err
 	}
 
@@ -88,7 +89,7 @@ func (o DiagnosticsOptions) findClusterClients(rawConfig *clientcmdapi.Config) (
 	if o.ClientClusterContext != "" { // user has specified cluster context to use
 		if context, exists := rawConfig.Contexts[o.ClientClusterContext]; exists {
 			configErr := fmt.Errorf("Specified '%s' as cluster-admin context, but it was not found in your client configuration.", o.ClientClusterContext)
-			o.Logger.Error("CED1003", configErr.Error())
+			o.logEntry(log.Entry{ID: "CED1003", Level: log.ErrorLevel, Message: configErr.Error()})
 			return nil, nil, false, "", configErr
 		} else if os, kube, found, serverUrl, err := o.makeClusterClients(rawConfig, o.ClientClusterContext, context); found {
 			return os, kube, true, serverUrl, err
@@ -99,7 +100,7 @@ func (o DiagnosticsOptions) findClusterClients(rawConfig *clientcmdapi.Config) (
 	currentContext, exists := rawConfig.Contexts[rawConfig.CurrentContext]
 	if !exists { // config specified cluster admin context that doesn't exist; complain and quit
 		configErr := fmt.Errorf("Current context '%s' not found in client configuration; will not attempt cluster diagnostics.", rawConfig.CurrentContext)
-		o.Logger.Error("CED1004", configErr.Error())
+		o.logEntry(log.Entry{ID: "CED1004", Level: log.ErrorLevel, Message: configErr.Error()})
 		return nil, nil, false, "", configErr
 	}
 	// check if current context is already cluster admin
@@ -141,7 +142,7 @@ func (o DiagnosticsOptions) makeClusterClients(rawConfig *clientcmdapi.Config, c
 				o.Logger.Debug("CED1007", fmt.Sprintf("Context '%s' does not have cluster-admin access:\n%v", contextName, err))
 				return nil, nil, false, "", nil
 			} else {
-				o.Logger.Error("CED1008", fmt.Sprintf("Unknown error testing cluster-admin access for context '%s':\n%v", contextName, err))
+				o.logEntry(log.Entry{ID: "CED1008", Level: log.ErrorLevel, Message: fmt.Sprintf("Unknown error testing cluster-admin access for context '%s':\n%v", contextName, err)})
 				return nil, nil, false, "", err
 			}
 		} else if resp.Allowed {
@@ -18,6 +18,7 @@ func (o DiagnosticsOptions) detectClientConfig() (bool, []types.DiagnosticError,
 	for _, entry := range result.Logs() {
 		o.Logger.LogEntry(entry)
 	}
+	o.Report.addEntries(diagnostic, result.Logs())
 	return diagnostic.SuccessfulLoad(), result.Warnings(), result.Errors()
 }
 
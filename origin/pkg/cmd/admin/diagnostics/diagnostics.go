@@ -3,6 +3,7 @@ package diagnostics
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime/debug"
@@ -55,6 +56,12 @@ type DiagnosticsOptions struct {
 	LogOptions *log.LoggerOptions
 	// The Logger is built with the options and should be used for all diagnostic output.
 	Logger *log.Logger
+	// OutputFormat selects machine-readable output (json, yaml or junit) in place of the text log.
+	OutputFormat string
+	// Report accumulates structured results while diagnostics run.
+	Report *DiagnosticsReport
+	// Out is where the machine-readable report is written.
+	Out io.Writer
 }
 
 const (
@@ -91,7 +98,12 @@ var (
 
 		    %[1]s <DiagnosticName>
 
-		The available diagnostic names are: %[2]s.`)
+		The available diagnostic names are: %[2]s.
+
+		Results can be written in a machine-readable format with the --output
+		flag (json, yaml or junit) instead of the text log. The command exits
+		with status 255 if any errors were seen, 1 if only warnings were seen,
+		and 0 otherwise.`)
 )
 
 // NewCmdDiagnostics is the base command for running any diagnostics.
@@ -100,6 +112,7 @@ func NewCmdDiagnostics(name string, fullName string, out io.Writer) *cobra.Comma
 		RequestedDiagnostics: []string{},
 		LogOptions:           &log.LoggerOptions{Out: out},
 		ImageTemplate:        variable.NewDefaultImageTemplate(),
+		Out:                  out,
 	}
 
 	cmd := &cobra.Command{
@@ -112,11 +125,19 @@ func NewCmdDiagnostics(name string, fullName string, out io.Writer) *cobra.Comma
 			kcmdutil.CheckErr(o.Validate())
 
 			failed, err, warnCount, errorCount := o.RunDiagnostics()
-			o.Logger.Summary(warnCount, errorCount)
+			if len(o.OutputFormat) > 0 {
+				o.Report.WarningCount, o.Report.ErrorCount = warnCount, errorCount
+				kcmdutil.CheckErr(WriteReport(o.Out, o.OutputFormat, o.Report))
+			} else {
+				o.Logger.Summary(warnCount, errorCount)
+				kcmdutil.CheckErr(err)
+			}
 
-			kcmdutil.CheckErr(err)
+			if code := ExitCode(warnCount, errorCount); code != 0 {
+				os.Exit(code)
+			}
 			if failed {
-				os.Exit(255)
+				os.Exit(ExitCodeErrors)
 			}
 
 		},
@@ -136,6 +157,7 @@ func NewCmdDiagnostics(name string, fullName string, out io.Writer) *cobra.Comma
 	cmd.Flags().BoolVar(&o.PreventModification, options.FlagPreventModificationName, false, "If true, may be set to prevent diagnostics making any changes via the API")
 	cmd.Flags().StringVar(&o.NetworkDiagLogDir, options.FlagNetworkDiagLogDir, netutil.NetworkDiagDefaultLogDir, "Path to store network diagnostic results in case of errors")
 	cmd.Flags().StringVar(&o.NetworkDiagPodImage, options.FlagNetworkDiagPodImage, netutil.NetworkDiagDefaultPodImage, "Image to use for network diagnostic pod")
+	cmd.Flags().StringVarP(&o.OutputFormat, options.FlagOutputName, "o", "", "Output format. One of: json|yaml|junit. If empty, results are logged as text.")
 	flagtypes.GLog(cmd.Flags())
 	options.BindLoggerOptionFlags(cmd.Flags(), o.LogOptions, options.RecommendedLoggerOptionFlags())
 
@@ -144,6 +166,12 @@ func NewCmdDiagnostics(name string, fullName string, out io.Writer) *cobra.Comma
 
 // Complete fills in DiagnosticsOptions needed if the command is actually invoked.
 func (o *DiagnosticsOptions) Complete(args []string) error {
+	if len(o.OutputFormat) > 0 {
+		// the report replaces the text log; keep the log from corrupting it
+		o.LogOptions.Out = ioutil.Discard
+		o.Report = NewDiagnosticsReport()
+	}
+
 	var err error
 	o.Logger, err = o.LogOptions.NewLogger()
 	if err != nil {
@@ -183,6 +211,10 @@ func (o *DiagnosticsOptions) Complete(args []string) error {
 }
 
 func (o *DiagnosticsOptions) Validate() error {
+	if err := ValidateOutputFormat(o.OutputFormat); err != nil {
+		return err
+	}
+
 	available := availableDiagnostics()
 
 	if common := available.Intersection(sets.NewString(o.RequestedDiagnostics...)); len(common) == 0 {
@@ -223,6 +255,7 @@ func (o DiagnosticsOptions) RunDiagnostics() (bool, error, int, int) {
 	warnings := []error{}
 	errors := []error{}
 	diagnostics := []types.Diagnostic{}
+	numDetectErrors := 0
 
 	func() { // don't trust discovery/build of diagnostics; wrap panic nicely in case of developer error
 		defer func() {
@@ -239,10 +272,11 @@ func (o DiagnosticsOptions) RunDiagnostics() (bool, error, int, int) {
 		for _, err := range detectErrors {
 			errors = append(errors, err)
 		}
+		numDetectErrors = len(detectErrors)
 		if !detected { // there just plain isn't any client config file available
-			o.Logger.Notice("CED3014", "No client configuration specified; skipping client and cluster diagnostics.")
+			o.logEntry(log.Entry{ID: "CED3014", Level: log.NoticeLevel, Message: "No client configuration specified; skipping client and cluster diagnostics."})
 		} else if rawConfig, err := o.buildRawConfig(); err != nil { // client config is totally broken - won't parse etc (problems may have been detected and logged)
-			o.Logger.Error("CED3015", fmt.Sprintf("Client configuration failed to load; skipping client and cluster diagnostics due to error: %s", err.Error()))
+			o.logEntry(log.Entry{ID: "CED3015", Level: log.ErrorLevel, Message: fmt.Sprintf("Client configuration failed to load; skipping client and cluster diagnostics due to error: %s", err.Error())})
 			errors = append(errors, err)
 		} else {
 			clientDiags, ok, err := o.buildClientDiagnostics(rawConfig)
@@ -274,6 +308,8 @@ func (o DiagnosticsOptions) RunDiagnostics() (bool, error, int, int) {
 		}
 	}()
 
+	// detection problems are already in the report as entries of the config loading diagnostic
+	o.Report.addErrors(errors[numDetectErrors:]...)
 	if failed {
 		return failed, kutilerrors.NewAggregate(errors), len(warnings), len(errors)
 	}
@@ -284,6 +320,12 @@ func (o DiagnosticsOptions) RunDiagnostics() (bool, error, int, int) {
 	return failed, err, numWarnings, numErrors
 }
 
+// logEntry logs an entry that is not attributable to a single diagnostic and records it in the report.
+func (o DiagnosticsOptions) logEntry(entry log.Entry) {
+	o.Logger.LogEntry(entry)
+	o.Report.addMessages(entry)
+}
+
 // Run performs the actual execution of diagnostics once they're built.
 func (o DiagnosticsOptions) Run(diagnostics []types.Diagnostic) (bool, error, int, int) {
 	warnCount := 0
@@ -294,13 +336,15 @@ func (o DiagnosticsOptions) Run(diagnostics []types.Diagnostic) (bool, error, in
 				if r := recover(); r != nil {
 					errorCount += 1
 					stack := debug.Stack()
-					o.Logger.Error("CED3017",
-						fmt.Sprintf("While running the %s diagnostic, a panic was encountered.\nThis is a bug in diagnostics. Error and stack trace follow: \n%s\n%s",
-							diagnostic.Name(), fmt.Sprintf("%v", r), stack))
+					msg := fmt.Sprintf("While running the %s diagnostic, a panic was encountered.\nThis is a bug in diagnostics. Error and stack trace follow: \n%s\n%s",
+						diagnostic.Name(), fmt.Sprintf("%v", r), stack)
+					o.Logger.Error("CED3017", msg)
+					o.Report.addEntries(diagnostic, []log.Entry{{ID: "CED3017", Level: log.ErrorLevel, Message: msg}})
 				}
 			}()
 
 			if canRun, reason := diagnostic.CanRun(); !canRun {
+				o.Report.addSkipped(diagnostic, reason)
 				if reason == nil {
 					o.Logger.Notice("CED3018", fmt.Sprintf("Skipping diagnostic: %s\nDescription: %s", diagnostic.Name(), diagnostic.Description()))
 				} else {
@@ -314,6 +358,7 @@ func (o DiagnosticsOptions) Run(diagnostics []types.Diagnostic) (bool, error, in
 			for _, entry := range r.Logs() {
 				o.Logger.LogEntry(entry)
 			}
+			o.Report.addEntries(diagnostic, r.Logs())
 			warnCount += len(r.Warnings())
 			errorCount += len(r.Errors())
 		}()
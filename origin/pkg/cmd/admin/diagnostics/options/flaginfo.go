@@ -55,4 +55,5 @@ const (
 	FlagPreventModificationName = "prevent-modification"
 	FlagNetworkDiagLogDir       = "network-logdir"
 	FlagNetworkDiagPodImage     = "network-pod-image"
+	FlagOutputName              = "output"
 )
@@ -0,0 +1,253 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/openshift/github.com/ghodss/yaml"
+
+	"github.com/openshift/origin/pkg/diagnostics/log"
+	"github.com/openshift/origin/pkg/diagnostics/types"
+	"github.com/openshift/origin/pkg/version"
+)
+
+const (
+	// Supported values for the --output flag. When empty, the colored text log is written instead.
+	OutputFormatJSON  = "json"
+	OutputFormatYAML  = "yaml"
+	OutputFormatJUnit = "junit"
+
+	// Exit codes returned by the diagnostics command.
+	ExitCodeErrors   = 255
+	ExitCodeWarnings = 1
+)
+
+// DiagnosticsReport is the machine-readable result of a diagnostics run.
+type DiagnosticsReport struct {
+	// Version of the client that produced the report
+	Version string `json:"version"`
+	// Diagnostics holds one entry per diagnostic that was considered for running
+	Diagnostics []DiagnosticReport `json:"diagnostics"`
+	// Messages logged while building the diagnostics, outside of any single diagnostic
+	Messages []DiagnosticReportEntry `json:"messages,omitempty"`
+	// Errors encountered while building the diagnostics, outside of any single diagnostic
+	Errors []string `json:"errors,omitempty"`
+	// WarningCount and ErrorCount are the totals used to determine the exit code
+	WarningCount int `json:"warningCount"`
+	ErrorCount   int `json:"errorCount"`
+}
+
+// DiagnosticReport records what happened to a single diagnostic.
+type DiagnosticReport struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Ran is false when the diagnostic was skipped; SkipReason explains why, if known.
+	Ran        bool                    `json:"ran"`
+	SkipReason string                  `json:"skipReason,omitempty"`
+	Entries    []DiagnosticReportEntry `json:"entries,omitempty"`
+}
+
+// DiagnosticReportEntry is a single log entry produced by a diagnostic.
+type DiagnosticReportEntry struct {
+	ID      string `json:"id"`
+	Level   string `json:"level"`
+	Origin  string `json:"origin,omitempty"`
+	Message string `json:"message"`
+}
+
+// NewDiagnosticsReport returns an empty report stamped with the client version.
+func NewDiagnosticsReport() *DiagnosticsReport {
+	return &DiagnosticsReport{
+		Version:     version.Get().String(),
+		Diagnostics: []DiagnosticReport{},
+	}
+}
+
+// addSkipped records a diagnostic whose CanRun check returned false.
+func (r *DiagnosticsReport) addSkipped(diagnostic types.Diagnostic, reason error) {
+	if r == nil {
+		return
+	}
+	d := DiagnosticReport{Name: diagnostic.Name(), Description: diagnostic.Description()}
+	if reason != nil {
+		d.SkipReason = reason.Error()
+	}
+	r.Diagnostics = append(r.Diagnostics, d)
+}
+
+// addEntries records a diagnostic that ran along with everything it logged.
+func (r *DiagnosticsReport) addEntries(diagnostic types.Diagnostic, entries []log.Entry) {
+	if r == nil {
+		return
+	}
+	d := DiagnosticReport{Name: diagnostic.Name(), Description: diagnostic.Description(), Ran: true}
+	for _, entry := range entries {
+		d.Entries = append(d.Entries, newReportEntry(entry))
+	}
+	r.Diagnostics = append(r.Diagnostics, d)
+}
+
+// addMessages records entries that were logged outside of any single diagnostic.
+func (r *DiagnosticsReport) addMessages(entries ...log.Entry) {
+	if r == nil {
+		return
+	}
+	for _, entry := range entries {
+		r.Messages = append(r.Messages, newReportEntry(entry))
+	}
+}
+
+func newReportEntry(entry log.Entry) DiagnosticReportEntry {
+	return DiagnosticReportEntry{
+		ID:      entry.ID,
+		Level:   entry.Level.Name,
+		Origin:  entry.Origin,
+		Message: strings.TrimSpace(entry.Message),
+	}
+}
+
+// addErrors records errors that are not attributable to a single diagnostic.
+func (r *DiagnosticsReport) addErrors(errs ...error) {
+	if r == nil {
+		return
+	}
+	for _, err := range errs {
+		if err != nil {
+			r.Errors = append(r.Errors, err.Error())
+		}
+	}
+}
+
+// ExitCode returns the process exit code for the report: ExitCodeErrors if any errors
+// were seen, ExitCodeWarnings if only warnings were seen, and 0 otherwise.
+func ExitCode(warningCount, errorCount int) int {
+	switch {
+	case errorCount > 0:
+		return ExitCodeErrors
+	case warningCount > 0:
+		return ExitCodeWarnings
+	}
+	return 0
+}
+
+// ValidateOutputFormat returns an error if the format is not one of the supported values.
+func ValidateOutputFormat(format string) error {
+	switch format {
+	case "", OutputFormatJSON, OutputFormatYAML, OutputFormatJUnit:
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q: must be one of %s, %s or %s", format, OutputFormatJSON, OutputFormatYAML, OutputFormatJUnit)
+}
+
+// WriteReport serializes the report to out in the requested format.
+func WriteReport(out io.Writer, format string, report *DiagnosticsReport) error {
+	switch format {
+	case OutputFormatJSON:
+		data, err := json.MarshalIndent(report, "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case OutputFormatYAML:
+		data, err := yaml.Marshal(report)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	case OutputFormatJUnit:
+		data, err := xml.MarshalIndent(report.junit(), "", "    ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "%s%s\n", xml.Header, data)
+		return err
+	}
+	return ValidateOutputFormat(format)
+}
+
+// The jUnit types below are a minimal subset of the schema, enough for CI systems
+// to show one test case per diagnostic.
+type junitTestSuite struct {
+	XMLName    xml.Name         `xml:"testsuite"`
+	Name       string           `xml:"name,attr"`
+	NumTests   int              `xml:"tests,attr"`
+	NumSkipped int              `xml:"skipped,attr"`
+	NumFailed  int              `xml:"failures,attr"`
+	TestCases  []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	XMLName   xml.Name      `xml:"testcase"`
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Skipped   *junitSkipped `xml:"skipped"`
+	Failure   *junitFailure `xml:"failure"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Output  string `xml:",chardata"`
+}
+
+// junit converts the report into a test suite. Diagnostics that logged errors are
+// failures; warnings are reported in the output but do not fail the test case.
+func (r *DiagnosticsReport) junit() *junitTestSuite {
+	suite := &junitTestSuite{Name: "diagnostics"}
+	for _, d := range r.Diagnostics {
+		tc := &junitTestCase{Name: d.Name, Classname: "diagnostics"}
+		suite.NumTests++
+		if !d.Ran {
+			tc.Skipped = &junitSkipped{Message: d.SkipReason}
+			suite.NumSkipped++
+			suite.TestCases = append(suite.TestCases, tc)
+			continue
+		}
+
+		failures := []string{}
+		out := []string{}
+		for _, entry := range d.Entries {
+			line := fmt.Sprintf("%s %s: %s", strings.ToUpper(entry.Level), entry.ID, entry.Message)
+			if entry.Level == log.ErrorLevel.Name {
+				failures = append(failures, line)
+			}
+			out = append(out, line)
+		}
+		if len(failures) > 0 {
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d error(s) reported by %s", len(failures), d.Name),
+				Output:  strings.Join(failures, "\n"),
+			}
+			suite.NumFailed++
+		}
+		tc.SystemOut = strings.Join(out, "\n")
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	if len(r.Errors) > 0 || len(r.Messages) > 0 {
+		tc := &junitTestCase{Name: "setup", Classname: "diagnostics"}
+		out := []string{}
+		for _, entry := range r.Messages {
+			out = append(out, fmt.Sprintf("%s %s: %s", strings.ToUpper(entry.Level), entry.ID, entry.Message))
+		}
+		tc.SystemOut = strings.Join(out, "\n")
+		if len(r.Errors) > 0 {
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d error(s) building diagnostics", len(r.Errors)),
+				Output:  strings.Join(r.Errors, "\n"),
+			}
+			suite.NumFailed++
+		}
+		suite.NumTests++
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+	return suite
+}
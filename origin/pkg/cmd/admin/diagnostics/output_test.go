@@ -0,0 +1,138 @@
+package diagnostics
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/openshift/origin/pkg/diagnostics/log"
+	"github.com/openshift/origin/pkg/diagnostics/types"
+)
+
+type fakeDiagnostic struct {
+	name string
+}
+
+func (d fakeDiagnostic) Name() string                  { return d.name }
+func (d fakeDiagnostic) Description() string           { return "fake " + d.name }
+func (d fakeDiagnostic) CanRun() (bool, error)         { return true, nil }
+func (d fakeDiagnostic) Check() types.DiagnosticResult { return types.NewDiagnosticResult(d.name) }
+
+func testReport() *DiagnosticsReport {
+	report := NewDiagnosticsReport()
+	report.addSkipped(fakeDiagnostic{"Skipped"}, errors.New("no client"))
+	report.addEntries(fakeDiagnostic{"Failing"}, []log.Entry{
+		{ID: "DTest1001", Level: log.InfoLevel, Message: "looking around"},
+		{ID: "DTest1002", Level: log.WarnLevel, Message: "something odd\n"},
+		{ID: "DTest1003", Level: log.ErrorLevel, Message: "something broken"},
+	})
+	report.addEntries(fakeDiagnostic{"Passing"}, nil)
+	report.WarningCount, report.ErrorCount = 1, 1
+	return report
+}
+
+func TestExitCode(t *testing.T) {
+	testCases := []struct {
+		warnings, errors, expected int
+	}{
+		{0, 0, 0},
+		{3, 0, ExitCodeWarnings},
+		{0, 1, ExitCodeErrors},
+		{2, 2, ExitCodeErrors},
+	}
+	for _, tc := range testCases {
+		if code := ExitCode(tc.warnings, tc.errors); code != tc.expected {
+			t.Errorf("warnings=%d errors=%d: expected exit code %d, got %d", tc.warnings, tc.errors, tc.expected, code)
+		}
+	}
+}
+
+func TestWriteReportJSON(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := WriteReport(out, OutputFormatJSON, testReport()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	decoded := &DiagnosticsReport{}
+	if err := json.Unmarshal(out.Bytes(), decoded); err != nil {
+		t.Fatalf("unable to decode report: %v\n%s", err, out.String())
+	}
+	if len(decoded.Diagnostics) != 3 {
+		t.Fatalf("expected 3 diagnostics, got %#v", decoded.Diagnostics)
+	}
+	if d := decoded.Diagnostics[0]; d.Ran || d.SkipReason != "no client" {
+		t.Errorf("expected skipped diagnostic with reason, got %#v", d)
+	}
+	failing := decoded.Diagnostics[1]
+	if !failing.Ran || len(failing.Entries) != 3 {
+		t.Fatalf("expected diagnostic with 3 entries, got %#v", failing)
+	}
+	if e := failing.Entries[1]; e.ID != "DTest1002" || e.Level != "warn" || e.Message != "something odd" {
+		t.Errorf("unexpected entry %#v", e)
+	}
+	if decoded.WarningCount != 1 || decoded.ErrorCount != 1 {
+		t.Errorf("unexpected counts: %d warnings, %d errors", decoded.WarningCount, decoded.ErrorCount)
+	}
+}
+
+func TestWriteReportJUnit(t *testing.T) {
+	out := &bytes.Buffer{}
+	if err := WriteReport(out, OutputFormatJUnit, testReport()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := out.String()
+	for _, expected := range []string{
+		`<testsuite name="diagnostics" tests="3" skipped="1" failures="1">`,
+		`<skipped message="no client"></skipped>`,
+		`ERROR DTest1003: something broken`,
+	} {
+		if !strings.Contains(s, expected) {
+			t.Errorf("expected output to contain %q:\n%s", expected, s)
+		}
+	}
+}
+
+func TestWriteReportInvalidFormat(t *testing.T) {
+	if err := WriteReport(&bytes.Buffer{}, "xml", testReport()); err == nil {
+		t.Errorf("expected error for unsupported format")
+	}
+}
+
+func TestWriteReportMessages(t *testing.T) {
+	report := NewDiagnosticsReport()
+	report.addMessages(log.Entry{ID: "CED3014", Level: log.NoticeLevel, Message: "No client configuration specified"})
+	report.addEntries(fakeDiagnostic{"ConfigLoading"}, []log.Entry{
+		{ID: "DCli0001", Level: log.WarnLevel, Message: "config file not found"},
+	})
+
+	out := &bytes.Buffer{}
+	if err := WriteReport(out, OutputFormatJSON, report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded := &DiagnosticsReport{}
+	if err := json.Unmarshal(out.Bytes(), decoded); err != nil {
+		t.Fatalf("unable to decode report: %v\n%s", err, out.String())
+	}
+	if len(decoded.Messages) != 1 || decoded.Messages[0].ID != "CED3014" || decoded.Messages[0].Level != "note" {
+		t.Errorf("expected the CED3014 message, got %#v", decoded.Messages)
+	}
+	if len(decoded.Diagnostics) != 1 || len(decoded.Diagnostics[0].Entries) != 1 || decoded.Diagnostics[0].Entries[0].Level != "warn" {
+		t.Errorf("expected the detection warning, got %#v", decoded.Diagnostics)
+	}
+
+	out.Reset()
+	if err := WriteReport(out, OutputFormatJUnit, report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := out.String()
+	for _, expected := range []string{
+		`<testsuite name="diagnostics" tests="2" skipped="0" failures="0">`,
+		`NOTE CED3014: No client configuration specified`,
+	} {
+		if !strings.Contains(s, expected) {
+			t.Errorf("expected output to contain %q:\n%s", expected, s)
+		}
+	}
+}
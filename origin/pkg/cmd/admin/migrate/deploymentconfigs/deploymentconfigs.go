@@ -0,0 +1,525 @@
+package deploymentconfigs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/openshift/github.com/spf13/cobra"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	"github.com/openshift/kubernetes/pkg/apis/extensions"
+	extensionsv1beta1 "github.com/openshift/kubernetes/pkg/apis/extensions/v1beta1"
+	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	kextensionsclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/extensions/internalversion"
+	"github.com/openshift/kubernetes/pkg/kubectl"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/openshift/kubernetes/pkg/kubectl/resource"
+	"github.com/openshift/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/admin/migrate"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imagetrigger "github.com/openshift/origin/pkg/image/controller/trigger"
+)
+
+const (
+	// MigratedToAnnotation is set on a deployment config while it is migrated, to the name of the
+	// Kubernetes deployment replacing it. The deployment config is deleted once the migration
+	// completes.
+	MigratedToAnnotation = "migrate.openshift.io/deployment"
+	// MigratedFromAnnotation is set on the Kubernetes deployment created by a migration, to the
+	// name of the deployment config it replaces.
+	MigratedFromAnnotation = "migrate.openshift.io/deployment-config"
+
+	// originalSpecAnnotation is set on a deployment config while it is migrated, to the paused flag
+	// and the triggers it had before the migration paused it. An interrupted migration is rerun
+	// from the original spec.
+	originalSpecAnnotation = "migrate.openshift.io/original-spec"
+)
+
+var (
+	internalMigrateDeploymentConfigsLong = templates.LongDesc(`
+		Migrate deployment configs to Kubernetes deployments
+
+		This command replaces each deployment config with a Kubernetes deployment of the same name.
+		The strategy, selector, pod template, replicas, minimum ready seconds and revision history
+		limit of the deployment config are kept. Image change triggers are converted to the
+		"image.openshift.io/triggers" annotation, which the master uses to update the containers of
+		the deployment when the image stream tags change.
+
+		Some features of deployment configs have no equivalent on Kubernetes deployments: lifecycle
+		hooks, custom strategies, test mode, paused configs, settings of the deployer pods and
+		deployment configs that only roll out on image changes or on request. Deployment configs
+		using them are reported and left untouched, unless --drop-unsupported is passed.
+
+		The running pods are taken over by the deployment, no new pods are started. The latest
+		rollout of the deployment config must have completed. The deployment config is paused and
+		its triggers are removed, then the replication controller of its latest rollout is replaced
+		by a replica set with the same pods, which the deployment adopts. Once the deployment is
+		available, the deployment config is deleted. Its older replication controllers are kept.
+
+		A deployment config whose migration was interrupted stays paused and records its original
+		triggers. Rerunning the command finishes its migration.`)
+
+	internalMigrateDeploymentConfigsExample = templates.Examples(`
+		# Perform a dry-run of migrating all deployment configs
+	  %[1]s
+
+	  # To actually perform the migration, the confirm flag must be appended
+	  %[1]s --confirm
+
+	  # Show the deployments that would be created in a project
+	  %[1]s -n myproject -o yaml
+
+	  # Migrate the deployment configs of a project even if some of their features are lost
+	  %[1]s -n myproject --drop-unsupported --confirm`)
+)
+
+type MigrateDeploymentConfigsOptions struct {
+	migrate.ResourceOptions
+
+	Client                      client.DeploymentConfigsNamespacer
+	DeploymentClient            kextensionsclient.DeploymentsGetter
+	ReplicaSetClient            kextensionsclient.ReplicaSetsGetter
+	ReplicationControllerClient kcoreclient.ReplicationControllersGetter
+
+	// DropUnsupported migrates deployment configs whose features can't all be converted.
+	DropUnsupported bool
+	// Timeout is how long to wait for a new deployment to be available.
+	Timeout time.Duration
+}
+
+// NewCmdMigrateDeploymentConfigs implements a MigrateDeploymentConfigs command
+func NewCmdMigrateDeploymentConfigs(name, fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	options := &MigrateDeploymentConfigsOptions{
+		ResourceOptions: migrate.ResourceOptions{
+			In:      in,
+			Out:     out,
+			ErrOut:  errout,
+			Include: []string{"deploymentconfigs"},
+		},
+		Timeout: 10 * time.Minute,
+	}
+	cmd := &cobra.Command{
+		Use:     name,
+		Short:   "Replace deployment configs with Kubernetes deployments",
+		Long:    internalMigrateDeploymentConfigsLong,
+		Example: fmt.Sprintf(internalMigrateDeploymentConfigsExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Validate())
+			kcmdutil.CheckErr(options.Run())
+		},
+	}
+	options.ResourceOptions.Bind(cmd)
+	cmd.Flags().BoolVar(&options.DropUnsupported, "drop-unsupported", false, "If true, migrate deployment configs using features that Kubernetes deployments don't support, and drop those features.")
+	cmd.Flags().DurationVar(&options.Timeout, "timeout", options.Timeout, "The length of time to wait for a new deployment to be available before giving up on its deployment config.")
+
+	return cmd
+}
+
+func (o *MigrateDeploymentConfigsOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("%s takes no positional arguments", c.Name())
+	}
+
+	o.ResourceOptions.SaveFn = o.save
+	if err := o.ResourceOptions.Complete(f, c); err != nil {
+		return err
+	}
+	if len(o.Output) > 0 {
+		// the objects to print are the new deployments, not the deployment configs that were visited
+		printer, _, err := kubectl.GetPrinter(o.Output, "", false, true)
+		if err != nil {
+			return err
+		}
+		first := true
+		o.ResourceOptions.PrintFn = func(info *resource.Info, reporter migrate.Reporter) error {
+			obj, err := kapi.Scheme.ConvertToVersion(reporter.(*conversion).deployment, extensionsv1beta1.SchemeGroupVersion)
+			if err != nil {
+				return err
+			}
+			if o.Output == "yaml" && !first {
+				fmt.Fprintln(o.Out, "---")
+			}
+			first = false
+			return printer.PrintObj(obj, o.Out)
+		}
+	}
+
+	osclient, kclient, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	o.Client = osclient
+	o.DeploymentClient = kclient.Extensions()
+	o.ReplicaSetClient = kclient.Extensions()
+	o.ReplicationControllerClient = kclient.Core()
+
+	return nil
+}
+
+func (o MigrateDeploymentConfigsOptions) Validate() error {
+	if o.Timeout <= 0 {
+		return fmt.Errorf("--timeout must be greater than zero")
+	}
+	return o.ResourceOptions.Validate()
+}
+
+func (o MigrateDeploymentConfigsOptions) Run() error {
+	return o.ResourceOptions.Visitor().Visit(func(info *resource.Info) (migrate.Reporter, error) {
+		return o.transform(info)
+	})
+}
+
+// conversion is the Kubernetes deployment a deployment config is migrated to.
+type conversion struct {
+	deployment *extensions.Deployment
+}
+
+func (c *conversion) Changed() bool {
+	return c.deployment != nil
+}
+
+// transform converts the deployment config of info to a Kubernetes deployment. Deployment configs
+// that were already migrated are reported unchanged, and those using unsupported features are
+// reported as errors unless DropUnsupported is set.
+func (o *MigrateDeploymentConfigsOptions) transform(info *resource.Info) (migrate.Reporter, error) {
+	config, ok := info.Object.(*deployapi.DeploymentConfig)
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := config.Annotations[originalSpecAnnotation]; ok {
+		// an interrupted migration paused the deployment config and removed its triggers
+		original, err := originalDeploymentConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		config = original
+	}
+
+	deployment, unsupported, err := ConvertDeploymentConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	if len(unsupported) > 0 {
+		if !o.DropUnsupported {
+			return nil, fmt.Errorf("uses features Kubernetes deployments don't support (pass --drop-unsupported to migrate it anyway): %s", strings.Join(unsupported, ", "))
+		}
+		fmt.Fprintf(o.ErrOut, "warning: deploymentconfigs/%s -n %s: dropping %s\n", config.Name, config.Namespace, strings.Join(unsupported, ", "))
+	}
+	return &conversion{deployment: deployment}, nil
+}
+
+// save pauses the deployment config, hands the pods of its latest rollout over to a replica set
+// and creates the Kubernetes deployment adopting it. Once the deployment is available, the
+// deployment config is deleted. The deployment config is left untouched unless its latest rollout
+// has completed. A migration that was interrupted can be rerun: the replica set and the deployment
+// created by an earlier run are reused.
+func (o *MigrateDeploymentConfigsOptions) save(info *resource.Info, reporter migrate.Reporter) error {
+	deployment := reporter.(*conversion).deployment
+	deployments := o.DeploymentClient.Deployments(deployment.Namespace)
+
+	config, err := o.Client.DeploymentConfigs(info.Namespace).Get(info.Name)
+	if err != nil {
+		return migrate.DefaultRetriable(info, err)
+	}
+	controller, replicaSet, err := o.latestRollout(info, config)
+	if err != nil {
+		return err
+	}
+
+	// a paused deployment config doesn't roll out, so the replication controller of its latest
+	// rollout isn't replaced or recreated while the pods are handed over
+	if _, ok := config.Annotations[originalSpecAnnotation]; !ok {
+		original, err := json.Marshal(originalSpec{Paused: config.Spec.Paused, Triggers: config.Spec.Triggers})
+		if err != nil {
+			return err
+		}
+		if config.Annotations == nil {
+			config.Annotations = map[string]string{}
+		}
+		config.Annotations[originalSpecAnnotation] = string(original)
+		config.Annotations[MigratedToAnnotation] = deployment.Name
+		config.Spec.Paused = true
+		config.Spec.Triggers = nil
+		if config, err = o.Client.DeploymentConfigs(info.Namespace).Update(config); err != nil {
+			return migrate.DefaultRetriable(info, err)
+		}
+	}
+
+	if controller != nil {
+		if name := deployutil.LatestDeploymentNameForConfig(config); name != controller.Name {
+			return fmt.Errorf("the rollout %q started before the deployment config was paused, rerun the migration once it has completed", name)
+		}
+		if replicaSet, err = o.replaceReplicationController(info, config, controller); err != nil {
+			return err
+		}
+	}
+	// the deployment adopts the replica set with its pod template instead of starting new pods
+	deployment.Spec.Template = replicaSet.Spec.Template
+	deployment.Spec.Replicas = replicaSet.Spec.Replicas
+
+	_, err = deployments.Create(deployment)
+	if kapierrors.IsAlreadyExists(err) {
+		existing, getErr := deployments.Get(deployment.Name)
+		if getErr != nil {
+			return migrate.DefaultRetriable(info, getErr)
+		}
+		if existing.Annotations[MigratedFromAnnotation] != info.Name {
+			return fmt.Errorf("a deployment named %q already exists", deployment.Name)
+		}
+		err = nil
+	}
+	if err != nil {
+		return migrate.DefaultRetriable(info, err)
+	}
+
+	if err := o.waitForAvailable(deployment.Namespace, deployment.Name); err != nil {
+		return err
+	}
+
+	// a deployment config that is left behind could be resumed and would roll out again next to the
+	// deployment. Its replication controllers have no owner references, the master keeps them.
+	if err := o.Client.DeploymentConfigs(info.Namespace).Delete(info.Name); err != nil && !kapierrors.IsNotFound(err) {
+		return migrate.DefaultRetriable(info, err)
+	}
+	return nil
+}
+
+// originalSpec is the part of the spec of a deployment config the migration changes when it pauses
+// the deployment config.
+type originalSpec struct {
+	Paused   bool                                `json:"paused"`
+	Triggers []deployapi.DeploymentTriggerPolicy `json:"triggers"`
+}
+
+// originalDeploymentConfig returns a copy of a deployment config paused by an interrupted migration
+// with the paused flag and the triggers it had before.
+func originalDeploymentConfig(config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+	var original originalSpec
+	if err := json.Unmarshal([]byte(config.Annotations[originalSpecAnnotation]), &original); err != nil {
+		return nil, fmt.Errorf("unable to read the original spec from the %s annotation: %v", originalSpecAnnotation, err)
+	}
+	copied, err := kapi.Scheme.DeepCopy(config)
+	if err != nil {
+		return nil, err
+	}
+	config = copied.(*deployapi.DeploymentConfig)
+	config.Spec.Paused = original.Paused
+	config.Spec.Triggers = original.Triggers
+	return config, nil
+}
+
+// latestRollout returns the replication controller of the latest rollout of a deployment config
+// once the rollout has completed. If an earlier run already replaced the replication controller,
+// the replica set replacing it is returned instead.
+func (o *MigrateDeploymentConfigsOptions) latestRollout(info *resource.Info, config *deployapi.DeploymentConfig) (*kapi.ReplicationController, *extensions.ReplicaSet, error) {
+	name := deployutil.LatestDeploymentNameForConfig(config)
+
+	controller, err := o.ReplicationControllerClient.ReplicationControllers(config.Namespace).Get(name)
+	if kapierrors.IsNotFound(err) {
+		replicaSet, err := o.ReplicaSetClient.ReplicaSets(config.Namespace).Get(name)
+		if kapierrors.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("the replication controller %q of the latest rollout doesn't exist", name)
+		}
+		if err != nil {
+			return nil, nil, migrate.DefaultRetriable(info, err)
+		}
+		if replicaSet.Annotations[MigratedFromAnnotation] != config.Name {
+			return nil, nil, fmt.Errorf("a replica set named %q already exists", name)
+		}
+		return nil, replicaSet, nil
+	}
+	if err != nil {
+		return nil, nil, migrate.DefaultRetriable(info, err)
+	}
+	if !deployutil.IsCompleteDeployment(controller) {
+		return nil, nil, fmt.Errorf("the latest rollout %q has not completed, rerun the migration once it has", name)
+	}
+	if controller.Spec.Template == nil {
+		return nil, nil, fmt.Errorf("the replication controller %q has no pod template", name)
+	}
+	return controller, nil, nil
+}
+
+// replaceReplicationController creates a replica set with the selector, pod template and replicas
+// of the replication controller of the latest rollout of a deployment config, then deletes the
+// replication controller without deleting its pods. The replica set takes over the running pods.
+func (o *MigrateDeploymentConfigsOptions) replaceReplicationController(info *resource.Info, config *deployapi.DeploymentConfig, controller *kapi.ReplicationController) (*extensions.ReplicaSet, error) {
+	name := controller.Name
+	replicaSets := o.ReplicaSetClient.ReplicaSets(config.Namespace)
+	controllers := o.ReplicationControllerClient.ReplicationControllers(config.Namespace)
+
+	replicaSet := &extensions.ReplicaSet{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        controller.Name,
+			Namespace:   controller.Namespace,
+			Labels:      controller.Labels,
+			Annotations: map[string]string{MigratedFromAnnotation: config.Name},
+		},
+		Spec: extensions.ReplicaSetSpec{
+			Replicas: controller.Spec.Replicas,
+			Selector: &unversioned.LabelSelector{MatchLabels: controller.Spec.Selector},
+			Template: *controller.Spec.Template,
+		},
+	}
+	created, err := replicaSets.Create(replicaSet)
+	if kapierrors.IsAlreadyExists(err) {
+		created, err = replicaSets.Get(name)
+		if err == nil && created.Annotations[MigratedFromAnnotation] != config.Name {
+			return nil, fmt.Errorf("a replica set named %q already exists", name)
+		}
+	}
+	if err != nil {
+		return nil, migrate.DefaultRetriable(info, err)
+	}
+
+	orphanDependents := true
+	if err := controllers.Delete(name, &kapi.DeleteOptions{OrphanDependents: &orphanDependents}); err != nil && !kapierrors.IsNotFound(err) {
+		return nil, migrate.DefaultRetriable(info, err)
+	}
+	return created, nil
+}
+
+// waitForAvailable waits until all the replicas of a deployment run its current pod template and
+// are available.
+func (o *MigrateDeploymentConfigsOptions) waitForAvailable(namespace, name string) error {
+	err := wait.PollImmediate(time.Second, o.Timeout, func() (bool, error) {
+		deployment, err := o.DeploymentClient.Deployments(namespace).Get(name)
+		if err != nil {
+			return false, err
+		}
+		return deployment.Status.ObservedGeneration >= deployment.Generation &&
+			deployment.Status.UpdatedReplicas >= deployment.Spec.Replicas &&
+			deployment.Status.AvailableReplicas >= deployment.Spec.Replicas, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("deployment %q was not available after %v, the deployment config was left paused, rerun the migration once the deployment is available", name, o.Timeout)
+	}
+	return err
+}
+
+// ConvertDeploymentConfig returns the Kubernetes deployment equivalent to a deployment config, and
+// a description of the features of the config the deployment lacks.
+func ConvertDeploymentConfig(config *deployapi.DeploymentConfig) (*extensions.Deployment, []string, error) {
+	if config.Spec.Template == nil {
+		return nil, nil, fmt.Errorf("has no pod template")
+	}
+	copied, err := kapi.Scheme.DeepCopy(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	config = copied.(*deployapi.DeploymentConfig)
+
+	unsupported := []string{}
+	deployment := &extensions.Deployment{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        config.Name,
+			Namespace:   config.Namespace,
+			Labels:      config.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec: extensions.DeploymentSpec{
+			Replicas:             config.Spec.Replicas,
+			Selector:             &unversioned.LabelSelector{MatchLabels: config.Spec.Selector},
+			Template:             *config.Spec.Template,
+			MinReadySeconds:      config.Spec.MinReadySeconds,
+			RevisionHistoryLimit: config.Spec.RevisionHistoryLimit,
+		},
+	}
+	for k, v := range config.Annotations {
+		deployment.Annotations[k] = v
+	}
+	deployment.Annotations[MigratedFromAnnotation] = config.Name
+
+	if config.Spec.Test {
+		unsupported = append(unsupported, "test mode")
+	}
+	if config.Spec.Paused {
+		unsupported = append(unsupported, "paused rollouts")
+	}
+
+	strategy := config.Spec.Strategy
+	switch strategy.Type {
+	case deployapi.DeploymentStrategyTypeRecreate:
+		deployment.Spec.Strategy.Type = extensions.RecreateDeploymentStrategyType
+		if p := strategy.RecreateParams; p != nil {
+			unsupported = appendHooks(unsupported, map[string]*deployapi.LifecycleHook{"pre": p.Pre, "mid": p.Mid, "post": p.Post})
+		}
+	case deployapi.DeploymentStrategyTypeRolling:
+		deployment.Spec.Strategy.Type = extensions.RollingUpdateDeploymentStrategyType
+		if p := strategy.RollingParams; p != nil {
+			deployment.Spec.Strategy.RollingUpdate = &extensions.RollingUpdateDeployment{
+				MaxUnavailable: p.MaxUnavailable,
+				MaxSurge:       p.MaxSurge,
+			}
+			if p.TimeoutSeconds != nil {
+				timeout := int32(*p.TimeoutSeconds)
+				deployment.Spec.ProgressDeadlineSeconds = &timeout
+			}
+			unsupported = appendHooks(unsupported, map[string]*deployapi.LifecycleHook{"pre": p.Pre, "post": p.Post})
+		}
+	default:
+		deployment.Spec.Strategy.Type = extensions.RollingUpdateDeploymentStrategyType
+		unsupported = append(unsupported, fmt.Sprintf("%s strategy", strings.ToLower(string(strategy.Type))))
+	}
+	if len(strategy.Resources.Limits) > 0 || len(strategy.Resources.Requests) > 0 {
+		unsupported = append(unsupported, "deployer pod resources")
+	}
+	if len(strategy.Labels) > 0 || len(strategy.Annotations) > 0 {
+		unsupported = append(unsupported, "deployer pod labels and annotations")
+	}
+
+	configChange := false
+	triggers := []imagetrigger.ImageTrigger{}
+	for _, t := range config.Spec.Triggers {
+		switch t.Type {
+		case deployapi.DeploymentTriggerOnConfigChange:
+			configChange = true
+		case deployapi.DeploymentTriggerOnImageChange:
+			p := t.ImageChangeParams
+			if p.From.Kind != "ImageStreamTag" {
+				unsupported = append(unsupported, fmt.Sprintf("image change trigger from %s", p.From.Kind))
+				continue
+			}
+			triggers = append(triggers, imagetrigger.ImageTrigger{
+				From: imagetrigger.ObjectReference{
+					Kind:      p.From.Kind,
+					Name:      p.From.Name,
+					Namespace: p.From.Namespace,
+				},
+				ContainerNames: p.ContainerNames,
+				Paused:         !p.Automatic,
+			})
+		}
+	}
+	if !configChange {
+		// a Kubernetes deployment rolls out every change of its pod template
+		unsupported = append(unsupported, "rollouts without a config change trigger")
+	}
+	if len(triggers) > 0 {
+		if err := imagetrigger.SetTriggers(deployment.Annotations, triggers); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return deployment, unsupported, nil
+}
+
+func appendHooks(unsupported []string, hooks map[string]*deployapi.LifecycleHook) []string {
+	for _, name := range []string{"pre", "mid", "post"} {
+		if hooks[name] != nil {
+			unsupported = append(unsupported, name+" lifecycle hook")
+		}
+	}
+	return unsupported
+}
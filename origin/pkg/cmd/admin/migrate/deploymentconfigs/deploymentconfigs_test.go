@@ -0,0 +1,344 @@
+package deploymentconfigs
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/meta"
+	"github.com/openshift/kubernetes/pkg/apis/extensions"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+	"github.com/openshift/kubernetes/pkg/kubectl/resource"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/intstr"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/cmd/admin/migrate"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imagetrigger "github.com/openshift/origin/pkg/image/controller/trigger"
+)
+
+func testConfig() *deployapi.DeploymentConfig {
+	historyLimit := int32(5)
+	timeout := int64(300)
+	return &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: "app", Labels: map[string]string{"app": "web"}},
+		Spec: deployapi.DeploymentConfigSpec{
+			Replicas:             3,
+			MinReadySeconds:      10,
+			RevisionHistoryLimit: &historyLimit,
+			Selector:             map[string]string{"deploymentconfig": "web"},
+			Strategy: deployapi.DeploymentStrategy{
+				Type: deployapi.DeploymentStrategyTypeRolling,
+				RollingParams: &deployapi.RollingDeploymentStrategyParams{
+					MaxSurge:       intstr.FromString("25%"),
+					MaxUnavailable: intstr.FromInt(0),
+					TimeoutSeconds: &timeout,
+				},
+			},
+			Triggers: []deployapi.DeploymentTriggerPolicy{
+				{Type: deployapi.DeploymentTriggerOnConfigChange},
+				{
+					Type: deployapi.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{
+						Automatic:      true,
+						ContainerNames: []string{"web"},
+						From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "web:latest"},
+					},
+				},
+			},
+			Template: &kapi.PodTemplateSpec{
+				ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{"deploymentconfig": "web"}},
+				Spec: kapi.PodSpec{
+					Containers: []kapi.Container{{Name: "web", Image: "registry/app/web@sha256:1"}},
+				},
+			},
+		},
+	}
+}
+
+func TestConvertDeploymentConfig(t *testing.T) {
+	deployment, unsupported, err := ConvertDeploymentConfig(testConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(unsupported) > 0 {
+		t.Errorf("unexpected unsupported features: %v", unsupported)
+	}
+
+	spec := deployment.Spec
+	if deployment.Name != "web" || deployment.Namespace != "app" || deployment.Labels["app"] != "web" {
+		t.Errorf("unexpected metadata: %#v", deployment.ObjectMeta)
+	}
+	if spec.Replicas != 3 || spec.MinReadySeconds != 10 || spec.RevisionHistoryLimit == nil || *spec.RevisionHistoryLimit != 5 {
+		t.Errorf("unexpected spec: %#v", spec)
+	}
+	if !reflect.DeepEqual(spec.Selector.MatchLabels, map[string]string{"deploymentconfig": "web"}) || spec.Template.Labels["deploymentconfig"] != "web" {
+		t.Errorf("unexpected selector or template: %#v", spec)
+	}
+	if spec.Strategy.Type != extensions.RollingUpdateDeploymentStrategyType || spec.Strategy.RollingUpdate == nil ||
+		spec.Strategy.RollingUpdate.MaxSurge != intstr.FromString("25%") || spec.Strategy.RollingUpdate.MaxUnavailable != intstr.FromInt(0) {
+		t.Errorf("unexpected strategy: %#v", spec.Strategy)
+	}
+	if spec.ProgressDeadlineSeconds == nil || *spec.ProgressDeadlineSeconds != 300 {
+		t.Errorf("unexpected progress deadline: %v", spec.ProgressDeadlineSeconds)
+	}
+	if deployment.Annotations[MigratedFromAnnotation] != "web" {
+		t.Errorf("expected the deployment to record its deployment config, got %v", deployment.Annotations)
+	}
+
+	triggers, err := imagetrigger.Triggers(deployment.Annotations)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []imagetrigger.ImageTrigger{{
+		From:           imagetrigger.ObjectReference{Kind: "ImageStreamTag", Name: "web:latest"},
+		ContainerNames: []string{"web"},
+	}}
+	if !reflect.DeepEqual(triggers, expected) {
+		t.Errorf("unexpected triggers: %#v", triggers)
+	}
+}
+
+func TestConvertDeploymentConfigUnsupported(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mutate   func(*deployapi.DeploymentConfig)
+		expected []string
+	}{
+		{
+			name: "lifecycle hooks",
+			mutate: func(config *deployapi.DeploymentConfig) {
+				config.Spec.Strategy = deployapi.DeploymentStrategy{
+					Type: deployapi.DeploymentStrategyTypeRecreate,
+					RecreateParams: &deployapi.RecreateDeploymentStrategyParams{
+						Pre: &deployapi.LifecycleHook{},
+						Mid: &deployapi.LifecycleHook{},
+					},
+				}
+			},
+			expected: []string{"pre lifecycle hook", "mid lifecycle hook"},
+		},
+		{
+			name: "custom strategy",
+			mutate: func(config *deployapi.DeploymentConfig) {
+				config.Spec.Strategy = deployapi.DeploymentStrategy{Type: deployapi.DeploymentStrategyTypeCustom}
+			},
+			expected: []string{"custom strategy"},
+		},
+		{
+			name: "test mode and deployer pod labels",
+			mutate: func(config *deployapi.DeploymentConfig) {
+				config.Spec.Test = true
+				config.Spec.Strategy.Labels = map[string]string{"a": "b"}
+			},
+			expected: []string{"test mode", "deployer pod labels and annotations"},
+		},
+		{
+			name: "no config change trigger",
+			mutate: func(config *deployapi.DeploymentConfig) {
+				config.Spec.Triggers = config.Spec.Triggers[1:]
+			},
+			expected: []string{"rollouts without a config change trigger"},
+		},
+	}
+
+	for _, test := range testCases {
+		config := testConfig()
+		test.mutate(config)
+		_, unsupported, err := ConvertDeploymentConfig(config)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(unsupported, test.expected) {
+			t.Errorf("%s: expected unsupported features %v, got %v", test.name, test.expected, unsupported)
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	errOut := &bytes.Buffer{}
+	o := &MigrateDeploymentConfigsOptions{ResourceOptions: migrate.ResourceOptions{ErrOut: errOut}}
+
+	config := testConfig()
+	config.Spec.Strategy.RollingParams.Post = &deployapi.LifecycleHook{}
+	if _, err := o.transform(&resource.Info{Object: config}); err == nil {
+		t.Errorf("expected an error for a deployment config with unsupported features")
+	}
+
+	o.DropUnsupported = true
+	reporter, err := o.transform(&resource.Info{Object: config})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reporter.Changed() {
+		t.Errorf("expected the deployment config to be migrated")
+	}
+	if errOut.String() != "warning: deploymentconfigs/web -n app: dropping post lifecycle hook\n" {
+		t.Errorf("unexpected warning: %q", errOut.String())
+	}
+
+	// a deployment config paused by an interrupted migration is converted from its original spec
+	errOut.Reset()
+	o.DropUnsupported = false
+	interrupted := testConfig()
+	interrupted.Annotations = map[string]string{
+		MigratedToAnnotation:   "web",
+		originalSpecAnnotation: `{"paused":false,"triggers":[{"Type":"ConfigChange"},{"Type":"ImageChange","ImageChangeParams":{"Automatic":true,"ContainerNames":["web"],"From":{"Kind":"ImageStreamTag","Name":"web:latest"}}}]}`,
+	}
+	interrupted.Spec.Paused = true
+	interrupted.Spec.Triggers = nil
+	if reporter, err = o.transform(&resource.Info{Object: interrupted}); err != nil || !reporter.Changed() {
+		t.Fatalf("expected an interrupted migration to be rerun, got %v", err)
+	}
+	triggers, err := imagetrigger.Triggers(reporter.(*conversion).deployment.Annotations)
+	if err != nil || len(triggers) != 1 || triggers[0].From.Name != "web:latest" {
+		t.Errorf("expected the original image trigger to be converted, got %#v (%v)", triggers, err)
+	}
+	if !interrupted.Spec.Paused || len(interrupted.Spec.Triggers) != 0 {
+		t.Errorf("expected the visited deployment config not to be modified, got %#v", interrupted.Spec)
+	}
+}
+
+func testReplicationController(config *deployapi.DeploymentConfig, status deployapi.DeploymentStatus) *kapi.ReplicationController {
+	name := deployutil.LatestDeploymentNameForConfig(config)
+	return &kapi.ReplicationController{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Namespace:   config.Namespace,
+			Annotations: map[string]string{deployapi.DeploymentStatusAnnotation: string(status)},
+		},
+		Spec: kapi.ReplicationControllerSpec{
+			Replicas: 3,
+			Selector: map[string]string{"deploymentconfig": "web", "deployment": name},
+			Template: &kapi.PodTemplateSpec{
+				ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{"deploymentconfig": "web", "deployment": name}},
+				Spec:       config.Spec.Template.Spec,
+			},
+		},
+	}
+}
+
+func TestSave(t *testing.T) {
+	config := testConfig()
+	config.Status.LatestVersion = 2
+	controller := testReplicationController(config, deployapi.DeploymentStatusComplete)
+	oclient := testclient.NewSimpleFake(config)
+	kclient := fake.NewSimpleClientset(controller)
+	kclient.PrependReactor("get", "deployments", func(action core.Action) (bool, runtime.Object, error) {
+		deployment := &extensions.Deployment{
+			ObjectMeta: kapi.ObjectMeta{Name: "web", Namespace: "app", Generation: 1},
+			Spec:       extensions.DeploymentSpec{Replicas: 3},
+			Status:     extensions.DeploymentStatus{ObservedGeneration: 1, UpdatedReplicas: 3, AvailableReplicas: 3},
+		}
+		return true, deployment, nil
+	})
+	o := &MigrateDeploymentConfigsOptions{
+		Client:                      oclient,
+		DeploymentClient:            kclient.Extensions(),
+		ReplicaSetClient:            kclient.Extensions(),
+		ReplicationControllerClient: kclient.Core(),
+		Timeout:                     time.Second,
+	}
+
+	deployment, _, err := ConvertDeploymentConfig(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info := &resource.Info{Name: "web", Namespace: "app", Object: config, Mapping: &meta.RESTMapping{MetadataAccessor: meta.NewAccessor()}}
+	if err := o.save(info, &conversion{deployment: deployment}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var replicaSet *extensions.ReplicaSet
+	var created *extensions.Deployment
+	deleted := false
+	for _, action := range kclient.Actions() {
+		switch {
+		case action.Matches("create", "replicasets"):
+			replicaSet = action.(core.CreateAction).GetObject().(*extensions.ReplicaSet)
+		case action.Matches("create", "deployments"):
+			created = action.(core.CreateAction).GetObject().(*extensions.Deployment)
+		case action.Matches("delete", "replicationcontrollers"):
+			deleted = action.(core.DeleteAction).GetName() == controller.Name
+		}
+	}
+	if replicaSet == nil || created == nil {
+		t.Fatalf("expected a replica set and a deployment to be created, got %v", kclient.Actions())
+	}
+	if replicaSet.Name != controller.Name || replicaSet.Spec.Replicas != 3 || !reflect.DeepEqual(replicaSet.Spec.Selector.MatchLabels, controller.Spec.Selector) {
+		t.Errorf("expected the replica set to replace the replication controller, got %#v", replicaSet)
+	}
+	if !reflect.DeepEqual(created.Spec.Template, replicaSet.Spec.Template) {
+		t.Errorf("expected the deployment to have the pod template of the replica set, got %#v", created.Spec.Template)
+	}
+	if !deleted {
+		t.Errorf("expected the replication controller to be deleted, got %v", kclient.Actions())
+	}
+
+	var updates []*deployapi.DeploymentConfig
+	deletedConfig := false
+	for _, action := range oclient.Actions() {
+		switch {
+		case action.Matches("update", "deploymentconfigs"):
+			updates = append(updates, action.(core.UpdateAction).GetObject().(*deployapi.DeploymentConfig))
+		case action.Matches("delete", "deploymentconfigs"):
+			deletedConfig = action.(core.DeleteAction).GetName() == "web"
+		}
+	}
+	if len(updates) != 1 {
+		t.Fatalf("expected the deployment config to be paused, got %v", oclient.Actions())
+	}
+	paused := updates[0]
+	if !paused.Spec.Paused || len(paused.Spec.Triggers) != 0 || paused.Spec.Replicas != 3 || paused.Annotations[MigratedToAnnotation] != "web" {
+		t.Errorf("expected the deployment config to be paused, without triggers, got %#v", paused)
+	}
+	original, err := originalDeploymentConfig(paused)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if original.Spec.Paused || !reflect.DeepEqual(original.Spec.Triggers, testConfig().Spec.Triggers) {
+		t.Errorf("expected the original triggers to be recorded, got %#v", original.Spec)
+	}
+	if !deletedConfig {
+		t.Errorf("expected the deployment config to be deleted, got %v", oclient.Actions())
+	}
+}
+
+func TestSaveIncompleteRollout(t *testing.T) {
+	config := testConfig()
+	config.Status.LatestVersion = 2
+	kclient := fake.NewSimpleClientset(testReplicationController(config, deployapi.DeploymentStatusRunning))
+	oclient := testclient.NewSimpleFake(config)
+	o := &MigrateDeploymentConfigsOptions{
+		Client:                      oclient,
+		DeploymentClient:            kclient.Extensions(),
+		ReplicaSetClient:            kclient.Extensions(),
+		ReplicationControllerClient: kclient.Core(),
+		Timeout:                     time.Second,
+	}
+
+	deployment, _, err := ConvertDeploymentConfig(config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info := &resource.Info{Name: "web", Namespace: "app", Object: config, Mapping: &meta.RESTMapping{MetadataAccessor: meta.NewAccessor()}}
+	if err := o.save(info, &conversion{deployment: deployment}); err == nil {
+		t.Fatalf("expected an error for a rollout that has not completed")
+	}
+	for _, action := range kclient.Actions() {
+		if action.GetVerb() != "get" {
+			t.Errorf("expected the rollout to be left alone, got %v", action)
+		}
+	}
+	for _, action := range oclient.Actions() {
+		if action.GetVerb() != "get" {
+			t.Errorf("expected the deployment config to be left alone, got %v", action)
+		}
+	}
+}
@@ -0,0 +1,671 @@
+package node
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift/github.com/spf13/cobra"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/apis/policy"
+	kclientset "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"github.com/openshift/kubernetes/pkg/client/retry"
+	"github.com/openshift/kubernetes/pkg/fields"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+	kubelettypes "github.com/openshift/kubernetes/pkg/kubelet/types"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+	"github.com/openshift/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	NodesRecommendedName    = "nodes"
+	MaintainRecommendedName = "maintain"
+)
+
+const (
+	// MaintenancePhaseAnnotation records the progress of the maintenance of a node, so that an interrupted
+	// maintenance can be resumed.
+	MaintenancePhaseAnnotation = "node.openshift.io/maintenance-phase"
+
+	// MaintenancePhaseDraining is set once the node is cordoned, while its pods are evicted.
+	MaintenancePhaseDraining = "Draining"
+	// MaintenancePhaseMaintaining is set once the node is drained, while the maintenance runs.
+	MaintenancePhaseMaintaining = "Maintaining"
+	// MaintenancePhaseMaintained is set once the maintenance is done, by the hook command or by an external tool.
+	MaintenancePhaseMaintained = "Maintained"
+	// MaintenancePhaseComplete is set once the node is Ready and schedulable again.
+	MaintenancePhaseComplete = "Complete"
+
+	// MaintenanceUnschedulableAnnotation records whether a node was unschedulable before its maintenance, so that
+	// only the nodes cordoned by the maintenance are uncordoned once it is complete.
+	MaintenanceUnschedulableAnnotation = "node.openshift.io/maintenance-unschedulable"
+	// MaintenanceEvictedControllersAnnotation lists the controllers of the pods evicted from a node as
+	// KIND/NAMESPACE/NAME, so that a resumed maintenance still waits for them to be rescheduled.
+	MaintenanceEvictedControllersAnnotation = "node.openshift.io/maintenance-evicted-controllers"
+)
+
+var (
+	maintainLong = templates.LongDesc(`
+		Perform a rolling maintenance of a group of nodes
+
+		The selected nodes are processed in batches of at most --max-unavailable nodes. Each node
+		of a batch is cordoned and drained: its pods are evicted, except for DaemonSet and mirror
+		pods, and the command waits for them to be gone. Pods using emptyDir volumes are only
+		evicted with --delete-local-data. The maintenance then runs:
+
+		* with --command, the command is run with the NODES environment variable holding the
+		  names of the nodes of the batch, and must exit successfully
+		* otherwise the command waits up to --timeout for an external tool to annotate each node
+		  of the batch with %[2]s=%[3]s
+
+		Once the nodes are Ready again and the controllers of the evicted pods have all of their
+		replicas ready, the nodes are uncordoned and the next batch starts. Nodes that were
+		unschedulable before the maintenance are left unschedulable.
+
+		Before each batch the health of the cluster is checked. The maintenance stops when more
+		than --max-not-ready nodes are not Ready, or when the --health-command fails. When a batch
+		is resumed, its own nodes are not counted.
+
+		The progress of each node is recorded in the %[2]s annotation. Running the same command
+		again after an interruption resumes the maintenance: the nodes in progress are finished
+		first and the nodes already maintained are skipped. Use --restart to maintain all of the
+		selected nodes again.`)
+
+	maintainExample = templates.Examples(`
+		# See the batches the nodes labeled region=east would be maintained in
+	  %[1]s --selector=region=east --max-unavailable=3 --dry-run
+
+	  # Update the packages of the nodes, two at a time
+	  %[1]s --selector=region=east --max-unavailable=2 \
+	      --command='for node in $NODES; do ssh $node "yum -y update && systemctl reboot"; done'
+
+	  # Let an external tool maintain the nodes, which signals it is done with
+	  #   oc annotate node NODE %[2]s=%[3]s --overwrite
+	  %[1]s node1.example.com node2.example.com`)
+)
+
+type MaintainOptions struct {
+	Options *NodeOptions
+
+	MaxUnavailable  int
+	MaxNotReady     int
+	Command         string
+	HealthCommand   string
+	WaitForReady    bool
+	Force           bool
+	DeleteLocalData bool
+	GracePeriod     int64
+	Timeout         time.Duration
+	Restart         bool
+	DryRun          bool
+
+	// PollInterval is how often the nodes and pods are checked while waiting
+	PollInterval time.Duration
+	// RunHook runs a shell command with the given extra environment
+	RunHook func(command string, env []string) error
+}
+
+// NewCmdNodes implements the OpenShift cli nodes command, which groups the operations on sets of nodes.
+func NewCmdNodes(name, fullName string, f *clientcmd.Factory, out, errout io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   name,
+		Short: "Manage groups of nodes",
+		Long:  "Manage groups of nodes",
+		Run:   kcmdutil.DefaultSubCommandRun(errout),
+	}
+	cmd.AddCommand(NewCmdMaintain(MaintainRecommendedName, fullName+" "+MaintainRecommendedName, f, out, errout))
+	return cmd
+}
+
+// NewMaintainOptions creates a new MaintainOptions with default values.
+func NewMaintainOptions(nodeOptions *NodeOptions) *MaintainOptions {
+	return &MaintainOptions{
+		Options:        nodeOptions,
+		MaxUnavailable: 1,
+		WaitForReady:   true,
+		GracePeriod:    -1,
+		Timeout:        30 * time.Minute,
+		PollInterval:   5 * time.Second,
+		RunHook:        runShellCommand,
+	}
+}
+
+// NewCmdMaintain implements the OpenShift cli nodes maintain command
+func NewCmdMaintain(name, fullName string, f *clientcmd.Factory, out, errout io.Writer) *cobra.Command {
+	opts := &NodeOptions{}
+	o := NewMaintainOptions(opts)
+
+	cmd := &cobra.Command{
+		Use:     name + " [NODE...] [--selector=SELECTOR]",
+		Short:   "Perform a rolling maintenance of a group of nodes",
+		Long:    fmt.Sprintf(maintainLong, fullName, MaintenancePhaseAnnotation, MaintenancePhaseMaintained),
+		Example: fmt.Sprintf(maintainExample, fullName, MaintenancePhaseAnnotation, MaintenancePhaseMaintained),
+		Run: func(c *cobra.Command, args []string) {
+			kcmdutil.CheckErr(o.Complete(f, args, out, errout))
+			if err := opts.Validate(c.Flag("selector").Changed); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(c, err.Error()))
+			}
+			if err := o.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(c, err.Error()))
+			}
+			kcmdutil.CheckErr(o.Run())
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Selector, "selector", "", "Label selector to filter nodes. Either pass one/more nodes as arguments or use this node selector")
+	flags.IntVar(&o.MaxUnavailable, "max-unavailable", o.MaxUnavailable, "The number of nodes maintained at the same time.")
+	flags.IntVar(&o.MaxNotReady, "max-not-ready", o.MaxNotReady, "Stop before a batch when more nodes than this are not Ready.")
+	flags.StringVar(&o.Command, "command", o.Command, "A shell command maintaining the nodes of a batch, named in the NODES environment variable. Without it, wait for the nodes to be annotated by an external tool.")
+	flags.StringVar(&o.HealthCommand, "health-command", o.HealthCommand, "A shell command checking the health of the cluster before each batch. The maintenance stops when it fails.")
+	flags.BoolVar(&o.WaitForReady, "wait-for-ready", o.WaitForReady, "Wait for the nodes to be Ready after their maintenance before uncordoning them.")
+	flags.BoolVar(&o.Force, "force", o.Force, "Delete pods not managed by a controller instead of stopping.")
+	flags.BoolVar(&o.DeleteLocalData, "delete-local-data", o.DeleteLocalData, "Continue even if there are pods using emptyDir (local data that will be deleted when the node is drained).")
+	flags.Int64Var(&o.GracePeriod, "grace-period", o.GracePeriod, "Period of time in seconds given to each pod to terminate gracefully. If negative, the default value specified in the pod will be used.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "How long to wait for the pods of a node to be evicted, for an external tool to maintain the nodes, for the nodes to be Ready and for the evicted pods to be rescheduled.")
+	flags.BoolVar(&o.Restart, "restart", o.Restart, "Forget the progress of a previous maintenance and maintain all of the selected nodes.")
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Show the batches the nodes would be maintained in, without changing anything.")
+
+	return cmd
+}
+
+// Complete sets up the node options without the printer flags, which maintain doesn't support.
+func (o *MaintainOptions) Complete(f *clientcmd.Factory, args []string, out, errout io.Writer) error {
+	defaultNamespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	_, kc, err := f.Clients()
+	if err != nil {
+		return err
+	}
+	mapper, typer := f.Object()
+
+	n := o.Options
+	n.DefaultNamespace = defaultNamespace
+	n.KubeClient = kc
+	n.Writer = out
+	n.ErrWriter = errout
+	n.Mapper = mapper
+	n.Typer = typer
+	n.RESTClientFactory = f.ClientForMapping
+	n.Printer = f.Printer
+	n.NodeNames = append([]string{}, args...)
+	return nil
+}
+
+func (o *MaintainOptions) Validate() error {
+	if o.MaxUnavailable < 1 {
+		return errors.New("--max-unavailable must be at least 1")
+	}
+	if o.MaxNotReady < 0 {
+		return errors.New("--max-not-ready must not be negative")
+	}
+	if o.Timeout <= 0 {
+		return errors.New("--timeout must be positive")
+	}
+	return nil
+}
+
+func (o *MaintainOptions) Run() error {
+	nodes, err := o.Options.GetNodes()
+	if err != nil {
+		return err
+	}
+	batches := o.batches(nodes)
+
+	if o.DryRun {
+		for i, batch := range batches {
+			fmt.Fprintf(o.Options.Writer, "batch %d: %s\n", i+1, strings.Join(nodeNames(batch), " "))
+		}
+		return nil
+	}
+
+	for i, batch := range batches {
+		fmt.Fprintf(o.Options.ErrWriter, "Maintaining batch %d/%d: %s\n", i+1, len(batches), strings.Join(nodeNames(batch), " "))
+		if err := o.runBatch(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runBatch drains, maintains and uncordons a batch of nodes, resuming from the phase recorded on each node.
+func (o *MaintainOptions) runBatch(batch []*kapi.Node) error {
+	client := o.Options.KubeClient
+	names := nodeNames(batch)
+
+	// the nodes of a resumed batch are already unavailable, only the other nodes are checked
+	exclude := sets.NewString()
+	for _, node := range batch {
+		if len(node.Annotations[MaintenancePhaseAnnotation]) > 0 {
+			exclude.Insert(names...)
+			break
+		}
+	}
+	if err := o.checkHealth(client, exclude); err != nil {
+		return fmt.Errorf("stopping the maintenance, the cluster is not healthy: %v", err)
+	}
+
+	controllers := []kapi.ObjectReference{}
+	for _, node := range batch {
+		phase := node.Annotations[MaintenancePhaseAnnotation]
+		if len(phase) > 0 && phase != MaintenancePhaseDraining {
+			// the node was drained by an earlier run, which recorded the controllers of the evicted pods
+			controllers = append(controllers, evictedControllers(node)...)
+			continue
+		}
+		if _, err := setNodeMaintenance(client, node.Name, MaintenancePhaseDraining, nil); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Options.ErrWriter, "node/%s cordoned\n", node.Name)
+		evicted, err := o.drain(client, node.Name)
+		if err != nil {
+			return fmt.Errorf("unable to drain node %s: %v", node.Name, err)
+		}
+		drained, err := setNodeMaintenance(client, node.Name, MaintenancePhaseMaintaining, evicted)
+		if err != nil {
+			return err
+		}
+		controllers = append(controllers, evictedControllers(drained)...)
+		fmt.Fprintf(o.Options.ErrWriter, "node/%s drained\n", node.Name)
+	}
+
+	if err := o.maintain(client, names); err != nil {
+		return err
+	}
+
+	if o.WaitForReady {
+		fmt.Fprintf(o.Options.ErrWriter, "Waiting for the nodes to be Ready ...\n")
+		if err := wait.PollImmediate(o.PollInterval, o.Timeout, func() (bool, error) {
+			for _, name := range names {
+				node, err := client.Core().Nodes().Get(name)
+				if err != nil {
+					return false, err
+				}
+				if !isNodeReady(node) {
+					return false, nil
+				}
+			}
+			return true, nil
+		}); err != nil {
+			return fmt.Errorf("the nodes did not become Ready: %v", err)
+		}
+	}
+
+	if err := o.waitForRescheduling(client, controllers); err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		node, err := setNodeMaintenance(client, name, MaintenancePhaseComplete, nil)
+		if err != nil {
+			return err
+		}
+		if node.Spec.Unschedulable {
+			fmt.Fprintf(o.Options.ErrWriter, "node/%s left unschedulable, as it was before the maintenance\n", name)
+			continue
+		}
+		fmt.Fprintf(o.Options.ErrWriter, "node/%s uncordoned\n", name)
+	}
+	return nil
+}
+
+// batches splits the nodes left to maintain in batches. The nodes whose maintenance is in progress come first.
+func (o *MaintainOptions) batches(nodes []*kapi.Node) [][]*kapi.Node {
+	inProgress, pending := []*kapi.Node{}, []*kapi.Node{}
+	for _, node := range nodes {
+		if o.Restart {
+			delete(node.Annotations, MaintenancePhaseAnnotation)
+		}
+		switch node.Annotations[MaintenancePhaseAnnotation] {
+		case MaintenancePhaseComplete:
+		case "":
+			pending = append(pending, node)
+		default:
+			inProgress = append(inProgress, node)
+		}
+	}
+	sort.Sort(nodesByName(inProgress))
+	sort.Sort(nodesByName(pending))
+
+	batches := [][]*kapi.Node{}
+	for _, nodes := range [][]*kapi.Node{inProgress, pending} {
+		for len(nodes) > 0 {
+			n := o.MaxUnavailable
+			if n > len(nodes) {
+				n = len(nodes)
+			}
+			batches = append(batches, nodes[:n])
+			nodes = nodes[n:]
+		}
+	}
+	return batches
+}
+
+// checkHealth runs the cluster health gates. The excluded nodes are not counted as not Ready.
+func (o *MaintainOptions) checkHealth(client kclientset.Interface, exclude sets.String) error {
+	nodes, err := client.Core().Nodes().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+	notReady := []string{}
+	for i := range nodes.Items {
+		if !exclude.Has(nodes.Items[i].Name) && !isNodeReady(&nodes.Items[i]) {
+			notReady = append(notReady, nodes.Items[i].Name)
+		}
+	}
+	if len(notReady) > o.MaxNotReady {
+		return fmt.Errorf("%d node(s) are not Ready: %s", len(notReady), strings.Join(notReady, ", "))
+	}
+
+	if len(o.HealthCommand) > 0 {
+		if err := o.RunHook(o.HealthCommand, nil); err != nil {
+			return fmt.Errorf("the health command failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// drain evicts the pods of a node and waits for them to be gone. It returns the controllers of the evicted pods.
+func (o *MaintainOptions) drain(client kclientset.Interface, nodeName string) ([]kapi.ObjectReference, error) {
+	pods, err := client.Core().Pods(kapi.NamespaceAll).List(kapi.ListOptions{FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName)})
+	if err != nil {
+		return nil, err
+	}
+
+	controllers := []kapi.ObjectReference{}
+	evict := []kapi.Pod{}
+	unmanaged := []string{}
+	localData := []string{}
+	for _, pod := range pods.Items {
+		if _, mirror := pod.Annotations[kubelettypes.ConfigMirrorAnnotationKey]; mirror {
+			continue
+		}
+		// any finished pod can be removed
+		if pod.Status.Phase == kapi.PodSucceeded || pod.Status.Phase == kapi.PodFailed {
+			evict = append(evict, pod)
+			continue
+		}
+		creator, err := podCreator(&pod)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case creator == nil:
+			if !o.Force {
+				unmanaged = append(unmanaged, pod.Namespace+"/"+pod.Name)
+				continue
+			}
+		case creator.Kind == "DaemonSet":
+			// the DaemonSet controller would recreate them on the unschedulable node anyway
+			continue
+		default:
+			controllers = append(controllers, *creator)
+		}
+		if !o.DeleteLocalData && hasLocalStorage(&pod) {
+			localData = append(localData, pod.Namespace+"/"+pod.Name)
+			continue
+		}
+		evict = append(evict, pod)
+	}
+	if len(unmanaged) > 0 {
+		return nil, fmt.Errorf("pods not managed by a controller would be lost, use --force to delete them: %s", strings.Join(unmanaged, ", "))
+	}
+	if len(localData) > 0 {
+		return nil, fmt.Errorf("the local data of pods using emptyDir volumes would be lost, use --delete-local-data to delete them: %s", strings.Join(localData, ", "))
+	}
+
+	var deleteOptions *kapi.DeleteOptions
+	if o.GracePeriod >= 0 {
+		deleteOptions = &kapi.DeleteOptions{GracePeriodSeconds: &o.GracePeriod}
+	}
+	for _, pod := range evict {
+		eviction := &policy.Eviction{
+			ObjectMeta:    kapi.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name},
+			DeleteOptions: deleteOptions,
+		}
+		// evictions are refused while they would violate a pod disruption budget
+		err := wait.PollImmediate(o.PollInterval, o.Timeout, func() (bool, error) {
+			err := client.Policy().Evictions(pod.Namespace).Evict(eviction)
+			switch {
+			case err == nil, kapierrors.IsNotFound(err):
+				return true, nil
+			case kapierrors.IsTooManyRequests(err):
+				glog.V(4).Infof("Eviction of pod %s/%s refused, retrying: %v", pod.Namespace, pod.Name, err)
+				return false, nil
+			default:
+				return false, err
+			}
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to evict pod %s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+
+	err = wait.PollImmediate(o.PollInterval, o.Timeout, func() (bool, error) {
+		for _, pod := range evict {
+			current, err := client.Core().Pods(pod.Namespace).Get(pod.Name)
+			if kapierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			if current.UID == pod.UID {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("the pods were not deleted: %v", err)
+	}
+	return controllers, nil
+}
+
+// maintain runs the maintenance hook of a batch, or waits for the external signal that the maintenance is done.
+func (o *MaintainOptions) maintain(client kclientset.Interface, names []string) error {
+	pending := []string{}
+	for _, name := range names {
+		node, err := client.Core().Nodes().Get(name)
+		if err != nil {
+			return err
+		}
+		if node.Annotations[MaintenancePhaseAnnotation] == MaintenancePhaseMaintaining {
+			pending = append(pending, name)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if len(o.Command) > 0 {
+		fmt.Fprintf(o.Options.ErrWriter, "Running the maintenance command ...\n")
+		if err := o.RunHook(o.Command, []string{"NODES=" + strings.Join(pending, " ")}); err != nil {
+			return fmt.Errorf("the maintenance command failed, the nodes are left cordoned: %v", err)
+		}
+		for _, name := range pending {
+			if _, err := setNodeMaintenance(client, name, MaintenancePhaseMaintained, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	fmt.Fprintf(o.Options.ErrWriter, "Waiting for the nodes to be annotated with %s=%s ...\n", MaintenancePhaseAnnotation, MaintenancePhaseMaintained)
+	err := wait.PollImmediate(o.PollInterval, o.Timeout, func() (bool, error) {
+		for _, name := range pending {
+			node, err := client.Core().Nodes().Get(name)
+			if err != nil {
+				return false, err
+			}
+			if node.Annotations[MaintenancePhaseAnnotation] != MaintenancePhaseMaintained {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("the nodes were not annotated with %s=%s within %v, they are left cordoned", MaintenancePhaseAnnotation, MaintenancePhaseMaintained, o.Timeout)
+	}
+	return err
+}
+
+// waitForRescheduling waits for the controllers of the evicted pods to have all of their replicas ready.
+func (o *MaintainOptions) waitForRescheduling(client kclientset.Interface, controllers []kapi.ObjectReference) error {
+	seen := sets.NewString()
+	for _, controller := range controllers {
+		key := controller.Kind + "/" + controller.Namespace + "/" + controller.Name
+		if seen.Has(key) {
+			continue
+		}
+		seen.Insert(key)
+
+		err := wait.PollImmediate(o.PollInterval, o.Timeout, func() (bool, error) {
+			switch controller.Kind {
+			case "ReplicationController":
+				rc, err := client.Core().ReplicationControllers(controller.Namespace).Get(controller.Name)
+				if kapierrors.IsNotFound(err) {
+					return true, nil
+				}
+				if err != nil {
+					return false, err
+				}
+				return rc.Status.ReadyReplicas >= rc.Spec.Replicas, nil
+			case "ReplicaSet":
+				rs, err := client.Extensions().ReplicaSets(controller.Namespace).Get(controller.Name)
+				if kapierrors.IsNotFound(err) {
+					return true, nil
+				}
+				if err != nil {
+					return false, err
+				}
+				return rs.Status.ReadyReplicas >= rs.Spec.Replicas, nil
+			}
+			// other controllers don't report ready replicas
+			return true, nil
+		})
+		if err != nil {
+			return fmt.Errorf("the pods of %s %s/%s were not rescheduled: %v", controller.Kind, controller.Namespace, controller.Name, err)
+		}
+	}
+	return nil
+}
+
+// setNodeMaintenance sets the maintenance phase of a node and adds the evicted controllers to the ones recorded on
+// it. The node is unschedulable until the maintenance is complete, then its schedulability from before the
+// maintenance is restored. It returns the updated node.
+func setNodeMaintenance(client kclientset.Interface, name string, phase string, evicted []kapi.ObjectReference) (*kapi.Node, error) {
+	var updated *kapi.Node
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		node, err := client.Core().Nodes().Get(name)
+		if err != nil {
+			return err
+		}
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		if _, recorded := node.Annotations[MaintenanceUnschedulableAnnotation]; !recorded {
+			node.Annotations[MaintenanceUnschedulableAnnotation] = strconv.FormatBool(node.Spec.Unschedulable)
+		}
+		if len(evicted) > 0 {
+			controllers := append(evictedControllers(node), evicted...)
+			keys := sets.NewString()
+			for _, controller := range controllers {
+				keys.Insert(controller.Kind + "/" + controller.Namespace + "/" + controller.Name)
+			}
+			node.Annotations[MaintenanceEvictedControllersAnnotation] = strings.Join(keys.List(), ",")
+		}
+		node.Annotations[MaintenancePhaseAnnotation] = phase
+		node.Spec.Unschedulable = true
+		if phase == MaintenancePhaseComplete {
+			node.Spec.Unschedulable = node.Annotations[MaintenanceUnschedulableAnnotation] == "true"
+			delete(node.Annotations, MaintenanceUnschedulableAnnotation)
+			delete(node.Annotations, MaintenanceEvictedControllersAnnotation)
+		}
+		updated, err = client.Core().Nodes().Update(node)
+		return err
+	})
+	return updated, err
+}
+
+// evictedControllers returns the controllers of the pods evicted from a node recorded by setNodeMaintenance.
+func evictedControllers(node *kapi.Node) []kapi.ObjectReference {
+	controllers := []kapi.ObjectReference{}
+	for _, key := range strings.Split(node.Annotations[MaintenanceEvictedControllersAnnotation], ",") {
+		parts := strings.SplitN(key, "/", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		controllers = append(controllers, kapi.ObjectReference{Kind: parts[0], Namespace: parts[1], Name: parts[2]})
+	}
+	return controllers
+}
+
+// podCreator returns the controller that created a pod, or nil for bare pods.
+func podCreator(pod *kapi.Pod) (*kapi.ObjectReference, error) {
+	creatorRef, found := pod.Annotations[kapi.CreatedByAnnotation]
+	if !found {
+		return nil, nil
+	}
+	sr := &kapi.SerializedReference{}
+	if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), []byte(creatorRef), sr); err != nil {
+		return nil, fmt.Errorf("unable to read the creator of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	return &sr.Reference, nil
+}
+
+// hasLocalStorage returns true if the pod uses emptyDir volumes, whose data is deleted with the pod.
+func hasLocalStorage(pod *kapi.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isNodeReady(node *kapi.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == kapi.NodeReady {
+			return condition.Status == kapi.ConditionTrue
+		}
+	}
+	return false
+}
+
+func nodeNames(nodes []*kapi.Node) []string {
+	names := []string{}
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	return names
+}
+
+type nodesByName []*kapi.Node
+
+func (n nodesByName) Len() int           { return len(n) }
+func (n nodesByName) Swap(i, j int)      { n[i], n[j] = n[j], n[i] }
+func (n nodesByName) Less(i, j int) bool { return n[i].Name < n[j].Name }
+
+func runShellCommand(command string, env []string) error {
+	cmd := exec.Command("/bin/sh", "-c", command)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,351 @@
+package node
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+	"github.com/openshift/kubernetes/pkg/fields"
+	kubelettypes "github.com/openshift/kubernetes/pkg/kubelet/types"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+)
+
+func TestMaintainFlags(t *testing.T) {
+	defaults := NewMaintainOptions(nil)
+
+	tests := map[string]struct {
+		flagName   string
+		defaultVal string
+	}{
+		"max unavailable": {
+			flagName:   "max-unavailable",
+			defaultVal: strconv.Itoa(defaults.MaxUnavailable),
+		},
+		"wait for ready": {
+			flagName:   "wait-for-ready",
+			defaultVal: strconv.FormatBool(defaults.WaitForReady),
+		},
+		"timeout": {
+			flagName:   "timeout",
+			defaultVal: defaults.Timeout.String(),
+		},
+		"delete local data": {
+			flagName:   "delete-local-data",
+			defaultVal: strconv.FormatBool(defaults.DeleteLocalData),
+		},
+	}
+
+	cmd := NewCmdMaintain(MaintainRecommendedName, MaintainRecommendedName, nil, nil, nil)
+	for _, v := range tests {
+		testFlag(cmd, v.flagName, v.defaultVal, t)
+	}
+}
+
+func maintenanceNode(name, phase string) *kapi.Node {
+	node := &kapi.Node{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Annotations: map[string]string{}},
+		Status: kapi.NodeStatus{
+			Conditions: []kapi.NodeCondition{{Type: kapi.NodeReady, Status: kapi.ConditionTrue}},
+		},
+	}
+	if len(phase) > 0 {
+		node.Annotations[MaintenancePhaseAnnotation] = phase
+		node.Spec.Unschedulable = phase != MaintenancePhaseComplete
+	}
+	return node
+}
+
+func createdBy(kind, name string) string {
+	return `{"kind":"SerializedReference","apiVersion":"v1","reference":{"kind":"` + kind + `","namespace":"test","name":"` + name + `"}}`
+}
+
+func TestMaintainBatches(t *testing.T) {
+	nodes := []*kapi.Node{
+		maintenanceNode("d", ""),
+		maintenanceNode("c", MaintenancePhaseComplete),
+		maintenanceNode("b", ""),
+		maintenanceNode("e", MaintenancePhaseMaintaining),
+		maintenanceNode("a", ""),
+	}
+
+	o := NewMaintainOptions(&NodeOptions{})
+	o.MaxUnavailable = 2
+	batches := o.batches(nodes)
+	expected := [][]string{{"e"}, {"a", "b"}, {"d"}}
+	actual := [][]string{}
+	for _, batch := range batches {
+		actual = append(actual, nodeNames(batch))
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected batches %v, got %v", expected, actual)
+	}
+
+	o.Restart = true
+	actual = [][]string{}
+	for _, batch := range o.batches(nodes) {
+		actual = append(actual, nodeNames(batch))
+	}
+	expected = [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected batches %v after a restart, got %v", expected, actual)
+	}
+}
+
+func TestMaintainRun(t *testing.T) {
+	pods := []kapi.Pod{
+		{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "web-1", UID: "1", Annotations: map[string]string{kapi.CreatedByAnnotation: createdBy("ReplicationController", "web")}},
+			Spec:       kapi.PodSpec{NodeName: "node-1"},
+			Status:     kapi.PodStatus{Phase: kapi.PodRunning},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "logger-1", UID: "2", Annotations: map[string]string{kapi.CreatedByAnnotation: createdBy("DaemonSet", "logger")}},
+			Spec:       kapi.PodSpec{NodeName: "node-1"},
+			Status:     kapi.PodStatus{Phase: kapi.PodRunning},
+		},
+		{
+			ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "static-1", UID: "3", Annotations: map[string]string{kubelettypes.ConfigMirrorAnnotationKey: "mirror"}},
+			Spec:       kapi.PodSpec{NodeName: "node-1"},
+			Status:     kapi.PodStatus{Phase: kapi.PodRunning},
+		},
+	}
+	rc := &kapi.ReplicationController{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "web"},
+		Spec:       kapi.ReplicationControllerSpec{Replicas: 1},
+		Status:     kapi.ReplicationControllerStatus{Replicas: 1, ReadyReplicas: 1},
+	}
+	nodes := []*kapi.Node{
+		maintenanceNode("node-1", ""),
+		maintenanceNode("node-2", ""),
+		maintenanceNode("node-3", MaintenancePhaseComplete),
+	}
+
+	client := fake.NewSimpleClientset(nodes[0], nodes[1], nodes[2], rc)
+	client.PrependReactor("list", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		list := &kapi.PodList{}
+		for _, pod := range pods {
+			if action.(core.ListAction).GetListRestrictions().Fields.Matches(podFields(&pod)) {
+				list.Items = append(list.Items, pod)
+			}
+		}
+		return true, list, nil
+	})
+	evictions := 0
+	client.PrependReactor("post", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		evictions++
+		// the first eviction is refused by a disruption budget
+		if evictions == 1 {
+			return true, nil, kapierrors.NewGenericServerResponse(kapierrors.StatusTooManyRequests, "post", unversioned.GroupResource{Resource: "pods"}, "web-1", "", 0, false)
+		}
+		return true, nil, nil
+	})
+	client.PrependReactor("get", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		name := action.(core.GetAction).GetName()
+		if name == "web-1" && evictions > 1 {
+			return true, nil, kapierrors.NewNotFound(kapi.Resource("pods"), name)
+		}
+		for i := range pods {
+			if pods[i].Name == name {
+				return true, &pods[i], nil
+			}
+		}
+		return true, nil, kapierrors.NewNotFound(kapi.Resource("pods"), name)
+	})
+
+	hooks := [][]string{}
+	o := NewMaintainOptions(&NodeOptions{
+		KubeClient: client,
+		Writer:     ioutil.Discard,
+		ErrWriter:  ioutil.Discard,
+	})
+	o.Command = "update"
+	o.PollInterval = time.Millisecond
+	o.Timeout = time.Second
+	o.RunHook = func(command string, env []string) error {
+		hooks = append(hooks, env)
+		// the node of the batch is cordoned and drained while the hook runs
+		for _, node := range nodes[:2] {
+			current, err := client.Core().Nodes().Get(node.Name)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if "NODES="+node.Name == env[0] && (!current.Spec.Unschedulable || current.Annotations[MaintenancePhaseAnnotation] != MaintenancePhaseMaintaining) {
+				t.Errorf("expected node %s to be cordoned and drained during its maintenance, got %#v", node.Name, current)
+			}
+		}
+		return nil
+	}
+
+	batches := o.batches(nodes)
+	if len(batches) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(batches))
+	}
+	for _, batch := range batches {
+		if err := o.runBatch(batch); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if expected := [][]string{{"NODES=node-1"}, {"NODES=node-2"}}; !reflect.DeepEqual(expected, hooks) {
+		t.Errorf("expected the hooks to run with %v, got %v", expected, hooks)
+	}
+	if evictions != 2 {
+		t.Errorf("expected web-1 to be evicted after a retry, got %d evictions", evictions)
+	}
+	for _, node := range nodes {
+		current, err := client.Core().Nodes().Get(node.Name)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if current.Spec.Unschedulable || current.Annotations[MaintenancePhaseAnnotation] != MaintenancePhaseComplete {
+			t.Errorf("expected node %s to be schedulable and complete, got %#v", node.Name, current)
+		}
+		if _, ok := current.Annotations[MaintenanceEvictedControllersAnnotation]; ok {
+			t.Errorf("expected the evicted controllers of node %s to be forgotten, got %#v", node.Name, current.Annotations)
+		}
+	}
+}
+
+func TestMaintainKeepsUnschedulableNodes(t *testing.T) {
+	node := maintenanceNode("node-1", "")
+	node.Spec.Unschedulable = true
+	client := fake.NewSimpleClientset(node)
+
+	o := NewMaintainOptions(&NodeOptions{KubeClient: client, Writer: ioutil.Discard, ErrWriter: ioutil.Discard})
+	o.Command = "update"
+	o.PollInterval = time.Millisecond
+	o.Timeout = 10 * time.Millisecond
+	o.RunHook = func(command string, env []string) error { return nil }
+
+	if err := o.runBatch([]*kapi.Node{node}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current, err := client.Core().Nodes().Get("node-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !current.Spec.Unschedulable || current.Annotations[MaintenancePhaseAnnotation] != MaintenancePhaseComplete {
+		t.Errorf("expected the node to be complete and left unschedulable, got %#v", current)
+	}
+	if _, ok := current.Annotations[MaintenanceUnschedulableAnnotation]; ok {
+		t.Errorf("expected the recorded schedulability to be removed, got %#v", current.Annotations)
+	}
+}
+
+func TestMaintainResumedBatchRescheduling(t *testing.T) {
+	resumed := maintenanceNode("node-1", MaintenancePhaseMaintained)
+	resumed.Annotations[MaintenanceUnschedulableAnnotation] = "false"
+	resumed.Annotations[MaintenanceEvictedControllersAnnotation] = "ReplicationController/test/web"
+	rc := &kapi.ReplicationController{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "web"},
+		Spec:       kapi.ReplicationControllerSpec{Replicas: 1},
+	}
+	client := fake.NewSimpleClientset(resumed, rc)
+
+	o := NewMaintainOptions(&NodeOptions{KubeClient: client, Writer: ioutil.Discard, ErrWriter: ioutil.Discard})
+	o.PollInterval = time.Millisecond
+	o.Timeout = 10 * time.Millisecond
+
+	// the pods evicted before the interruption are not ready yet, so the node stays cordoned
+	if err := o.runBatch([]*kapi.Node{resumed}); err == nil || !strings.Contains(err.Error(), "test/web") {
+		t.Errorf("expected the resumed batch to wait for the pods of test/web, got %v", err)
+	}
+	current, err := client.Core().Nodes().Get("node-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !current.Spec.Unschedulable {
+		t.Errorf("expected the node to be left cordoned")
+	}
+}
+
+func TestMaintainDrainLocalData(t *testing.T) {
+	pod := kapi.Pod{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "cache-1", UID: "1", Annotations: map[string]string{kapi.CreatedByAnnotation: createdBy("ReplicationController", "cache")}},
+		Spec: kapi.PodSpec{
+			NodeName: "node-1",
+			Volumes:  []kapi.Volume{{Name: "data", VolumeSource: kapi.VolumeSource{EmptyDir: &kapi.EmptyDirVolumeSource{}}}},
+		},
+		Status: kapi.PodStatus{Phase: kapi.PodRunning},
+	}
+	client := fake.NewSimpleClientset(&kapi.PodList{Items: []kapi.Pod{pod}})
+	evicted := false
+	client.PrependReactor("post", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		evicted = evicted || action.GetSubresource() == "eviction"
+		return true, nil, nil
+	})
+
+	o := NewMaintainOptions(&NodeOptions{KubeClient: client, Writer: ioutil.Discard, ErrWriter: ioutil.Discard})
+	o.PollInterval = time.Millisecond
+	o.Timeout = 10 * time.Millisecond
+	if _, err := o.drain(client, "node-1"); err == nil || !strings.Contains(err.Error(), "--delete-local-data") {
+		t.Errorf("expected the drain to stop on a pod with local data, got %v", err)
+	}
+	if evicted {
+		t.Errorf("expected no pod to be evicted without --delete-local-data")
+	}
+
+	o.DeleteLocalData = true
+	o.drain(client, "node-1")
+	if !evicted {
+		t.Errorf("expected the pod to be evicted with --delete-local-data")
+	}
+}
+
+func TestMaintainResumedBatchHealth(t *testing.T) {
+	resumed := maintenanceNode("node-1", MaintenancePhaseMaintained)
+	resumed.Status.Conditions[0].Status = kapi.ConditionFalse
+	other := maintenanceNode("node-2", "")
+	other.Status.Conditions[0].Status = kapi.ConditionFalse
+	client := fake.NewSimpleClientset(resumed, other)
+
+	o := NewMaintainOptions(&NodeOptions{KubeClient: client, Writer: ioutil.Discard, ErrWriter: ioutil.Discard})
+	o.PollInterval = time.Millisecond
+	o.Timeout = 10 * time.Millisecond
+	healthChecked := false
+	o.HealthCommand = "check"
+	o.RunHook = func(command string, env []string) error {
+		healthChecked = healthChecked || command == "check"
+		return nil
+	}
+
+	// the other node is not Ready, so the resumed batch is not finished
+	if err := o.runBatch([]*kapi.Node{resumed}); err == nil || !strings.Contains(err.Error(), "node-2") {
+		t.Errorf("expected the health check to stop the resumed batch on node-2, got %v", err)
+	}
+
+	o.MaxNotReady = 1
+	if err := o.checkHealth(client, sets.NewString("node-1")); err != nil {
+		t.Errorf("expected the nodes of the resumed batch not to be counted, got %v", err)
+	}
+	if !healthChecked {
+		t.Errorf("expected the health command to run")
+	}
+}
+
+func TestMaintainAnnotationTimeout(t *testing.T) {
+	client := fake.NewSimpleClientset(maintenanceNode("node-1", MaintenancePhaseMaintaining))
+	o := NewMaintainOptions(&NodeOptions{KubeClient: client, Writer: ioutil.Discard, ErrWriter: ioutil.Discard})
+	o.PollInterval = time.Millisecond
+	o.Timeout = 10 * time.Millisecond
+
+	if err := o.maintain(client, []string{"node-1"}); err == nil || !strings.Contains(err.Error(), "left cordoned") {
+		t.Errorf("expected waiting for the annotation to time out, got %v", err)
+	}
+}
+
+func podFields(pod *kapi.Pod) fields.Set {
+	return fields.Set{"spec.nodeName": pod.Spec.NodeName}
+}
@@ -0,0 +1,161 @@
+package project
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+)
+
+const (
+	// BackupFormatVersion is the version of the archive layout written by backup. Restore
+	// refuses archives with a version it does not understand.
+	BackupFormatVersion = "v1"
+
+	// backupManifestFile and backupProjectFile are always present in an archive.
+	backupManifestFile = "manifest.json"
+	backupProjectFile  = "project.json"
+)
+
+// BackupResourceOrder is the order resources are written in and recreated in on restore.
+// Objects that others depend on (quota, service accounts, secrets, policy, claims, image
+// streams) come before the objects that consume them.
+var BackupResourceOrder = []string{
+	"limitranges",
+	"resourcequotas",
+	"serviceaccounts",
+	"secrets",
+	"configmaps",
+	"roles",
+	"rolebindings",
+	"persistentvolumeclaims",
+	"imagestreams",
+	"templates",
+	"services",
+	"buildconfigs",
+	"deploymentconfigs",
+	"deployments",
+	"statefulsets",
+	"replicationcontrollers",
+	"jobs",
+	"horizontalpodautoscalers",
+	"routes",
+}
+
+// BackupManifest describes the contents of a project backup archive.
+type BackupManifest struct {
+	// FormatVersion is the archive layout version, see BackupFormatVersion.
+	FormatVersion string `json:"formatVersion"`
+	// Project is the name of the project that was backed up.
+	Project string `json:"project"`
+	// Created is when the backup was taken.
+	Created time.Time `json:"created"`
+	// Resources lists the files in the archive in the order they must be restored.
+	Resources []BackupResource `json:"resources"`
+}
+
+// BackupResource describes a single file in the archive holding a list of one resource type.
+type BackupResource struct {
+	Resource string `json:"resource"`
+	File     string `json:"file"`
+	Count    int    `json:"count"`
+}
+
+// backupFileName returns the archive file name for the resource at the given position.
+func backupFileName(index int, resource string) string {
+	return fmt.Sprintf("%02d-%s.json", index, resource)
+}
+
+// archiveWriter writes files into a gzipped tar stream.
+type archiveWriter struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func newArchiveWriter(w io.Writer) *archiveWriter {
+	gz := gzip.NewWriter(w)
+	return &archiveWriter{gz: gz, tw: tar.NewWriter(gz)}
+}
+
+// WriteFile adds a single file with the provided contents to the archive.
+func (a *archiveWriter) WriteFile(name string, data []byte) error {
+	header := &tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(data)),
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}
+	if err := a.tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := a.tw.Write(data)
+	return err
+}
+
+// WriteManifest serializes the manifest into the archive.
+func (a *archiveWriter) WriteManifest(manifest *BackupManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return a.WriteFile(backupManifestFile, data)
+}
+
+// Close flushes the tar and gzip streams.
+func (a *archiveWriter) Close() error {
+	if err := a.tw.Close(); err != nil {
+		return err
+	}
+	return a.gz.Close()
+}
+
+// readArchive loads all files of a backup archive into memory and validates the manifest.
+func readArchive(r io.Reader) (*BackupManifest, map[string][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("backup archive is not a gzipped tar file: %v", err)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, nil, err
+		}
+		files[header.Name] = data
+	}
+
+	data, ok := files[backupManifestFile]
+	if !ok {
+		return nil, nil, fmt.Errorf("backup archive has no %s", backupManifestFile)
+	}
+	manifest := &BackupManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, nil, fmt.Errorf("unable to read backup manifest: %v", err)
+	}
+	if manifest.FormatVersion != BackupFormatVersion {
+		return nil, nil, fmt.Errorf("backup archive format %q is not supported, expected %q", manifest.FormatVersion, BackupFormatVersion)
+	}
+	for _, resource := range manifest.Resources {
+		if _, ok := files[resource.File]; !ok {
+			return nil, nil, fmt.Errorf("backup archive is missing %s listed in the manifest", resource.File)
+		}
+	}
+	return manifest, files, nil
+}
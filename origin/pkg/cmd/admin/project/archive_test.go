@@ -0,0 +1,86 @@
+package project
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := newArchiveWriter(buf)
+	if err := w.WriteFile(backupProjectFile, []byte(`{"kind":"Project"}`)); err != nil {
+		t.Fatal(err)
+	}
+	file := backupFileName(3, "secrets")
+	if err := w.WriteFile(file, []byte(`{"kind":"List"}`)); err != nil {
+		t.Fatal(err)
+	}
+	manifest := &BackupManifest{
+		FormatVersion: BackupFormatVersion,
+		Project:       "test",
+		Created:       time.Now().UTC(),
+		Resources:     []BackupResource{{Resource: "secrets", File: file, Count: 1}},
+	}
+	if err := w.WriteManifest(manifest); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	read, files, err := readArchive(buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if read.Project != "test" || len(read.Resources) != 1 || read.Resources[0].File != "03-secrets.json" {
+		t.Errorf("unexpected manifest: %#v", read)
+	}
+	if string(files[file]) != `{"kind":"List"}` {
+		t.Errorf("unexpected file contents: %q", files[file])
+	}
+}
+
+func TestReadArchiveErrors(t *testing.T) {
+	testCases := map[string]struct {
+		manifest *BackupManifest
+		expected string
+	}{
+		"no manifest": {
+			expected: "has no manifest.json",
+		},
+		"unknown version": {
+			manifest: &BackupManifest{FormatVersion: "v0", Project: "test"},
+			expected: `format "v0" is not supported`,
+		},
+		"missing file": {
+			manifest: &BackupManifest{
+				FormatVersion: BackupFormatVersion,
+				Project:       "test",
+				Resources:     []BackupResource{{Resource: "routes", File: "16-routes.json"}},
+			},
+			expected: "missing 16-routes.json",
+		},
+	}
+	for name, tc := range testCases {
+		buf := &bytes.Buffer{}
+		w := newArchiveWriter(buf)
+		if tc.manifest != nil {
+			if err := w.WriteManifest(tc.manifest); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		_, _, err := readArchive(buf)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("%s: expected error containing %q, got %v", name, tc.expected, err)
+		}
+	}
+
+	if _, _, err := readArchive(strings.NewReader("not an archive")); err == nil {
+		t.Errorf("expected error for invalid archive")
+	}
+}
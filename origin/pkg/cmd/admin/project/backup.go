@@ -0,0 +1,239 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift/github.com/spf13/cobra"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/api/meta"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/openshift/kubernetes/pkg/kubectl/resource"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/cli/cmd"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	projectapiv1 "github.com/openshift/origin/pkg/project/api/v1"
+)
+
+const BackupRecommendedName = "backup"
+
+var (
+	backupLong = templates.LongDesc(`
+		Back up the resources of a project into an archive
+
+		Every exportable resource in the project is written into a versioned, gzipped tar
+		archive in the order it needs to be recreated: quota and limits, service accounts,
+		secrets and config maps, roles and role bindings, persistent volume claims, image
+		streams, and finally the application objects that depend on them.
+
+		Objects are cleaned the same way 'export' does. Secrets holding service account
+		tokens and the dockercfg secrets generated for service accounts are omitted because
+		they are regenerated when the service account is recreated, image streams keep only
+		their spec, and persistent volume claims are unbound from their volume. Replication
+		controllers owned by a deployment config are left to the deployment config.
+
+		Use 'restore' to recreate the project from the archive.`)
+
+	backupExample = templates.Examples(`
+		# Back up the project 'myproject' into myproject.tar.gz
+	  %[1]s myproject --file=myproject.tar.gz`)
+)
+
+// BackupOptions holds the options for backing up a project.
+type BackupOptions struct {
+	Project  string
+	Filename string
+
+	Client   client.Interface
+	Exporter cmd.Exporter
+	Mapper   meta.RESTMapper
+	Typer    runtime.ObjectTyper
+	// ClientMapper returns a client for each resource type being backed up.
+	ClientMapper resource.ClientMapper
+	Decoder      runtime.Decoder
+
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// NewCmdBackup implements the backup command.
+func NewCmdBackup(name, fullName string, f *clientcmd.Factory, out, errout io.Writer) *cobra.Command {
+	o := &BackupOptions{Out: out, ErrOut: errout}
+	cmd := &cobra.Command{
+		Use:     name + " PROJECT --file=ARCHIVE",
+		Short:   "Back up the resources of a project into an archive",
+		Long:    backupLong,
+		Example: fmt.Sprintf(backupExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			kcmdutil.CheckErr(o.Complete(f, c, args))
+			kcmdutil.CheckErr(o.Validate())
+			kcmdutil.CheckErr(o.Run())
+		},
+	}
+	cmd.Flags().StringVarP(&o.Filename, "file", "f", "", "The archive file to write. Use '-' to write to standard output.")
+	cmd.MarkFlagFilename("file")
+	return cmd
+}
+
+func (o *BackupOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return kcmdutil.UsageError(c, "you must specify the project to back up")
+	}
+	o.Project = args[0]
+
+	var err error
+	if o.Client, _, err = f.Clients(); err != nil {
+		return err
+	}
+	o.Mapper, o.Typer = f.Object()
+	o.ClientMapper = resource.ClientMapperFunc(f.ClientForMapping)
+	o.Decoder = f.Decoder(true)
+	o.Exporter = &cmd.DefaultExporter{}
+	return nil
+}
+
+func (o *BackupOptions) Validate() error {
+	if len(o.Project) == 0 {
+		return errors.New("a project name is required")
+	}
+	if len(o.Filename) == 0 {
+		return errors.New("--file is required")
+	}
+	return nil
+}
+
+// Run writes the archive.
+func (o *BackupOptions) Run() error {
+	project, err := o.Client.Projects().Get(o.Project)
+	if err != nil {
+		return err
+	}
+	if err := o.Exporter.Export(project, false); err != nil {
+		return err
+	}
+
+	out := o.Out
+	if o.Filename != "-" {
+		f, err := os.Create(o.Filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	archive := newArchiveWriter(out)
+
+	data, err := runtime.Encode(kapi.Codecs.LegacyCodec(projectapiv1.SchemeGroupVersion), project)
+	if err != nil {
+		return err
+	}
+	if err := archive.WriteFile(backupProjectFile, data); err != nil {
+		return err
+	}
+
+	manifest := &BackupManifest{
+		FormatVersion: BackupFormatVersion,
+		Project:       o.Project,
+		Created:       time.Now().UTC(),
+	}
+	for i, resourceName := range BackupResourceOrder {
+		infos, err := o.exportResource(resourceName)
+		if err != nil {
+			return fmt.Errorf("unable to back up %s: %v", resourceName, err)
+		}
+		if len(infos) == 0 {
+			continue
+		}
+		gv := infos[0].Mapping.GroupVersionKind.GroupVersion()
+		list, err := resource.AsVersionedObject(infos, true, gv, kapi.Codecs.LegacyCodec(gv))
+		if err != nil {
+			return err
+		}
+		data, err := runtime.Encode(kapi.Codecs.LegacyCodec(gv), list)
+		if err != nil {
+			return err
+		}
+		file := backupFileName(i, resourceName)
+		if err := archive.WriteFile(file, data); err != nil {
+			return err
+		}
+		manifest.Resources = append(manifest.Resources, BackupResource{Resource: resourceName, File: file, Count: len(infos)})
+		fmt.Fprintf(o.ErrOut, "Backed up %d %s\n", len(infos), resourceName)
+	}
+
+	if err := archive.WriteManifest(manifest); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// exportResource lists every object of the named resource in the project and cleans it
+// for recreation. Resources the server does not know about are skipped.
+func (o *BackupOptions) exportResource(resourceName string) ([]*resource.Info, error) {
+	infos, err := resource.NewBuilder(o.Mapper, o.Typer, o.ClientMapper, o.Decoder).
+		NamespaceParam(o.Project).
+		ResourceTypeOrNameArgs(true, resourceName).
+		Flatten().
+		Do().Infos()
+	if err != nil {
+		if kerrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			glog.V(4).Infof("Skipping %s, not available on the server: %v", resourceName, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	exported := []*resource.Info{}
+	for _, info := range infos {
+		if !includeInBackup(info.Object) {
+			continue
+		}
+		if err := o.Exporter.Export(info.Object, false); err != nil {
+			if err == cmd.ErrExportOmit {
+				continue
+			}
+			return nil, err
+		}
+		prepareForBackup(info.Object)
+		exported = append(exported, info)
+	}
+	return exported, nil
+}
+
+// includeInBackup returns false for objects that are recreated by a controller from
+// another object in the backup.
+func includeInBackup(obj runtime.Object) bool {
+	switch t := obj.(type) {
+	case *kapi.ReplicationController:
+		if len(t.Annotations[deployapi.DeploymentConfigAnnotation]) > 0 {
+			return false
+		}
+	case *kapi.Secret:
+		if t.Type == kapi.SecretTypeServiceAccountToken || len(t.Annotations[kapi.ServiceAccountUIDKey]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// prepareForBackup clears fields that tie an object to the cluster it was taken from
+// and that export does not already handle.
+func prepareForBackup(obj runtime.Object) {
+	switch t := obj.(type) {
+	case *kapi.PersistentVolumeClaim:
+		// the volume the claim was bound to belongs to the old claim; let the binder pick again
+		t.Spec.VolumeName = ""
+		delete(t.Annotations, "pv.kubernetes.io/bind-completed")
+		delete(t.Annotations, "pv.kubernetes.io/bound-by-controller")
+	}
+}
@@ -0,0 +1,33 @@
+package project
+
+import (
+	"io"
+
+	"github.com/openshift/github.com/spf13/cobra"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const ProjectRecommendedName = "project"
+
+var projectLong = templates.LongDesc(`
+	Manage the contents of projects
+
+	These commands help administrators move whole projects around, for instance to back
+	them up before an upgrade or to copy them into another project.`)
+
+// NewCmdProject is the parent command for project maintenance.
+func NewCmdProject(name, fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	cmds := &cobra.Command{
+		Use:   name,
+		Short: "Manage the contents of projects",
+		Long:  projectLong,
+		Run:   kcmdutil.DefaultSubCommandRun(errout),
+	}
+
+	cmds.AddCommand(NewCmdBackup(BackupRecommendedName, fullName+" "+BackupRecommendedName, f, out, errout))
+	cmds.AddCommand(NewCmdRestore(RestoreRecommendedName, fullName+" "+RestoreRecommendedName, f, in, out, errout))
+	return cmds
+}
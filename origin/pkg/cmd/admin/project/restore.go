@@ -0,0 +1,317 @@
+package project
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/openshift/github.com/spf13/cobra"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/api/meta"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/openshift/kubernetes/pkg/kubectl/resource"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/serviceaccount"
+
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+	"github.com/openshift/origin/pkg/security"
+)
+
+const RestoreRecommendedName = "restore"
+
+// Values accepted by --on-conflict.
+const (
+	ConflictSkip      = "skip"
+	ConflictOverwrite = "overwrite"
+	ConflictFail      = "fail"
+)
+
+var (
+	restoreLong = templates.LongDesc(`
+		Restore a project from an archive created by 'backup'
+
+		Objects are recreated in the order they were written to the archive. By default they
+		are restored into the project they were taken from; use --to-project to restore into
+		a different project. Role bindings referring to the original project's roles and
+		service accounts are rewritten to refer to the new project. The project is created if
+		it does not exist.
+
+		When an object already exists, --on-conflict decides what happens: 'skip' leaves the
+		existing object alone, 'overwrite' replaces it with the archived copy, and 'fail'
+		stops the restore.
+
+		By default the restore only reports what it would do. Pass --confirm to make changes.`)
+
+	restoreExample = templates.Examples(`
+		# Show what restoring myproject.tar.gz would do
+	  %[1]s --file=myproject.tar.gz
+
+	  # Restore the archive into a new project called 'myproject-copy'
+	  %[1]s --file=myproject.tar.gz --to-project=myproject-copy --confirm`)
+)
+
+// RestoreOptions holds the options for restoring a project.
+type RestoreOptions struct {
+	Filename   string
+	ToProject  string
+	OnConflict string
+	Confirm    bool
+
+	Client       client.Interface
+	Mapper       meta.RESTMapper
+	Typer        runtime.ObjectTyper
+	ClientMapper resource.ClientMapper
+	Decoder      runtime.Decoder
+
+	In     io.Reader
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// RestoreResult is the outcome for a single object in the archive.
+type RestoreResult struct {
+	Resource string
+	Name     string
+	Action   string
+	Err      error
+}
+
+// NewCmdRestore implements the restore command.
+func NewCmdRestore(name, fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	o := &RestoreOptions{In: in, Out: out, ErrOut: errout, OnConflict: ConflictSkip}
+	cmd := &cobra.Command{
+		Use:     name + " --file=ARCHIVE [--to-project=NAME]",
+		Short:   "Restore a project from an archive",
+		Long:    restoreLong,
+		Example: fmt.Sprintf(restoreExample, fullName),
+		Run: func(c *cobra.Command, args []string) {
+			kcmdutil.CheckErr(o.Complete(f, c, args))
+			kcmdutil.CheckErr(o.Validate())
+			kcmdutil.CheckErr(o.Run())
+		},
+	}
+	cmd.Flags().StringVarP(&o.Filename, "file", "f", "", "The archive to restore from. Use '-' to read from standard input.")
+	cmd.MarkFlagFilename("file")
+	cmd.Flags().StringVar(&o.ToProject, "to-project", "", "Restore into this project instead of the one the archive was taken from.")
+	cmd.Flags().StringVar(&o.OnConflict, "on-conflict", o.OnConflict, "What to do when an object already exists: skip, overwrite or fail.")
+	cmd.Flags().BoolVar(&o.Confirm, "confirm", false, "If true, create the objects. Defaults to false, reporting what would be restored.")
+	return cmd
+}
+
+func (o *RestoreOptions) Complete(f *clientcmd.Factory, c *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return kcmdutil.UsageError(c, "restore takes no arguments, pass the archive with --file")
+	}
+	var err error
+	if o.Client, _, err = f.Clients(); err != nil {
+		return err
+	}
+	o.Mapper, o.Typer = f.Object()
+	o.ClientMapper = resource.ClientMapperFunc(f.ClientForMapping)
+	o.Decoder = f.Decoder(true)
+	return nil
+}
+
+func (o *RestoreOptions) Validate() error {
+	if len(o.Filename) == 0 {
+		return errors.New("--file is required")
+	}
+	switch o.OnConflict {
+	case ConflictSkip, ConflictOverwrite, ConflictFail:
+	default:
+		return fmt.Errorf("--on-conflict must be one of %s, %s or %s", ConflictSkip, ConflictOverwrite, ConflictFail)
+	}
+	return nil
+}
+
+// Run recreates the archived objects in order and prints a report.
+func (o *RestoreOptions) Run() error {
+	in := o.In
+	if o.Filename != "-" {
+		f, err := os.Open(o.Filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	manifest, files, err := readArchive(in)
+	if err != nil {
+		return err
+	}
+
+	target := o.ToProject
+	if len(target) == 0 {
+		target = manifest.Project
+	}
+
+	results := []RestoreResult{}
+	if err := o.ensureProject(target, files[backupProjectFile]); err != nil {
+		return err
+	}
+
+	var restoreErr error
+	for _, r := range manifest.Resources {
+		infos, err := resource.NewBuilder(o.Mapper, o.Typer, o.ClientMapper, o.Decoder).
+			Stream(bytes.NewReader(files[r.File]), r.File).
+			Flatten().
+			Do().Infos()
+		if err != nil {
+			return fmt.Errorf("unable to read %s from the archive: %v", r.File, err)
+		}
+		for _, info := range infos {
+			result := o.restoreObject(info, r.Resource, manifest.Project, target)
+			results = append(results, result)
+			if result.Err != nil && o.OnConflict == ConflictFail {
+				restoreErr = result.Err
+				break
+			}
+		}
+		if restoreErr != nil {
+			break
+		}
+	}
+
+	o.printResults(target, results)
+	if restoreErr != nil {
+		return restoreErr
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			return fmt.Errorf("some objects could not be restored")
+		}
+	}
+	return nil
+}
+
+// ensureProject creates the target project from the archived project metadata if it does
+// not exist yet.
+func (o *RestoreOptions) ensureProject(name string, data []byte) error {
+	if _, err := o.Client.Projects().Get(name); err == nil {
+		return nil
+	} else if !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	project, err := restoredProject(name, data)
+	if err != nil {
+		return err
+	}
+	if !o.Confirm {
+		fmt.Fprintf(o.ErrOut, "Would create project %s\n", name)
+		return nil
+	}
+	if _, err := o.Client.Projects().Create(project); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.ErrOut, "Created project %s\n", name)
+	return nil
+}
+
+// restoredProject returns the project named name to create from the archived project metadata.
+// When the archive is restored into a different project, the security context constraint
+// ranges of the archived project are dropped so that the new project is allocated its own.
+func restoredProject(name string, data []byte) (*projectapi.Project, error) {
+	project := &projectapi.Project{}
+	if len(data) > 0 {
+		if err := runtime.DecodeInto(kapi.Codecs.UniversalDecoder(), data, project); err != nil {
+			return nil, fmt.Errorf("unable to read the archived project: %v", err)
+		}
+	}
+	if project.Name != name {
+		delete(project.Annotations, security.UIDRangeAnnotation)
+		delete(project.Annotations, security.MCSAnnotation)
+		delete(project.Annotations, security.SupplementalGroupsAnnotation)
+	}
+	project.Name = name
+	return project, nil
+}
+
+// restoreObject creates one object in the target project, applying the conflict policy.
+func (o *RestoreOptions) restoreObject(info *resource.Info, resourceName, source, target string) RestoreResult {
+	result := RestoreResult{Resource: resourceName, Name: info.Name}
+	if err := info.Mapping.MetadataAccessor.SetNamespace(info.Object, target); err != nil {
+		result.Err = err
+		return result
+	}
+	info.Namespace = target
+	rewriteNamespaceReferences(info.Object, source, target)
+
+	helper := resource.NewHelper(info.Client, info.Mapping)
+	_, err := helper.Get(target, info.Name, false)
+	exists := err == nil
+	if err != nil && !kerrors.IsNotFound(err) {
+		result.Err = err
+		return result
+	}
+
+	switch {
+	case !exists:
+		result.Action = "create"
+		if o.Confirm {
+			_, result.Err = helper.Create(target, false, info.Object)
+		}
+	case o.OnConflict == ConflictSkip:
+		result.Action = "skip (exists)"
+	case o.OnConflict == ConflictOverwrite:
+		result.Action = "overwrite"
+		if o.Confirm {
+			_, result.Err = helper.Replace(target, info.Name, true, info.Object)
+		}
+	default:
+		result.Action = "conflict"
+		result.Err = fmt.Errorf("%s %q already exists in project %s", resourceName, info.Name, target)
+	}
+	return result
+}
+
+// rewriteNamespaceReferences points references to the source project at the target
+// project. Only role bindings carry such references in a way the server will not fix.
+func rewriteNamespaceReferences(obj runtime.Object, source, target string) {
+	if source == target {
+		return
+	}
+	binding, ok := obj.(*authorizationapi.RoleBinding)
+	if !ok {
+		return
+	}
+	if binding.RoleRef.Namespace == source {
+		binding.RoleRef.Namespace = target
+	}
+	sourceGroup := serviceaccount.MakeNamespaceGroupName(source)
+	for i := range binding.Subjects {
+		subject := &binding.Subjects[i]
+		switch {
+		case subject.Kind == authorizationapi.ServiceAccountKind && subject.Namespace == source:
+			subject.Namespace = target
+		case subject.Kind == authorizationapi.SystemGroupKind && subject.Name == sourceGroup:
+			subject.Name = serviceaccount.MakeNamespaceGroupName(target)
+		}
+	}
+}
+
+func (o *RestoreOptions) printResults(target string, results []RestoreResult) {
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+	if !o.Confirm {
+		fmt.Fprintf(w, "Dry run: no changes were made to project %s. Pass --confirm to restore.\n", target)
+	}
+	fmt.Fprintln(w, "RESOURCE\tNAME\tACTION\tERROR")
+	for _, r := range results {
+		msg := ""
+		if r.Err != nil {
+			msg = strings.Replace(r.Err.Error(), "\n", " ", -1)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Resource, r.Name, r.Action, msg)
+	}
+}
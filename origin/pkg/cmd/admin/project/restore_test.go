@@ -0,0 +1,111 @@
+package project
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	_ "github.com/openshift/origin/pkg/api/install"
+	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+	projectapiv1 "github.com/openshift/origin/pkg/project/api/v1"
+	"github.com/openshift/origin/pkg/security"
+)
+
+func TestRewriteNamespaceReferences(t *testing.T) {
+	binding := &authorizationapi.RoleBinding{
+		RoleRef: kapi.ObjectReference{Namespace: "old", Name: "local-role"},
+		Subjects: []kapi.ObjectReference{
+			{Kind: authorizationapi.ServiceAccountKind, Namespace: "old", Name: "builder"},
+			{Kind: authorizationapi.ServiceAccountKind, Namespace: "other", Name: "deployer"},
+			{Kind: authorizationapi.SystemGroupKind, Name: "system:serviceaccounts:old"},
+			{Kind: authorizationapi.UserKind, Name: "old"},
+		},
+	}
+	rewriteNamespaceReferences(binding, "old", "new")
+
+	if binding.RoleRef.Namespace != "new" {
+		t.Errorf("expected role ref to be rewritten, got %#v", binding.RoleRef)
+	}
+	expected := []kapi.ObjectReference{
+		{Kind: authorizationapi.ServiceAccountKind, Namespace: "new", Name: "builder"},
+		{Kind: authorizationapi.ServiceAccountKind, Namespace: "other", Name: "deployer"},
+		{Kind: authorizationapi.SystemGroupKind, Name: "system:serviceaccounts:new"},
+		{Kind: authorizationapi.UserKind, Name: "old"},
+	}
+	for i := range expected {
+		if binding.Subjects[i] != expected[i] {
+			t.Errorf("subject %d: expected %#v, got %#v", i, expected[i], binding.Subjects[i])
+		}
+	}
+}
+
+func TestIncludeInBackup(t *testing.T) {
+	owned := &kapi.ReplicationController{}
+	owned.Annotations = map[string]string{"openshift.io/deployment-config.name": "frontend"}
+	if includeInBackup(owned) {
+		t.Errorf("expected replication controllers owned by a deployment config to be skipped")
+	}
+	if !includeInBackup(&kapi.ReplicationController{}) {
+		t.Errorf("expected standalone replication controllers to be included")
+	}
+	if includeInBackup(&kapi.Secret{Type: kapi.SecretTypeServiceAccountToken}) {
+		t.Errorf("expected service account token secrets to be skipped")
+	}
+	generated := &kapi.Secret{Type: kapi.SecretTypeDockercfg}
+	generated.Annotations = map[string]string{kapi.ServiceAccountUIDKey: "uid"}
+	if includeInBackup(generated) {
+		t.Errorf("expected dockercfg secrets generated for service accounts to be skipped")
+	}
+	if !includeInBackup(&kapi.Secret{Type: kapi.SecretTypeDockercfg}) {
+		t.Errorf("expected user created dockercfg secrets to be included")
+	}
+	if !includeInBackup(&kapi.Secret{Type: kapi.SecretTypeOpaque}) {
+		t.Errorf("expected opaque secrets to be included")
+	}
+}
+
+func TestRestoredProject(t *testing.T) {
+	archived := &projectapi.Project{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: "old",
+			Annotations: map[string]string{
+				"openshift.io/display-name":           "Old",
+				security.UIDRangeAnnotation:           "1000060000/10000",
+				security.MCSAnnotation:                "s0:c8,c2",
+				security.SupplementalGroupsAnnotation: "1000060000/10000",
+			},
+		},
+	}
+	data, err := runtime.Encode(kapi.Codecs.LegacyCodec(projectapiv1.SchemeGroupVersion), archived)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the same project keeps its ranges, so that the restored pods keep their users
+	project, err := restoredProject("old", data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(project.Annotations) != 4 {
+		t.Errorf("expected the archived annotations, got %v", project.Annotations)
+	}
+
+	// another project must be allocated its own ranges
+	project, err = restoredProject("new", data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if project.Name != "new" {
+		t.Errorf("expected project new, got %s", project.Name)
+	}
+	if expected := map[string]string{"openshift.io/display-name": "Old"}; !reflect.DeepEqual(project.Annotations, expected) {
+		t.Errorf("expected annotations %v, got %v", expected, project.Annotations)
+	}
+
+	if project, err := restoredProject("new", nil); err != nil || project.Name != "new" {
+		t.Errorf("unexpected project %#v or error %v", project, err)
+	}
+}
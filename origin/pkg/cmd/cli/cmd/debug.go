@@ -32,6 +32,7 @@ import (
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	generateapp "github.com/openshift/origin/pkg/generate/app"
 	imageapi "github.com/openshift/origin/pkg/image/api"
+	securityapi "github.com/openshift/origin/pkg/security/api"
 )
 
 type DebugOptions struct {
@@ -59,8 +60,12 @@ type DebugOptions struct {
 	KeepInitContainers bool
 	OneContainer       bool
 	NodeName           string
+	Image              string
 	AddEnv             []kapi.EnvVar
 	RemoveEnv          []string
+
+	// DebugNode is set when debugging a node rather than a pod template
+	DebugNode bool
 }
 
 const (
@@ -68,6 +73,11 @@ const (
 
 	debugPodAnnotationSourceContainer = "debug.openshift.io/source-container"
 	debugPodAnnotationSourceResource  = "debug.openshift.io/source-resource"
+
+	// defaultNodeDebugImage is the image used to debug nodes, it includes common troubleshooting tools
+	defaultNodeDebugImage = "centos/tools"
+	// nodeDebugHostRoot is where the root of the node filesystem is mounted in node debug pods
+	nodeDebugHostRoot = "/host"
 )
 
 var (
@@ -90,6 +100,13 @@ var (
 		as a root user on the cluster. You can use this command to test running a pod as
 		non-root (with --as-user) or to run a non-root pod as root (with --as-root).
 
+		Debugging a node starts a privileged pod on that node in the current project instead.
+		The pod shares the process and network namespaces of the host, and the root of the host
+		filesystem is mounted at /host, so you can inspect the node without logging in to it.
+		Run 'chroot /host' to use the binaries of the host. The pod runs a tools image, which
+		can be changed with --image. You must be allowed to run privileged pods in the project,
+		for instance through the privileged security context constraint.
+
 		The debug pod is deleted when the the remote command completes or the user interrupts
 		the shell.`)
 
@@ -104,7 +121,13 @@ var (
 	  %[1]s dc/test -c second -- /bin/env
 
 	  # See the pod that would be created to debug
-	  %[1]s dc/test -o yaml`)
+	  %[1]s dc/test -o yaml
+
+	  # Open a shell on a node
+	  %[1]s node/node1.example.com
+
+	  # Check the disk usage of a node with your own tools image
+	  %[1]s node/node1.example.com --image=myregistry/tools -- chroot /host df -h`)
 )
 
 // NewCmdDebug creates a command for debugging pods.
@@ -160,6 +183,7 @@ func NewCmdDebug(fullName string, f *clientcmd.Factory, in io.Reader, out, errou
 	cmd.Flags().BoolVar(&options.KeepReadiness, "keep-readiness", false, "If true, keep the original pod readiness probes")
 	cmd.Flags().BoolVar(&options.OneContainer, "one-container", false, "If true, run only the selected container, remove all others")
 	cmd.Flags().StringVar(&options.NodeName, "node-name", "", "Set a specific node to run on - by default the pod will run on any valid node")
+	cmd.Flags().StringVar(&options.Image, "image", "", fmt.Sprintf("Override the image of the debugged container. Nodes are debugged with %s by default.", defaultNodeDebugImage))
 	cmd.Flags().BoolVar(&options.AsRoot, "as-root", false, "If true, try to run the container as the root user")
 	cmd.Flags().Int64Var(&options.AsUser, "as-user", -1, "Try to run the container as a specific user UID (note: admins may limit your ability to use this flag)")
 
@@ -241,18 +265,30 @@ func (o *DebugOptions) Complete(cmd *cobra.Command, f *clientcmd.Factory, args [
 		return fmt.Errorf("you must identify a resource with a pod template to debug")
 	}
 
-	template, err := f.ApproximatePodTemplateForObject(infos[0].Object)
-	if err != nil && template == nil {
-		return fmt.Errorf("cannot debug %s: %v", infos[0].Name, err)
-	}
-	if err != nil {
-		glog.V(4).Infof("Unable to get exact template, but continuing with fallback: %v", err)
-	}
-	pod := &kapi.Pod{
-		ObjectMeta: template.ObjectMeta,
-		Spec:       template.Spec,
+	var pod *kapi.Pod
+	if node, ok := infos[0].Object.(*kapi.Node); ok {
+		o.DebugNode = true
+		o.NodeName = node.Name
+		if len(o.Image) == 0 {
+			o.Image = defaultNodeDebugImage
+		}
+		pod = nodeDebugPod(node)
+		pod.Namespace = cmdNamespace
+	} else {
+		template, err := f.ApproximatePodTemplateForObject(infos[0].Object)
+		if err != nil && template == nil {
+			return fmt.Errorf("cannot debug %s: %v", infos[0].Name, err)
+		}
+		if err != nil {
+			glog.V(4).Infof("Unable to get exact template, but continuing with fallback: %v", err)
+		}
+		pod = &kapi.Pod{
+			ObjectMeta: template.ObjectMeta,
+			Spec:       template.Spec,
+		}
+		pod.Namespace = infos[0].Namespace
 	}
-	pod.Name, pod.Namespace = fmt.Sprintf("%s-debug", generateapp.MakeSimpleName(infos[0].Name)), infos[0].Namespace
+	pod.Name = fmt.Sprintf("%s-debug", generateapp.MakeSimpleName(infos[0].Name))
 	o.Attach.Pod = pod
 
 	o.AsNonRoot = !o.AsRoot && cmd.Flag("as-root").Changed
@@ -327,8 +363,18 @@ func (o *DebugOptions) Debug() error {
 		return o.Print(pod, o.Attach.Out)
 	}
 
+	if o.DebugNode {
+		if err := o.checkNodeDebugPodAllowed(pod); err != nil {
+			return err
+		}
+	}
+
 	glog.V(5).Infof("Creating pod: %#v", pod)
-	fmt.Fprintf(o.Attach.Err, "Debugging with pod/%s, original command: %s\n", pod.Name, commandString)
+	if o.DebugNode {
+		fmt.Fprintf(o.Attach.Err, "Starting pod/%s on node/%s, the host root is mounted at %s\n", pod.Name, o.NodeName, nodeDebugHostRoot)
+	} else {
+		fmt.Fprintf(o.Attach.Err, "Debugging with pod/%s, original command: %s\n", pod.Name, commandString)
+	}
 	pod, err := o.createPod(pod)
 	if err != nil {
 		return err
@@ -516,6 +562,9 @@ func (o *DebugOptions) transformPodForDebug(annotations map[string]string) (*kap
 		originalCommand = append(originalCommand, container.Args...)
 	}
 
+	if len(o.Image) > 0 {
+		container.Image = o.Image
+	}
 	container.Command = o.Command
 	container.Args = nil
 	container.TTY = o.Attach.Stdin && o.Attach.TTY
@@ -600,6 +649,69 @@ func (o *DebugOptions) transformPodForDebug(annotations map[string]string) (*kap
 	return pod, originalCommand
 }
 
+// nodeDebugPod returns a privileged pod running on the given node with access to the host namespaces and
+// filesystem. The image is set from the debug options.
+func nodeDebugPod(node *kapi.Node) *kapi.Pod {
+	privileged := true
+	zero := int64(0)
+	return &kapi.Pod{
+		Spec: kapi.PodSpec{
+			NodeName: node.Name,
+			SecurityContext: &kapi.PodSecurityContext{
+				HostNetwork: true,
+				HostPID:     true,
+			},
+			Containers: []kapi.Container{
+				{
+					Name: "container-00",
+					SecurityContext: &kapi.SecurityContext{
+						Privileged: &privileged,
+						RunAsUser:  &zero,
+					},
+					VolumeMounts: []kapi.VolumeMount{
+						{Name: "host", MountPath: nodeDebugHostRoot},
+					},
+				},
+			},
+			Volumes: []kapi.Volume{
+				{
+					Name: "host",
+					VolumeSource: kapi.VolumeSource{
+						HostPath: &kapi.HostPathVolumeSource{Path: "/"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// checkNodeDebugPodAllowed verifies that the security context constraints allow the current user to run the
+// privileged node debug pod, to report a clear error instead of the pod being rejected on creation.
+func (o *DebugOptions) checkNodeDebugPodAllowed(pod *kapi.Pod) error {
+	review, err := o.Client.PodSecurityPolicySelfSubjectReviews(pod.Namespace).Create(&securityapi.PodSecurityPolicySelfSubjectReview{
+		Spec: securityapi.PodSecurityPolicySelfSubjectReviewSpec{
+			Template: kapi.PodTemplateSpec{ObjectMeta: pod.ObjectMeta, Spec: pod.Spec},
+		},
+	})
+	if kapierrors.IsForbidden(err) {
+		// the pod will be checked on creation anyway
+		glog.V(4).Infof("Unable to check the node debug pod against the security context constraints: %v", err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if review.Status.AllowedBy == nil {
+		msg := fmt.Sprintf("you are not allowed to run privileged pods with access to the host in project %q", pod.Namespace)
+		if len(review.Status.Reason) > 0 {
+			msg += ": " + review.Status.Reason
+		}
+		return errors.New(msg)
+	}
+	glog.V(4).Infof("The node debug pod is allowed by %s %s", review.Status.AllowedBy.Kind, review.Status.AllowedBy.Name)
+	return nil
+}
+
 // createPod creates the debug pod, and will attempt to delete an existing debug
 // pod with the same name, but will return an error in any other case.
 func (o *DebugOptions) createPod(pod *kapi.Pod) (*kapi.Pod, error) {
@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/openshift/github.com/ghodss/yaml"
+	"github.com/openshift/github.com/pmezard/go-difflib/difflib"
+	"github.com/openshift/github.com/spf13/cobra"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/annotations"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/openshift/kubernetes/pkg/kubectl/resource"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/cmd/templates"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	// DiffFormatUnified prints a unified diff of the YAML of each object
+	DiffFormatUnified = "unified"
+	// DiffFormatStructured prints the fields that differ in each object
+	DiffFormatStructured = "structured"
+
+	// DiffChangedExitCode is the exit code of the diff commands when objects differ from the server
+	DiffChangedExitCode = 2
+)
+
+var diffFormats = []string{DiffFormatUnified, DiffFormatStructured}
+
+// errDiffChanged is returned by the diff commands once the differences are printed, to exit with
+// DiffChangedExitCode.
+var errDiffChanged = errors.New("the objects differ from the server")
+
+var (
+	diffLong = templates.LongDesc(`
+		Show the differences between local resources and the server
+
+		Each resource of the files is compared with its live version on the server. The fields set by
+		the server, such as the resource version, the status or a service's cluster IP, are cleared on
+		both sides before the comparison, the same way as '%[1]s export' does. Resources which do not
+		exist on the server are shown as created.
+
+		The differences are printed as a unified diff of the YAML of each resource. Pass
+		--output=structured to print the list of the fields which would change instead.
+
+		The command exits with 0 when the resources match the server, 2 when they differ, and 1 on
+		any error.`)
+
+	diffExample = templates.Examples(`
+		# Show what applying a file would change
+	  %[1]s diff -f app.yaml
+
+	  # List the fields of the resources in a directory which differ from the server
+	  %[1]s diff -f ./app/ -R -o structured
+
+	  # Show what processing and applying a template would change
+	  %[1]s process -f template.json --diff`)
+)
+
+// DiffOptions compares objects with their live version on the server.
+type DiffOptions struct {
+	Format   string
+	Exporter Exporter
+	Out      io.Writer
+}
+
+// NewCmdDiff implements the OpenShift cli diff command
+func NewCmdDiff(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	o := &DiffOptions{
+		Format:   DiffFormatUnified,
+		Exporter: &DefaultExporter{},
+		Out:      out,
+	}
+	filenameOptions := &resource.FilenameOptions{}
+	cmd := &cobra.Command{
+		Use:     "diff -f FILENAME",
+		Short:   "Show the differences between local resources and the server",
+		Long:    fmt.Sprintf(diffLong, fullName),
+		Example: fmt.Sprintf(diffExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 0 {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "no arguments are supported, pass the resources with --filename"))
+			}
+			if kcmdutil.IsFilenameEmpty(filenameOptions.Filenames) {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, "--filename is required"))
+			}
+			if err := o.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			checkDiffErr(RunDiff(f, o, filenameOptions))
+		},
+	}
+	kcmdutil.AddFilenameOptionFlags(cmd, filenameOptions, "containing the resources to compare with the server.")
+	cmd.Flags().StringVarP(&o.Format, "output", "o", o.Format, fmt.Sprintf("Format of the differences. One of: %s.", strings.Join(diffFormats, "|")))
+	return cmd
+}
+
+// RunDiff compares the resources of the files with the server.
+func RunDiff(f *clientcmd.Factory, o *DiffOptions, filenameOptions *resource.FilenameOptions) error {
+	namespace, explicit, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	mapper, typer := f.Object()
+	infos, err := resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder()).
+		ContinueOnError().
+		NamespaceParam(namespace).DefaultNamespace().
+		FilenameParam(explicit, filenameOptions).
+		Flatten().
+		Do().
+		Infos()
+	if err != nil {
+		return err
+	}
+	changed, err := o.Diff(infos)
+	if err != nil {
+		return err
+	}
+	if changed {
+		return errDiffChanged
+	}
+	return nil
+}
+
+// checkDiffErr exits with DiffChangedExitCode when objects differ, and handles any other error as usual.
+func checkDiffErr(err error) {
+	if err == errDiffChanged {
+		os.Exit(DiffChangedExitCode)
+	}
+	kcmdutil.CheckErr(err)
+}
+
+func (o *DiffOptions) Validate() error {
+	if !sets.NewString(diffFormats...).Has(o.Format) {
+		return fmt.Errorf("the output format must be one of: %s", strings.Join(diffFormats, ", "))
+	}
+	return nil
+}
+
+// Diff prints the differences between the objects and their live version, and returns whether any differ.
+func (o *DiffOptions) Diff(infos []*resource.Info) (bool, error) {
+	changed := false
+	for _, info := range infos {
+		name := info.Mapping.Resource + "/" + info.Name
+
+		local, err := o.normalize(info.Object, info)
+		if err == ErrExportOmit {
+			continue
+		}
+		if err != nil {
+			return changed, fmt.Errorf("unable to compare %s: %v", name, err)
+		}
+
+		var live map[string]interface{}
+		liveObj, err := resource.NewHelper(info.Client, info.Mapping).Get(info.Namespace, info.Name, false)
+		switch {
+		case kapierrors.IsNotFound(err):
+		case err != nil:
+			return changed, fmt.Errorf("unable to get %s: %v", name, err)
+		default:
+			if live, err = o.normalize(liveObj, info); err != nil {
+				return changed, fmt.Errorf("unable to compare %s: %v", name, err)
+			}
+			if info.Mapping.GroupVersionKind.Kind == "DeploymentConfig" {
+				keepTriggeredImages(live, local)
+			}
+		}
+
+		if live != nil && reflect.DeepEqual(live, local) {
+			continue
+		}
+		changed = true
+		if err := o.print(name, live, local); err != nil {
+			return changed, err
+		}
+	}
+	return changed, nil
+}
+
+// normalize converts an object to the fields of its versioned form which can be compared with the server,
+// clearing the fields set by the server.
+func (o *DiffOptions) normalize(obj runtime.Object, info *resource.Info) (map[string]interface{}, error) {
+	codec := kapi.Codecs.LegacyCodec(info.Mapping.GroupVersionKind.GroupVersion())
+	data, err := runtime.Encode(codec, obj)
+	if err != nil {
+		return nil, err
+	}
+	// decode a copy in the internal version the exporter works with
+	obj, err = runtime.Decode(kapi.Codecs.UniversalDecoder(), data)
+	if err != nil {
+		return nil, err
+	}
+	// the fields assigned by the server on creation are cleared as well, they are usually not set locally
+	if err := o.Exporter.Export(obj, false); err != nil {
+		return nil, err
+	}
+	if data, err = runtime.Encode(codec, obj); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "status")
+	if metadata, ok := fields["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "generation")
+		if objAnnotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(objAnnotations, annotations.LastAppliedConfigAnnotation)
+			if len(objAnnotations) == 0 {
+				delete(metadata, "annotations")
+			}
+		}
+	}
+	return fields, nil
+}
+
+// keepTriggeredImages sets the images of the live deployment config's containers which are updated by
+// an automatic image change trigger of the local one to their local value. The server resolves those
+// images from the image streams, so they never match the local file.
+func keepTriggeredImages(live, local map[string]interface{}) {
+	liveContainers := dcContainers(live)
+	localContainers := dcContainers(local)
+	spec, _ := local["spec"].(map[string]interface{})
+	triggers, _ := spec["triggers"].([]interface{})
+	for _, t := range triggers {
+		trigger, _ := t.(map[string]interface{})
+		params, _ := trigger["imageChangeParams"].(map[string]interface{})
+		if trigger["type"] != "ImageChange" || params == nil || params["automatic"] != true {
+			continue
+		}
+		names, _ := params["containerNames"].([]interface{})
+		for _, n := range names {
+			name, _ := n.(string)
+			liveContainer, localContainer := liveContainers[name], localContainers[name]
+			if liveContainer == nil || localContainer == nil {
+				continue
+			}
+			if image, ok := localContainer["image"]; ok {
+				liveContainer["image"] = image
+			} else {
+				delete(liveContainer, "image")
+			}
+		}
+	}
+}
+
+// dcContainers returns the containers of the pod template of a decoded deployment config keyed by name.
+func dcContainers(dc map[string]interface{}) map[string]map[string]interface{} {
+	containers := map[string]map[string]interface{}{}
+	spec, _ := dc["spec"].(map[string]interface{})
+	template, _ := spec["template"].(map[string]interface{})
+	podSpec, _ := template["spec"].(map[string]interface{})
+	items, _ := podSpec["containers"].([]interface{})
+	for _, item := range items {
+		if container, ok := item.(map[string]interface{}); ok {
+			if name, ok := container["name"].(string); ok {
+				containers[name] = container
+			}
+		}
+	}
+	return containers
+}
+
+// print prints the differences of an object. A nil live object is one which doesn't exist on the server.
+func (o *DiffOptions) print(name string, live, local map[string]interface{}) error {
+	if o.Format == DiffFormatStructured {
+		if live == nil {
+			_, err := fmt.Fprintf(o.Out, "%s: created\n", name)
+			return err
+		}
+		fmt.Fprintf(o.Out, "%s:\n", name)
+		for _, line := range fieldDiffs("", live, local) {
+			fmt.Fprintf(o.Out, "  %s\n", line)
+		}
+		return nil
+	}
+
+	from, to := []byte{}, []byte{}
+	var err error
+	if live != nil {
+		if from, err = yaml.Marshal(live); err != nil {
+			return err
+		}
+	}
+	if to, err = yaml.Marshal(local); err != nil {
+		return err
+	}
+	return difflib.WriteUnifiedDiff(o.Out, difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: "live/" + name,
+		ToFile:   "local/" + name,
+		Context:  3,
+	})
+}
+
+// fieldDiffs lists the fields which differ between two decoded JSON values, with their path.
+func fieldDiffs(path string, from, to interface{}) []string {
+	fromMap, fromOK := from.(map[string]interface{})
+	toMap, toOK := to.(map[string]interface{})
+	if !fromOK || !toOK {
+		if reflect.DeepEqual(from, to) {
+			return nil
+		}
+		return []string{fmt.Sprintf("~ %s: %s -> %s", path, jsonValue(from), jsonValue(to))}
+	}
+
+	keys := sets.NewString()
+	for key := range fromMap {
+		keys.Insert(key)
+	}
+	for key := range toMap {
+		keys.Insert(key)
+	}
+	diffs := []string{}
+	for _, key := range keys.List() {
+		fieldPath := key
+		if len(path) > 0 {
+			fieldPath = path + "." + key
+		}
+		fromValue, fromFound := fromMap[key]
+		toValue, toFound := toMap[key]
+		switch {
+		case !fromFound:
+			diffs = append(diffs, fmt.Sprintf("+ %s: %s", fieldPath, jsonValue(toValue)))
+		case !toFound:
+			diffs = append(diffs, fmt.Sprintf("- %s: %s", fieldPath, jsonValue(fromValue)))
+		default:
+			diffs = append(diffs, fieldDiffs(fieldPath, fromValue, toValue)...)
+		}
+	}
+	return diffs
+}
+
+func jsonValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
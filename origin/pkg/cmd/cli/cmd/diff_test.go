@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/meta"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	"github.com/openshift/kubernetes/pkg/apimachinery/registered"
+	"github.com/openshift/kubernetes/pkg/client/restclient/fake"
+	"github.com/openshift/kubernetes/pkg/kubectl/resource"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+func diffService(name string, port int32) *kapi.Service {
+	return &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Labels: map[string]string{"app": "test"}},
+		Spec: kapi.ServiceSpec{
+			Selector: map[string]string{"app": "test"},
+			Ports:    []kapi.ServicePort{{Port: port, Protocol: kapi.ProtocolTCP}},
+		},
+	}
+}
+
+func diffDeploymentConfig(name string, automatic bool, image, proxyImage string) *deployapi.DeploymentConfig {
+	return &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: name},
+		Spec: deployapi.DeploymentConfigSpec{
+			Replicas: 1,
+			Selector: map[string]string{"app": "test"},
+			Triggers: []deployapi.DeploymentTriggerPolicy{
+				{
+					Type: deployapi.DeploymentTriggerOnImageChange,
+					ImageChangeParams: &deployapi.DeploymentTriggerImageChangeParams{
+						Automatic:      automatic,
+						ContainerNames: []string{"app"},
+						From:           kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:latest"},
+					},
+				},
+			},
+			Template: &kapi.PodTemplateSpec{
+				ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{"app": "test"}},
+				Spec: kapi.PodSpec{
+					Containers: []kapi.Container{
+						{Name: "app", Image: image},
+						{Name: "proxy", Image: proxyImage},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	live := diffService("frontend", 8080)
+	live.Namespace = "test"
+	live.ResourceVersion = "10"
+	live.UID = "uid"
+	live.CreationTimestamp = unversioned.Now()
+	live.Spec.ClusterIP = "172.30.0.1"
+	live.Status.LoadBalancer.Ingress = []kapi.LoadBalancerIngress{{IP: "10.0.0.1"}}
+	resolved := "172.30.1.1:5000/test/app@sha256:0123456789012345678901234567890123456789012345678901234567890123"
+	liveObjects := map[string]runtime.Object{
+		"/namespaces/test/services/frontend":          live,
+		"/namespaces/test/deploymentconfigs/frontend": diffDeploymentConfig("frontend", true, resolved, "proxy:v1"),
+		"/namespaces/test/deploymentconfigs/manual":   diffDeploymentConfig("manual", false, resolved, "proxy:v1"),
+	}
+	liveData := map[string][]byte{}
+	for path, obj := range liveObjects {
+		data, err := runtime.Encode(kapi.Codecs.LegacyCodec(unversioned.GroupVersion{Version: "v1"}), obj)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		liveData[path] = data
+	}
+
+	client := &fake.RESTClient{
+		NegotiatedSerializer: kapi.Codecs,
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			header := http.Header{"Content-Type": []string{runtime.ContentTypeJSON}}
+			if data, ok := liveData[req.URL.Path]; ok && req.Method == "GET" {
+				return &http.Response{StatusCode: http.StatusOK, Header: header, Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+			}
+			return &http.Response{StatusCode: http.StatusNotFound, Header: header, Body: ioutil.NopCloser(bytes.NewReader([]byte{}))}, nil
+		}),
+	}
+	info := func(obj runtime.Object) *resource.Info {
+		gk := kapi.Kind("Service")
+		if _, ok := obj.(*deployapi.DeploymentConfig); ok {
+			gk = deployapi.Kind("DeploymentConfig")
+		}
+		mapping, err := registered.RESTMapper().RESTMapping(gk, "v1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return &resource.Info{Client: client, Mapping: mapping, Namespace: "test", Name: accessor.GetName(), Object: obj}
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		local    runtime.Object
+		changed  bool
+		expected []string
+	}{
+		{
+			name:   "server fields are ignored",
+			format: DiffFormatUnified,
+			local:  diffService("frontend", 8080),
+		},
+		{
+			name:     "unified",
+			format:   DiffFormatUnified,
+			local:    diffService("frontend", 9090),
+			changed:  true,
+			expected: []string{"--- live/services/frontend", "+++ local/services/frontend", "-  - port: 8080", "+  - port: 9090"},
+		},
+		{
+			name:     "structured",
+			format:   DiffFormatStructured,
+			local:    diffService("frontend", 9090),
+			changed:  true,
+			expected: []string{"services/frontend:\n", `~ spec.ports: [{"port":8080,"protocol":"TCP","targetPort":8080}] -> [{"port":9090,"protocol":"TCP","targetPort":9090}]`},
+		},
+		{
+			name:     "created",
+			format:   DiffFormatStructured,
+			local:    diffService("backend", 8080),
+			changed:  true,
+			expected: []string{"services/backend: created\n"},
+		},
+		{
+			name:   "images of automatic image change triggers are ignored",
+			format: DiffFormatUnified,
+			local:  diffDeploymentConfig("frontend", true, " ", "proxy:v1"),
+		},
+		{
+			name:     "images of other containers are compared",
+			format:   DiffFormatUnified,
+			local:    diffDeploymentConfig("frontend", true, " ", "proxy:v2"),
+			changed:  true,
+			expected: []string{"-      - image: proxy:v1", "+      - image: proxy:v2"},
+		},
+		{
+			name:     "images of manual image change triggers are compared",
+			format:   DiffFormatUnified,
+			local:    diffDeploymentConfig("manual", false, "app:latest", "proxy:v1"),
+			changed:  true,
+			expected: []string{"-      - image: " + resolved, "+      - image: app:latest"},
+		},
+	}
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		o := &DiffOptions{Format: test.format, Exporter: &DefaultExporter{}, Out: out}
+		changed, err := o.Diff([]*resource.Info{info(test.local)})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if changed != test.changed {
+			t.Errorf("%s: expected changed=%t, got %t", test.name, test.changed, changed)
+		}
+		if len(test.expected) == 0 && out.Len() > 0 {
+			t.Errorf("%s: expected no output, got:\n%s", test.name, out.String())
+		}
+		for _, s := range test.expected {
+			if !strings.Contains(out.String(), s) {
+				t.Errorf("%s: expected the output to contain %q, got:\n%s", test.name, s, out.String())
+			}
+		}
+	}
+}
+
+func TestFieldDiffs(t *testing.T) {
+	from := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "test", "tier": "web"}},
+		"spec":     map[string]interface{}{"replicas": 1.0},
+	}
+	to := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "test", "version": "2"}},
+		"spec":     map[string]interface{}{"replicas": 2.0},
+	}
+	expected := []string{
+		`- metadata.labels.tier: "web"`,
+		`+ metadata.labels.version: "2"`,
+		`~ spec.replicas: 1 -> 2`,
+	}
+	if actual := fieldDiffs("", from, to); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
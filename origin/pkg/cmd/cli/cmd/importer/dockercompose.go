@@ -36,6 +36,11 @@ var (
 		During transformation fields in the compose syntax that are not relevant when running on top of
 		a containerized platform will be ignored and a warning printed.
 
+		Files using the version 2 and 3 schemas are supported: named volumes become persistent volume
+		claims, healthchecks become liveness and readiness probes, the replicas and resources of deploy
+		blocks are applied to the deployments, and secrets and configs become secrets and config maps
+		mounted where the services expect them.
+
 		The command will create objects unless you pass the -o yaml or --as-template flags to generate a
 		configuration file for later use.
 
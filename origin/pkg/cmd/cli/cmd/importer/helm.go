@@ -0,0 +1,173 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/openshift/github.com/spf13/cobra"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	configcmd "github.com/openshift/origin/pkg/config/cmd"
+	appcmd "github.com/openshift/origin/pkg/generate/app/cmd"
+	"github.com/openshift/origin/pkg/generate/helm"
+	templateprocessor "github.com/openshift/origin/pkg/template"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+var (
+	helmLong = templates.LongDesc(`
+		Import a Helm chart as OpenShift objects
+
+		Helm charts package the Kubernetes objects of an application as Go templates configured
+		by a values.yaml file. This command renders a chart directory or archive with its default
+		values and converts the result into an OpenShift template. The values the chart templates
+		print as they are, and the name of the release, become template parameters - the other values
+		are used in the logic of the templates and are kept with their defaults. Parts of a chart
+		that can't be expressed by a template, like hooks, dependencies, or unsupported template
+		functions, are ignored and a warning printed.
+
+		The command will create objects unless you pass the -o yaml or --as-template flags to generate a
+		configuration file for later use.
+
+		Experimental: This command is under active development and may change without notice.`)
+
+	helmExample = templates.Examples(`
+		# Import the chart in the mychart directory into OpenShift
+	  %[1]s helm ./mychart
+
+		# Turn a packaged chart into a template
+	  %[1]s helm mychart-0.1.0.tgz -o yaml --as-template=mychart`)
+)
+
+type HelmOptions struct {
+	Action configcmd.BulkAction
+
+	Path       string
+	AsTemplate string
+
+	PrintObject func(runtime.Object) error
+
+	Namespace string
+	Client    client.TemplateConfigsNamespacer
+}
+
+// NewCmdHelm imports a Helm chart as a template.
+func NewCmdHelm(fullName string, f *clientcmd.Factory, in io.Reader, out, errout io.Writer) *cobra.Command {
+	options := &HelmOptions{
+		Action: configcmd.BulkAction{
+			Out:    out,
+			ErrOut: errout,
+		},
+	}
+	cmd := &cobra.Command{
+		Use:     "helm CHART",
+		Short:   "Import a Helm chart into OpenShift (experimental)",
+		Long:    helmLong,
+		Example: fmt.Sprintf(helmExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, cmd, args))
+			kcmdutil.CheckErr(options.Validate())
+			if err := options.Run(); err != nil {
+				// TODO: move me to kcmdutil
+				if err == cmdutil.ErrExit {
+					os.Exit(1)
+				}
+				kcmdutil.CheckErr(err)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&options.AsTemplate, "as-template", "", "If set, generate a template with the provided name")
+
+	options.Action.BindForOutput(cmd.Flags())
+	// the printers read output-version, it can't be left out
+	cmd.Flags().String("output-version", "", "The preferred API version of the printed template or list. The objects rendered by the chart keep the API versions set by the chart.")
+
+	return cmd
+}
+
+func (o *HelmOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return kcmdutil.UsageError(cmd, "you must provide the path to a chart directory or archive")
+	}
+	o.Path = args[0]
+
+	if version := kcmdutil.GetFlagString(cmd, "output-version"); len(version) > 0 {
+		if _, err := unversioned.ParseGroupVersion(version); err != nil {
+			return fmt.Errorf("provided output-version %q is not valid: %v", version, err)
+		}
+	}
+
+	o.Action.Bulk.Mapper = clientcmd.ResourceMapper(f)
+	o.Action.Bulk.Op = configcmd.Create
+	mapper, _ := f.Object()
+	o.PrintObject = cmdutil.VersionedPrintObject(f.PrintObject, cmd, mapper, o.Action.Out)
+
+	ns, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = ns
+
+	o.Client, _, err = f.Clients()
+	return err
+}
+
+func (o *HelmOptions) Validate() error {
+	if !helm.IsPossibleHelmChart(o.Path) {
+		return fmt.Errorf("%s is neither a chart archive nor a directory containing a Chart.yaml file", o.Path)
+	}
+	return nil
+}
+
+func (o *HelmOptions) Run() error {
+	template, err := helm.Generate(o.Path)
+	if err != nil {
+		return err
+	}
+
+	// charts commonly set a chart label of their own, with the version of the chart
+	template.ObjectLabels = map[string]string{
+		"helm-chart": template.Name,
+	}
+
+	// the objects rendered by the chart are already versioned, so unlike the other
+	// importers they are not converted
+	if o.Action.ShouldPrint() || (o.Action.Output == "name" && len(o.AsTemplate) > 0) {
+		var out runtime.Object
+		if len(o.AsTemplate) > 0 {
+			template.Name = o.AsTemplate
+			out = template
+		} else {
+			// the objects reference the parameters, print them with the chart values
+			if errs := templateprocessor.NewProcessor(nil).Process(template); len(errs) > 0 {
+				return kapierrors.NewInvalid(templateapi.Kind("Template"), template.Name, errs)
+			}
+			out = &kapi.List{Items: template.Objects}
+		}
+		return o.PrintObject(out)
+	}
+
+	result, err := appcmd.TransformTemplate(template, o.Client, o.Namespace, nil)
+	if err != nil {
+		return err
+	}
+
+	if o.Action.Verbose() {
+		appcmd.DescribeGeneratedTemplate(o.Action.Out, "", result, o.Namespace)
+	}
+
+	if errs := o.Action.WithMessage("Importing Helm chart", "created").Run(&kapi.List{Items: result.Objects}, o.Namespace); len(errs) > 0 {
+		return cmdutil.ErrExit
+	}
+	return nil
+}
@@ -31,5 +31,6 @@ func NewCmdImport(fullName string, f *clientcmd.Factory, in io.Reader, out, erro
 
 	cmd.AddCommand(NewCmdDockerCompose(name, f, in, out, errout))
 	cmd.AddCommand(NewCmdAppJSON(name, f, in, out, errout))
+	cmd.AddCommand(NewCmdHelm(name, f, in, out, errout))
 	return cmd
 }
@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"text/template"
 	"time"
@@ -26,15 +27,18 @@ import (
 	"github.com/openshift/kubernetes/pkg/api/meta"
 	"github.com/openshift/kubernetes/pkg/api/unversioned"
 	"github.com/openshift/kubernetes/pkg/client/cache"
+	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
 	"github.com/openshift/kubernetes/pkg/healthz"
 	cmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
 	"github.com/openshift/kubernetes/pkg/kubectl/resource"
 	"github.com/openshift/kubernetes/pkg/runtime"
 	"github.com/openshift/kubernetes/pkg/util/jsonpath"
+	kutilrand "github.com/openshift/kubernetes/pkg/util/rand"
 	"github.com/openshift/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/cmd/templates"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	"github.com/openshift/origin/pkg/util/leaderlease"
 	"github.com/openshift/origin/pkg/util/proc"
 )
 
@@ -138,6 +142,19 @@ var (
 		server, and any node in the inventory file that no longer exists will trigger
 		a call to remove_from_inventory.sh with the name of the node.
 
+		Instead of a --names command, observe can save the last processed resource version
+		and the known names to a config map in the current project with --state-configmap.
+		When it is restarted, the objects which did not change since then are not passed
+		to your command again, and the saved names that no longer exist on the server are
+		passed to your --delete command. The state is saved whenever all of the received
+		changes have been processed, so your commands may still be invoked more than once
+		for the same change.
+
+		To run several observers for high availability, pass --leader-election along with
+		the same --state-configmap to each of them. Only the observer holding the lease
+		recorded on the config map runs the commands, and another one takes over from the
+		saved state once the lease expires. An observer which loses its lease exits.
+
 		Important: when handling deletes, the previous state of the object may not be
 		available and only the name/namespace of the object will be passed to	your
 		--delete command as arguments (all custom arguments are omitted).
@@ -155,7 +172,11 @@ var (
 	  %[1]s observe services
 
 	  # Observe changes to services, including the clusterIP and invoke a script for each
-	  %[1]s observe services -a '{ .spec.clusterIP }' -- register_dns.sh`)
+	  %[1]s observe services -a '{ .spec.clusterIP }' -- register_dns.sh
+
+	  # Run one of several replicas of an observer, which resume from the state saved in a config map
+	  %[1]s observe services --state-configmap=dns-observer --leader-election \
+	      --delete unregister_dns.sh -- register_dns.sh`)
 )
 
 // NewCmdObserve creates the observe command.
@@ -166,6 +187,7 @@ func NewCmdObserve(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 		templateType:    "jsonpath",
 		maximumErrors:   20,
 		listenAddr:      ":11251",
+		leaseTTL:        30 * time.Second,
 	}
 
 	cmd := &cobra.Command{
@@ -214,6 +236,11 @@ func NewCmdObserve(fullName string, f *clientcmd.Factory, out, errOut io.Writer)
 	cmd.Flags().BoolVar(&options.printMetricsOnExit, "print-metrics-on-exit", false, "If true, on exit write all metrics to stdout.")
 	cmd.Flags().StringVar(&options.listenAddr, "listen-addr", options.listenAddr, "The name of an interface to listen on to expose metrics and health checking.")
 
+	// persist the progress and coordinate with other observers
+	cmd.Flags().StringVar(&options.stateConfigMap, "state-configmap", "", "The name of a config map in the current project to save the last processed resource version and the known names to, optional. Use to resume after a restart and to get notifications when objects are deleted without --names.")
+	cmd.Flags().BoolVar(&options.leaderElection, "leader-election", false, "If true, only run the commands while holding a lease recorded on the --state-configmap. Use to run several observers with only one active at a time.")
+	cmd.Flags().DurationVar(&options.leaseTTL, "leader-election-ttl", options.leaseTTL, "How long the lease of an observer is valid without renewal. A standby observer takes over after this period when the active one stops.")
+
 	// additional debug output
 	cmd.Flags().BoolVar(&options.noHeaders, "no-headers", false, "If true, skip printing information about each event prior to executing the command.")
 
@@ -257,6 +284,14 @@ type ObserveOptions struct {
 	resyncPeriod       time.Duration
 	printMetricsOnExit bool
 
+	// persist the progress and coordinate with other observers
+	stateConfigMap string
+	leaderElection bool
+	leaseTTL       time.Duration
+	configMaps     kcoreclient.ConfigMapsGetter
+	// state is nil if the progress is not saved
+	state *observerState
+
 	// control the output of the command
 	templateType    string
 	templates       stringSliceFlag
@@ -318,6 +353,14 @@ func (o *ObserveOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args
 		return err
 	}
 
+	if len(o.stateConfigMap) > 0 {
+		_, kc, err := f.Clients()
+		if err != nil {
+			return err
+		}
+		o.configMaps = kc.Core()
+	}
+
 	switch o.templateType {
 	case "jsonpath":
 		p, err := NewJSONPathArgumentPrinter(o.includeNamespace, o.strictTemplates, o.templates...)
@@ -376,7 +419,8 @@ func (o *ObserveOptions) Complete(f *clientcmd.Factory, cmd *cobra.Command, args
 			return outputNames, nil
 		}
 		o.knownObjects = o.argumentStore
-	case len(o.deleteCommand) > 0:
+	case len(o.deleteCommand) > 0, len(o.stateConfigMap) > 0:
+		// the known objects are the ones seen since start, and those of the saved state
 		o.knownObjects = o.argumentStore
 	}
 
@@ -387,11 +431,20 @@ func (o *ObserveOptions) Validate(args []string) error {
 	if len(o.nameSyncCommand) > 0 && len(o.deleteCommand) == 0 {
 		return fmt.Errorf("--delete and --names must both be specified")
 	}
+	if len(o.nameSyncCommand) > 0 && len(o.stateConfigMap) > 0 {
+		return fmt.Errorf("--names and --state-configmap may not both be specified")
+	}
+	if o.leaderElection && len(o.stateConfigMap) == 0 {
+		return fmt.Errorf("--leader-election requires --state-configmap")
+	}
+	if o.leaderElection && o.leaseTTL <= 0 {
+		return fmt.Errorf("--leader-election-ttl must be positive")
+	}
 	return nil
 }
 
 func (o *ObserveOptions) Run() error {
-	if len(o.deleteCommand) > 0 && len(o.nameSyncCommand) == 0 {
+	if len(o.deleteCommand) > 0 && len(o.nameSyncCommand) == 0 && len(o.stateConfigMap) == 0 {
 		fmt.Fprintf(o.errOut, "warning: If you are modifying resources outside of %q, you should use the --names command to ensure you don't miss deletions that occur while the command is not running.\n", o.mapping.Resource)
 	}
 
@@ -440,6 +493,55 @@ func (o *ObserveOptions) Run() error {
 
 	defer o.dumpMetrics()
 
+	// wait to be the active observer, and exit as soon as another one may have taken over. leaseLost
+	// is set before waiting for the running command, so that the loop starts no other command meanwhile.
+	id := ""
+	var leaseLost int32
+	checkLease := func() error {
+		if atomic.LoadInt32(&leaseLost) != 0 {
+			return fmt.Errorf("the lease on config map %s/%s was lost", o.namespace, o.stateConfigMap)
+		}
+		return nil
+	}
+	if o.leaderElection {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return err
+		}
+		id = fmt.Sprintf("%s-%s", hostname, kutilrand.String(8))
+		leaser := leaderlease.NewConfigMap(o.configMaps, o.namespace, o.stateConfigMap, id, o.leaseTTL)
+		notify := make(chan error, 1)
+		go leaser.AcquireAndHold(notify)
+		fmt.Fprintf(o.errOut, "Waiting to acquire the lease on config map %s/%s as %s ...\n", o.namespace, o.stateConfigMap, id)
+		if err := <-notify; err != nil {
+			return err
+		}
+		defer leaser.Release()
+		go func() {
+			err := <-notify
+			atomic.StoreInt32(&leaseLost, 1)
+			lock.Lock()
+			defer lock.Unlock()
+			o.dumpMetrics()
+			fmt.Fprintf(o.errOut, "error: the lease was lost, exiting: %v\n", err)
+			os.Exit(1)
+		}()
+	}
+
+	// resume from the saved state
+	resuming := false
+	if len(o.stateConfigMap) > 0 {
+		state, err := loadObserverState(o.configMaps, o.namespace, o.stateConfigMap, id)
+		if err != nil {
+			return fmt.Errorf("unable to load the state from config map %s/%s: %v", o.namespace, o.stateConfigMap, err)
+		}
+		for _, key := range state.names {
+			o.argumentStore.Put(key, objectArguments{key: key})
+		}
+		o.state = state
+		resuming = len(state.resourceVersion) > 0
+	}
+
 	// start the reflector
 	reflector := cache.NewNamedReflector("observer", lw, nil, store, o.resyncPeriod)
 	reflector.Run()
@@ -472,6 +574,10 @@ func (o *ObserveOptions) Run() error {
 					lock.Lock()
 					defer lock.Unlock()
 
+					if err := checkLease(); err != nil {
+						return err
+					}
+
 					// handle before and after observe notification
 					switch {
 					case !syncing && delta.Type == cache.Sync:
@@ -498,6 +604,10 @@ func (o *ObserveOptions) Run() error {
 					if err != nil {
 						return err
 					}
+					if resuming && delta.Type == cache.Sync && o.state.processed(object) {
+						glog.V(4).Infof("Skipping %s %v, unchanged since the saved state", delta.Type, arguments)
+						return nil
+					}
 					if err := o.next(delta.Type, object, output, arguments); err != nil {
 						return err
 					}
@@ -513,6 +623,27 @@ func (o *ObserveOptions) Run() error {
 			return err
 		}
 
+		// save the progress once all of the received changes are processed. The reflector queues the
+		// changes before recording their resource version, which is read first.
+		if o.state != nil {
+			if resourceVersion := reflector.LastSyncResourceVersion(); len(resourceVersion) > 0 && len(store.ListKeys()) == 0 {
+				if err := checkLease(); err != nil {
+					return err
+				}
+				lock.Lock()
+				err := o.state.save(resourceVersion, o.argumentStore.ListKeys())
+				lock.Unlock()
+				if err != nil {
+					if err := o.handleCommandError(fmt.Errorf("unable to save the state to config map %s/%s: %v", o.namespace, o.stateConfigMap, err)); err != nil {
+						return err
+					}
+				} else {
+					// the objects of the initial list have all been processed
+					resuming = false
+				}
+			}
+		}
+
 		// if we only want to run once, exit here
 		if o.once && store.HasSynced() {
 			if syncing {
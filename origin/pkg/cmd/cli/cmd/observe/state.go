@@ -0,0 +1,117 @@
+package observe
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/api/meta"
+	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"github.com/openshift/kubernetes/pkg/client/retry"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/util/leaderlease"
+)
+
+const (
+	// stateResourceVersionKey is the config map key holding the last processed resource version
+	stateResourceVersionKey = "resourceVersion"
+	// stateNamesKey is the config map key holding the newline-delimited known names
+	stateNamesKey = "names"
+)
+
+// observerState is the progress of an observer, saved to a config map so that a restarted observer
+// can skip the objects it already processed and detect the objects deleted while it was stopped.
+type observerState struct {
+	client    kcoreclient.ConfigMapsGetter
+	namespace string
+	name      string
+	// holder is the value of the observer holding the lease on the config map, if leader
+	// election is used. The state is only saved while it still holds the lease.
+	holder string
+
+	resourceVersion string
+	names           []string
+}
+
+// loadObserverState reads the state saved in a config map. A missing config map is an empty state.
+func loadObserverState(client kcoreclient.ConfigMapsGetter, namespace, name, holder string) (*observerState, error) {
+	state := &observerState{client: client, namespace: namespace, name: name, holder: holder}
+	configMap, err := client.ConfigMaps(namespace).Get(name)
+	if kapierrors.IsNotFound(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	state.resourceVersion = configMap.Data[stateResourceVersionKey]
+	for _, name := range strings.Split(configMap.Data[stateNamesKey], "\n") {
+		if len(name) > 0 {
+			state.names = append(state.names, name)
+		}
+	}
+	return state, nil
+}
+
+// save records the last processed resource version and the known names, unless they are unchanged.
+// With leader election, the state is only saved if the lease is still held: the update fails on a
+// conflict if another observer takes the lease in the meantime.
+func (s *observerState) save(resourceVersion string, names []string) error {
+	names = append([]string{}, names...)
+	sort.Strings(names)
+	if resourceVersion == s.resourceVersion && strings.Join(names, "\n") == strings.Join(s.names, "\n") {
+		return nil
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := s.client.ConfigMaps(s.namespace).Get(s.name)
+		if kapierrors.IsNotFound(err) {
+			configMap = &kapi.ConfigMap{ObjectMeta: kapi.ObjectMeta{Namespace: s.namespace, Name: s.name}}
+		} else if err != nil {
+			return err
+		}
+		if len(s.holder) > 0 {
+			if holder := leaderlease.ConfigMapLeaseHolder(configMap); holder != s.holder {
+				return fmt.Errorf("the lease on config map %s/%s is no longer held by %s", s.namespace, s.name, s.holder)
+			}
+		}
+		// other keys and the annotations, such as the leader lease, are preserved
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[stateResourceVersionKey] = resourceVersion
+		configMap.Data[stateNamesKey] = strings.Join(names, "\n")
+		if len(configMap.ResourceVersion) == 0 {
+			_, err = s.client.ConfigMaps(s.namespace).Create(configMap)
+		} else {
+			_, err = s.client.ConfigMaps(s.namespace).Update(configMap)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	s.resourceVersion, s.names = resourceVersion, names
+	return nil
+}
+
+// processed returns true if the object was not changed since the saved resource version. Resource
+// versions are opaque, so objects whose versions can't be compared are always processed.
+func (s *observerState) processed(obj runtime.Object) bool {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	saved, err := strconv.ParseUint(s.resourceVersion, 10, 64)
+	if err != nil {
+		return false
+	}
+	current, err := strconv.ParseUint(m.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return false
+	}
+	return current <= saved
+}
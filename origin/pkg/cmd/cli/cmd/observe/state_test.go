@@ -0,0 +1,66 @@
+package observe
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+)
+
+func TestObserverState(t *testing.T) {
+	lease := map[string]string{"leaderlease.openshift.io/lease": "{}"}
+	client := fake.NewSimpleClientset(&kapi.ConfigMap{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "state", ResourceVersion: "1", Annotations: lease}})
+
+	state, err := loadObserverState(client.Core(), "test", "state", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(state.resourceVersion) != 0 || len(state.names) != 0 {
+		t.Fatalf("expected an empty state, got %#v", state)
+	}
+	if err := state.save("12", []string{"ns/b", "ns/a"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	configMap, err := client.Core().ConfigMaps("test").Get("state")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(configMap.Annotations, lease) {
+		t.Errorf("expected the annotations to be preserved, got %v", configMap.Annotations)
+	}
+
+	state, err = loadObserverState(client.Core(), "test", "state", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state.resourceVersion != "12" || !reflect.DeepEqual(state.names, []string{"ns/a", "ns/b"}) {
+		t.Errorf("unexpected state %#v", state)
+	}
+
+	for resourceVersion, expected := range map[string]bool{"11": true, "12": true, "13": false, "": false} {
+		pod := &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "a", ResourceVersion: resourceVersion}}
+		if processed := state.processed(pod); processed != expected {
+			t.Errorf("resource version %q: expected processed=%t, got %t", resourceVersion, expected, processed)
+		}
+	}
+}
+
+func TestObserverStateLostLease(t *testing.T) {
+	lease := map[string]string{"leaderlease.openshift.io/lease": `{"holder":"b"}`}
+	client := fake.NewSimpleClientset(&kapi.ConfigMap{ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "state", ResourceVersion: "1", Annotations: lease}})
+
+	state, err := loadObserverState(client.Core(), "test", "state", "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := state.save("12", []string{"ns/a"}); err == nil {
+		t.Fatalf("expected an error saving the state without holding the lease")
+	}
+	for _, action := range client.Actions() {
+		if action.GetVerb() != "get" {
+			t.Errorf("unexpected action %v", action)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"math/rand"
@@ -46,7 +47,11 @@ var (
 
 		Process resolves the template on the server, but you may pass --local to parameterize the template
 		locally. When running locally be aware that the version of your client tools will determine what
-		template transformations are supported, rather than the server.`)
+		template transformations are supported, rather than the server.
+
+		Pass --diff to compare the processed resources with the server instead of printing them. The
+		command then exits with 0 when the resources match the server, 2 when they differ, and 1 on
+		any error. See '%[1]s diff' for more information.`)
 
 	processExample = templates.Examples(`
 		# Convert template.json file into resource list and pass to create
@@ -68,7 +73,10 @@ var (
 	  %[1]s process openshift//foo
 
 	  # Convert template.json into resource list
-	  cat template.json | %[1]s process -f -`)
+	  cat template.json | %[1]s process -f -
+
+	  # Show what creating or updating the processed resources would change on the server
+	  %[1]s process -f template.json --diff`)
 )
 
 // NewCmdProcess implements the OpenShift cli process command
@@ -76,11 +84,11 @@ func NewCmdProcess(fullName string, f *clientcmd.Factory, in io.Reader, out, err
 	cmd := &cobra.Command{
 		Use:     "process (TEMPLATE | -f FILENAME) [-p=KEY=VALUE]",
 		Short:   "Process a template into list of resources",
-		Long:    processLong,
+		Long:    fmt.Sprintf(processLong, fullName),
 		Example: fmt.Sprintf(processExample, fullName),
 		Run: func(cmd *cobra.Command, args []string) {
 			err := RunProcess(f, in, out, errout, cmd, args)
-			kcmdutil.CheckErr(err)
+			checkDiffErr(err)
 		},
 	}
 	cmd.Flags().StringP("filename", "f", "", "Filename or URL to file to read a template")
@@ -99,6 +107,8 @@ func NewCmdProcess(fullName string, f *clientcmd.Factory, in io.Reader, out, err
 	cmd.Flags().Bool("raw", false, "If true, output the processed template instead of the template's objects. Implied by -o describe")
 	cmd.Flags().String("output-version", "", "Output the formatted object with the given version (default api-version).")
 	cmd.Flags().StringP("template", "t", "", "Template string or path to template file to use when -o=go-template, -o=go-templatefile.  The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]")
+	cmd.Flags().Bool("diff", false, "If true, show the differences between the processed resources and the server instead of printing them.")
+	cmd.Flags().String("diff-output", DiffFormatUnified, fmt.Sprintf("Format of the differences shown with --diff. One of: %s.", strings.Join(diffFormats, "|")))
 
 	// kcmdutil.PrinterForCommand needs these flags, however they are useless
 	// here because oc process returns list of heterogeneous objects that is
@@ -152,13 +162,26 @@ func RunProcess(f *clientcmd.Factory, in io.Reader, out, errout io.Writer, cmd *
 	}
 
 	if kcmdutil.GetFlagBool(cmd, "parameters") {
-		for _, flag := range []string{"value", "param", "labels", "output", "output-version", "raw", "template"} {
+		for _, flag := range []string{"value", "param", "labels", "output", "output-version", "raw", "template", "diff"} {
 			if f := cmd.Flags().Lookup(flag); f != nil && f.Changed {
 				return kcmdutil.UsageError(cmd, "The --parameters flag does not process the template, can't be used with --%v", flag)
 			}
 		}
 	}
 
+	diff := kcmdutil.GetFlagBool(cmd, "diff")
+	diffOptions := &DiffOptions{Format: kcmdutil.GetFlagString(cmd, "diff-output"), Exporter: &DefaultExporter{}, Out: out}
+	if diff {
+		for _, flag := range []string{"output", "output-version", "raw", "template"} {
+			if f := cmd.Flags().Lookup(flag); f != nil && f.Changed {
+				return kcmdutil.UsageError(cmd, "The --diff flag does not print the resources, can't be used with --%v", flag)
+			}
+		}
+		if err := diffOptions.Validate(); err != nil {
+			return kcmdutil.UsageError(cmd, err.Error())
+		}
+	}
+
 	namespace, explicit, err := f.DefaultNamespace()
 	if err != nil {
 		return err
@@ -317,6 +340,10 @@ func RunProcess(f *clientcmd.Factory, in io.Reader, out, errout io.Writer, cmd *
 	}
 	p = kubectl.NewVersionedPrinter(p, kapi.Scheme, version)
 
+	if diff {
+		return diffProcessedObjects(f, diffOptions, namespace, version, objects)
+	}
+
 	// use generic output
 	if kcmdutil.GetFlagBool(cmd, "raw") {
 		for i := range objects {
@@ -331,6 +358,34 @@ func RunProcess(f *clientcmd.Factory, in io.Reader, out, errout io.Writer, cmd *
 	}, out)
 }
 
+// diffProcessedObjects compares the objects of a processed template with the server, the same way they would
+// be created or applied in the namespace.
+func diffProcessedObjects(f *clientcmd.Factory, o *DiffOptions, namespace string, version unversioned.GroupVersion, objects []runtime.Object) error {
+	data, err := runtime.Encode(kapi.Codecs.LegacyCodec(version), &kapi.List{Items: objects})
+	if err != nil {
+		return err
+	}
+	mapper, typer := f.Object()
+	infos, err := resource.NewBuilder(mapper, typer, resource.ClientMapperFunc(f.ClientForMapping), kapi.Codecs.UniversalDecoder()).
+		ContinueOnError().
+		NamespaceParam(namespace).DefaultNamespace().
+		Stream(bytes.NewReader(data), "template").
+		Flatten().
+		Do().
+		Infos()
+	if err != nil {
+		return err
+	}
+	changed, err := o.Diff(infos)
+	if err != nil {
+		return err
+	}
+	if changed {
+		return errDiffChanged
+	}
+	return nil
+}
+
 // injectUserVars injects user specified variables into the Template
 func injectUserVars(values app.Environment, t *templateapi.Template) []error {
 	var errors []error
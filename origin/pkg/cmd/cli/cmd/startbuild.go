@@ -65,6 +65,9 @@ var (
 	  # Starts build from a previous build "hello-world-1"
 	  %[1]s start-build --from-build=hello-world-1
 
+	  # Starts build from build config "hello-world" overriding the Docker build argument "VERSION"
+	  %[1]s start-build hello-world --build-arg=VERSION=1.2
+
 	  # Use the contents of a directory as build input
 	  %[1]s start-build hello-world --from-dir=src/
 
@@ -97,6 +100,7 @@ func NewCmdStartBuild(fullName string, f *clientcmd.Factory, in io.Reader, out,
 	}
 	cmd.Flags().StringVar(&o.LogLevel, "build-loglevel", o.LogLevel, "Specify the log level for the build log output")
 	cmd.Flags().StringArrayVarP(&o.Env, "env", "e", o.Env, "Specify a key-value pair for an environment variable to set for the build container.")
+	cmd.Flags().StringArrayVar(&o.Args, "build-arg", o.Args, "Specify a key-value pair to pass to Docker during the build.")
 	cmd.Flags().StringVar(&o.FromBuild, "from-build", o.FromBuild, "Specify the name of a build which should be re-run")
 
 	cmd.Flags().BoolVarP(&o.Follow, "follow", "F", o.Follow, "Start a build and watch its logs until it completes or fails")
@@ -133,7 +137,8 @@ type StartBuildOptions struct {
 	FromRepo    string
 	FromArchive string
 
-	Env []string
+	Env  []string
+	Args []string
 
 	Follow          bool
 	WaitForComplete bool
@@ -149,6 +154,7 @@ type StartBuildOptions struct {
 	AsBinary    bool
 	ShortOutput bool
 	EnvVar      []kapi.EnvVar
+	BuildArgs   []kapi.EnvVar
 	Name        string
 	Namespace   string
 }
@@ -278,6 +284,13 @@ func (o *StartBuildOptions) Complete(f *clientcmd.Factory, in io.Reader, out, er
 	}
 	o.EnvVar = env
 
+	cmdutil.WarnAboutCommaSeparation(o.ErrOut, o.Args, "--build-arg")
+	buildArgs, _, err := cmdutil.ParseEnv(o.Args, in)
+	if err != nil {
+		return err
+	}
+	o.BuildArgs = buildArgs
+
 	return nil
 }
 
@@ -302,6 +315,11 @@ func (o *StartBuildOptions) Run() error {
 	if len(o.EnvVar) > 0 {
 		request.Env = o.EnvVar
 	}
+	if len(o.BuildArgs) > 0 {
+		request.DockerStrategyOptions = &buildapi.DockerStrategyOptions{
+			BuildArgs: o.BuildArgs,
+		}
+	}
 	if len(o.Commit) > 0 {
 		request.Revision = &buildapi.SourceRevision{
 			Git: &buildapi.GitSourceRevision{
@@ -324,6 +342,9 @@ func (o *StartBuildOptions) Run() error {
 		if len(o.EnvVar) > 0 {
 			fmt.Fprintf(o.ErrOut, "WARNING: Specifying environment variables with binary builds is not supported.\n")
 		}
+		if len(o.BuildArgs) > 0 {
+			fmt.Fprintf(o.ErrOut, "WARNING: Specifying build arguments with binary builds is not supported.\n")
+		}
 		if newBuild, err = streamPathToBuild(o.Git, o.In, o.ErrOut, o.Client.BuildConfigs(o.Namespace), o.FromDir, o.FromFile, o.FromRepo, request); err != nil {
 			if kerrors.IsAlreadyExists(err) {
 				return transformIsAlreadyExistsError(err, o.Name)
@@ -143,6 +143,10 @@ func (d *BuildDescriber) Describe(namespace, name string, settings kctl.Describe
 		if build.Status.Output.To != nil && len(build.Status.Output.To.ImageDigest) > 0 {
 			formatString(out, "Image Digest", build.Status.Output.To.ImageDigest)
 		}
+		if build.Status.Output.To != nil && build.Status.Output.To.ImageSizeBytes > 0 {
+			formatString(out, "Image Size", units.HumanSize(float64(build.Status.Output.To.ImageSizeBytes)))
+		}
+		describeBuildStages(build.Status.Stages, out)
 
 		describeCommonSpec(build.Spec.CommonSpec, out)
 		describeBuildTriggerCauses(build.Spec.TriggeredBy, out)
@@ -176,6 +180,19 @@ func describeBuildDuration(build *buildapi.Build) string {
 	return fmt.Sprintf("%v", duration)
 }
 
+// describeBuildStages prints the stages a build went through with the time
+// each of them took.
+func describeBuildStages(stages []buildapi.StageInfo, out *tabwriter.Writer) {
+	if len(stages) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "\nStage\tStarted\tDuration\n")
+	for _, stage := range stages {
+		duration := time.Duration(stage.DurationMilliseconds) * time.Millisecond
+		fmt.Fprintf(out, "%s\t%s\t%v\n", stage.Name, stage.StartTime.Time.Format(time.RFC1123), duration)
+	}
+}
+
 // BuildConfigDescriber generates information about a buildConfig
 type BuildConfigDescriber struct {
 	client.Interface
@@ -557,6 +574,16 @@ func describeImage(image *imageapi.Image, imageName string) (string, error) {
 		formatString(out, "Image Created", fmt.Sprintf("%s ago", formatRelativeTime(image.DockerImageMetadata.Created.Time)))
 		formatString(out, "Author", image.DockerImageMetadata.Author)
 		formatString(out, "Arch", image.DockerImageMetadata.Architecture)
+		if len(image.DockerImageManifests) > 0 {
+			fmt.Fprintf(out, "Manifests:\n")
+			for _, manifest := range image.DockerImageManifests {
+				platform := manifest.OS + "/" + manifest.Architecture
+				if len(manifest.Variant) > 0 {
+					platform += "/" + manifest.Variant
+				}
+				fmt.Fprintf(out, "  %s\t%s\n", platform, manifest.Digest)
+			}
+		}
 		describeDockerImage(out, image.DockerImageMetadata.Config)
 		return nil
 	})
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 	"text/tabwriter"
+	"time"
 
 	kapi "github.com/openshift/kubernetes/pkg/api"
 	"github.com/openshift/kubernetes/pkg/api/unversioned"
@@ -57,6 +58,7 @@ var DescriberCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&oauthapi.OAuthAuthorizeToken{}),                   // normal users don't ever look at these
 	reflect.TypeOf(&oauthapi.OAuthClientAuthorization{}),              // normal users don't ever look at these
 	reflect.TypeOf(&projectapi.ProjectRequest{}),                      // normal users don't ever look at these
+	reflect.TypeOf(&projectapi.ProjectHealth{}),                       // a subresource of projects, shown by 'oc status'
 	reflect.TypeOf(&authorizationapi.IsPersonalSubjectAccessReview{}), // not a top level resource
 	// ATM image signature doesn't provide any human readable information
 	reflect.TypeOf(&imageapi.ImageSignature{}),
@@ -454,3 +456,30 @@ func TestDescribeBuildSpec(t *testing.T) {
 		}
 	}
 }
+
+func TestDescribeBuildStages(t *testing.T) {
+	start := unversioned.NewTime(time.Date(2016, 10, 6, 10, 0, 0, 0, time.UTC))
+	stages := []buildapi.StageInfo{
+		{Name: buildapi.StageFetchInputs, StartTime: start, DurationMilliseconds: 1500},
+		{Name: buildapi.StagePushImage, StartTime: start, DurationMilliseconds: 90000},
+	}
+	var b bytes.Buffer
+	out := tabwriter.NewWriter(&b, 0, 8, 0, '\t', 0)
+	describeBuildStages(stages, out)
+	if err := out.Flush(); err != nil {
+		t.Fatalf("flush error: %v", err)
+	}
+	got := b.String()
+	for _, want := range []string{"FetchInputs\tThu, 06 Oct 2016 10:00:00 UTC\t1.5s", "PushImage\tThu, 06 Oct 2016 10:00:00 UTC\t1m30s"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("describeBuildStages(%+v, out) = %q, should contain %q", stages, got, want)
+		}
+	}
+
+	b.Reset()
+	describeBuildStages(nil, out)
+	out.Flush()
+	if b.Len() != 0 {
+		t.Errorf("expected no output for a build without stages, got %q", b.String())
+	}
+}
@@ -409,6 +409,11 @@ func formatImageStreamTags(out *tabwriter.Writer, stream *imageapi.ImageStream)
 					d := now.Sub(condition.LastTransitionTime.Time)
 					fmt.Fprintf(out, "  ! error: Import failed (%s): %s\n      %s ago\n", condition.Reason, condition.Message, units.HumanDuration(d))
 				}
+			case imageapi.ImageReplicated:
+				if condition.Status == api.ConditionFalse {
+					d := now.Sub(condition.LastTransitionTime.Time)
+					fmt.Fprintf(out, "  ! error: Replication failed (%s): %s\n      %s ago\n", condition.Reason, condition.Message, units.HumanDuration(d))
+				}
 			}
 		}
 
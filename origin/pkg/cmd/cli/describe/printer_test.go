@@ -35,6 +35,7 @@ var PrinterCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&deployapi.DeploymentRequest{}),    // normal users don't ever look at these
 	reflect.TypeOf(&deployapi.DeploymentLog{}),        // just a marker type
 	reflect.TypeOf(&deployapi.DeploymentLogOptions{}), // just a marker type
+	reflect.TypeOf(&projectapi.ProjectHealth{}),       // a subresource of projects, shown by 'oc status'
 
 	// these resources can't be "GET"ed, so we probably don't need a printer for them
 	reflect.TypeOf(&authorizationapi.SubjectAccessReviewResponse{}),
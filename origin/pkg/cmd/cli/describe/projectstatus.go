@@ -144,6 +144,33 @@ func (d *ProjectStatusDescriber) MakeGraph(namespace string) (osgraph.Graph, set
 	return g, forbiddenResources, nil
 }
 
+// MakeMarkers runs every marker scanner against a graph built by MakeGraph and returns the markers
+// that belong to the namespace, in the order they are displayed.
+func (d *ProjectStatusDescriber) MakeMarkers(g osgraph.Graph, forbiddenResources sets.String, namespace string, f osgraph.Namer) osgraph.Markers {
+	allMarkers := osgraph.Markers{}
+	allMarkers = append(allMarkers, createForbiddenMarkers(forbiddenResources)...)
+	for _, scanner := range getMarkerScanners(d.LogsCommandName, d.SecurityPolicyCommandFormat, d.SetProbeCommandName, forbiddenResources) {
+		allMarkers = append(allMarkers, scanner(g, f)...)
+	}
+
+	// TODO: Provide an option to chase these hidden markers.
+	allMarkers = allMarkers.FilterByNamespace(namespace)
+
+	sort.Stable(osgraph.ByKey(allMarkers))
+	sort.Stable(osgraph.ByNodeID(allMarkers))
+	return allMarkers
+}
+
+// ProjectMarkers builds the graph of a single namespace and returns its markers, named relative
+// to that namespace.
+func (d *ProjectStatusDescriber) ProjectMarkers(namespace string) (osgraph.Markers, error) {
+	g, forbiddenResources, err := d.MakeGraph(namespace)
+	if err != nil {
+		return nil, err
+	}
+	return d.MakeMarkers(g, forbiddenResources, namespace, namespacedFormatter{currentNamespace: namespace}), nil
+}
+
 // Describe returns the description of a project
 func (d *ProjectStatusDescriber) Describe(namespace, name string) (string, error) {
 	var f formatter = namespacedFormatter{}
@@ -279,20 +306,10 @@ func (d *ProjectStatusDescriber) Describe(namespace, name string) (string, error
 			printLines(out, indent, 0, describeMonopod(f, monopod.Pod)...)
 		}
 
-		allMarkers := osgraph.Markers{}
-		allMarkers = append(allMarkers, createForbiddenMarkers(forbiddenResources)...)
-		for _, scanner := range getMarkerScanners(d.LogsCommandName, d.SecurityPolicyCommandFormat, d.SetProbeCommandName, forbiddenResources) {
-			allMarkers = append(allMarkers, scanner(g, f)...)
-		}
-
-		// TODO: Provide an option to chase these hidden markers.
-		allMarkers = allMarkers.FilterByNamespace(namespace)
+		allMarkers := d.MakeMarkers(g, forbiddenResources, namespace, f)
 
 		fmt.Fprintln(out)
 
-		sort.Stable(osgraph.ByKey(allMarkers))
-		sort.Stable(osgraph.ByNodeID(allMarkers))
-
 		errorMarkers := allMarkers.BySeverity(osgraph.ErrorSeverity)
 		errorSuggestions := 0
 		if len(errorMarkers) > 0 {
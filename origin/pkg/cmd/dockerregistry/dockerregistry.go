@@ -51,6 +51,14 @@ func Execute(configFile io.Reader) {
 	}
 	setDefaultMiddleware(config)
 	setDefaultLogParameters(config)
+	for _, middleware := range config.Middleware["repository"] {
+		if middleware.Name != "openshift" {
+			continue
+		}
+		if err := server.ValidateReplication(middleware.Options); err != nil {
+			log.Fatalf("error configuring image replication: %v", err)
+		}
+	}
 
 	ctx := context.Background()
 	ctx, err = configureLogging(ctx, config)
@@ -101,6 +109,17 @@ func Execute(configFile io.Reader) {
 		pruneAccessRecords,
 	)
 
+	app.RegisterRoute(
+		// POST /admin/gc
+		adminRouter.Path("/gc").Methods("POST"),
+		// handler
+		server.GarbageCollectionDispatcher,
+		// repo name not required in url
+		handlers.NameNotRequired,
+		// custom access records
+		pruneAccessRecords,
+	)
+
 	// Registry extensions endpoint provides extra functionality to handle the image
 	// signatures.
 	server.RegisterSignatureHandler(app)
@@ -37,7 +37,26 @@ var (
 		will provide IP failover capability. If you are running in production, it is
 		recommended that the labeled selector for the nodes matches at least 2 nodes
 		to ensure you have failover protection, and that you provide a --replicas=<n>
-		value that matches the number of nodes for the given labeled selector.`)
+		value that matches the number of nodes for the given labeled selector.
+
+		By default keepalived announces itself with multicast VRRP. On networks
+		that block multicast, pass --unicast to send the adverts to the other
+		selected nodes instead. The peers are looked up from the nodes matching
+		the selector when the configuration is generated; pass --unicast-peers
+		to list them explicitly, and re-run the command when the nodes change.
+
+		Each address in --virtual-ips gets its own VRRP instance. To fail over
+		several addresses together, or to make different addresses favor
+		different nodes, use --vip-group one or more times. A group takes the
+		form VIPS;vrid=N[;priority=N][;preempt=true|false|SECONDS]
+		[;nodes=NODE1:NODE2][;check=SCRIPT]. The group is held by the listed
+		nodes with the given priority whenever they are healthy. The priority
+		must be in the range 65..254 (default 200), because the other nodes
+		use lower priorities below it.
+
+		Check scripts can be supplied in a config map with
+		--check-script-configmap. The config map is mounted into the pod and
+		check script names that are not absolute paths refer to its keys.`)
 
 	ipFailover_example = templates.Examples(`
 		# Check the default IP failover configuration ("ipfailover"):
@@ -54,6 +73,12 @@ var (
 	  # listening on port 80, such as the router process).
 	  %[1]s %[2]s ipfailover --selector="router=us-west-ha" --virtual-ips="1.2.3.4,10.1.1.100-104,5.6.7.8" --watch-port=80 --replicas=4 --create
 
+	  # Use unicast VRRP and two VIP groups that prefer different nodes, with a
+	  # check script taken from the "ipf-checks" config map:
+	  %[1]s %[2]s ipf-groups --selector="router=us-west-ha" --unicast --check-script-configmap=ipf-checks \
+	      --vip-group="10.1.1.10-11;vrid=10;nodes=node-a;check=web.sh" \
+	      --vip-group="10.1.1.20;vrid=20;priority=150;preempt=false;nodes=node-b" --replicas=2 --create
+
 	  # Use a different IP failover config image and see the configuration:
 	  %[1]s %[2]s ipf-alt --selector="hagroup=us-west-ha" --virtual-ips="1.2.3.4" -o yaml --images=myrepo/myipfailover:mytag`)
 )
@@ -105,6 +130,10 @@ func NewCmdIPFailoverConfig(f *clientcmd.Factory, parentName, name string, out,
 
 	cmd.Flags().IntVarP(&options.WatchPort, "watch-port", "w", ipfailover.DefaultWatchPort, "Port to monitor or watch for resource availability.")
 	cmd.Flags().IntVar(&options.VRRPIDOffset, "vrrp-id-offset", options.VRRPIDOffset, "Offset to use for setting ids of VRRP instances (default offset is 0). This allows multiple ipfailover instances to run within the same cluster.")
+	cmd.Flags().BoolVar(&options.UseUnicast, "unicast", options.UseUnicast, "If true, send VRRP adverts to the selected nodes with unicast instead of multicast.")
+	cmd.Flags().StringVar(&options.UnicastPeers, "unicast-peers", "", "Comma separated addresses of the unicast VRRP peers. Defaults to the addresses of the nodes matching the selector.")
+	cmd.Flags().StringArrayVar(&options.VIPGroups, "vip-group", options.VIPGroups, "A group of virtual IPs that fail over together: VIPS;vrid=N[;priority=N][;preempt=true|false|SECONDS][;nodes=NODE1:NODE2][;check=SCRIPT]. May be repeated.")
+	cmd.Flags().StringVar(&options.CheckScriptConfigMap, "check-script-configmap", "", "Name of a config map holding check scripts to mount into the pod. Relative check script names refer to its keys.")
 	cmd.Flags().Int32VarP(&options.Replicas, "replicas", "r", options.Replicas, "The replication factor of this IP failover configuration; commonly 2 when high availability is desired. Please ensure this matches the number of nodes that satisfy the selector (or default selector) specified.")
 
 	options.Action.BindForOutput(cmd.Flags())
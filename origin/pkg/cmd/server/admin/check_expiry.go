@@ -0,0 +1,525 @@
+package admin
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openshift/github.com/ghodss/yaml"
+	"github.com/openshift/github.com/spf13/cobra"
+
+	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
+	kclientset "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"github.com/openshift/kubernetes/pkg/client/unversioned/clientcmd"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+
+	configapi "github.com/openshift/origin/pkg/cmd/server/api"
+	configapilatest "github.com/openshift/origin/pkg/cmd/server/api/latest"
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+	"github.com/openshift/origin/pkg/cmd/templates"
+	osclientcmd "github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const CheckExpiryCommandName = "check-expiry"
+
+const (
+	// CertSetMaster holds the master serving certificates and the client certificates the master uses.
+	CertSetMaster = "master"
+	// CertSetEtcd holds the etcd serving and peer certificates and the etcd client certificate of the master.
+	CertSetEtcd = "etcd"
+	// CertSetNode holds the node serving certificates and the client certificates nodes use.
+	CertSetNode = "node"
+)
+
+// CertSets lists the certificate sets that can be rotated.
+var CertSets = []string{CertSetMaster, CertSetEtcd, CertSetNode}
+
+const (
+	CertificateStatusOK       = "ok"
+	CertificateStatusExpiring = "expiring"
+	CertificateStatusExpired  = "expired"
+)
+
+var checkExpiryLong = templates.LongDesc(`
+	Check when the cluster certificates expire
+
+	Lists the certificates referenced by the given master and node configuration files,
+	including the client certificates embedded in the kubeconfig files they reference,
+	and the certificates stored in well known secrets, such as the ones of the router
+	and of the integrated registry. Each certificate is listed with its expiry date and
+	flagged when it expires within the warning period. The command fails if any
+	certificate is expired or about to expire.`)
+
+var checkExpiryExample = templates.Examples(`
+	# Check the certificates of a master and of the node running next to it
+	%[1]s --master-config=/etc/origin/master/master-config.yaml --node-config=/etc/origin/node/node-config.yaml
+
+	# Check the certificates of a master without contacting the cluster
+	%[1]s --master-config=/etc/origin/master/master-config.yaml --secrets=''
+
+	# List the certificates expiring in the next 90 days as JSON
+	%[1]s --master-config=/etc/origin/master/master-config.yaml --days=90 -o json`)
+
+// DefaultCertificateSecrets are the secrets holding the certificates of the router and of the integrated registry.
+var DefaultCertificateSecrets = []string{"default/router-certs", "default/registry-certificates"}
+
+type CheckExpiryOptions struct {
+	MasterConfigFile string
+	NodeConfigFiles  []string
+	KubeConfigFiles  []string
+	Secrets          []string
+
+	Days   int
+	Output string
+
+	KubeClient kclientset.Interface
+	Now        func() time.Time
+	Out        io.Writer
+}
+
+// CertificateExpiry describes when a single certificate expires.
+type CertificateExpiry struct {
+	Name     string    `json:"name"`
+	Source   string    `json:"source"`
+	Subject  string    `json:"subject"`
+	NotAfter time.Time `json:"notAfter"`
+	DaysLeft int       `json:"daysLeft"`
+	Status   string    `json:"status"`
+}
+
+func NewCommandCheckExpiry(f *osclientcmd.Factory, commandName string, fullName string, out io.Writer) *cobra.Command {
+	options := &CheckExpiryOptions{
+		Secrets: DefaultCertificateSecrets,
+		Days:    30,
+		Now:     time.Now,
+		Out:     out,
+	}
+
+	cmd := &cobra.Command{
+		Use:     commandName,
+		Short:   "Check when the cluster certificates expire",
+		Long:    checkExpiryLong,
+		Example: fmt.Sprintf(checkExpiryExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			kcmdutil.CheckErr(options.Complete(f, args))
+			if err := options.Validate(); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			kcmdutil.CheckErr(options.CheckExpiry())
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&options.MasterConfigFile, "master-config", options.MasterConfigFile, "Location of the master configuration file.")
+	flags.StringSliceVar(&options.NodeConfigFiles, "node-config", options.NodeConfigFiles, "Location of a node configuration file. May be repeated.")
+	flags.StringSliceVar(&options.KubeConfigFiles, "kubeconfig-files", options.KubeConfigFiles, "Additional kubeconfig files whose certificates should be checked, such as admin.kubeconfig.")
+	flags.StringSliceVar(&options.Secrets, "secrets", options.Secrets, "Secrets holding certificates to check, as namespace/name. Set to '' to skip contacting the cluster.")
+	flags.IntVar(&options.Days, "days", options.Days, "Certificates expiring within this number of days are reported as expiring.")
+	flags.StringVarP(&options.Output, "output", "o", options.Output, "Output format. One of: json|yaml.")
+
+	cmd.MarkFlagFilename("master-config", "yaml", "yml")
+	cmd.MarkFlagFilename("node-config", "yaml", "yml")
+	cmd.MarkFlagFilename("kubeconfig-files")
+
+	return cmd
+}
+
+func (o *CheckExpiryOptions) Complete(f *osclientcmd.Factory, args []string) error {
+	if len(args) != 0 {
+		return errors.New("no arguments are supported")
+	}
+	if len(o.Secrets) == 0 {
+		return nil
+	}
+	kubeClient, err := f.ClientSet()
+	if err != nil {
+		return fmt.Errorf("unable to read the certificate secrets, use --secrets='' to only check the configuration files: %v", err)
+	}
+	o.KubeClient = kubeClient
+	return nil
+}
+
+func (o CheckExpiryOptions) Validate() error {
+	if len(o.MasterConfigFile) == 0 && len(o.NodeConfigFiles) == 0 && len(o.KubeConfigFiles) == 0 && len(o.Secrets) == 0 {
+		return errors.New("at least one of --master-config, --node-config, --kubeconfig-files or --secrets must be provided")
+	}
+	for _, secret := range o.Secrets {
+		if parts := strings.Split(secret, "/"); len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return fmt.Errorf("--secrets: %q must be of the form namespace/name", secret)
+		}
+	}
+	if o.Days < 0 {
+		return errors.New("--days must not be negative")
+	}
+	switch o.Output {
+	case "", "json", "yaml":
+	default:
+		return fmt.Errorf("--output: unsupported format %q", o.Output)
+	}
+	return nil
+}
+
+func (o CheckExpiryOptions) CheckExpiry() error {
+	locations, err := configCertLocations(o.MasterConfigFile, o.NodeConfigFiles, o.KubeConfigFiles)
+	if err != nil {
+		return err
+	}
+	secretLocations, err := o.secretCertLocations()
+	if err != nil {
+		return err
+	}
+	locations = append(locations, secretLocations...)
+
+	now := o.Now()
+	expiries := []CertificateExpiry{}
+	for _, location := range locations {
+		certs, err := location.certificates()
+		if err != nil {
+			return err
+		}
+		for _, cert := range certs {
+			expiries = append(expiries, newCertificateExpiry(location, cert, now, o.Days))
+		}
+	}
+	sort.Stable(byNotAfter(expiries))
+
+	if err := printCertificateExpiries(o.Out, o.Output, expiries); err != nil {
+		return err
+	}
+
+	failing := 0
+	for _, expiry := range expiries {
+		if expiry.Status != CertificateStatusOK {
+			failing++
+		}
+	}
+	if failing > 0 {
+		return fmt.Errorf("%d certificate(s) expired or expire within %d days", failing, o.Days)
+	}
+	return nil
+}
+
+func (o CheckExpiryOptions) secretCertLocations() ([]certLocation, error) {
+	locations := []certLocation{}
+	for _, secret := range o.Secrets {
+		parts := strings.Split(secret, "/")
+		s, err := o.KubeClient.Core().Secrets(parts[0]).Get(parts[1])
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys := []string{}
+		for key := range s.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			// secrets hold keys and other data next to the certificates
+			if _, err := crypto.CertsFromPEM(s.Data[key]); err != nil {
+				continue
+			}
+			locations = append(locations, certLocation{
+				Name:     fmt.Sprintf("secret %s", secret),
+				source:   fmt.Sprintf("secret/%s[%s]", secret, key),
+				certData: s.Data[key],
+				CA:       true,
+			})
+		}
+	}
+	return locations, nil
+}
+
+func newCertificateExpiry(location certLocation, cert *x509.Certificate, now time.Time, days int) CertificateExpiry {
+	expiry := CertificateExpiry{
+		Name:     location.Name,
+		Source:   location.Source(),
+		Subject:  cert.Subject.CommonName,
+		NotAfter: cert.NotAfter,
+		DaysLeft: int(cert.NotAfter.Sub(now).Hours() / 24),
+		Status:   CertificateStatusOK,
+	}
+	switch {
+	case now.After(cert.NotAfter):
+		expiry.Status = CertificateStatusExpired
+	case now.AddDate(0, 0, days).After(cert.NotAfter):
+		expiry.Status = CertificateStatusExpiring
+	}
+	return expiry
+}
+
+type byNotAfter []CertificateExpiry
+
+func (s byNotAfter) Len() int           { return len(s) }
+func (s byNotAfter) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byNotAfter) Less(i, j int) bool { return s[i].NotAfter.Before(s[j].NotAfter) }
+
+func printCertificateExpiries(out io.Writer, format string, expiries []CertificateExpiry) error {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(expiries, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(data))
+		return nil
+	case "yaml":
+		data, err := yaml.Marshal(expiries)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(data))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCE\tSUBJECT\tEXPIRES\tDAYS LEFT\tSTATUS")
+	for _, expiry := range expiries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", expiry.Name, expiry.Source, expiry.Subject, expiry.NotAfter.Format(time.RFC3339), expiry.DaysLeft, expiry.Status)
+	}
+	return w.Flush()
+}
+
+// certLocation describes where a certificate is kept and what it is used for.
+type certLocation struct {
+	// Name describes what the certificate is used for.
+	Name string
+	// Set is the certificate set the certificate is rotated with. It is empty for certificates that are never
+	// rotated, such as CAs.
+	Set string
+	// CA is true for files holding CA bundles. Every certificate of the file is checked, while only the first
+	// one is checked for other files, the rest being the chain of its signers.
+	CA bool
+	// Serving is true for serving certificates, which are regenerated for the same hostnames.
+	Serving bool
+
+	CertFile string
+	KeyFile  string
+
+	// KubeConfigFile and AuthInfo identify a client certificate embedded in a kubeconfig.
+	KubeConfigFile string
+	AuthInfo       string
+
+	source   string
+	certData []byte
+}
+
+// Source returns a description of where the certificate is kept.
+func (l certLocation) Source() string {
+	switch {
+	case len(l.source) > 0:
+		return l.source
+	case len(l.KubeConfigFile) > 0:
+		return fmt.Sprintf("%s[%s]", l.KubeConfigFile, l.AuthInfo)
+	default:
+		return l.CertFile
+	}
+}
+
+func (l certLocation) certificates() ([]*x509.Certificate, error) {
+	data := l.certData
+	if data == nil {
+		var err error
+		if data, err = ioutil.ReadFile(l.CertFile); err != nil {
+			return nil, err
+		}
+	}
+	certs, err := crypto.CertsFromPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the %s certificate from %s: %v", l.Name, l.Source(), err)
+	}
+	if !l.CA {
+		certs = certs[:1]
+	}
+	return certs, nil
+}
+
+// configCertLocations returns the certificates referenced by the given configuration files, without duplicates.
+func configCertLocations(masterConfigFile string, nodeConfigFiles, kubeConfigFiles []string) ([]certLocation, error) {
+	locations := []certLocation{}
+	if len(masterConfigFile) > 0 {
+		masterConfig, err := configapilatest.ReadAndResolveMasterConfig(masterConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		masterLocations, err := masterCertLocations(masterConfig)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, masterLocations...)
+	}
+	for _, nodeConfigFile := range nodeConfigFiles {
+		nodeConfig, err := configapilatest.ReadAndResolveNodeConfig(nodeConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		nodeLocations, err := nodeCertLocations(nodeConfig)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, nodeLocations...)
+	}
+	for _, kubeConfigFile := range kubeConfigFiles {
+		kubeConfigLocations, err := kubeConfigCertLocations("kubeconfig", CertSetMaster, kubeConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, kubeConfigLocations...)
+	}
+
+	// several configuration fields commonly point at the same file
+	seen := map[string]bool{}
+	unique := []certLocation{}
+	for _, location := range locations {
+		if seen[location.Source()] {
+			continue
+		}
+		seen[location.Source()] = true
+		unique = append(unique, location)
+	}
+	return unique, nil
+}
+
+func masterCertLocations(config *configapi.MasterConfig) ([]certLocation, error) {
+	locations := []certLocation{}
+	addCA := func(name, file string) {
+		if len(file) > 0 {
+			locations = append(locations, certLocation{Name: name, CA: true, CertFile: file})
+		}
+	}
+	addCert := func(name, set string, serving bool, certInfo configapi.CertInfo) {
+		if len(certInfo.CertFile) > 0 {
+			locations = append(locations, certLocation{Name: name, Set: set, Serving: serving, CertFile: certInfo.CertFile, KeyFile: certInfo.KeyFile})
+		}
+	}
+
+	addCA("master client CA", config.ServingInfo.ClientCA)
+	addCA("etcd CA", config.EtcdClientInfo.CA)
+	addCA("kubelet CA", config.KubeletClientInfo.CA)
+	if signer := config.ControllerConfig.ServiceServingCert.Signer; signer != nil {
+		addCA("service serving signer", signer.CertFile)
+	}
+
+	addCert("master serving", CertSetMaster, true, config.ServingInfo.ServerCert)
+	for _, namedCert := range config.ServingInfo.NamedCertificates {
+		addCert(fmt.Sprintf("master serving %s", strings.Join(namedCert.Names, ",")), CertSetMaster, true, namedCert.CertInfo)
+	}
+	if config.AssetConfig != nil {
+		addCert("asset serving", CertSetMaster, true, config.AssetConfig.ServingInfo.ServerCert)
+	}
+	addCert("kubelet client", CertSetMaster, false, config.KubeletClientInfo.ClientCert)
+	if config.KubernetesMasterConfig != nil {
+		addCert("proxy client", CertSetMaster, false, config.KubernetesMasterConfig.ProxyClientInfo)
+	}
+
+	if config.EtcdConfig != nil {
+		addCA("etcd client CA", config.EtcdConfig.ServingInfo.ClientCA)
+		addCA("etcd peer CA", config.EtcdConfig.PeerServingInfo.ClientCA)
+		addCert("etcd serving", CertSetEtcd, true, config.EtcdConfig.ServingInfo.ServerCert)
+		addCert("etcd peer serving", CertSetEtcd, true, config.EtcdConfig.PeerServingInfo.ServerCert)
+	}
+	addCert("etcd client", CertSetEtcd, false, config.EtcdClientInfo.ClientCert)
+
+	for _, kubeConfig := range []struct{ name, file string }{
+		{"loopback kubeconfig", config.MasterClients.OpenShiftLoopbackKubeConfig},
+		{"external kubernetes kubeconfig", config.MasterClients.ExternalKubernetesKubeConfig},
+	} {
+		if len(kubeConfig.file) == 0 {
+			continue
+		}
+		kubeConfigLocations, err := kubeConfigCertLocations(kubeConfig.name, CertSetMaster, kubeConfig.file)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, kubeConfigLocations...)
+	}
+
+	return locations, nil
+}
+
+func nodeCertLocations(config *configapi.NodeConfig) ([]certLocation, error) {
+	locations := []certLocation{}
+	if len(config.ServingInfo.ClientCA) > 0 {
+		locations = append(locations, certLocation{Name: "node client CA", CA: true, CertFile: config.ServingInfo.ClientCA})
+	}
+	if len(config.ServingInfo.ServerCert.CertFile) > 0 {
+		locations = append(locations, certLocation{
+			Name:     "node serving",
+			Set:      CertSetNode,
+			Serving:  true,
+			CertFile: config.ServingInfo.ServerCert.CertFile,
+			KeyFile:  config.ServingInfo.ServerCert.KeyFile,
+		})
+	}
+	if len(config.MasterKubeConfig) > 0 {
+		kubeConfigLocations, err := kubeConfigCertLocations("node kubeconfig", CertSetNode, config.MasterKubeConfig)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, kubeConfigLocations...)
+	}
+	return locations, nil
+}
+
+// kubeConfigCertLocations returns the CAs and the client certificates of a kubeconfig, whether they are embedded
+// or referenced.
+func kubeConfigCertLocations(name, set, kubeConfigFile string) ([]certLocation, error) {
+	kubeConfig, err := clientcmd.LoadFromFile(kubeConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := clientcmd.ResolveLocalPaths(kubeConfig); err != nil {
+		return nil, err
+	}
+
+	locations := []certLocation{}
+	clusterNames := []string{}
+	for clusterName := range kubeConfig.Clusters {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	for _, clusterName := range clusterNames {
+		cluster := kubeConfig.Clusters[clusterName]
+		switch {
+		case len(cluster.CertificateAuthorityData) > 0:
+			locations = append(locations, certLocation{
+				Name:     name + " CA",
+				CA:       true,
+				source:   fmt.Sprintf("%s[%s]", kubeConfigFile, clusterName),
+				certData: cluster.CertificateAuthorityData,
+			})
+		case len(cluster.CertificateAuthority) > 0:
+			locations = append(locations, certLocation{Name: name + " CA", CA: true, CertFile: cluster.CertificateAuthority})
+		}
+	}
+
+	authInfoNames := []string{}
+	for authInfoName := range kubeConfig.AuthInfos {
+		authInfoNames = append(authInfoNames, authInfoName)
+	}
+	sort.Strings(authInfoNames)
+	for _, authInfoName := range authInfoNames {
+		authInfo := kubeConfig.AuthInfos[authInfoName]
+		switch {
+		case len(authInfo.ClientCertificateData) > 0:
+			locations = append(locations, certLocation{
+				Name:           name,
+				Set:            set,
+				KubeConfigFile: kubeConfigFile,
+				AuthInfo:       authInfoName,
+				certData:       authInfo.ClientCertificateData,
+			})
+		case len(authInfo.ClientCertificate) > 0:
+			locations = append(locations, certLocation{Name: name, Set: set, CertFile: authInfo.ClientCertificate, KeyFile: authInfo.ClientKey})
+		}
+	}
+	return locations, nil
+}
@@ -0,0 +1,283 @@
+package admin
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift/github.com/spf13/cobra"
+
+	"github.com/openshift/kubernetes/pkg/auth/user"
+	"github.com/openshift/kubernetes/pkg/client/unversioned/clientcmd"
+	clientcmdapi "github.com/openshift/kubernetes/pkg/client/unversioned/clientcmd/api"
+	kcmdutil "github.com/openshift/kubernetes/pkg/kubectl/cmd/util"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+	"github.com/openshift/origin/pkg/cmd/templates"
+)
+
+const RotateCertsCommandName = "rotate"
+
+var rotateCertsLong = templates.LongDesc(`
+	Regenerate the cluster certificates
+
+	Regenerates the certificates of the chosen sets, signed by the existing signer, so that
+	clients trusting the CA keep trusting the new certificates:
+
+	* master: the master and asset serving certificates, the kubelet and proxy client
+	  certificates and the client certificates of the master kubeconfig files
+	* etcd: the etcd serving and peer certificates and the etcd client certificate
+	* node: the node serving certificates and the client certificates of the node kubeconfig files
+
+	Serving certificates are regenerated for the hostnames they are currently valid for, client
+	certificates for the same user and groups. Certificates not issued by the signer, such as
+	custom named certificates, are left alone. Every file is backed up next to itself before
+	it is replaced, and kubeconfig files embedding a rotated client certificate are updated.
+
+	By default the command only lists the certificates it would regenerate. Add --confirm to
+	regenerate them, then restart the master and node processes to load the new certificates.`)
+
+var rotateCertsExample = templates.Examples(`
+	# List the master and etcd certificates that would be regenerated
+	%[1]s --master-config=/etc/origin/master/master-config.yaml --certs=master,etcd
+
+	# Regenerate them, along with the client certificate of admin.kubeconfig
+	%[1]s --master-config=/etc/origin/master/master-config.yaml --certs=master,etcd \
+	    --kubeconfig-files=/etc/origin/master/admin.kubeconfig --confirm
+
+	# Regenerate the certificates of a node
+	%[1]s --node-config=/etc/origin/node/node-config.yaml --certs=node \
+	    --signer-cert=/etc/origin/master/ca.crt --signer-key=/etc/origin/master/ca.key \
+	    --signer-serial=/etc/origin/master/ca.serial.txt --confirm`)
+
+type RotateCertsOptions struct {
+	SignerCertOptions *SignerCertOptions
+
+	MasterConfigFile string
+	NodeConfigFiles  []string
+	KubeConfigFiles  []string
+	CertSets         []string
+
+	ExpireDays int
+	Confirm    bool
+
+	Now func() time.Time
+	Out io.Writer
+}
+
+func NewCommandRotateCerts(commandName string, fullName string, out io.Writer) *cobra.Command {
+	options := &RotateCertsOptions{
+		SignerCertOptions: NewDefaultSignerCertOptions(),
+		ExpireDays:        crypto.DefaultCertificateLifetimeInDays,
+		Now:               time.Now,
+		Out:               out,
+	}
+
+	cmd := &cobra.Command{
+		Use:     commandName,
+		Short:   "Regenerate the cluster certificates with the existing signer",
+		Long:    rotateCertsLong,
+		Example: fmt.Sprintf(rotateCertsExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			options.Complete(cmd)
+			if err := options.Validate(args); err != nil {
+				kcmdutil.CheckErr(kcmdutil.UsageError(cmd, err.Error()))
+			}
+			kcmdutil.CheckErr(options.RotateCerts())
+		},
+	}
+
+	flags := cmd.Flags()
+	BindSignerCertOptions(options.SignerCertOptions, flags, "")
+
+	flags.StringVar(&options.MasterConfigFile, "master-config", options.MasterConfigFile, "Location of the master configuration file. The signer defaults to the CA next to it.")
+	flags.StringSliceVar(&options.NodeConfigFiles, "node-config", options.NodeConfigFiles, "Location of a node configuration file. May be repeated.")
+	flags.StringSliceVar(&options.KubeConfigFiles, "kubeconfig-files", options.KubeConfigFiles, "Additional kubeconfig files whose client certificates are part of the master set, such as admin.kubeconfig.")
+	flags.StringSliceVar(&options.CertSets, "certs", options.CertSets, fmt.Sprintf("The certificate sets to regenerate. Any of: %s.", strings.Join(CertSets, ", ")))
+	flags.IntVar(&options.ExpireDays, "expire-days", options.ExpireDays, "Validity of the regenerated certificates in days (defaults to 2 years). WARNING: extending this above default value is highly discouraged.")
+	flags.BoolVar(&options.Confirm, "confirm", options.Confirm, "If true, regenerate the certificates. Defaults to false, listing what would be regenerated without changing anything.")
+
+	cmd.MarkFlagFilename("master-config", "yaml", "yml")
+	cmd.MarkFlagFilename("node-config", "yaml", "yml")
+	cmd.MarkFlagFilename("kubeconfig-files")
+
+	return cmd
+}
+
+// Complete defaults the signer to the CA stored next to the master configuration.
+func (o *RotateCertsOptions) Complete(cmd *cobra.Command) {
+	if len(o.MasterConfigFile) == 0 {
+		return
+	}
+	flags := cmd.Flags()
+	if flags.Changed("signer-cert") || flags.Changed("signer-key") || flags.Changed("signer-serial") {
+		return
+	}
+	certDir := path.Dir(o.MasterConfigFile)
+	o.SignerCertOptions.CertFile = DefaultCertFilename(certDir, CAFilePrefix)
+	o.SignerCertOptions.KeyFile = DefaultKeyFilename(certDir, CAFilePrefix)
+	o.SignerCertOptions.SerialFile = DefaultSerialFilename(certDir, CAFilePrefix)
+}
+
+func (o RotateCertsOptions) Validate(args []string) error {
+	if len(args) != 0 {
+		return errors.New("no arguments are supported")
+	}
+	if len(o.MasterConfigFile) == 0 && len(o.NodeConfigFiles) == 0 && len(o.KubeConfigFiles) == 0 {
+		return errors.New("at least one of --master-config, --node-config or --kubeconfig-files must be provided")
+	}
+	if len(o.CertSets) == 0 {
+		return fmt.Errorf("--certs must be provided, any of: %s", strings.Join(CertSets, ", "))
+	}
+	for _, set := range o.CertSets {
+		if !sets.NewString(CertSets...).Has(set) {
+			return fmt.Errorf("--certs: unknown certificate set %q, must be any of: %s", set, strings.Join(CertSets, ", "))
+		}
+	}
+	if o.ExpireDays <= 0 {
+		return errors.New("expire-days must be valid number of days")
+	}
+	if o.SignerCertOptions == nil {
+		return errors.New("signer options are required")
+	}
+	return o.SignerCertOptions.Validate()
+}
+
+func (o RotateCertsOptions) RotateCerts() error {
+	signer, err := o.SignerCertOptions.CA()
+	if err != nil {
+		return err
+	}
+
+	locations, err := configCertLocations(o.MasterConfigFile, o.NodeConfigFiles, o.KubeConfigFiles)
+	if err != nil {
+		return err
+	}
+	certSets := sets.NewString(o.CertSets...)
+
+	backupSuffix := "." + o.Now().Format("20060102150405") + ".bak"
+	kubeConfigs := map[string]*clientcmdapi.Config{}
+	kubeConfigFiles := []string{}
+
+	w := tabwriter.NewWriter(o.Out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCE\tSUBJECT\tEXPIRES\tACTION")
+	for _, location := range locations {
+		if !certSets.Has(location.Set) {
+			continue
+		}
+		certs, err := location.certificates()
+		if err != nil {
+			return err
+		}
+		cert := certs[0]
+
+		action := "rotate"
+		if err := cert.CheckSignatureFrom(signer.Config.Certs[0]); err != nil {
+			action = "skip (not issued by the signer)"
+		} else if o.Confirm {
+			action = "rotated"
+			newCert, err := o.regenerate(signer, location, cert)
+			if err != nil {
+				return err
+			}
+			certData, keyData, err := newCert.GetPEMBytes()
+			if err != nil {
+				return err
+			}
+
+			if len(location.KubeConfigFile) == 0 {
+				if err := replaceFile(location.CertFile, certData, backupSuffix); err != nil {
+					return err
+				}
+				if err := replaceFile(location.KeyFile, keyData, backupSuffix); err != nil {
+					return err
+				}
+			} else {
+				kubeConfig, ok := kubeConfigs[location.KubeConfigFile]
+				if !ok {
+					if kubeConfig, err = clientcmd.LoadFromFile(location.KubeConfigFile); err != nil {
+						return err
+					}
+					kubeConfigs[location.KubeConfigFile] = kubeConfig
+					kubeConfigFiles = append(kubeConfigFiles, location.KubeConfigFile)
+				}
+				kubeConfig.AuthInfos[location.AuthInfo].ClientCertificateData = certData
+				kubeConfig.AuthInfos[location.AuthInfo].ClientKeyData = keyData
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", location.Name, location.Source(), cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339), action)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	// kubeconfigs are written once all of their client certificates have been regenerated
+	for _, kubeConfigFile := range kubeConfigFiles {
+		if err := backupFile(kubeConfigFile, backupSuffix); err != nil {
+			return err
+		}
+		if err := clientcmd.WriteToFile(*kubeConfigs[kubeConfigFile], kubeConfigFile); err != nil {
+			return err
+		}
+		glog.V(3).Infof("Updated the client certificates embedded in %s", kubeConfigFile)
+	}
+
+	if !o.Confirm {
+		fmt.Fprintln(os.Stderr, "Dry run enabled - no modifications will be made. Add --confirm to regenerate the certificates")
+		return nil
+	}
+	fmt.Fprintf(o.Out, "Previous files were saved with the %s suffix. Restart the master and node processes to load the new certificates.\n", backupSuffix)
+	return nil
+}
+
+// regenerate creates a certificate replacing the given one: a serving certificate for the same hostnames or a
+// client certificate for the same user and groups.
+func (o RotateCertsOptions) regenerate(signer *crypto.CA, location certLocation, cert *x509.Certificate) (*crypto.TLSCertificateConfig, error) {
+	if location.Serving {
+		hostnames := sets.NewString(cert.DNSNames...)
+		for _, ip := range cert.IPAddresses {
+			hostnames.Insert(ip.String())
+		}
+		if hostnames.Len() == 0 {
+			return nil, fmt.Errorf("the %s certificate in %s is not valid for any hostname", location.Name, location.Source())
+		}
+		return signer.MakeServerCert(hostnames, o.ExpireDays)
+	}
+
+	u := &user.DefaultInfo{Name: cert.Subject.CommonName, Groups: cert.Subject.Organization}
+	return signer.MakeClientCert(u, o.ExpireDays)
+}
+
+// replaceFile backs up a file, then replaces its content, preserving its mode.
+func replaceFile(filename string, data []byte, backupSuffix string) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+	if err := backupFile(filename, backupSuffix); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, info.Mode())
+}
+
+func backupFile(filename, backupSuffix string) error {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	glog.V(3).Infof("Backing up %s to %s", filename, filename+backupSuffix)
+	return ioutil.WriteFile(filename+backupSuffix, data, info.Mode())
+}
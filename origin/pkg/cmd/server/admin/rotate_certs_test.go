@@ -0,0 +1,159 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+func certSerials(t *testing.T, nodeConfigFile string) (map[string]string, map[string]certLocation) {
+	locations, err := configCertLocations("", []string{nodeConfigFile}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	serials := map[string]string{}
+	bySource := map[string]certLocation{}
+	for _, location := range locations {
+		certs, err := location.certificates()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		serials[location.Source()] = certs[0].SerialNumber.String()
+		bySource[location.Source()] = location
+	}
+	return serials, bySource
+}
+
+func TestRotateNodeCerts(t *testing.T) {
+	signerCert, signerKey, signerSerial := makeSignerCert(t)
+	defer os.Remove(signerCert)
+	defer os.Remove(signerKey)
+	defer os.Remove(signerSerial)
+
+	configDirName := executeNodeConfig([]string{"--node=my-node", "--hostnames=example.org", "--listen=https://0.0.0.0", "--certificate-authority=" + signerCert, "--node-client-certificate-authority=" + signerCert, "--signer-cert=" + signerCert, "--signer-key=" + signerKey, "--signer-serial=" + signerSerial})
+	defer os.RemoveAll(configDirName)
+	nodeConfigFile := path.Join(configDirName, "node-config.yaml")
+
+	before, locations := certSerials(t, nodeConfigFile)
+	if len(before) == 0 {
+		t.Fatalf("expected the node certificates to be found")
+	}
+
+	now := time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC)
+	options := RotateCertsOptions{
+		SignerCertOptions: &SignerCertOptions{CertFile: signerCert, KeyFile: signerKey, SerialFile: signerSerial},
+		NodeConfigFiles:   []string{nodeConfigFile},
+		CertSets:          []string{CertSetNode},
+		ExpireDays:        10,
+		Now:               func() time.Time { return now },
+		Out:               &bytes.Buffer{},
+	}
+	if err := options.Validate(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// a dry run changes nothing
+	if err := options.RotateCerts(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if afterDryRun, _ := certSerials(t, nodeConfigFile); len(afterDryRun) != len(before) {
+		t.Fatalf("expected %d certificates, got %d", len(before), len(afterDryRun))
+	} else {
+		for source, serial := range before {
+			if afterDryRun[source] != serial {
+				t.Errorf("%s: expected the dry run to keep the certificate", source)
+			}
+		}
+	}
+
+	options.Confirm = true
+	if err := options.RotateCerts(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	after, _ := certSerials(t, nodeConfigFile)
+	backupSuffix := ".20170102030405.bak"
+	for source, serial := range before {
+		location := locations[source]
+		rotated := after[source] != serial
+		if rotated != (location.Set == CertSetNode) {
+			t.Errorf("%s: expected rotated=%t, got %t", source, location.Set == CertSetNode, rotated)
+		}
+		if !rotated {
+			continue
+		}
+		backup := location.CertFile
+		if len(location.KubeConfigFile) > 0 {
+			backup = location.KubeConfigFile
+		}
+		if _, err := os.Stat(backup + backupSuffix); err != nil {
+			t.Errorf("%s: expected a backup: %v", source, err)
+		}
+	}
+
+	server, err := crypto.GetTLSCertificateConfig(path.Join(configDirName, "server.crt"), path.Join(configDirName, "server.key"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if names := server.Certs[0].DNSNames; len(names) != 1 || names[0] != "example.org" {
+		t.Errorf("expected the serving certificate to be valid for example.org, got %v", names)
+	}
+	if days := server.Certs[0].NotAfter.Sub(time.Now()).Hours() / 24; days > 10 {
+		t.Errorf("expected the serving certificate to expire within 10 days, got %f", days)
+	}
+}
+
+func TestCheckExpiry(t *testing.T) {
+	signerCert, signerKey, signerSerial := makeSignerCert(t)
+	defer os.Remove(signerCert)
+	defer os.Remove(signerKey)
+	defer os.Remove(signerSerial)
+
+	configDirName := executeNodeConfig([]string{"--node=my-node", "--hostnames=example.org", "--listen=https://0.0.0.0", "--certificate-authority=" + signerCert, "--node-client-certificate-authority=" + signerCert, "--signer-cert=" + signerCert, "--signer-key=" + signerKey, "--signer-serial=" + signerSerial})
+	defer os.RemoveAll(configDirName)
+
+	out := &bytes.Buffer{}
+	options := CheckExpiryOptions{
+		NodeConfigFiles: []string{path.Join(configDirName, "node-config.yaml")},
+		Days:            30,
+		Output:          "json",
+		Now:             time.Now,
+		Out:             out,
+	}
+	if err := options.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := options.CheckExpiry(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expiries := []CertificateExpiry{}
+	if err := json.Unmarshal(out.Bytes(), &expiries); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	names := map[string]bool{}
+	for _, expiry := range expiries {
+		names[expiry.Name] = true
+		if expiry.Status != CertificateStatusOK {
+			t.Errorf("%s: expected the certificate to be valid, got %s", expiry.Source, expiry.Status)
+		}
+	}
+	for _, name := range []string{"node serving", "node client CA", "node kubeconfig"} {
+		if !names[name] {
+			t.Errorf("expected the %s certificate to be listed, got %#v", name, expiries)
+		}
+	}
+
+	// the signer expires within a year
+	options.Days = 400
+	options.Output = ""
+	options.Out = ioutil.Discard
+	if err := options.CheckExpiry(); err == nil || !strings.Contains(err.Error(), "expire within 400 days") {
+		t.Errorf("expected the certificates to be reported as expiring, got %v", err)
+	}
+}
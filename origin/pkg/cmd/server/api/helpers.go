@@ -259,6 +259,8 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 
 	refs = append(refs, &config.AuditConfig.AuditFilePath)
 
+	refs = append(refs, &config.BuildLogArchiveConfig.Directory)
+
 	return refs
 }
 
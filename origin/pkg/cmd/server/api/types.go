@@ -340,6 +340,28 @@ type MasterConfig struct {
 
 	// AuditConfig holds information related to auditing capabilities.
 	AuditConfig AuditConfig
+
+	// BuildLogArchiveConfig controls where the logs of completed builds are archived so they remain
+	// available after the build pods are removed. If not set, build logs are not archived.
+	BuildLogArchiveConfig BuildLogArchiveConfig
+}
+
+// BuildLogArchiveConfig holds configuration for archiving the logs of completed builds. At most one
+// archive may be configured.
+type BuildLogArchiveConfig struct {
+	// Directory is the path of a directory build logs are archived in, usually a persistent volume
+	// mounted on every master.
+	Directory string
+	// ObjectStore selects an object store build logs are archived in.
+	ObjectStore *BuildLogObjectStoreConfig
+}
+
+// BuildLogObjectStoreConfig selects the object store build logs are archived in
+type BuildLogObjectStoreConfig struct {
+	// Provider is the name of the object store provider. Only "s3" is supported.
+	Provider string
+	// Parameters holds the provider specific configuration, such as the bucket and credentials to use.
+	Parameters map[string]string
 }
 
 // AuditConfig holds configuration for the audit capabilities
@@ -109,6 +109,26 @@ func (BasicAuthPasswordIdentityProvider) SwaggerDoc() map[string]string {
 	return map_BasicAuthPasswordIdentityProvider
 }
 
+var map_BuildLogArchiveConfig = map[string]string{
+	"":            "BuildLogArchiveConfig holds configuration for archiving the logs of completed builds. At most one archive may be configured.",
+	"directory":   "Directory is the path of a directory build logs are archived in, usually a persistent volume mounted on every master.",
+	"objectStore": "ObjectStore selects an object store build logs are archived in.",
+}
+
+func (BuildLogArchiveConfig) SwaggerDoc() map[string]string {
+	return map_BuildLogArchiveConfig
+}
+
+var map_BuildLogObjectStoreConfig = map[string]string{
+	"":           "BuildLogObjectStoreConfig selects the object store build logs are archived in",
+	"provider":   "Provider is the name of the object store provider. Only \"s3\" is supported.",
+	"parameters": "Parameters holds the provider specific configuration, such as the bucket and credentials to use.",
+}
+
+func (BuildLogObjectStoreConfig) SwaggerDoc() map[string]string {
+	return map_BuildLogObjectStoreConfig
+}
+
 var map_CertInfo = map[string]string{
 	"":         "CertInfo relates a certificate with a private key",
 	"certFile": "CertFile is a file containing a PEM-encoded certificate",
@@ -474,6 +494,7 @@ var map_MasterConfig = map[string]string{
 	"volumeConfig":           "MasterVolumeConfig contains options for configuring volume plugins in the master node.",
 	"jenkinsPipelineConfig":  "JenkinsPipelineConfig holds information about the default Jenkins template used for JenkinsPipeline build strategy.",
 	"auditConfig":            "AuditConfig holds information related to auditing capabilities.",
+	"buildLogArchiveConfig":  "BuildLogArchiveConfig controls where the logs of completed builds are archived so they remain available after the build pods are removed. If not set, build logs are not archived.",
 }
 
 func (MasterConfig) SwaggerDoc() map[string]string {
@@ -112,6 +112,9 @@ auditConfig:
   maximumFileRetentionDays: 0
   maximumFileSizeMegabytes: 0
   maximumRetainedFiles: 0
+buildLogArchiveConfig:
+  directory: ""
+  objectStore: null
 controllerConfig:
   serviceServingCert:
     signer: null
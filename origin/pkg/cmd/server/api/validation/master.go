@@ -18,6 +18,7 @@ import (
 	kuval "github.com/openshift/kubernetes/pkg/util/validation"
 	"github.com/openshift/kubernetes/pkg/util/validation/field"
 
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/cmd/server/api"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
 	"github.com/openshift/origin/pkg/security/mcs"
@@ -193,9 +194,33 @@ func ValidateMasterConfig(config *api.MasterConfig, fldPath *field.Path) Validat
 
 	validationResults.Append(ValidateAuditConfig(config.AuditConfig, fldPath.Child("auditConfig")))
 
+	validationResults.AddErrors(ValidateBuildLogArchiveConfig(config.BuildLogArchiveConfig, fldPath.Child("buildLogArchiveConfig"))...)
+
 	return validationResults
 }
 
+func ValidateBuildLogArchiveConfig(config api.BuildLogArchiveConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(config.Directory) > 0 && config.ObjectStore != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("objectStore"), config.ObjectStore.Provider, "may not be set together with directory"))
+	}
+	if len(config.Directory) > 0 {
+		allErrs = append(allErrs, ValidateDir(config.Directory, fldPath.Child("directory"))...)
+	}
+	if config.ObjectStore != nil {
+		providers := sets.NewString(logarchive.ObjectStoreProviders()...)
+		switch {
+		case len(config.ObjectStore.Provider) == 0:
+			allErrs = append(allErrs, field.Required(fldPath.Child("objectStore", "provider"), ""))
+		case !providers.Has(config.ObjectStore.Provider):
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("objectStore", "provider"), config.ObjectStore.Provider, providers.List()))
+		}
+	}
+
+	return allErrs
+}
+
 func ValidateAuditConfig(config api.AuditConfig, fldPath *field.Path) ValidationResults {
 	validationResults := ValidationResults{}
 
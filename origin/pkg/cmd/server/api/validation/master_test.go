@@ -488,3 +488,35 @@ func TestValidateIngressIPNetworkCIDR(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBuildLogArchiveConfig(t *testing.T) {
+	testCases := []struct {
+		testName   string
+		config     configapi.BuildLogArchiveConfig
+		errorCount int
+	}{
+		{
+			testName: "Not archived",
+		},
+		{
+			testName: "S3 object store",
+			config:   configapi.BuildLogArchiveConfig{ObjectStore: &configapi.BuildLogObjectStoreConfig{Provider: "s3"}},
+		},
+		{
+			testName:   "Missing provider",
+			config:     configapi.BuildLogArchiveConfig{ObjectStore: &configapi.BuildLogObjectStoreConfig{}},
+			errorCount: 1,
+		},
+		{
+			testName:   "Unknown provider",
+			config:     configapi.BuildLogArchiveConfig{ObjectStore: &configapi.BuildLogObjectStoreConfig{Provider: "unknown"}},
+			errorCount: 1,
+		},
+	}
+	for _, test := range testCases {
+		errorCount := len(ValidateBuildLogArchiveConfig(test.config, nil))
+		if test.errorCount != errorCount {
+			t.Errorf("%s: expected %d errors, got %d", test.testName, test.errorCount, errorCount)
+		}
+	}
+}
@@ -20,6 +20,9 @@ const (
 	InfraBuildControllerServiceAccountName = "build-controller"
 	BuildControllerRoleName                = "system:build-controller"
 
+	InfraBuildStatusReportControllerServiceAccountName = "build-status-report-controller"
+	BuildStatusReportControllerRoleName                = "system:build-status-report-controller"
+
 	InfraReplicationControllerServiceAccountName = "replication-controller"
 	ReplicationControllerRoleName                = "system:replication-controller"
 
@@ -32,6 +35,9 @@ const (
 	InfraDeploymentControllerServiceAccountName = "deployment-controller"
 	DeploymentControllerRoleName                = "system:deployment-controller"
 
+	InfraDeploymentImageTriggerControllerServiceAccountName = "deployment-image-trigger-controller"
+	DeploymentImageTriggerControllerRoleName                = "system:deployment-image-trigger-controller"
+
 	InfraJobControllerServiceAccountName = "job-controller"
 	JobControllerRoleName                = "system:job-controller"
 
@@ -150,10 +156,16 @@ func init() {
 					Resources: sets.NewString("builds"),
 				},
 				// BuildController.BuildUpdater (OSClientBuildClient)
+				// BuildController.BuildDeleter (OSClientBuildClient)
 				{
-					Verbs:     sets.NewString("update"),
+					Verbs:     sets.NewString("update", "delete"),
 					Resources: sets.NewString("builds"),
 				},
+				// BuildController.BuildConfigGetter (OSClientBuildConfigClient)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("buildconfigs"),
+				},
 				// Create permission on virtual build type resources allows builds of those types to be updated
 				{
 					Verbs:     sets.NewString("create"),
@@ -171,6 +183,11 @@ func init() {
 					Verbs:     sets.NewString("get", "list", "create", "delete"),
 					Resources: sets.NewString("pods"),
 				},
+				// BuildController.LogArchiver (ControllerClient)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("pods/log"),
+				},
 				// BuildController.Recorder (EventBroadcaster)
 				{
 					Verbs:     sets.NewString("create", "update", "patch"),
@@ -183,6 +200,38 @@ func init() {
 		panic(err)
 	}
 
+	// The build status report controller reads the tokens used to post commit statuses, so it runs
+	// under its own service account rather than extending the build controller with access to secrets.
+	err = InfraSAs.addServiceAccount(
+		InfraBuildStatusReportControllerServiceAccountName,
+		authorizationapi.ClusterRole{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: BuildStatusReportControllerRoleName,
+			},
+			Rules: []authorizationapi.PolicyRule{
+				// BuildStatusReportControllerFactory.buildLW
+				// BuildStatusReportController.BuildClient
+				{
+					Verbs:     sets.NewString("get", "list", "watch", "update"),
+					Resources: sets.NewString("builds"),
+				},
+				// BuildStatusReportController.SecretClient
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("secrets"),
+				},
+				// BuildStatusReportController.Recorder (EventBroadcaster)
+				{
+					Verbs:     sets.NewString("create", "update", "patch"),
+					Resources: sets.NewString("events"),
+				},
+			},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	err = InfraSAs.addServiceAccount(
 		InfraDeploymentConfigControllerServiceAccountName,
 		authorizationapi.ClusterRole{
@@ -335,6 +384,26 @@ func init() {
 		panic(err)
 	}
 
+	err = InfraSAs.addServiceAccount(
+		InfraDeploymentImageTriggerControllerServiceAccountName,
+		authorizationapi.ClusterRole{
+			ObjectMeta: kapi.ObjectMeta{
+				Name: DeploymentImageTriggerControllerRoleName,
+			},
+			Rules: []authorizationapi.PolicyRule{
+				// DeploymentImageTriggerController.client
+				{
+					APIGroups: []string{extensions.GroupName},
+					Verbs:     sets.NewString("update"),
+					Resources: sets.NewString("deployments"),
+				},
+			},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	err = InfraSAs.addServiceAccount(
 		InfraJobControllerServiceAccountName,
 		authorizationapi.ClusterRole{
@@ -998,6 +1067,12 @@ func init() {
 					Verbs:     sets.NewString("get", "list", "watch", "create", "update"),
 					Resources: sets.NewString("secrets"),
 				},
+				// the CA bundle is injected into annotated configmaps
+				{
+					APIGroups: []string{kapi.GroupName},
+					Verbs:     sets.NewString("list", "watch", "update"),
+					Resources: sets.NewString("configmaps"),
+				},
 			},
 		},
 	)
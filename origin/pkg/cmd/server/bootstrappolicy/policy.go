@@ -320,6 +320,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				authorizationapi.NewRule("create").Groups(imageGroup).Resources("imagestreamimports").RuleOrDie(),
 
 				authorizationapi.NewRule("get", "patch", "update", "delete").Groups(projectGroup).Resources("projects").RuleOrDie(),
+				authorizationapi.NewRule("get").Groups(projectGroup).Resources("projects/health").RuleOrDie(),
 
 				authorizationapi.NewRule(read...).Groups(quotaGroup).Resources("appliedclusterresourcequotas").RuleOrDie(),
 
@@ -377,7 +378,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				authorizationapi.NewRule("get", "update").Groups(imageGroup).Resources("imagestreams/layers").RuleOrDie(),
 				authorizationapi.NewRule("create").Groups(imageGroup).Resources("imagestreamimports").RuleOrDie(),
 
-				authorizationapi.NewRule("get").Groups(projectGroup).Resources("projects").RuleOrDie(),
+				authorizationapi.NewRule("get").Groups(projectGroup).Resources("projects", "projects/health").RuleOrDie(),
 
 				authorizationapi.NewRule(read...).Groups(quotaGroup).Resources("appliedclusterresourcequotas").RuleOrDie(),
 
@@ -429,7 +430,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 				// pull images
 				// authorizationapi.NewRule("get").Groups(imageGroup).Resources("imagestreams/layers").RuleOrDie(),
 
-				authorizationapi.NewRule("get").Groups(projectGroup).Resources("projects").RuleOrDie(),
+				authorizationapi.NewRule("get").Groups(projectGroup).Resources("projects", "projects/health").RuleOrDie(),
 
 				authorizationapi.NewRule(read...).Groups(quotaGroup).Resources("appliedclusterresourcequotas").RuleOrDie(),
 
@@ -654,11 +655,16 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			},
 			Rules: []authorizationapi.PolicyRule{
 				authorizationapi.NewRule("list").Groups(kapiGroup).Resources("limitranges", "resourcequotas").RuleOrDie(),
+				authorizationapi.NewRule("list").Groups(quotaGroup).Resources("appliedclusterresourcequotas").RuleOrDie(),
 
 				authorizationapi.NewRule("get", "delete").Groups(imageGroup).Resources("images", "imagestreamtags").RuleOrDie(),
 				authorizationapi.NewRule("get").Groups(imageGroup).Resources("imagestreamimages", "imagestreams/secrets").RuleOrDie(),
 				authorizationapi.NewRule("get", "update").Groups(imageGroup).Resources("images", "imagestreams").RuleOrDie(),
-				authorizationapi.NewRule("create").Groups(imageGroup).Resources("imagestreammappings").RuleOrDie(),
+				authorizationapi.NewRule("create").Groups(imageGroup).Resources("images", "imagestreammappings").RuleOrDie(),
+				// the replication status of pushed images is recorded in the image stream tag conditions
+				authorizationapi.NewRule("update").Groups(imageGroup).Resources("imagestreams/status").RuleOrDie(),
+				// the garbage collector lists all images to find the blobs still in use
+				authorizationapi.NewRule("list").Groups(imageGroup).Resources("images").RuleOrDie(),
 			},
 		},
 		{
@@ -500,18 +500,11 @@ func (ca *CA) MakeClientCertificate(certFile, keyFile string, u user.Info, expir
 		return nil, err
 	}
 
-	clientPublicKey, clientPrivateKey, _ := NewKeyPair()
-	clientTemplate := newClientCertificateTemplate(x509request.UserToSubject(u), expireDays, time.Now)
-	clientCrt, err := ca.signCertificate(clientTemplate, clientPublicKey)
-	if err != nil {
-		return nil, err
-	}
-
-	certData, err := encodeCertificates(clientCrt)
+	client, err := ca.MakeClientCert(u, expireDays)
 	if err != nil {
 		return nil, err
 	}
-	keyData, err := encodeKey(clientPrivateKey)
+	certData, keyData, err := client.GetPEMBytes()
 	if err != nil {
 		return nil, err
 	}
@@ -526,6 +519,21 @@ func (ca *CA) MakeClientCertificate(certFile, keyFile string, u user.Info, expir
 	return GetTLSCertificateConfig(certFile, keyFile)
 }
 
+// MakeClientCert creates a client certificate for the given user without writing it to disk.
+func (ca *CA) MakeClientCert(u user.Info, expireDays int) (*TLSCertificateConfig, error) {
+	clientPublicKey, clientPrivateKey, _ := NewKeyPair()
+	clientTemplate := newClientCertificateTemplate(x509request.UserToSubject(u), expireDays, time.Now)
+	clientCrt, err := ca.signCertificate(clientTemplate, clientPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	client := &TLSCertificateConfig{
+		Certs: []*x509.Certificate{clientCrt},
+		Key:   clientPrivateKey,
+	}
+	return client, nil
+}
+
 func (ca *CA) signCertificate(template *x509.Certificate, requestKey crypto.PublicKey) (*x509.Certificate, error) {
 	// Increment and persist serial
 	serial, err := ca.SerialGenerator.Next(template)
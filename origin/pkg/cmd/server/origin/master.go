@@ -26,6 +26,7 @@ import (
 	v1beta1extensions "github.com/openshift/kubernetes/pkg/apis/extensions/v1beta1"
 	"github.com/openshift/kubernetes/pkg/apiserver"
 	kapiserverfilters "github.com/openshift/kubernetes/pkg/apiserver/filters"
+	"github.com/openshift/kubernetes/pkg/client/cache"
 	kclientset "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset"
 	"github.com/openshift/kubernetes/pkg/client/restclient"
 	"github.com/openshift/kubernetes/pkg/genericapiserver"
@@ -81,7 +82,9 @@ import (
 	clientregistry "github.com/openshift/origin/pkg/oauth/registry/oauthclient"
 	clientetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclient/etcd"
 	clientauthetcd "github.com/openshift/origin/pkg/oauth/registry/oauthclientauthorization/etcd"
+	projecthealth "github.com/openshift/origin/pkg/project/health"
 	projectproxy "github.com/openshift/origin/pkg/project/registry/project/proxy"
+	projecthealthregistry "github.com/openshift/origin/pkg/project/registry/projecthealth"
 	projectrequeststorage "github.com/openshift/origin/pkg/project/registry/projectrequest/delegated"
 	routeallocationcontroller "github.com/openshift/origin/pkg/route/controller/allocation"
 	routeetcd "github.com/openshift/origin/pkg/route/registry/route/etcd"
@@ -677,6 +680,21 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 
 	projectStorage := projectproxy.NewREST(c.PrivilegedLoopbackKubernetesClientset.Core().Namespaces(), c.ProjectAuthorizationCache, c.ProjectAuthorizationCache, c.ProjectCache)
 
+	projectHealthCache := projecthealth.NewCache(c.PrivilegedLoopbackKubernetesClientset, c.PrivilegedLoopbackOpenShiftClient, projecthealth.DefaultTTL)
+	for _, informer := range []cache.SharedIndexInformer{
+		c.Informers.KubernetesInformers().Pods().Informer(),
+		c.Informers.KubernetesInformers().PersistentVolumeClaims().Informer(),
+		c.Informers.KubernetesInformers().ServiceAccounts().Informer(),
+		c.Informers.ReplicationControllers().Informer(),
+		c.Informers.DeploymentConfigs().Informer(),
+		c.Informers.BuildConfigs().Informer(),
+		c.Informers.ImageStreams().Informer(),
+	} {
+		projectHealthCache.InvalidateOnChange(informer)
+	}
+	projectHealthCache.InvalidateOnNamespaceDelete(c.Informers.KubernetesInformers().Namespaces().Informer())
+	projectHealthStorage := projecthealthregistry.NewREST(projectStorage, projectHealthCache, c.Authorizer)
+
 	namespace, templateName, err := configapi.ParseNamespaceAndName(c.Options.ProjectConfig.ProjectRequestTemplate)
 	if err != nil {
 		glog.Errorf("Error parsing project request template value: %v", err)
@@ -688,6 +706,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	buildConfigWebHooks := buildconfigregistry.NewWebHookREST(
 		buildConfigRegistry,
 		buildclient.NewOSClientBuildConfigInstantiatorClient(bcClient),
+		buildclient.NewOSClientBuildClient(bcClient),
 		map[string]webhook.Plugin{
 			"generic": generic.New(),
 			"github":  github.New(),
@@ -746,6 +765,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		"routes/status": routeStatusStorage,
 
 		"projects":        projectStorage,
+		"projects/health": projectHealthStorage,
 		"projectRequests": projectRequestStorage,
 
 		"hostSubnets":           hostSubnetStorage,
@@ -795,7 +815,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	if configapi.IsBuildEnabled(&c.Options) {
 		storage["builds"] = buildStorage
 		storage["builds/clone"] = buildclone.NewStorage(buildGenerator)
-		storage["builds/log"] = buildlogregistry.NewREST(buildStorage, buildStorage, c.BuildLogClient().Core(), nodeConnectionInfoGetter)
+		storage["builds/log"] = buildlogregistry.NewREST(buildStorage, buildStorage, c.BuildLogClient().Core(), nodeConnectionInfoGetter, c.BuildLogArchive)
 		storage["builds/details"] = buildDetailsStorage
 
 		storage["buildConfigs"] = buildConfigStorage
@@ -55,6 +55,7 @@ import (
 	policybindingregistry "github.com/openshift/origin/pkg/authorization/registry/policybinding"
 	policybindingetcd "github.com/openshift/origin/pkg/authorization/registry/policybinding/etcd"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	osclient "github.com/openshift/origin/pkg/client"
 	oadmission "github.com/openshift/origin/pkg/cmd/server/admission"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
@@ -161,6 +162,9 @@ type MasterConfig struct {
 	// Informers is a shared factory for getting SharedInformers. It is important to get your informers, indexers, and listers
 	// from here so that we only end up with a single cache of objects
 	Informers shared.InformerFactory
+
+	// BuildLogArchive is where the logs of completed builds are archived. It is nil if build logs are not archived.
+	BuildLogArchive logarchive.Archive
 }
 
 // BuildMasterConfig builds and returns the OpenShift master configuration based on the
@@ -264,6 +268,11 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 
 	plug, plugStart := newControllerPlug(options, client)
 
+	buildLogArchive, err := newBuildLogArchive(options.BuildLogArchiveConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &MasterConfig{
 		Options: options,
 
@@ -304,6 +313,8 @@ func BuildMasterConfig(options configapi.MasterConfig) (*MasterConfig, error) {
 		PrivilegedLoopbackOpenShiftClient:     privilegedLoopbackOpenShiftClient,
 		PrivilegedLoopbackKubernetesClientset: privilegedLoopbackKubeClientset,
 		Informers: informerFactory,
+
+		BuildLogArchive: buildLogArchive,
 	}
 
 	// ensure that the limit range informer will be started
@@ -586,6 +597,19 @@ func newControllerPlug(options configapi.MasterConfig, client etcdclient.Client)
 	}
 }
 
+// newBuildLogArchive returns the archive the logs of completed builds are kept
+// in, or nil if build logs are not archived.
+func newBuildLogArchive(config configapi.BuildLogArchiveConfig) (logarchive.Archive, error) {
+	switch {
+	case len(config.Directory) > 0:
+		return logarchive.NewDirectoryArchive(config.Directory), nil
+	case config.ObjectStore != nil:
+		return logarchive.NewObjectStoreArchive(config.ObjectStore.Provider, config.ObjectStore.Parameters)
+	default:
+		return nil, nil
+	}
+}
+
 func newServiceAccountTokenGetter(options configapi.MasterConfig) (serviceaccount.ServiceAccountTokenGetter, error) {
 	if options.KubernetesMasterConfig == nil {
 		// When we're running against an external Kubernetes, use the external kubernetes client to validate service account tokens
@@ -891,6 +915,15 @@ func (c *MasterConfig) BuildControllerClients() (*osclient.Client, *kclientset.C
 	return osClient, kClient
 }
 
+// BuildStatusReportControllerClients returns the build status report controller client objects
+func (c *MasterConfig) BuildStatusReportControllerClients() (*osclient.Client, *kclientset.Clientset) {
+	_, osClient, kClient, err := c.GetServiceAccountClients(bootstrappolicy.InfraBuildStatusReportControllerServiceAccountName)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	return osClient, kClient
+}
+
 // BuildPodControllerClients returns the build pod controller client objects
 func (c *MasterConfig) BuildPodControllerClients() (*osclient.Client, *kclientset.Clientset) {
 	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClientset
@@ -945,6 +978,15 @@ func (c *MasterConfig) DeploymentTriggerControllerClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
 }
 
+// DeploymentImageTriggerControllerClient returns the deployment image trigger controller client object
+func (c *MasterConfig) DeploymentImageTriggerControllerClient() *kclientset.Clientset {
+	_, _, kClient, err := c.GetServiceAccountClients(bootstrappolicy.InfraDeploymentImageTriggerControllerServiceAccountName)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	return kClient
+}
+
 // DeploymentLogClient returns the deployment log client object
 func (c *MasterConfig) DeploymentLogClient() *kclientset.Clientset {
 	return c.PrivilegedLoopbackKubernetesClientset
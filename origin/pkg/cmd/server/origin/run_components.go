@@ -46,6 +46,7 @@ import (
 	triggercontroller "github.com/openshift/origin/pkg/deploy/controller/generictrigger"
 	"github.com/openshift/origin/pkg/dns"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
+	imagetriggercontroller "github.com/openshift/origin/pkg/image/controller/trigger"
 	projectcontroller "github.com/openshift/origin/pkg/project/controller"
 	quota "github.com/openshift/origin/pkg/quota"
 	quotacontroller "github.com/openshift/origin/pkg/quota/controller"
@@ -256,10 +257,12 @@ func (c *MasterConfig) RunBuildController(informers shared.InformerFactory) erro
 
 	osclient, kclient := c.BuildControllerClients()
 	factory := buildcontrollerfactory.BuildControllerFactory{
-		KubeClient:   kclient,
-		OSClient:     osclient,
-		BuildUpdater: buildclient.NewOSClientBuildClient(osclient),
-		BuildLister:  buildclient.NewOSClientBuildClient(osclient),
+		KubeClient:        kclient,
+		OSClient:          osclient,
+		BuildUpdater:      buildclient.NewOSClientBuildClient(osclient),
+		BuildLister:       buildclient.NewOSClientBuildClient(osclient),
+		BuildDeleter:      buildclient.NewOSClientBuildClient(osclient),
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(osclient),
 		DockerBuildStrategy: &buildstrategy.DockerBuildStrategy{
 			Image: dockerImage,
 			// TODO: this will be set to --storage-version (the internal schema we use)
@@ -277,6 +280,7 @@ func (c *MasterConfig) RunBuildController(informers shared.InformerFactory) erro
 		},
 		BuildDefaults:  buildDefaults,
 		BuildOverrides: buildOverrides,
+		LogArchive:     c.BuildLogArchive,
 	}
 
 	controller := factory.Create()
@@ -286,14 +290,36 @@ func (c *MasterConfig) RunBuildController(informers shared.InformerFactory) erro
 	return nil
 }
 
+// RunBuildStatusReportController starts the controller that reports build statuses to the hosting
+// services of the build sources.
+func (c *MasterConfig) RunBuildStatusReportController() {
+	osclient, kclient := c.BuildStatusReportControllerClients()
+	factory := buildcontrollerfactory.BuildStatusReportControllerFactory{
+		OSClient:                osclient,
+		KubeClient:              kclient,
+		BuildConfigLister:       &oscache.StoreToBuildConfigListerImpl{Indexer: c.Informers.BuildConfigs().Indexer()},
+		BuildConfigListerSynced: c.Informers.BuildConfigs().Informer().HasSynced,
+		MasterPublicURL:         c.Options.MasterPublicURL,
+	}
+	if c.WebConsoleEnabled() {
+		factory.ConsoleURL = c.Options.AssetConfig.PublicURL
+	}
+	go func() {
+		factory.Create().Run()
+	}()
+}
+
 // RunBuildPodController starts the build/pod status sync loop for build status
 func (c *MasterConfig) RunBuildPodController() {
 	osclient, kclient := c.BuildPodControllerClients()
 	factory := buildcontrollerfactory.BuildPodControllerFactory{
-		OSClient:     osclient,
-		KubeClient:   kclient,
-		BuildUpdater: buildclient.NewOSClientBuildClient(osclient),
-		BuildLister:  buildclient.NewOSClientBuildClient(osclient),
+		OSClient:          osclient,
+		KubeClient:        kclient,
+		BuildUpdater:      buildclient.NewOSClientBuildClient(osclient),
+		BuildLister:       buildclient.NewOSClientBuildClient(osclient),
+		BuildDeleter:      buildclient.NewOSClientBuildClient(osclient),
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(osclient),
+		LogArchive:        c.BuildLogArchive,
 	}
 	controller := factory.Create()
 	controller.Run()
@@ -377,6 +403,20 @@ func (c *MasterConfig) RunDeploymentTriggerController() {
 	go controller.Run(5, utilwait.NeverStop)
 }
 
+// RunDeploymentImageTriggerController starts the controller that updates the images of the
+// Kubernetes deployments with image triggers.
+func (c *MasterConfig) RunDeploymentImageTriggerController() {
+	deploymentInformer := c.Informers.KubernetesInformers().Deployments().Informer()
+	streamInformer := c.Informers.ImageStreams().Informer()
+	kclient := c.DeploymentImageTriggerControllerClient()
+
+	controller, err := imagetriggercontroller.NewDeploymentImageTriggerController(deploymentInformer, streamInformer, kclient.Extensions())
+	if err != nil {
+		glog.Fatalf("Unable to start the deployment image trigger controller: %v", err)
+	}
+	go controller.Run(5, utilwait.NeverStop)
+}
+
 // RunSDNController runs openshift-sdn if the said network plugin is provided
 func (c *MasterConfig) RunSDNController() {
 	oClient, kClient := c.SDNControllerClients()
@@ -399,6 +439,12 @@ func (c *MasterConfig) RunServiceServingCertController(client *kclientset.Client
 
 	servingCertUpdateController := servingcertcontroller.NewServiceServingCertUpdateController(client.Core(), client.Core(), ca, "cluster.local", 20*time.Minute)
 	go servingCertUpdateController.Run(5, make(chan struct{}))
+
+	caBundleInjectionController, err := servingcertcontroller.NewConfigMapCABundleInjectionController(client.Core(), c.Options.ControllerConfig.ServiceServingCert.Signer.CertFile, 20*time.Minute)
+	if err != nil {
+		glog.Fatalf("service serving CA bundle injection controller failed: %v", err)
+	}
+	go caBundleInjectionController.Run(1, make(chan struct{}))
 }
 
 // RunImageImportController starts the image import trigger controller process.
@@ -731,12 +731,14 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 			return err
 		}
 		oc.RunBuildPodController()
+		oc.RunBuildStatusReportController()
 		oc.RunBuildConfigChangeController()
 		oc.RunBuildImageChangeTriggerController()
 	}
 	oc.RunDeploymentController()
 	oc.RunDeploymentConfigController()
 	oc.RunDeploymentTriggerController()
+	oc.RunDeploymentImageTriggerController()
 	oc.RunImageImportController()
 	oc.RunOriginNamespaceController()
 	oc.RunSDNController()
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/context"
@@ -72,3 +74,63 @@ func (bh *blobHandler) Delete(w http.ResponseWriter, req *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// ErrorCodeGarbageCollectionInProgress is returned when the garbage collection is requested while another
+// one is running.
+var ErrorCodeGarbageCollectionInProgress = errcode.Register(errGroup, errcode.ErrorDescriptor{
+	Value:          "GC_IN_PROGRESS",
+	Message:        "garbage collection is already in progress",
+	HTTPStatusCode: http.StatusConflict,
+})
+
+// GarbageCollectionDispatcher takes the request context and builds the appropriate handler for handling
+// garbage collection requests.
+func GarbageCollectionDispatcher(ctx *handlers.Context, r *http.Request) http.Handler {
+	gcHandler := &garbageCollectionHandler{
+		Context: ctx,
+	}
+
+	return gorillahandlers.MethodHandler{
+		"POST": http.HandlerFunc(gcHandler.Run),
+	}
+}
+
+// garbageCollectionHandler handles http operations on the garbage collector.
+type garbageCollectionHandler struct {
+	*handlers.Context
+}
+
+// Run collects the blobs not referenced by any image and reports the freed space. If the dryrun query
+// parameter is true, nothing is deleted.
+func (gh *garbageCollectionHandler) Run(w http.ResponseWriter, req *http.Request) {
+	defer req.Body.Close()
+
+	if blobCollector == nil {
+		gh.Errors = append(gh.Errors, errcode.ErrorCodeUnavailable.WithDetail("garbage collector is not configured"))
+		return
+	}
+
+	dryRun := false
+	if s := req.URL.Query().Get("dryrun"); len(s) > 0 {
+		var err error
+		dryRun, err = strconv.ParseBool(s)
+		if err != nil {
+			gh.Errors = append(gh.Errors, errcode.ErrorCodeUnknown.WithDetail(fmt.Sprintf("invalid dryrun value %q: %v", s, err)))
+			return
+		}
+	}
+
+	result, err := blobCollector.Run(gh, dryRun)
+	if err != nil {
+		if err == errGarbageCollectionInProgress {
+			gh.Errors = append(gh.Errors, ErrorCodeGarbageCollectionInProgress)
+		} else {
+			gh.Errors = append(gh.Errors, errcode.ErrorCodeUnknown.WithDetail(err.Error()))
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
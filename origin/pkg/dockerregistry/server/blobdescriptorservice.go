@@ -8,6 +8,7 @@ import (
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/context"
 	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/openshift/github.com/docker/distribution/registry/middleware/registry"
 	"github.com/openshift/github.com/docker/distribution/registry/storage"
@@ -208,6 +209,16 @@ func imageHasBlob(
 		return true
 	}
 
+	if imageapi.IsManifestList(image.DockerImageManifestMediaType) {
+		// the blobs belong to the images referenced by the list
+		for _, manifest := range image.DockerImageManifests {
+			if imageHasBlob(r, cacheName, manifest.Digest, blobDigest, requireManaged) {
+				return true
+			}
+		}
+		return false
+	}
+
 	if len(image.DockerImageLayers) == 0 {
 		if len(image.DockerImageManifestMediaType) > 0 {
 			// If the media type is set, we can safely assume that the best effort to fill the image layers
@@ -229,9 +240,9 @@ func imageHasBlob(
 		}
 	}
 
-	// only manifest V2 schema2 has docker image config filled where dockerImage.Metadata.id is its digest
-	if image.DockerImageManifestMediaType == schema2.MediaTypeManifest &&
-		image.DockerImageMetadata.ID == blobDigest {
+	// only manifest V2 schema2 and OCI manifests have docker image config filled where dockerImage.Metadata.id
+	// is its digest
+	if hasImageConfig(image) && image.DockerImageMetadata.ID == blobDigest {
 		// remember manifest config reference of schema 2 as well
 		r.rememberLayersOfImage(image, cacheName)
 		return true
@@ -240,6 +251,11 @@ func imageHasBlob(
 	return false
 }
 
+// hasImageConfig returns true if the image manifest references an image config blob.
+func hasImageConfig(image *imageapi.Image) bool {
+	return image.DockerImageManifestMediaType == schema2.MediaTypeManifest || image.DockerImageManifestMediaType == ocischema.MediaTypeManifest
+}
+
 func isEmptyDigest(dgst digest.Digest) bool {
 	return dgst == digestSha256EmptyTar || dgst == digestSHA256GzippedEmptyTar
 }
@@ -0,0 +1,320 @@
+package server
+
+import (
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/openshift/github.com/docker/distribution/context"
+	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/registry/storage"
+	storagedriver "github.com/openshift/github.com/docker/distribution/registry/storage/driver"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	// GCIntervalEnvVar is an environment variable specifying how often the registry collects unreferenced
+	// blobs. It takes a valid time duration string (e.g. "24h"). If empty or zero, blobs are collected only
+	// on demand.
+	GCIntervalEnvVar = "REGISTRY_MIDDLEWARE_REGISTRY_OPENSHIFT_GCINTERVAL"
+
+	// GCGracePeriodEnvVar is an environment variable specifying how old an unreferenced blob must be to be
+	// collected. It protects blobs of pushes that are still in progress, whose images don't exist yet.
+	GCGracePeriodEnvVar = "REGISTRY_MIDDLEWARE_REGISTRY_OPENSHIFT_GCGRACEPERIOD"
+
+	defaultGCGracePeriod = time.Hour
+
+	// gcMarkRefreshInterval is how often the sweep lists the images again to protect the blobs of the
+	// images created since the blobs were marked.
+	gcMarkRefreshInterval = 5 * time.Second
+
+	// blobsRootPath is the directory of the registry storage holding the blob data. Blobs are stored in
+	// <blobsRootPath>/<algorithm>/<first two hex digits>/<hex>/data.
+	blobsRootPath = "/docker/registry/v2/blobs"
+
+	// repositoriesRootPath is the directory of the registry storage holding the repositories. A blob
+	// uploaded to or mounted into a repository is linked in
+	// <repositoriesRootPath>/<name>/_layers/<algorithm>/<hex>/link.
+	repositoriesRootPath = "/docker/registry/v2/repositories"
+)
+
+// blobCollector is shared by the scheduled and on demand garbage collections. It is initialized by the
+// registry middleware.
+var blobCollector *garbageCollector
+
+// GarbageCollectionResult summarizes a single run of the garbage collector.
+type GarbageCollectionResult struct {
+	// DryRun is true if the unreferenced blobs were only reported.
+	DryRun bool `json:"dryRun,omitempty"`
+	// MarkedBlobs is the number of blobs referenced by images.
+	MarkedBlobs int `json:"markedBlobs"`
+	// DeletedBlobs is the number of deleted blobs.
+	DeletedBlobs int `json:"deletedBlobs"`
+	// FreedBytes is the total size of the deleted blobs.
+	FreedBytes int64 `json:"freedBytes"`
+	// Duration is how long the collection took.
+	Duration string `json:"duration"`
+}
+
+// garbageCollector deletes blobs from the registry storage that aren't referenced by any image. The set of
+// live blobs is taken from the image API, not from the manifests in the storage, so that blobs left behind by
+// pruned images are collected without stopping the registry.
+//
+// The collection is safe to run while the registry serves requests:
+//  1. blobs younger than the grace period are never deleted, which covers pushes whose images aren't
+//     created yet,
+//  2. the blob store is walked before the images are listed, so every image created while the blob store
+//     is walked protects its blobs,
+//  3. blobs linked into a repository within the grace period are kept as well, because a push reusing
+//     an existing blob mounts it without modifying the blob data,
+//  4. the images are listed again right before blobs are deleted and every gcMarkRefreshInterval during
+//     the sweep, so an image created after the blobs were marked protects its blobs even when it reuses
+//     a blob whose data and links are old,
+//  5. the modification time of each blob is checked again right before it is deleted.
+//
+// Several registry instances sharing the storage may collect at the same time; deleting a blob twice is
+// harmless.
+type garbageCollector struct {
+	driver       storagedriver.StorageDriver
+	imagesGetter client.ImagesInterfacer
+	gracePeriod  time.Duration
+
+	lock    sync.Mutex
+	running bool
+}
+
+func newGarbageCollector(driver storagedriver.StorageDriver, imagesGetter client.ImagesInterfacer, gracePeriod time.Duration) *garbageCollector {
+	return &garbageCollector{
+		driver:       driver,
+		imagesGetter: imagesGetter,
+		gracePeriod:  gracePeriod,
+	}
+}
+
+// errGarbageCollectionInProgress is returned when a collection is requested while another one is running
+// in this registry instance.
+var errGarbageCollectionInProgress = fmt.Errorf("garbage collection is already in progress")
+
+// Run collects unreferenced blobs older than the grace period. If dryRun is true, the blobs are only
+// counted.
+func (gc *garbageCollector) Run(ctx context.Context, dryRun bool) (*GarbageCollectionResult, error) {
+	gc.lock.Lock()
+	if gc.running {
+		gc.lock.Unlock()
+		return nil, errGarbageCollectionInProgress
+	}
+	gc.running = true
+	gc.lock.Unlock()
+	defer func() {
+		gc.lock.Lock()
+		gc.running = false
+		gc.lock.Unlock()
+	}()
+
+	started := time.Now()
+	result := &GarbageCollectionResult{DryRun: dryRun}
+
+	candidates, err := gc.unreferencedCandidates(ctx, started.Add(-gc.gracePeriod))
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk the blob store: %v", err)
+	}
+
+	marked, err := gc.mark()
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark blobs referenced by images: %v", err)
+	}
+	result.MarkedBlobs = len(marked)
+
+	linked, err := gc.recentlyLinked(ctx, started.Add(-gc.gracePeriod))
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk the repositories: %v", err)
+	}
+
+	vacuum := storage.NewVacuum(ctx, gc.driver)
+	var refreshed time.Time
+	for dgst, size := range candidates {
+		if _, ok := marked[dgst]; ok {
+			continue
+		}
+		if _, ok := linked[dgst]; ok {
+			continue
+		}
+		if !dryRun {
+			if time.Since(refreshed) > gcMarkRefreshInterval {
+				// creation timestamps are set by the master with a second precision, the grace period
+				// allows for the clock skew
+				if err := gc.markCreatedSince(marked, started.Add(-gc.gracePeriod).Truncate(time.Second)); err != nil {
+					return nil, fmt.Errorf("failed to mark blobs referenced by new images: %v", err)
+				}
+				refreshed = time.Now()
+				if _, ok := marked[dgst]; ok {
+					continue
+				}
+			}
+			// the blob may have been uploaded again since the blob store was walked
+			fileInfo, err := gc.driver.Stat(ctx, blobDataPath(dgst))
+			if err != nil || !fileInfo.ModTime().Before(started.Add(-gc.gracePeriod)) {
+				continue
+			}
+			if err := vacuum.RemoveBlob(dgst.String()); err != nil {
+				if _, ok := err.(storagedriver.PathNotFoundError); !ok {
+					context.GetLogger(ctx).Errorf("garbage collector: failed to delete blob %s: %v", dgst, err)
+					continue
+				}
+			}
+		}
+		result.DeletedBlobs++
+		result.FreedBytes += size
+	}
+
+	result.Duration = time.Since(started).String()
+	context.GetLogger(ctx).Infof("garbage collector: deleted %d blobs (%d bytes) of %d candidates, %d blobs referenced by images, dry run: %t, took %s",
+		result.DeletedBlobs, result.FreedBytes, len(candidates), result.MarkedBlobs, dryRun, result.Duration)
+
+	return result, nil
+}
+
+// unreferencedCandidates returns the sizes of the blobs last modified before the given time keyed by their
+// digests.
+func (gc *garbageCollector) unreferencedCandidates(ctx context.Context, before time.Time) (map[digest.Digest]int64, error) {
+	candidates := make(map[digest.Digest]int64)
+
+	err := storage.Walk(ctx, gc.driver, blobsRootPath, func(fileInfo storagedriver.FileInfo) error {
+		if fileInfo.IsDir() || path.Base(fileInfo.Path()) != "data" {
+			return nil
+		}
+		if !fileInfo.ModTime().Before(before) {
+			return nil
+		}
+		dgst, err := blobDigestFromPath(fileInfo.Path())
+		if err != nil {
+			context.GetLogger(ctx).Warnf("garbage collector: skipping %s: %v", fileInfo.Path(), err)
+			return nil
+		}
+		candidates[dgst] = fileInfo.Size()
+		return nil
+	})
+	if _, ok := err.(storagedriver.PathNotFoundError); ok {
+		// nothing has been pushed yet
+		return candidates, nil
+	}
+
+	return candidates, err
+}
+
+// recentlyLinked returns the digests of the blobs linked into any repository after the given time.
+func (gc *garbageCollector) recentlyLinked(ctx context.Context, after time.Time) (map[digest.Digest]struct{}, error) {
+	linked := make(map[digest.Digest]struct{})
+
+	err := storage.Walk(ctx, gc.driver, repositoriesRootPath, func(fileInfo storagedriver.FileInfo) error {
+		if fileInfo.IsDir() {
+			switch path.Base(fileInfo.Path()) {
+			case "_manifests", "_uploads":
+				return storage.ErrSkipDir
+			}
+			return nil
+		}
+		if path.Base(fileInfo.Path()) != "link" || fileInfo.ModTime().Before(after) {
+			return nil
+		}
+		dgst, ok := layerLinkDigest(fileInfo.Path())
+		if !ok {
+			return nil
+		}
+		linked[dgst] = struct{}{}
+		return nil
+	})
+	if _, ok := err.(storagedriver.PathNotFoundError); ok {
+		return linked, nil
+	}
+
+	return linked, err
+}
+
+// mark returns the digests of all the blobs referenced by images: their manifests, layers and configs.
+func (gc *garbageCollector) mark() (map[digest.Digest]struct{}, error) {
+	marked := make(map[digest.Digest]struct{})
+	if err := gc.markCreatedSince(marked, time.Time{}); err != nil {
+		return nil, err
+	}
+	return marked, nil
+}
+
+// markCreatedSince adds the digests of the blobs referenced by the images created at or after since to
+// marked.
+func (gc *garbageCollector) markCreatedSince(marked map[digest.Digest]struct{}, since time.Time) error {
+	images, err := gc.imagesGetter.Images().List(kapi.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for i := range images.Items {
+		image := &images.Items[i]
+		if image.CreationTimestamp.Time.Before(since) {
+			continue
+		}
+
+		if len(image.DockerImageLayers) == 0 && len(image.DockerImageManifestMediaType) == 0 {
+			if err := imageapi.ImageWithMetadata(image); err != nil {
+				// the blobs of the image can't be determined, so the collection must not proceed
+				return fmt.Errorf("failed to get metadata for image %s: %v", image.Name, err)
+			}
+		}
+
+		marked[digest.Digest(image.Name)] = struct{}{}
+		for _, layer := range image.DockerImageLayers {
+			marked[digest.Digest(layer.Name)] = struct{}{}
+		}
+		if hasImageConfig(image) {
+			marked[digest.Digest(image.DockerImageMetadata.ID)] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// blobDigestFromPath parses the digest out of a path to the blob data.
+func blobDigestFromPath(blobPath string) (digest.Digest, error) {
+	hexDir := path.Dir(blobPath)
+	algorithm := path.Base(path.Dir(path.Dir(hexDir)))
+	dgst := digest.NewDigestFromHex(algorithm, path.Base(hexDir))
+	if err := dgst.Validate(); err != nil {
+		return "", err
+	}
+	return dgst, nil
+}
+
+// layerLinkDigest parses the digest out of a path to a layer link of a repository.
+func layerLinkDigest(linkPath string) (digest.Digest, bool) {
+	hexDir := path.Dir(linkPath)
+	algorithmDir := path.Dir(hexDir)
+	if path.Base(path.Dir(algorithmDir)) != "_layers" {
+		return "", false
+	}
+	dgst := digest.NewDigestFromHex(path.Base(algorithmDir), path.Base(hexDir))
+	if err := dgst.Validate(); err != nil {
+		return "", false
+	}
+	return dgst, true
+}
+
+// blobDataPath returns the path to the data of the blob in the registry storage.
+func blobDataPath(dgst digest.Digest) string {
+	return path.Join(blobsRootPath, string(dgst.Algorithm()), dgst.Hex()[:2], dgst.Hex(), "data")
+}
+
+// startGarbageCollection runs the collector every interval until the process exits.
+func startGarbageCollection(ctx context.Context, gc *garbageCollector, interval time.Duration) {
+	go func() {
+		for range time.Tick(interval) {
+			if _, err := gc.Run(ctx, false); err != nil {
+				context.GetLogger(ctx).Errorf("garbage collector: %v", err)
+			}
+		}
+	}()
+}
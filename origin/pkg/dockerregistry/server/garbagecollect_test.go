@@ -0,0 +1,213 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift/github.com/docker/distribution/context"
+	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
+	storagedriver "github.com/openshift/github.com/docker/distribution/registry/storage/driver"
+	"github.com/openshift/github.com/docker/distribution/registry/storage/driver/inmemory"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func putTestBlob(t *testing.T, ctx context.Context, driver storagedriver.StorageDriver, content string) digest.Digest {
+	dgst := digest.FromBytes([]byte(content))
+	if err := driver.PutContent(ctx, blobDataPath(dgst), []byte(content)); err != nil {
+		t.Fatalf("failed to put blob %s: %v", dgst, err)
+	}
+	return dgst
+}
+
+func TestGarbageCollectorRun(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name            string
+		gracePeriod     time.Duration
+		dryRun          bool
+		expectedDeleted int
+	}{
+		{
+			name:            "unreferenced blob is deleted",
+			expectedDeleted: 1,
+		},
+		{
+			name:            "dry run deletes nothing",
+			dryRun:          true,
+			expectedDeleted: 1,
+		},
+		{
+			name:        "blobs within the grace period are kept",
+			gracePeriod: time.Hour,
+		},
+	} {
+		driver := inmemory.New()
+
+		manifest := putTestBlob(t, ctx, driver, "manifest")
+		layer := putTestBlob(t, ctx, driver, "layer")
+		config := putTestBlob(t, ctx, driver, "config")
+		unreferenced := putTestBlob(t, ctx, driver, "unreferenced")
+
+		image := imageapi.Image{
+			ObjectMeta:                   kapi.ObjectMeta{Name: manifest.String()},
+			DockerImageManifestMediaType: schema2.MediaTypeManifest,
+			DockerImageLayers:            []imageapi.ImageLayer{{Name: layer.String()}},
+			DockerImageMetadata:          imageapi.DockerImage{ID: config.String()},
+		}
+		client := testclient.NewSimpleFake(&imageapi.ImageList{Items: []imageapi.Image{image}})
+
+		// the blobs must be older than the grace period
+		time.Sleep(10 * time.Millisecond)
+
+		gc := newGarbageCollector(driver, client, tc.gracePeriod)
+		result, err := gc.Run(ctx, tc.dryRun)
+		if err != nil {
+			t.Errorf("[%s] unexpected error: %v", tc.name, err)
+			continue
+		}
+
+		if result.MarkedBlobs != 3 {
+			t.Errorf("[%s] expected 3 marked blobs, got %d", tc.name, result.MarkedBlobs)
+		}
+		if result.DeletedBlobs != tc.expectedDeleted {
+			t.Errorf("[%s] expected %d deleted blobs, got %d", tc.name, tc.expectedDeleted, result.DeletedBlobs)
+		}
+		if expectedFreed := int64(tc.expectedDeleted * len("unreferenced")); result.FreedBytes != expectedFreed {
+			t.Errorf("[%s] expected %d freed bytes, got %d", tc.name, expectedFreed, result.FreedBytes)
+		}
+
+		for _, dgst := range []digest.Digest{manifest, layer, config, unreferenced} {
+			_, err := driver.Stat(ctx, blobDataPath(dgst))
+			deleted := err != nil
+			expectDeleted := dgst == unreferenced && tc.expectedDeleted > 0 && !tc.dryRun
+			if deleted != expectDeleted {
+				t.Errorf("[%s] blob %s: expected deleted=%t, got %t (%v)", tc.name, dgst, expectDeleted, deleted, err)
+			}
+		}
+	}
+}
+
+func TestGarbageCollectorKeepsRecentlyLinkedBlobs(t *testing.T) {
+	ctx := context.Background()
+	driver := inmemory.New()
+
+	mounted := putTestBlob(t, ctx, driver, "mounted")
+	unreferenced := putTestBlob(t, ctx, driver, "unreferenced")
+
+	// the blobs are older than the grace period, the link isn't
+	time.Sleep(50 * time.Millisecond)
+	linkPath := fmt.Sprintf("%s/ns/app/_layers/%s/%s/link", repositoriesRootPath, mounted.Algorithm(), mounted.Hex())
+	if err := driver.PutContent(ctx, linkPath, []byte(mounted)); err != nil {
+		t.Fatalf("failed to link blob %s: %v", mounted, err)
+	}
+
+	gc := newGarbageCollector(driver, testclient.NewSimpleFake(&imageapi.ImageList{}), 25*time.Millisecond)
+	result, err := gc.Run(ctx, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.DeletedBlobs != 1 {
+		t.Errorf("expected 1 deleted blob, got %d", result.DeletedBlobs)
+	}
+	if _, err := driver.Stat(ctx, blobDataPath(mounted)); err != nil {
+		t.Errorf("expected the recently linked blob to be kept: %v", err)
+	}
+	if _, err := driver.Stat(ctx, blobDataPath(unreferenced)); err == nil {
+		t.Errorf("expected the unreferenced blob to be deleted")
+	}
+}
+
+func TestGarbageCollectorKeepsBlobsOfNewImages(t *testing.T) {
+	ctx := context.Background()
+	driver := inmemory.New()
+
+	manifest := putTestBlob(t, ctx, driver, "manifest")
+	reused := putTestBlob(t, ctx, driver, "reused")
+	unreferenced := putTestBlob(t, ctx, driver, "unreferenced")
+	time.Sleep(10 * time.Millisecond)
+
+	// the image reusing the old layer is created after the blobs were marked
+	image := imageapi.Image{
+		ObjectMeta:                   kapi.ObjectMeta{Name: manifest.String()},
+		DockerImageManifestMediaType: schema2.MediaTypeManifest,
+		DockerImageLayers:            []imageapi.ImageLayer{{Name: reused.String()}},
+	}
+	client := &testclient.Fake{}
+	lists := 0
+	client.AddReactor("list", "images", func(action core.Action) (bool, runtime.Object, error) {
+		lists++
+		if lists == 1 {
+			return true, &imageapi.ImageList{}, nil
+		}
+		image.CreationTimestamp = unversioned.Now()
+		return true, &imageapi.ImageList{Items: []imageapi.Image{image}}, nil
+	})
+
+	gc := newGarbageCollector(driver, client, 0)
+	result, err := gc.Run(ctx, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lists != 2 {
+		t.Errorf("expected the images to be listed again before deleting blobs, got %d lists", lists)
+	}
+	if result.DeletedBlobs != 1 {
+		t.Errorf("expected 1 deleted blob, got %d", result.DeletedBlobs)
+	}
+	for _, dgst := range []digest.Digest{manifest, reused} {
+		if _, err := driver.Stat(ctx, blobDataPath(dgst)); err != nil {
+			t.Errorf("expected blob %s of the new image to be kept: %v", dgst, err)
+		}
+	}
+	if _, err := driver.Stat(ctx, blobDataPath(unreferenced)); err == nil {
+		t.Errorf("expected the unreferenced blob to be deleted")
+	}
+}
+
+func TestGarbageCollectorEmptyStorage(t *testing.T) {
+	gc := newGarbageCollector(inmemory.New(), testclient.NewSimpleFake(), 0)
+	result, err := gc.Run(context.Background(), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.DeletedBlobs != 0 || result.FreedBytes != 0 {
+		t.Errorf("expected nothing to be deleted, got %#v", result)
+	}
+}
+
+func TestBlobDigestFromPath(t *testing.T) {
+	dgst := digest.FromBytes([]byte("blob"))
+	got, err := blobDigestFromPath(fmt.Sprintf("%s/sha256/%s/%s/data", blobsRootPath, dgst.Hex()[:2], dgst.Hex()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != dgst {
+		t.Errorf("expected %s, got %s", dgst, got)
+	}
+
+	if _, err := blobDigestFromPath(blobsRootPath + "/sha256/ab/invalid/data"); err == nil {
+		t.Errorf("expected an error for an invalid path")
+	}
+}
+
+func TestLayerLinkDigest(t *testing.T) {
+	dgst := digest.FromBytes([]byte("blob"))
+	got, ok := layerLinkDigest(fmt.Sprintf("%s/ns/app/_layers/sha256/%s/link", repositoriesRootPath, dgst.Hex()))
+	if !ok || got != dgst {
+		t.Errorf("expected %s, got %s", dgst, got)
+	}
+
+	if _, ok := layerLinkDigest(fmt.Sprintf("%s/ns/app/_manifests/tags/latest/current/link", repositoriesRootPath)); ok {
+		t.Errorf("expected a tag link not to be parsed as a layer link")
+	}
+}
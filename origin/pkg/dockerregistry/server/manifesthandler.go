@@ -6,6 +6,8 @@ import (
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/context"
 	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 
@@ -41,6 +43,10 @@ func NewManifestHandler(repo *repository, manifest distribution.Manifest) (Manif
 		return &manifestSchema1Handler{repo: repo, manifest: t}, nil
 	case *schema2.DeserializedManifest:
 		return &manifestSchema2Handler{repo: repo, manifest: t}, nil
+	case *ocischema.DeserializedManifest:
+		return &manifestOCIHandler{repo: repo, manifest: t}, nil
+	case *manifestlist.DeserializedManifestList:
+		return &manifestListHandler{repo: repo, manifest: t}, nil
 	default:
 		return nil, fmt.Errorf("unsupported manifest type %T", manifest)
 	}
@@ -58,6 +64,10 @@ func NewManifestHandlerFromImage(repo *repository, image *imageapi.Image) (Manif
 		manifest, err = unmarshalManifestSchema1([]byte(image.DockerImageManifest), image.DockerImageSignatures)
 	case schema2.MediaTypeManifest:
 		manifest, err = unmarshalManifestSchema2([]byte(image.DockerImageManifest))
+	case ocischema.MediaTypeManifest:
+		manifest, err = unmarshalManifestOCI([]byte(image.DockerImageManifest))
+	case manifestlist.MediaTypeManifestList, manifestlist.MediaTypeOCIIndex:
+		manifest, err = unmarshalManifestList([]byte(image.DockerImageManifest), image.DockerImageManifestMediaType)
 	default:
 		return nil, fmt.Errorf("unsupported manifest media type %s", image.DockerImageManifestMediaType)
 	}
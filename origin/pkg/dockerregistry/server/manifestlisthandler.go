@@ -0,0 +1,86 @@
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/openshift/github.com/docker/distribution"
+	"github.com/openshift/github.com/docker/distribution/context"
+	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func unmarshalManifestList(content []byte, mediaType string) (distribution.Manifest, error) {
+	var deserializedManifestList manifestlist.DeserializedManifestList
+	if err := json.Unmarshal(content, &deserializedManifestList); err != nil {
+		return nil, err
+	}
+	// the media type is optional in OCI image indexes
+	if len(deserializedManifestList.MediaType) == 0 {
+		deserializedManifestList.MediaType = mediaType
+	}
+
+	return &deserializedManifestList, nil
+}
+
+// manifestListHandler handles manifest lists and OCI image indexes. They reference platform specific image
+// manifests instead of layers.
+type manifestListHandler struct {
+	repo     *repository
+	manifest *manifestlist.DeserializedManifestList
+}
+
+var _ ManifestHandler = &manifestListHandler{}
+
+func (h *manifestListHandler) FillImageMetadata(ctx context.Context, image *imageapi.Image) error {
+	return imageapi.ImageWithMetadata(image)
+}
+
+func (h *manifestListHandler) Manifest() distribution.Manifest {
+	return h.manifest
+}
+
+func (h *manifestListHandler) Payload() (mediaType string, payload []byte, canonical []byte, err error) {
+	mt, p, err := h.manifest.Payload()
+	return mt, p, p, err
+}
+
+func (h *manifestListHandler) Verify(ctx context.Context, skipDependencyVerification bool) error {
+	var errs distribution.ErrManifestVerification
+
+	if skipDependencyVerification {
+		return nil
+	}
+
+	// The referenced manifests must have been pushed to this repository before the list. They are looked up
+	// in the registry storage since they don't have Image objects yet.
+	ms, err := h.repo.Repository.Manifests(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, desc := range h.manifest.References() {
+		exists, err := ms.Exists(ctx, desc.Digest)
+		if err != nil && err != distribution.ErrBlobUnknown {
+			errs = append(errs, err)
+		}
+		if err != nil || !exists {
+			// On error here, we always append unknown blob errors.
+			errs = append(errs, distribution.ErrManifestBlobUnknown{Digest: desc.Digest})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (h *manifestListHandler) Digest() (digest.Digest, error) {
+	_, p, err := h.manifest.Payload()
+	if err != nil {
+		return "", err
+	}
+	return digest.FromBytes(p), nil
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/context"
 	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/openshift/github.com/docker/distribution/registry/api/errcode"
 	regapi "github.com/openshift/github.com/docker/distribution/registry/api/v2"
@@ -112,6 +114,9 @@ func (m *manifestService) Put(ctx context.Context, manifest distribution.Manifes
 	if !m.acceptschema2 && mediaType == schema2.MediaTypeManifest {
 		return "", regapi.ErrorCodeManifestInvalid.WithDetail(fmt.Errorf("manifest V2 schema 2 not allowed"))
 	}
+	if !m.acceptschema2 && (mediaType == ocischema.MediaTypeManifest || imageapi.IsManifestList(mediaType)) {
+		return "", regapi.ErrorCodeManifestInvalid.WithDetail(fmt.Errorf("manifest lists and OCI manifests not allowed"))
+	}
 
 	// in order to stat the referenced blobs, repository need to be set on the context
 	if err := mh.Verify(WithRepository(ctx, m.repo), false); err != nil {
@@ -126,6 +131,32 @@ func (m *manifestService) Put(ctx context.Context, manifest distribution.Manifes
 	// Calculate digest
 	dgst := digest.FromBytes(canonical)
 
+	tag := ""
+	for _, option := range options {
+		if opt, ok := option.(distribution.WithTagOption); ok {
+			tag = opt.Tag
+			break
+		}
+	}
+	if len(tag) == 0 {
+		// Manifests pushed by digest are usually the platform specific images of a manifest list pushed
+		// next. A mapping needs a tag, so only the Image is created. It keeps the blobs of the manifest
+		// from being collected until the list is pushed or the image is pruned.
+		context.GetLogger(ctx).Debugf("manifest %s pushed without a tag, not creating an ImageStreamMapping", dgst.String())
+		if err := m.createImage(WithRepository(ctx, m.repo), dgst, manifest); err != nil {
+			return "", err
+		}
+		return dgst, nil
+	}
+
+	var children []distribution.Manifest
+	if list, ok := manifest.(*manifestlist.DeserializedManifestList); ok {
+		children, err = m.createManifestListImages(WithRepository(ctx, m.repo), list)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	// Upload to openshift
 	ism := imageapi.ImageStreamMapping{
 		ObjectMeta: kapi.ObjectMeta{
@@ -143,13 +174,7 @@ func (m *manifestService) Put(ctx context.Context, manifest distribution.Manifes
 			DockerImageManifest:          string(payload),
 			DockerImageManifestMediaType: mediaType,
 		},
-	}
-
-	for _, option := range options {
-		if opt, ok := option.(distribution.WithTagOption); ok {
-			ism.Tag = opt.Tag
-			break
-		}
+		Tag: tag,
 	}
 
 	if err = mh.FillImageMetadata(ctx, &ism.Image); err != nil {
@@ -200,9 +225,72 @@ func (m *manifestService) Put(ctx context.Context, manifest distribution.Manifes
 		}
 	}
 
+	if replicator != nil {
+		replicator.Enqueue(ctx, m.repo.namespace, m.repo.name, tag, dgst, manifest, children, m.repo.Blobs(ctx))
+	}
+
 	return dgst, nil
 }
 
+// createManifestListImages creates Image objects for the manifests referenced by the given manifest list. The
+// manifests have been pushed to the storage by digest before the list. The referenced manifests are returned.
+func (m *manifestService) createManifestListImages(ctx context.Context, list *manifestlist.DeserializedManifestList) ([]distribution.Manifest, error) {
+	manifests := []distribution.Manifest{}
+	for _, desc := range list.References() {
+		manifest, err := m.manifests.Get(ctx, desc.Digest)
+		if err != nil {
+			context.GetLogger(ctx).Errorf("unable to get manifest %s of manifest list: %v", desc.Digest.String(), err)
+			return nil, err
+		}
+		// the Image is usually created when the manifest is pushed, unless it has been pruned since
+		if err := m.createImage(ctx, desc.Digest, manifest); err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, manifest)
+	}
+	return manifests, nil
+}
+
+// createImage creates the Image object for a manifest stored in the repository. An existing Image is left
+// as it is.
+func (m *manifestService) createImage(ctx context.Context, dgst digest.Digest, manifest distribution.Manifest) error {
+	mh, err := NewManifestHandler(m.repo, manifest)
+	if err != nil {
+		return regapi.ErrorCodeManifestInvalid.WithDetail(err)
+	}
+	mediaType, payload, _, err := mh.Payload()
+	if err != nil {
+		return regapi.ErrorCodeManifestInvalid.WithDetail(err)
+	}
+
+	image := &imageapi.Image{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: dgst.String(),
+			Annotations: map[string]string{
+				imageapi.ManagedByOpenShiftAnnotation: "true",
+			},
+		},
+		DockerImageReference:         fmt.Sprintf("%s/%s/%s@%s", m.repo.registryAddr, m.repo.namespace, m.repo.name, dgst.String()),
+		DockerImageManifest:          string(payload),
+		DockerImageManifestMediaType: mediaType,
+	}
+	if err := mh.FillImageMetadata(ctx, image); err != nil {
+		return err
+	}
+	image.DockerImageManifest = ""
+	image.DockerImageConfig = ""
+
+	if _, err := m.repo.registryOSClient.Images().Create(image); err != nil && !kerrors.IsAlreadyExists(err) {
+		if quotautil.IsErrorQuotaExceeded(err) {
+			context.GetLogger(ctx).Errorf("denied creating Image %s: %v", image.Name, err)
+			return distribution.ErrAccessDenied
+		}
+		context.GetLogger(ctx).Errorf("error creating Image %s: %v", image.Name, err)
+		return err
+	}
+	return nil
+}
+
 // Delete deletes the manifest with digest `dgst`. Note: Image resources
 // in OpenShift are deleted via 'oadm prune images'. This function deletes
 // the content related to the manifest in the registry's storage (signatures).
@@ -10,6 +10,13 @@
 // *Note*: Here, we take into account just a single layer, not the image as a whole because the layers are
 // uploaded before the manifest. This leads to a situation where several layers can be written until a big
 // enough layer will be received that exceeds the limit.
+//
+// Layers are also refused when they would make the project exceed the openshift.io/imagestreams.storage
+// resource of its resource quotas or cluster resource quotas. The usage is computed by the master from the
+// images already tagged into the project's image streams, so a layer that is already referenced there is
+// counted against the limit once more while it is being pushed. The quotas are cached like the limit ranges,
+// so the usage may be out of date by up to the project cache TTL. Layers are admitted when the quotas cannot
+// be listed, since the master enforces the quota again when the image is tagged.
 package server
 
 import (
@@ -22,6 +29,8 @@ import (
 	kapi "github.com/openshift/kubernetes/pkg/api"
 
 	imageadmission "github.com/openshift/origin/pkg/image/admission"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	quotaapi "github.com/openshift/origin/pkg/quota/api"
 )
 
 const (
@@ -59,7 +68,9 @@ func newQuotaEnforcingConfig(ctx context.Context, enforceQuota, projectCacheTTL
 
 	context.GetLogger(ctx).Infof("caching project quota objects with TTL %s", ttl.String())
 	return &quotaEnforcingConfig{
-		limitRanges: newProjectObjectListCache(ttl),
+		limitRanges:           newProjectObjectListCache(ttl),
+		resourceQuotas:        newProjectObjectListCache(ttl),
+		clusterResourceQuotas: newProjectObjectListCache(ttl),
 	}
 }
 
@@ -72,6 +83,10 @@ type quotaEnforcingConfig struct {
 	projectCacheDisabled bool
 	// a cache of limit range objects keyed by project name
 	limitRanges projectObjectListStore
+	// a cache of resource quota objects keyed by project name
+	resourceQuotas projectObjectListStore
+	// a cache of applied cluster resource quota objects keyed by project name
+	clusterResourceQuotas projectObjectListStore
 }
 
 // quotaRestrictedBlobStore wraps upstream blob store with a guard preventing big layers exceeding image quotas
@@ -179,6 +194,10 @@ func admitBlobWrite(ctx context.Context, repo *repository, size int64) error {
 		}
 	}
 
+	if err := admitBlobWriteStorage(ctx, repo, size); err != nil {
+		return err
+	}
+
 	// TODO(1): admit also against openshift.io/ImageStream quota resource when we have image stream cache in the
 	// registry
 	// TODO(2): admit also against openshift.io/imagestreamimages and openshift.io/imagestreamtags resources once
@@ -186,3 +205,84 @@ func admitBlobWrite(ctx context.Context, repo *repository, size int64) error {
 
 	return nil
 }
+
+// admitBlobWriteStorage checks whether the blob fits into the image storage left by the resource quotas and
+// cluster resource quotas of the project. Returns ErrAccessDenied error if any of the quotas would be exceeded.
+func admitBlobWriteStorage(ctx context.Context, repo *repository, size int64) error {
+	if quotas := listResourceQuotas(ctx, repo); quotas != nil {
+		for _, quota := range quotas.Items {
+			if exceedsStorageQuota(quota.Status, size) {
+				context.GetLogger(ctx).Errorf("refusing to write blob of %d bytes exceeding %s of resource quota %s", size, imageapi.ResourceImageStreamsStorage, quota.Name)
+				return distribution.ErrAccessDenied
+			}
+		}
+	}
+
+	if clusterQuotas := listClusterResourceQuotas(ctx, repo); clusterQuotas != nil {
+		for _, quota := range clusterQuotas.Items {
+			if exceedsStorageQuota(quota.Status.Total, size) {
+				context.GetLogger(ctx).Errorf("refusing to write blob of %d bytes exceeding %s of cluster resource quota %s", size, imageapi.ResourceImageStreamsStorage, quota.Name)
+				return distribution.ErrAccessDenied
+			}
+		}
+	}
+
+	return nil
+}
+
+// listResourceQuotas returns the resource quotas of the repository's project from the project cache or the
+// master. It returns nil if the quotas cannot be listed.
+func listResourceQuotas(ctx context.Context, repo *repository) *kapi.ResourceQuotaList {
+	if !quotaEnforcing.projectCacheDisabled {
+		obj, exists, _ := quotaEnforcing.resourceQuotas.get(repo.namespace)
+		if exists {
+			return obj.(*kapi.ResourceQuotaList)
+		}
+	}
+	context.GetLogger(ctx).Debugf("listing resource quotas in namespace %s", repo.namespace)
+	quotas, err := repo.quotaClient.ResourceQuotas(repo.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		context.GetLogger(ctx).Errorf("failed to list resourcequotas, not checking the image storage quota: %v", err)
+		return nil
+	}
+	if !quotaEnforcing.projectCacheDisabled {
+		if err := quotaEnforcing.resourceQuotas.add(repo.namespace, quotas); err != nil {
+			context.GetLogger(ctx).Errorf("failed to cache resource quota list: %v", err)
+		}
+	}
+	return quotas
+}
+
+// listClusterResourceQuotas returns the cluster resource quotas applied to the repository's project from the
+// project cache or the master. It returns nil if the quotas cannot be listed.
+func listClusterResourceQuotas(ctx context.Context, repo *repository) *quotaapi.AppliedClusterResourceQuotaList {
+	if !quotaEnforcing.projectCacheDisabled {
+		obj, exists, _ := quotaEnforcing.clusterResourceQuotas.get(repo.namespace)
+		if exists {
+			return obj.(*quotaapi.AppliedClusterResourceQuotaList)
+		}
+	}
+	context.GetLogger(ctx).Debugf("listing applied cluster resource quotas in namespace %s", repo.namespace)
+	quotas, err := repo.registryOSClient.AppliedClusterResourceQuotas(repo.namespace).List(kapi.ListOptions{})
+	if err != nil {
+		context.GetLogger(ctx).Errorf("failed to list appliedclusterresourcequotas, not checking the image storage quota: %v", err)
+		return nil
+	}
+	if !quotaEnforcing.projectCacheDisabled {
+		if err := quotaEnforcing.clusterResourceQuotas.add(repo.namespace, quotas); err != nil {
+			context.GetLogger(ctx).Errorf("failed to cache applied cluster resource quota list: %v", err)
+		}
+	}
+	return quotas
+}
+
+// exceedsStorageQuota returns true if adding size bytes to the image storage used in the quota status would
+// exceed its hard limit. Quotas that do not limit image storage are never exceeded.
+func exceedsStorageQuota(status kapi.ResourceQuotaStatus, size int64) bool {
+	hard, ok := status.Hard[imageapi.ResourceImageStreamsStorage]
+	if !ok {
+		return false
+	}
+	used := status.Used[imageapi.ResourceImageStreamsStorage]
+	return used.Value()+size > hard.Value()
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift/github.com/docker/distribution"
+	"github.com/openshift/github.com/docker/distribution/context"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/resource"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestExceedsStorageQuota(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		status   kapi.ResourceQuotaStatus
+		size     int64
+		expected bool
+	}{
+		{
+			name:   "no storage limit",
+			status: kapi.ResourceQuotaStatus{Hard: kapi.ResourceList{imageapi.ResourceImageStreams: resource.MustParse("1")}},
+			size:   1024,
+		},
+		{
+			name: "fits",
+			status: kapi.ResourceQuotaStatus{
+				Hard: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse("1Ki")},
+				Used: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse("512")},
+			},
+			size: 512,
+		},
+		{
+			name: "exceeds",
+			status: kapi.ResourceQuotaStatus{
+				Hard: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse("1Ki")},
+				Used: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse("512")},
+			},
+			size:     513,
+			expected: true,
+		},
+		{
+			name: "no usage computed yet",
+			status: kapi.ResourceQuotaStatus{
+				Hard: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse("1Ki")},
+			},
+			size:     2048,
+			expected: true,
+		},
+	} {
+		if got := exceedsStorageQuota(tc.status, tc.size); got != tc.expected {
+			t.Errorf("[%s]: expected %t, got %t", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestAdmitBlobWriteStorage(t *testing.T) {
+	quota := &kapi.ResourceQuota{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "nm", Name: "storage"},
+		Status: kapi.ResourceQuotaStatus{
+			Hard: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse("1Ki")},
+			Used: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse("512")},
+		},
+	}
+	listErr := func(core.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("connection refused")
+	}
+
+	for _, tc := range []struct {
+		name          string
+		cache         bool
+		quotaErr      bool
+		clusterErr    bool
+		size          int64
+		expectedErr   error
+		expectedLists int
+	}{
+		{
+			name:          "fits",
+			size:          512,
+			expectedLists: 4,
+		},
+		{
+			name:          "exceeds",
+			size:          513,
+			expectedErr:   distribution.ErrAccessDenied,
+			expectedLists: 2,
+		},
+		{
+			name:          "cached",
+			cache:         true,
+			size:          512,
+			expectedLists: 2,
+		},
+		{
+			name:          "quotas can't be listed",
+			quotaErr:      true,
+			clusterErr:    true,
+			size:          2048,
+			expectedLists: 4,
+		},
+	} {
+		quotaEnforcing = &quotaEnforcingConfig{projectCacheDisabled: true}
+		if tc.cache {
+			quotaEnforcing = &quotaEnforcingConfig{
+				resourceQuotas:        newProjectObjectListCache(time.Minute),
+				clusterResourceQuotas: newProjectObjectListCache(time.Minute),
+			}
+		}
+		kclient := fake.NewSimpleClientset(quota)
+		if tc.quotaErr {
+			kclient.PrependReactor("list", "resourcequotas", listErr)
+		}
+		osclient := testclient.NewSimpleFake()
+		if tc.clusterErr {
+			osclient.PrependReactor("list", "appliedclusterresourcequotas", listErr)
+		}
+		repo := &repository{namespace: "nm", quotaClient: kclient.Core(), registryOSClient: osclient}
+
+		var err error
+		for i := 0; i < 2; i++ {
+			if err = admitBlobWriteStorage(context.Background(), repo, tc.size); err != tc.expectedErr {
+				t.Errorf("[%s]: expected error %v, got %v", tc.name, tc.expectedErr, err)
+			}
+		}
+		if lists := len(kclient.Actions()) + len(osclient.Actions()); lists != tc.expectedLists {
+			t.Errorf("[%s]: expected %d lists, got %d", tc.name, tc.expectedLists, lists)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"fmt"
+
 	log "github.com/openshift/github.com/Sirupsen/logrus"
 
 	"github.com/openshift/github.com/docker/distribution"
@@ -18,6 +20,32 @@ func init() {
 	middleware.Register("openshift", func(ctx context.Context, registry distribution.Namespace, options map[string]interface{}) (distribution.Namespace, error) {
 		log.Info("OpenShift registry middleware initializing")
 		dockerRegistry = registry
+
+		gcInterval, err := getDurationOption(GCIntervalEnvVar, "gcinterval", 0, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gcinterval: %v", err)
+		}
+		if gcInterval < 0 {
+			return nil, fmt.Errorf("invalid gcinterval: %s must not be negative", gcInterval)
+		}
+		gcGracePeriod, err := getDurationOption(GCGracePeriodEnvVar, "gcgraceperiod", defaultGCGracePeriod, options)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gcgraceperiod: %v", err)
+		}
+		if gcGracePeriod < 0 {
+			return nil, fmt.Errorf("invalid gcgraceperiod: %s must not be negative", gcGracePeriod)
+		}
+
+		registryOSClient, _, err := DefaultRegistryClient.Clients()
+		if err != nil {
+			return nil, err
+		}
+		blobCollector = newGarbageCollector(dockerStorageDriver, registryOSClient, gcGracePeriod)
+		if gcInterval > 0 {
+			log.Infof("Collecting unreferenced blobs every %s", gcInterval)
+			startGarbageCollection(ctx, blobCollector, gcInterval)
+		}
+
 		return dockerRegistry, nil
 	})
 }
@@ -0,0 +1,461 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/openshift/github.com/docker/distribution"
+	"github.com/openshift/github.com/docker/distribution/context"
+	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
+	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
+	"github.com/openshift/github.com/docker/distribution/reference"
+	"github.com/openshift/github.com/docker/distribution/registry/client"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"github.com/openshift/kubernetes/pkg/client/retry"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/importer"
+)
+
+const (
+	// replicationQueueSize is the number of pushes waiting for replication. Pushes exceeding it are not
+	// replicated.
+	replicationQueueSize = 256
+	// replicationWorkers is the number of pushes replicated at the same time.
+	replicationWorkers = 2
+	// replicationMountCacheTTL is how long a blob replicated to a remote repository is considered mountable
+	// from it.
+	replicationMountCacheTTL = time.Hour
+
+	// Reasons of the ImageReplicated tag condition
+	replicationSucceededReason = "Replicated"
+	replicationFailedReason    = "ReplicationFailed"
+)
+
+// replicator replicates the images pushed to the integrated registry. It is initialized by the repository
+// middleware from its "replication" option.
+var replicator *imageReplicator
+
+// replicationRule configures the replication of matching repositories to a remote registry. It is set in the
+// openshift repository middleware options, e.g.:
+//
+//	replication:
+//	  - repository: prod/*
+//	    destination: dr.example.com:5000/mirror
+//	    secret: default/dr-registry-credentials
+type replicationRule struct {
+	// Repository is a pattern matched against <namespace>/<name> of the pushed repository. The syntax is the
+	// one of path.Match.
+	Repository string
+	// Destination is the remote registry host optionally followed by a repository prefix. The image pushed to
+	// <namespace>/<name> is replicated to <destination>/<namespace>/<name>.
+	Destination string
+	// Secret is the <namespace>/<name> of a docker config secret with the credentials for the destination.
+	// The registry service account must be allowed to get it.
+	Secret string
+	// Insecure allows replication over plain HTTP or to a registry with an untrusted certificate.
+	Insecure bool
+}
+
+// matches returns true if the rule applies to the given repository.
+func (rule *replicationRule) matches(namespace, name string) bool {
+	matched, _ := path.Match(rule.Repository, namespace+"/"+name)
+	return matched
+}
+
+// target returns the remote registry host and the repository name the given repository is replicated to.
+func (rule *replicationRule) target(namespace, name string) (string, string) {
+	parts := strings.SplitN(rule.Destination, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], path.Join(namespace, name)
+	}
+	return parts[0], path.Join(parts[1], namespace, name)
+}
+
+// parseReplicationRules reads the replication rules from the repository middleware options.
+func parseReplicationRules(options map[string]interface{}) ([]replicationRule, error) {
+	value, ok := options["replication"]
+	if !ok {
+		return nil, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("config option %q: expected a list, not %T", "replication", value)
+	}
+
+	rules := []replicationRule{}
+	for i, item := range items {
+		fields := map[string]interface{}{}
+		switch t := item.(type) {
+		case map[string]interface{}:
+			fields = t
+		case map[interface{}]interface{}:
+			for k, v := range t {
+				fields[fmt.Sprintf("%v", k)] = v
+			}
+		default:
+			return nil, fmt.Errorf("config option %q: item %d: expected a map, not %T", "replication", i, item)
+		}
+
+		var (
+			rule replicationRule
+			err  error
+		)
+		for key, value := range fields {
+			switch key {
+			case "repository":
+				rule.Repository, err = getStringOption("", key, "", fields)
+			case "destination":
+				rule.Destination, err = getStringOption("", key, "", fields)
+			case "secret":
+				rule.Secret, err = getStringOption("", key, "", fields)
+			case "insecure":
+				rule.Insecure, err = getBoolOption("", key, false, fields)
+			default:
+				err = fmt.Errorf("unknown field %q with value %v", key, value)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("config option %q: item %d: %v", "replication", i, err)
+			}
+		}
+
+		if _, err := path.Match(rule.Repository, ""); err != nil || len(rule.Repository) == 0 {
+			return nil, fmt.Errorf("config option %q: item %d: invalid repository pattern %q", "replication", i, rule.Repository)
+		}
+		if len(rule.Destination) == 0 {
+			return nil, fmt.Errorf("config option %q: item %d: destination is required", "replication", i)
+		}
+		if len(rule.Secret) > 0 && len(strings.Split(rule.Secret, "/")) != 2 {
+			return nil, fmt.Errorf("config option %q: item %d: secret %q must be of the format <namespace>/<name>", "replication", i, rule.Secret)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// ValidateReplication returns an error if the replication rules in the openshift repository middleware options
+// are invalid.
+func ValidateReplication(options map[string]interface{}) error {
+	_, err := parseReplicationRules(options)
+	return err
+}
+
+// replicationJob is a push of a tag to be replicated.
+type replicationJob struct {
+	namespace string
+	name      string
+	tag       string
+	dgst      digest.Digest
+	manifest  distribution.Manifest
+	// children are the manifests referenced by a manifest list. They are replicated before the list.
+	children []distribution.Manifest
+	// blobs reads the blobs of the pushed repository, falling back to pullthrough for blobs that aren't stored
+	// locally.
+	blobs distribution.BlobProvider
+	rules []replicationRule
+}
+
+// imageReplicator copies the images pushed to the integrated registry to remote registries in the background.
+// The outcome is recorded as the ImageReplicated condition of the pushed image stream tag.
+type imageReplicator struct {
+	rules   []replicationRule
+	queue   chan replicationJob
+	streams osclient.ImageStreamsNamespacer
+	secrets kcoreclient.SecretsGetter
+	// mounts remembers the remote repositories the blobs were replicated to, so that the next replication to
+	// the same registry can mount them instead of uploading them again.
+	mounts digestToRepositoryCache
+}
+
+// newImageReplicator starts the replication workers if there are any rules.
+func newImageReplicator(ctx context.Context, rules []replicationRule, streams osclient.ImageStreamsNamespacer, secrets kcoreclient.SecretsGetter) (*imageReplicator, error) {
+	mounts, err := newDigestToRepositoryCache(defaultDigestToRepositoryCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	r := &imageReplicator{
+		rules:   rules,
+		queue:   make(chan replicationJob, replicationQueueSize),
+		streams: streams,
+		secrets: secrets,
+		mounts:  mounts,
+	}
+	if len(rules) > 0 {
+		for i := 0; i < replicationWorkers; i++ {
+			go r.run(ctx)
+		}
+	}
+	return r, nil
+}
+
+// Enqueue schedules the replication of the manifest pushed to the tag of the repository if any rule matches it.
+// The blobs of the image are read from blobs.
+func (r *imageReplicator) Enqueue(ctx context.Context, namespace, name, tag string, dgst digest.Digest, manifest distribution.Manifest, children []distribution.Manifest, blobs distribution.BlobProvider) {
+	job := replicationJob{
+		namespace: namespace,
+		name:      name,
+		tag:       tag,
+		dgst:      dgst,
+		manifest:  manifest,
+		children:  children,
+		blobs:     blobs,
+	}
+	for _, rule := range r.rules {
+		if rule.matches(namespace, name) {
+			job.rules = append(job.rules, rule)
+		}
+	}
+	if len(job.rules) == 0 {
+		return
+	}
+
+	select {
+	case r.queue <- job:
+		context.GetLogger(ctx).Debugf("queued replication of %s/%s:%s", namespace, name, tag)
+	default:
+		context.GetLogger(ctx).Errorf("replication queue is full, not replicating %s/%s:%s", namespace, name, tag)
+	}
+}
+
+func (r *imageReplicator) run(ctx context.Context) {
+	for job := range r.queue {
+		r.replicate(ctx, job)
+	}
+}
+
+// replicate copies the job's image to all the destinations of its rules and records the outcome.
+func (r *imageReplicator) replicate(ctx context.Context, job replicationJob) {
+	destinations := []string{}
+	failures := []string{}
+	for _, rule := range job.rules {
+		host, repoName := rule.target(job.namespace, job.name)
+		destination := fmt.Sprintf("%s/%s:%s", host, repoName, job.tag)
+		if err := r.replicateTo(ctx, job, &rule, host, repoName); err != nil {
+			context.GetLogger(ctx).Errorf("failed to replicate %s/%s:%s to %s: %v", job.namespace, job.name, job.tag, destination, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", destination, err))
+			continue
+		}
+		context.GetLogger(ctx).Infof("replicated %s/%s:%s to %s", job.namespace, job.name, job.tag, destination)
+		destinations = append(destinations, destination)
+	}
+
+	condition := imageapi.TagEventCondition{
+		Type:               imageapi.ImageReplicated,
+		Status:             kapi.ConditionTrue,
+		LastTransitionTime: unversioned.Now(),
+		Reason:             replicationSucceededReason,
+		Message:            fmt.Sprintf("image %s replicated to %s", job.dgst, strings.Join(destinations, ", ")),
+	}
+	if len(failures) > 0 {
+		condition.Status = kapi.ConditionFalse
+		condition.Reason = replicationFailedReason
+		condition.Message = fmt.Sprintf("image %s not replicated to %s", job.dgst, strings.Join(failures, "; "))
+	}
+	if err := r.setTagCondition(job, condition); err != nil {
+		context.GetLogger(ctx).Errorf("failed to record replication status of %s/%s:%s: %v", job.namespace, job.name, job.tag, err)
+	}
+}
+
+// replicateTo pushes the job's blobs and manifests to the remote repository.
+func (r *imageReplicator) replicateTo(ctx context.Context, job replicationJob, rule *replicationRule, host, repoName string) error {
+	secrets := []kapi.Secret{}
+	if len(rule.Secret) > 0 {
+		parts := strings.SplitN(rule.Secret, "/", 2)
+		secret, err := r.secrets.Secrets(parts[0]).Get(parts[1])
+		if err != nil {
+			return fmt.Errorf("unable to get secret %s: %v", rule.Secret, err)
+		}
+		secrets = append(secrets, *secret)
+	}
+	credentials := importer.NewCredentialsForSecrets(secrets)
+
+	retriever := importer.NewContext(secureTransport, insecureTransport).WithPushCredentials(credentials, r.mountSources(job, host, repoName)...)
+	remote, err := retriever.Repository(ctx, &url.URL{Scheme: "https", Host: host}, repoName, rule.Insecure)
+	if err != nil {
+		return err
+	}
+	ms, err := remote.Manifests(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range job.children {
+		if err := r.copyBlobs(ctx, job, remote, host, repoName, child); err != nil {
+			return err
+		}
+		if _, err := ms.Put(ctx, child); err != nil {
+			return fmt.Errorf("unable to push manifest: %v", err)
+		}
+	}
+	if err := r.copyBlobs(ctx, job, remote, host, repoName, job.manifest); err != nil {
+		return err
+	}
+	if _, err := ms.Put(ctx, job.manifest, distribution.WithTag(job.tag)); err != nil {
+		return fmt.Errorf("unable to push manifest: %v", err)
+	}
+	return nil
+}
+
+// copyBlobs makes sure the blobs of the manifest exist in the remote repository. Blobs already replicated to
+// another repository of the same registry are mounted from it, the others are uploaded from the pushed
+// repository.
+func (r *imageReplicator) copyBlobs(ctx context.Context, job replicationJob, remote distribution.Repository, host, repoName string, manifest distribution.Manifest) error {
+	bs := remote.Blobs(ctx)
+	for _, desc := range replicatedBlobs(manifest) {
+		if _, err := bs.Stat(ctx, desc.Digest); err == nil {
+			r.mounts.RememberDigest(desc.Digest, replicationMountCacheTTL, path.Join(host, repoName))
+			continue
+		} else if err != distribution.ErrBlobUnknown {
+			return fmt.Errorf("unable to stat blob %s: %v", desc.Digest, err)
+		}
+
+		if err := r.copyBlob(ctx, job, bs, host, repoName, desc); err != nil {
+			return fmt.Errorf("unable to copy blob %s: %v", desc.Digest, err)
+		}
+		r.mounts.RememberDigest(desc.Digest, replicationMountCacheTTL, path.Join(host, repoName))
+	}
+	return nil
+}
+
+// mountSources returns the repositories of the remote registry the blobs of the job can be mounted from.
+func (r *imageReplicator) mountSources(job replicationJob, host, repoName string) []string {
+	sources := []string{}
+	seen := make(map[string]struct{})
+	manifests := append([]distribution.Manifest{job.manifest}, job.children...)
+	for _, manifest := range manifests {
+		for _, desc := range replicatedBlobs(manifest) {
+			for _, source := range r.mountSourcesForDigest(desc.Digest, host, repoName) {
+				if _, ok := seen[source]; !ok {
+					seen[source] = struct{}{}
+					sources = append(sources, source)
+				}
+			}
+		}
+	}
+	return sources
+}
+
+// mountSourcesForDigest returns the repositories of the remote registry the blob was replicated to, except the
+// repository repoName itself.
+func (r *imageReplicator) mountSourcesForDigest(dgst digest.Digest, host, repoName string) []string {
+	sources := []string{}
+	for _, repo := range r.mounts.RepositoriesForDigest(dgst) {
+		if !strings.HasPrefix(repo, host+"/") || repo == path.Join(host, repoName) {
+			continue
+		}
+		sources = append(sources, strings.TrimPrefix(repo, host+"/"))
+	}
+	return sources
+}
+
+func (r *imageReplicator) copyBlob(ctx context.Context, job replicationJob, bs distribution.BlobStore, host, repoName string, desc distribution.Descriptor) error {
+	options := []distribution.BlobCreateOption{}
+	for _, repo := range r.mountSourcesForDigest(desc.Digest, host, repoName) {
+		named, err := reference.ParseNamed(repo)
+		if err != nil {
+			continue
+		}
+		source, err := reference.WithDigest(named, desc.Digest)
+		if err != nil {
+			continue
+		}
+		// the registry starts a regular upload if the blob can't be mounted
+		options = append(options, client.WithMountFrom(source))
+		break
+	}
+
+	writer, err := bs.Create(ctx, options...)
+	if _, mounted := err.(distribution.ErrBlobMounted); mounted {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer writer.Cancel(ctx)
+
+	reader, err := job.blobs.Open(ctx, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	size, err := io.Copy(writer, reader)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Commit(ctx, distribution.Descriptor{
+		MediaType: desc.MediaType,
+		Digest:    desc.Digest,
+		Size:      size,
+	})
+	return err
+}
+
+// setTagCondition sets the condition on the replicated tag unless another image has been pushed to it since.
+func (r *imageReplicator) setTagCondition(job replicationJob, condition imageapi.TagEventCondition) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		stream, err := r.streams.ImageStreams(job.namespace).Get(job.name)
+		if err != nil {
+			return err
+		}
+		event := imageapi.LatestTaggedImage(stream, job.tag)
+		if event == nil || event.Image != job.dgst.String() {
+			return nil
+		}
+		condition.Generation = event.Generation
+
+		tagEvents := stream.Status.Tags[job.tag]
+		conditions := []imageapi.TagEventCondition{condition}
+		for _, c := range tagEvents.Conditions {
+			if c.Type != imageapi.ImageReplicated {
+				conditions = append(conditions, c)
+			}
+		}
+		tagEvents.Conditions = conditions
+		stream.Status.Tags[job.tag] = tagEvents
+
+		_, err = r.streams.ImageStreams(job.namespace).UpdateStatus(stream)
+		return err
+	})
+}
+
+// replicatedBlobs returns the blobs of the manifest stored in the registry. Foreign and non-distributable layers
+// are downloaded from elsewhere.
+func replicatedBlobs(manifest distribution.Manifest) []distribution.Descriptor {
+	candidates := []distribution.Descriptor{}
+	switch m := manifest.(type) {
+	case *manifestlist.DeserializedManifestList:
+		// the references are manifests
+		return nil
+	case *schema2.DeserializedManifest:
+		candidates = append(candidates, m.Config)
+	case *ocischema.DeserializedManifest:
+		candidates = append(candidates, m.Config)
+	}
+	candidates = append(candidates, manifest.References()...)
+
+	descriptors := []distribution.Descriptor{}
+	seen := make(map[digest.Digest]struct{})
+	for _, desc := range candidates {
+		if desc.MediaType == schema2.MediaTypeForeignLayer || ocischema.IsNonDistributableLayer(desc.MediaType) {
+			continue
+		}
+		if _, ok := seen[desc.Digest]; ok {
+			continue
+		}
+		seen[desc.Digest] = struct{}{}
+		descriptors = append(descriptors, desc)
+	}
+	return descriptors
+}
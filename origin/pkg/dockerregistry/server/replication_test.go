@@ -0,0 +1,217 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openshift/github.com/docker/distribution"
+	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest"
+	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestParseReplicationRules(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		options       map[string]interface{}
+		expectedRules []replicationRule
+		expectError   bool
+	}{
+		{
+			name:    "no rules",
+			options: map[string]interface{}{},
+		},
+		{
+			name: "rules from yaml",
+			options: map[string]interface{}{
+				"replication": []interface{}{
+					map[interface{}]interface{}{
+						"repository":  "prod/*",
+						"destination": "dr.example.com:5000/mirror",
+						"secret":      "default/dr",
+					},
+					map[interface{}]interface{}{
+						"repository":  "*/*",
+						"destination": "backup.example.com",
+						"insecure":    true,
+					},
+				},
+			},
+			expectedRules: []replicationRule{
+				{Repository: "prod/*", Destination: "dr.example.com:5000/mirror", Secret: "default/dr"},
+				{Repository: "*/*", Destination: "backup.example.com", Insecure: true},
+			},
+		},
+		{
+			name: "missing destination",
+			options: map[string]interface{}{
+				"replication": []interface{}{
+					map[interface{}]interface{}{"repository": "prod/*"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid pattern",
+			options: map[string]interface{}{
+				"replication": []interface{}{
+					map[interface{}]interface{}{"repository": "prod/[", "destination": "dr.example.com"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid secret",
+			options: map[string]interface{}{
+				"replication": []interface{}{
+					map[interface{}]interface{}{"repository": "prod/*", "destination": "dr.example.com", "secret": "dr"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "unknown field",
+			options: map[string]interface{}{
+				"replication": []interface{}{
+					map[interface{}]interface{}{"repository": "prod/*", "destination": "dr.example.com", "tag": "latest"},
+				},
+			},
+			expectError: true,
+		},
+	} {
+		rules, err := parseReplicationRules(tc.options)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("[%s] expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("[%s] unexpected error: %v", tc.name, err)
+			continue
+		}
+		if len(rules) != len(tc.expectedRules) || (len(rules) > 0 && !reflect.DeepEqual(rules, tc.expectedRules)) {
+			t.Errorf("[%s] expected rules %#v, got %#v", tc.name, tc.expectedRules, rules)
+		}
+	}
+}
+
+func TestReplicationRuleTarget(t *testing.T) {
+	rule := replicationRule{Repository: "prod/*", Destination: "dr.example.com:5000/mirror"}
+	if !rule.matches("prod", "app") {
+		t.Errorf("expected prod/app to match")
+	}
+	if rule.matches("dev", "app") {
+		t.Errorf("expected dev/app not to match")
+	}
+	if host, repo := rule.target("prod", "app"); host != "dr.example.com:5000" || repo != "mirror/prod/app" {
+		t.Errorf("unexpected target %s %s", host, repo)
+	}
+
+	rule.Destination = "dr.example.com"
+	if host, repo := rule.target("prod", "app"); host != "dr.example.com" || repo != "prod/app" {
+		t.Errorf("unexpected target %s %s", host, repo)
+	}
+}
+
+func TestReplicatedBlobs(t *testing.T) {
+	config := distribution.Descriptor{MediaType: schema2.MediaTypeConfig, Digest: digest.FromBytes([]byte("config"))}
+	layer := distribution.Descriptor{MediaType: schema2.MediaTypeLayer, Digest: digest.FromBytes([]byte("layer"))}
+	foreign := distribution.Descriptor{MediaType: schema2.MediaTypeForeignLayer, Digest: digest.FromBytes([]byte("foreign"))}
+
+	m, err := schema2.FromStruct(schema2.Manifest{
+		Versioned: manifest.Versioned{SchemaVersion: 2, MediaType: schema2.MediaTypeManifest},
+		Config:    config,
+		Layers:    []distribution.Descriptor{foreign, layer, layer},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	blobs := replicatedBlobs(m)
+	if expected := []distribution.Descriptor{config, layer}; !reflect.DeepEqual(blobs, expected) {
+		t.Errorf("expected blobs %#v, got %#v", expected, blobs)
+	}
+}
+
+func TestReplicationMountSources(t *testing.T) {
+	layer := distribution.Descriptor{MediaType: schema2.MediaTypeLayer, Digest: digest.FromBytes([]byte("layer"))}
+	m, err := schema2.FromStruct(schema2.Manifest{
+		Versioned: manifest.Versioned{SchemaVersion: 2, MediaType: schema2.MediaTypeManifest},
+		Config:    distribution.Descriptor{MediaType: schema2.MediaTypeConfig, Digest: digest.FromBytes([]byte("config"))},
+		Layers:    []distribution.Descriptor{layer},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mounts, err := newDigestToRepositoryCache(defaultDigestToRepositoryCacheSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	mounts.RememberDigest(layer.Digest, time.Hour, "dr.example.com/mirror/prod/base")
+	mounts.RememberDigest(layer.Digest, time.Hour, "dr.example.com/mirror/prod/app")
+	mounts.RememberDigest(layer.Digest, time.Hour, "other.example.com/prod/base")
+	r := &imageReplicator{mounts: mounts}
+
+	sources := r.mountSources(replicationJob{manifest: m}, "dr.example.com", "mirror/prod/app")
+	if expected := []string{"mirror/prod/base"}; !reflect.DeepEqual(sources, expected) {
+		t.Errorf("expected mount sources %v, got %v", expected, sources)
+	}
+}
+
+func TestReplicationSetTagCondition(t *testing.T) {
+	dgst := digest.FromBytes([]byte("manifest"))
+	stream := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "prod", Name: "app"},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {
+					Items: []imageapi.TagEvent{{Image: dgst.String(), Generation: 2}},
+				},
+				"old": {
+					Items: []imageapi.TagEvent{{Image: "sha256:other"}},
+				},
+			},
+		},
+	}
+	client := testclient.NewSimpleFake(stream)
+	r := &imageReplicator{streams: client}
+
+	condition := imageapi.TagEventCondition{
+		Type:   imageapi.ImageReplicated,
+		Status: kapi.ConditionFalse,
+		Reason: replicationFailedReason,
+	}
+	if err := r.setTagCondition(replicationJob{namespace: "prod", name: "app", tag: "latest", dgst: dgst}, condition); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// another image has been pushed to the tag since
+	if err := r.setTagCondition(replicationJob{namespace: "prod", name: "app", tag: "old", dgst: dgst}, condition); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var updated *imageapi.ImageStream
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" && action.GetSubresource() == "status" {
+			if updated != nil {
+				t.Fatalf("expected a single status update")
+			}
+			updated = action.(core.UpdateAction).GetObject().(*imageapi.ImageStream)
+		}
+	}
+	if updated == nil {
+		t.Fatalf("expected the image stream status to be updated")
+	}
+	conditions := updated.Status.Tags["latest"].Conditions
+	if len(conditions) != 1 || conditions[0].Type != imageapi.ImageReplicated || conditions[0].Generation != 2 {
+		t.Errorf("unexpected conditions %#v", conditions)
+	}
+}
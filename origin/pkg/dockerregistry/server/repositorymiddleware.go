@@ -10,11 +10,12 @@ import (
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/context"
 	"github.com/openshift/github.com/docker/distribution/digest"
-	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/openshift/github.com/docker/distribution/registry/api/errcode"
 	repomw "github.com/openshift/github.com/docker/distribution/registry/middleware/repository"
 	registrystorage "github.com/openshift/github.com/docker/distribution/registry/storage"
+	"github.com/openshift/github.com/hashicorp/golang-lru"
 
+	kapi "github.com/openshift/kubernetes/pkg/api"
 	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
 	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
 	"github.com/openshift/kubernetes/pkg/client/restclient"
@@ -59,8 +60,9 @@ const (
 
 	// Default values
 
-	defaultDigestToRepositoryCacheSize = 2048
-	defaultBlobRepositoryCacheTTL      = time.Minute * 10
+	defaultDigestToRepositoryCacheSize   = 2048
+	defaultManifestListChildrenCacheSize = 1024
+	defaultBlobRepositoryCacheTTL        = time.Minute * 10
 )
 
 var (
@@ -73,6 +75,10 @@ var (
 	// middleware. The second associates a blob with a local repository. Such a blob is expected to reside on
 	// local storage. It's set and used by blobDescriptorService middleware.
 	cachedLayers digestToRepositoryCache
+	// cachedManifestListChildren is a shared cache of the digests of the manifests referenced by an image
+	// keyed by the digest of the image. Images are immutable, so the entries never get stale. Images that
+	// aren't manifest lists have no children.
+	cachedManifestListChildren *lru.Cache
 	// secureTransport is the transport pool used for pullthrough to remote registries marked as
 	// secure.
 	secureTransport http.RoundTripper
@@ -91,6 +97,11 @@ func init() {
 	}
 	cachedLayers = cache
 
+	cachedManifestListChildren, err = lru.New(defaultManifestListChildrenCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
 	// load the client when the middleware is initialized, which allows test code to change
 	// DefaultRegistryClient before starting a registry.
 	repomw.Register("openshift",
@@ -110,6 +121,16 @@ func init() {
 			if quotaEnforcing == nil {
 				quotaEnforcing = newQuotaEnforcingConfig(ctx, os.Getenv(EnforceQuotaEnvVar), os.Getenv(ProjectCacheTTLEnvVar), options)
 			}
+			if replicator == nil {
+				rules, err := parseReplicationRules(options)
+				if err != nil {
+					return nil, err
+				}
+				replicator, err = newImageReplicator(context.Background(), rules, registryOSClient, kClient.Core())
+				if err != nil {
+					return nil, err
+				}
+			}
 
 			return newRepositoryWithClient(registryOSClient, kClient.Core(), kClient.Core(), ctx, repo, options)
 		},
@@ -387,13 +408,24 @@ func (r *repository) getImageOfImageStream(dgst digest.Digest) (*imageapi.Image,
 		return nil, nil, wrapKStatusErrorOnGetImage(r.name, dgst, err)
 	}
 
+	var list digest.Digest
 	_, err = imageapi.ResolveImageID(stream, dgst.String())
 	if err != nil {
-		context.GetLogger(r.ctx).Errorf("failed to resolve image %s in ImageStream %s/%s: %v", dgst.String(), r.namespace, r.name, err)
-		return nil, nil, wrapKStatusErrorOnGetImage(r.name, dgst, err)
+		var ok bool
+		if list, ok = r.imageStreamManifestListOf(stream, dgst); !ok {
+			context.GetLogger(r.ctx).Errorf("failed to resolve image %s in ImageStream %s/%s: %v", dgst.String(), r.namespace, r.name, err)
+			return nil, nil, wrapKStatusErrorOnGetImage(r.name, dgst, err)
+		}
 	}
 
 	image, err := r.getImage(dgst)
+	if _, notFound := err.(distribution.ErrManifestUnknownRevision); notFound && len(list) > 0 {
+		// only the list is created when a manifest list is imported, its manifests are pulled through from
+		// the remote repository of the list
+		if remote, ok := r.remoteManifestListChild(list, dgst); ok {
+			return remote, stream, nil
+		}
+	}
 	if err != nil {
 		return nil, nil, wrapKStatusErrorOnGetImage(r.name, dgst, err)
 	}
@@ -401,6 +433,76 @@ func (r *repository) getImageOfImageStream(dgst digest.Digest) (*imageapi.Image,
 	return image, stream, nil
 }
 
+// remoteManifestListChild returns an Image referring to the manifest with digest `dgst` in the remote
+// repository of the manifest list with digest `list`. It returns false if the list was pushed to this
+// registry or its remote repository is unknown.
+func (r *repository) remoteManifestListChild(list, dgst digest.Digest) (*imageapi.Image, bool) {
+	image, err := r.getImage(list)
+	if err != nil || isImageManaged(image) {
+		return nil, false
+	}
+	ref, err := imageapi.ParseDockerImageReference(image.DockerImageReference)
+	if err != nil {
+		context.GetLogger(r.ctx).Errorf("bad DockerImageReference (%q) in Image %s: %v", image.DockerImageReference, list.String(), err)
+		return nil, false
+	}
+	ref.Tag = ""
+	ref.ID = dgst.String()
+
+	return &imageapi.Image{
+		ObjectMeta:           kapi.ObjectMeta{Name: dgst.String()},
+		DockerImageReference: ref.Exact(),
+	}, true
+}
+
+// imageStreamManifestListOf returns the digest of a manifest list currently tagged in the given stream that
+// references the image with digest `dgst`. Such images are not tagged themselves. Only the latest image of
+// each tag is examined, so a child of a manifest list that is no longer tagged is not served. The children of
+// the tagged images are cached, so the images are fetched only the first time they are examined.
+func (r *repository) imageStreamManifestListOf(stream *imageapi.ImageStream, dgst digest.Digest) (digest.Digest, bool) {
+	checked := make(map[string]struct{})
+	for _, history := range stream.Status.Tags {
+		if len(history.Items) == 0 {
+			continue
+		}
+		name := history.Items[0].Image
+		if _, ok := checked[name]; ok {
+			continue
+		}
+		checked[name] = struct{}{}
+
+		children, err := r.getManifestListChildren(digest.Digest(name))
+		if err != nil {
+			continue
+		}
+		if _, ok := children[dgst.String()]; ok {
+			return digest.Digest(name), true
+		}
+	}
+	return "", false
+}
+
+// getManifestListChildren returns the digests of the manifests referenced by the image with digest `dgst`.
+// The set is empty unless the image is a manifest list.
+func (r *repository) getManifestListChildren(dgst digest.Digest) (map[string]struct{}, error) {
+	if children, ok := cachedManifestListChildren.Get(dgst.String()); ok {
+		return children.(map[string]struct{}), nil
+	}
+
+	image, err := r.getImage(dgst)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[string]struct{})
+	if imageapi.IsManifestList(image.DockerImageManifestMediaType) {
+		for _, manifest := range image.DockerImageManifests {
+			children[manifest.Digest] = struct{}{}
+		}
+	}
+	cachedManifestListChildren.Add(dgst.String(), children)
+	return children, nil
+}
+
 // updateImage modifies the Image.
 func (r *repository) updateImage(image *imageapi.Image) (*imageapi.Image, error) {
 	return r.registryOSClient.Images().Update(image)
@@ -417,8 +519,8 @@ func (r *repository) rememberLayersOfImage(image *imageapi.Image, cacheName stri
 		for _, layer := range image.DockerImageLayers {
 			r.cachedLayers.RememberDigest(digest.Digest(layer.Name), r.blobrepositorycachettl, cacheName)
 		}
-		// remember reference to manifest config as well for schema 2
-		if image.DockerImageManifestMediaType == schema2.MediaTypeManifest && len(image.DockerImageMetadata.ID) > 0 {
+		// remember reference to manifest config as well for schema 2 and OCI manifests
+		if hasImageConfig(image) && len(image.DockerImageMetadata.ID) > 0 {
 			r.cachedLayers.RememberDigest(digest.Digest(image.DockerImageMetadata.ID), r.blobrepositorycachettl, cacheName)
 		}
 		return
@@ -52,6 +52,10 @@ func (g *FakeGit) Checkout(dir string, ref string) error {
 	return nil
 }
 
+func (g *FakeGit) FetchRef(dir string, ref string) error {
+	return nil
+}
+
 func (g *FakeGit) SubmoduleUpdate(dir string, init, recurse bool) error {
 	g.SubmoduleUpdateCalled = true
 	return nil
@@ -122,6 +122,14 @@ func Generate(paths ...string) (*templateapi.Template, error) {
 		}
 	}
 
+	// convert the named volumes, secrets, configs, healthchecks, and deploy blocks
+	var pods [][]string
+	for _, set := range colocated {
+		pods = append(pods, set.List())
+	}
+	resources, resourceErrs := newServiceResources(p, pods, warnings)
+	errs = append(errs, resourceErrs...)
+
 	// identify service aliases
 	aliases := make(map[string]sets.String)
 	for _, v := range p.Configs {
@@ -340,7 +348,28 @@ func Generate(paths ...string) (*templateapi.Template, error) {
 					c.Resources.Requests[kapi.ResourceCPU] = *q
 				}
 
+				if r, ok := resources.resources[k]; ok {
+					for name, q := range r.Limits {
+						if c.Resources.Limits == nil {
+							c.Resources.Limits = make(kapi.ResourceList)
+						}
+						c.Resources.Limits[name] = q
+					}
+					for name, q := range r.Requests {
+						if c.Resources.Requests == nil {
+							c.Resources.Requests = make(kapi.ResourceList)
+						}
+						c.Resources.Requests[name] = q
+					}
+				}
+
+				if probe, ok := resources.probes[k]; ok {
+					liveness, readiness := *probe, *probe
+					c.LivenessProbe, c.ReadinessProbe = &liveness, &readiness
+				}
+
 				mountPoints := make(map[string][]string)
+				readOnly := sets.NewString()
 				for _, s := range v.Volumes {
 					switch parts := strings.SplitN(s, ":", 3); len(parts) {
 					case 1:
@@ -350,18 +379,31 @@ func Generate(paths ...string) (*templateapi.Template, error) {
 						fallthrough
 					default:
 						mountPoints[parts[0]] = append(mountPoints[parts[0]], parts[1])
+						if len(parts) == 3 && sets.NewString(strings.Split(parts[2], ",")...).Has("ro") {
+							readOnly.Insert(parts[1])
+						}
 					}
 				}
 				for from, at := range mountPoints {
 					name, ok := commonMounts[from]
 					if !ok {
-						name = fmt.Sprintf("dir-%d", len(commonMounts)+1)
+						if _, named := p.Volumes[from]; named {
+							name = namedVolumeName(from)
+						} else {
+							name = fmt.Sprintf("dir-%d", len(commonMounts)+1)
+						}
 						commonMounts[from] = name
 					}
 					for _, path := range at {
-						c.VolumeMounts = append(c.VolumeMounts, kapi.VolumeMount{Name: name, MountPath: path})
+						c.VolumeMounts = append(c.VolumeMounts, kapi.VolumeMount{Name: name, MountPath: path, ReadOnly: readOnly.Has(path)})
 					}
 				}
+				for _, ref := range v.Secrets {
+					c.VolumeMounts = append(c.VolumeMounts, fileMount("secret", ref))
+				}
+				for _, ref := range v.Configs {
+					c.VolumeMounts = append(c.VolumeMounts, fileMount("config", ref))
+				}
 			}
 
 			pipeline, err := app.NewPipelineBuilder(k, nil, true).To(k).NewImagePipeline(k, inputImage)
@@ -403,6 +445,9 @@ func Generate(paths ...string) (*templateapi.Template, error) {
 	for _, obj := range objects {
 		switch t := obj.(type) {
 		case *deployapi.DeploymentConfig:
+			setReplicas(t, p.Configs)
+			setVolumeSources(&t.Spec.Template.Spec, resources.volumes)
+
 			ports := app.UniqueContainerToServicePorts(app.AllContainerPorts(t.Spec.Template.Spec.Containers...))
 			if len(ports) == 0 {
 				msg := "no ports defined to send traffic to - no OpenShift service was created"
@@ -426,6 +471,7 @@ func Generate(paths ...string) (*templateapi.Template, error) {
 	for _, svc := range services {
 		objects = append(objects, svc)
 	}
+	objects = append(objects, resources.objects...)
 
 	// for each container that defines VolumesFrom, copy equivalent mounts.
 	// TODO: ensure mount names are unique?
@@ -562,6 +608,15 @@ func warnUnusableComposeElements(k string, v *project.ServiceConfig, warnings ma
 	if len(v.Ulimits.Elements) > 0 {
 		fn("ulimits is not supported")
 	}
+	if len(v.DependsOn) > 0 {
+		fn("depends_on is ignored - all services are started at once, use healthchecks to wait for dependencies")
+	}
+	if v.Deploy != nil {
+		warnUnusableDeployElements(v.Deploy, fn)
+	}
+	for _, key := range v.Unsupported {
+		fn(fmt.Sprintf("%s is not supported", key))
+	}
 	// TODO: fields to handle
 	// EnvFile       Stringorslice     `yaml:"env_file,omitempty"`
 }
@@ -0,0 +1,413 @@
+package dockercompose
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	units "github.com/openshift/github.com/docker/go-units"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/resource"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/third_party/github.com/docker/libcompose/project"
+)
+
+const (
+	// defaultVolumeSize is the size requested by the claims created for named volumes,
+	// which compose leaves to the volume driver.
+	defaultVolumeSize = "1Gi"
+	// secretsDir is where relative secret targets are mounted, like in a swarm.
+	secretsDir = "/run/secrets"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// objectName converts a compose name to a valid object or volume name.
+func objectName(name string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// namedVolumeName is the name of the pod volume for a named volume.
+func namedVolumeName(name string) string {
+	return "volume-" + objectName(name)
+}
+
+// fileVolumeName is the name of the pod volume for a secret or a config.
+func fileVolumeName(kind, name string) string {
+	return kind + "-" + objectName(name)
+}
+
+// serviceResources holds the parts of the services of a project that become
+// objects other than deployments and services.
+type serviceResources struct {
+	// objects are the claims, secrets, and config maps to create
+	objects []runtime.Object
+	// volumes are the sources of the pod volumes that are not empty directories, by volume name
+	volumes map[string]kapi.VolumeSource
+	// probes are the liveness and readiness probes of each service
+	probes map[string]*kapi.Probe
+	// resources are the resource limits and requests of each service from its deploy block
+	resources map[string]kapi.ResourceRequirements
+}
+
+// newServiceResources converts the named volumes, secrets, configs, healthchecks,
+// and deploy blocks of the services of the project. colocated are the groups of
+// services that share a pod.
+func newServiceResources(p *project.Project, colocated [][]string, warnings map[string][]string) (*serviceResources, []error) {
+	r := &serviceResources{
+		volumes:   make(map[string]kapi.VolumeSource),
+		probes:    make(map[string]*kapi.Probe),
+		resources: make(map[string]kapi.ResourceRequirements),
+	}
+	warn := func(name, msg string) {
+		warnings[msg] = append(warnings[msg], name)
+	}
+	var errs []error
+
+	// named volumes become claims, read-write-many if they are shared by pods or
+	// mounted by a service with more than one replica
+	pods := make(map[string]map[int]bool)
+	replicated := sets.NewString()
+	for i, services := range colocated {
+		for _, k := range services {
+			v := p.Configs[k]
+			for _, s := range v.Volumes {
+				from := strings.SplitN(s, ":", 2)[0]
+				if _, ok := p.Volumes[from]; !ok {
+					continue
+				}
+				if pods[from] == nil {
+					pods[from] = make(map[int]bool)
+				}
+				pods[from][i] = true
+				if v.Deploy != nil && v.Deploy.Replicas != nil && *v.Deploy.Replicas > 1 {
+					replicated.Insert(from)
+				}
+			}
+		}
+	}
+	for _, name := range sets.StringKeySet(p.Volumes).List() {
+		v := p.Volumes[name]
+		if len(v.Driver) > 0 || len(v.DriverOpts) > 0 {
+			warn(name, fmt.Sprintf("volume driver and driver_opts are ignored - a claim of %s is created", defaultVolumeSize))
+		}
+		if len(v.Labels.MapParts()) > 0 {
+			warn(name, "volume labels are ignored")
+		}
+		claimName := externalName(name, v.Name, v.External)
+		r.volumes[namedVolumeName(name)] = kapi.VolumeSource{
+			PersistentVolumeClaim: &kapi.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+		}
+		if v.External.External {
+			continue
+		}
+		accessMode := kapi.ReadWriteOnce
+		if len(pods[name]) > 1 || replicated.Has(name) {
+			accessMode = kapi.ReadWriteMany
+		}
+		r.objects = append(r.objects, &kapi.PersistentVolumeClaim{
+			ObjectMeta: kapi.ObjectMeta{Name: claimName},
+			Spec: kapi.PersistentVolumeClaimSpec{
+				AccessModes: []kapi.PersistentVolumeAccessMode{accessMode},
+				Resources: kapi.ResourceRequirements{
+					Requests: kapi.ResourceList{kapi.ResourceStorage: resource.MustParse(defaultVolumeSize)},
+				},
+			},
+		})
+	}
+
+	// secrets and configs become secrets and config maps holding the file under the name of the object
+	for _, name := range sets.StringKeySet(p.Secrets).List() {
+		s := p.Secrets[name]
+		if len(s.Labels.MapParts()) > 0 {
+			warn(name, "secret labels are ignored")
+		}
+		if s.External.External {
+			continue
+		}
+		data, err := readFileObject("secret", name, s)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		r.objects = append(r.objects, &kapi.Secret{
+			ObjectMeta: kapi.ObjectMeta{Name: externalName(name, s.Name, s.External)},
+			Type:       kapi.SecretTypeOpaque,
+			Data:       map[string][]byte{name: data},
+		})
+	}
+	for _, name := range sets.StringKeySet(p.ConfigObjects).List() {
+		c := p.ConfigObjects[name]
+		if len(c.Labels.MapParts()) > 0 {
+			warn(name, "config labels are ignored")
+		}
+		if c.External.External {
+			continue
+		}
+		data, err := readFileObject("config", name, c)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		r.objects = append(r.objects, &kapi.ConfigMap{
+			ObjectMeta: kapi.ObjectMeta{Name: externalName(name, c.Name, c.External)},
+			Data:       map[string]string{name: string(data)},
+		})
+	}
+
+	for _, k := range sets.StringKeySet(p.Configs).List() {
+		v := p.Configs[k]
+
+		for _, ref := range v.Secrets {
+			s, ok := p.Secrets[ref.Source]
+			if !ok {
+				errs = append(errs, fmt.Errorf("service %q refers to the undefined secret %q", k, ref.Source))
+				continue
+			}
+			r.volumes[fileVolumeName("secret", ref.Source)] = kapi.VolumeSource{
+				Secret: &kapi.SecretVolumeSource{SecretName: externalName(ref.Source, s.Name, s.External), DefaultMode: fileMode(ref)},
+			}
+			if len(ref.UID) > 0 || len(ref.GID) > 0 {
+				warn(k, "uid and gid of secrets are not supported")
+			}
+		}
+		for _, ref := range v.Configs {
+			c, ok := p.ConfigObjects[ref.Source]
+			if !ok {
+				errs = append(errs, fmt.Errorf("service %q refers to the undefined config %q", k, ref.Source))
+				continue
+			}
+			source := &kapi.ConfigMapVolumeSource{DefaultMode: fileMode(ref)}
+			source.Name = externalName(ref.Source, c.Name, c.External)
+			r.volumes[fileVolumeName("config", ref.Source)] = kapi.VolumeSource{ConfigMap: source}
+			if len(ref.UID) > 0 || len(ref.GID) > 0 {
+				warn(k, "uid and gid of configs are not supported")
+			}
+		}
+
+		if v.Healthcheck != nil {
+			probe, err := healthcheckProbe(v.Healthcheck)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("service %q has an invalid healthcheck: %v", k, err))
+			} else if probe != nil {
+				r.probes[k] = probe
+			}
+		}
+
+		if v.Deploy != nil {
+			resources, err := deployResources(v.Deploy.Resources)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("service %q has invalid resources: %v", k, err))
+			} else {
+				r.resources[k] = resources
+			}
+		}
+	}
+
+	return r, errs
+}
+
+// externalName is the name of the object for a volume, secret, or config.
+func externalName(name, explicit string, external project.External) string {
+	switch {
+	case len(external.Name) > 0:
+		return external.Name
+	case len(explicit) > 0:
+		return explicit
+	case external.External:
+		return name
+	default:
+		return objectName(name)
+	}
+}
+
+// readFileObject reads the file of a secret or config.
+func readFileObject(kind, name string, object *project.FileObjectConfig) ([]byte, error) {
+	if len(object.File) == 0 {
+		return nil, fmt.Errorf("%s %q must be external or have a file", kind, name)
+	}
+	data, err := ioutil.ReadFile(object.File)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s %q: %v", kind, name, err)
+	}
+	return data, nil
+}
+
+// fileMount returns the mount of the secret or config referenced by a service.
+// Secrets are mounted under /run/secrets and configs at the root of the
+// filesystem, unless their target is absolute.
+func fileMount(kind string, ref project.ServiceFileReference) kapi.VolumeMount {
+	target := ref.Target
+	if len(target) == 0 {
+		target = ref.Source
+	}
+	if !path.IsAbs(target) {
+		dir := "/"
+		if kind == "secret" {
+			dir = secretsDir
+		}
+		target = path.Join(dir, target)
+	}
+	return kapi.VolumeMount{
+		Name:      fileVolumeName(kind, ref.Source),
+		MountPath: target,
+		SubPath:   ref.Source,
+		ReadOnly:  true,
+	}
+}
+
+// healthcheckProbe converts a healthcheck to a probe, or returns nil if the
+// healthcheck is disabled.
+func healthcheckProbe(h *project.HealthcheckConfig) (*kapi.Probe, error) {
+	test := h.Test.Slice()
+	if h.Disable || len(test) == 0 || test[0] == "NONE" {
+		return nil, nil
+	}
+	var command []string
+	switch test[0] {
+	case "CMD":
+		command = test[1:]
+	case "CMD-SHELL":
+		command = []string{"/bin/sh", "-c", strings.Join(test[1:], " ")}
+	default:
+		command = []string{"/bin/sh", "-c", strings.Join(test, " ")}
+	}
+	if len(command) == 0 {
+		return nil, fmt.Errorf("the test has no command")
+	}
+
+	probe := &kapi.Probe{
+		Handler:          kapi.Handler{Exec: &kapi.ExecAction{Command: command}},
+		FailureThreshold: int32(h.Retries),
+	}
+	for _, d := range []struct {
+		value string
+		into  *int32
+	}{
+		{h.Interval, &probe.PeriodSeconds},
+		{h.Timeout, &probe.TimeoutSeconds},
+		{h.StartPeriod, &probe.InitialDelaySeconds},
+	} {
+		if len(d.value) == 0 {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, err
+		}
+		seconds := int32((duration + time.Second - 1) / time.Second)
+		if seconds < 1 {
+			seconds = 1
+		}
+		*d.into = seconds
+	}
+	return probe, nil
+}
+
+// deployResources converts the resources of a deploy block to limits and requests.
+func deployResources(resources project.DeployResources) (kapi.ResourceRequirements, error) {
+	var requirements kapi.ResourceRequirements
+	var err error
+	if requirements.Limits, err = resourceList(resources.Limits); err != nil {
+		return requirements, err
+	}
+	requirements.Requests, err = resourceList(resources.Reservations)
+	return requirements, err
+}
+
+func resourceList(config project.ResourceConfig) (kapi.ResourceList, error) {
+	if len(config.CPUs) == 0 && len(config.Memory) == 0 {
+		return nil, nil
+	}
+	list := make(kapi.ResourceList)
+	if len(config.CPUs) > 0 {
+		q, err := resource.ParseQuantity(config.CPUs)
+		if err != nil {
+			return nil, fmt.Errorf("cpus %q: %v", config.CPUs, err)
+		}
+		list[kapi.ResourceCPU] = q
+	}
+	if len(config.Memory) > 0 {
+		bytes, err := units.RAMInBytes(config.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("memory %q: %v", config.Memory, err)
+		}
+		list[kapi.ResourceMemory] = *resource.NewQuantity(bytes, resource.BinarySI)
+	}
+	return list, nil
+}
+
+// setReplicas sets the replicas of a deployment to the highest replicas of the
+// deploy blocks of the services it runs.
+func setReplicas(dc *deployapi.DeploymentConfig, configs map[string]*project.ServiceConfig) {
+	replicas := -1
+	for _, c := range dc.Spec.Template.Spec.Containers {
+		for k, v := range configs {
+			if c.Name != k && c.Name != v.ContainerName {
+				continue
+			}
+			if v.Deploy != nil && v.Deploy.Replicas != nil && *v.Deploy.Replicas > replicas {
+				replicas = *v.Deploy.Replicas
+			}
+		}
+	}
+	if replicas >= 0 {
+		dc.Spec.Replicas = int32(replicas)
+	}
+}
+
+// setVolumeSources replaces the empty directories of the pod volumes that have a
+// source, and removes the duplicate volumes of the containers sharing a mount.
+func setVolumeSources(spec *kapi.PodSpec, sources map[string]kapi.VolumeSource) {
+	var volumes []kapi.Volume
+	seen := sets.NewString()
+	for _, volume := range spec.Volumes {
+		if seen.Has(volume.Name) {
+			continue
+		}
+		seen.Insert(volume.Name)
+		if source, ok := sources[volume.Name]; ok {
+			volume.VolumeSource = source
+		}
+		volumes = append(volumes, volume)
+	}
+	spec.Volumes = volumes
+}
+
+// warnUnusableDeployElements adds warnings for the parts of a deploy block that have no equivalent.
+func warnUnusableDeployElements(d *project.DeployConfig, fn func(msg string)) {
+	if d.Mode == "global" {
+		fn("deploy mode global is not supported - use a daemon set")
+	}
+	if len(d.Labels.MapParts()) > 0 {
+		fn("deploy labels are ignored")
+	}
+	if len(d.RestartPolicy) > 0 {
+		fn("deploy restart_policy is ignored - all pods are automatically restarted")
+	}
+	if len(d.UpdateConfig) > 0 || len(d.RollbackConfig) > 0 {
+		fn("deploy update_config and rollback_config are not supported")
+	}
+	if len(d.Placement) > 0 {
+		fn("deploy placement is not supported - use node selectors")
+	}
+	if len(d.EndpointMode) > 0 {
+		fn("deploy endpoint_mode is not supported")
+	}
+}
+
+// fileMode returns the mode of a secret or config reference.
+func fileMode(ref project.ServiceFileReference) *int32 {
+	if ref.Mode == nil {
+		return nil
+	}
+	mode := int32(*ref.Mode)
+	return &mode
+}
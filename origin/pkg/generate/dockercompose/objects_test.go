@@ -0,0 +1,226 @@
+package dockercompose
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/resource"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/third_party/github.com/docker/libcompose/project"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestHealthcheckProbe(t *testing.T) {
+	tests := []struct {
+		name        string
+		healthcheck project.HealthcheckConfig
+		expected    *kapi.Probe
+		expectedErr bool
+	}{
+		{
+			name: "command",
+			healthcheck: project.HealthcheckConfig{
+				Test:        project.NewStringorslice("CMD", "curl", "-f", "http://localhost"),
+				Interval:    "1m30s",
+				Timeout:     "500ms",
+				Retries:     3,
+				StartPeriod: "40s",
+			},
+			expected: &kapi.Probe{
+				Handler:             kapi.Handler{Exec: &kapi.ExecAction{Command: []string{"curl", "-f", "http://localhost"}}},
+				PeriodSeconds:       90,
+				TimeoutSeconds:      1,
+				InitialDelaySeconds: 40,
+				FailureThreshold:    3,
+			},
+		},
+		{
+			name:        "shell command",
+			healthcheck: project.HealthcheckConfig{Test: project.NewStringorslice("CMD-SHELL", "pg_isready", "-U", "postgres")},
+			expected: &kapi.Probe{
+				Handler: kapi.Handler{Exec: &kapi.ExecAction{Command: []string{"/bin/sh", "-c", "pg_isready -U postgres"}}},
+			},
+		},
+		{
+			name:        "string",
+			healthcheck: project.HealthcheckConfig{Test: project.NewStringorslice("redis-cli ping")},
+			expected: &kapi.Probe{
+				Handler: kapi.Handler{Exec: &kapi.ExecAction{Command: []string{"/bin/sh", "-c", "redis-cli ping"}}},
+			},
+		},
+		{
+			name:        "none",
+			healthcheck: project.HealthcheckConfig{Test: project.NewStringorslice("NONE")},
+		},
+		{
+			name:        "disabled",
+			healthcheck: project.HealthcheckConfig{Test: project.NewStringorslice("CMD", "true"), Disable: true},
+		},
+		{
+			name:        "no command",
+			healthcheck: project.HealthcheckConfig{Test: project.NewStringorslice("CMD")},
+			expectedErr: true,
+		},
+		{
+			name:        "invalid interval",
+			healthcheck: project.HealthcheckConfig{Test: project.NewStringorslice("CMD", "true"), Interval: "often"},
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		probe, err := healthcheckProbe(&test.healthcheck)
+		if test.expectedErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, probe) {
+			t.Errorf("%s: expected %#v, got %#v", test.name, test.expected, probe)
+		}
+	}
+}
+
+func TestDeployResources(t *testing.T) {
+	tests := []struct {
+		name        string
+		resources   project.DeployResources
+		expected    kapi.ResourceRequirements
+		expectedErr bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "limits and reservations",
+			resources: project.DeployResources{
+				Limits:       project.ResourceConfig{CPUs: "0.5", Memory: "512M"},
+				Reservations: project.ResourceConfig{Memory: "1g"},
+			},
+			expected: kapi.ResourceRequirements{
+				Limits: kapi.ResourceList{
+					kapi.ResourceCPU:    resource.MustParse("0.5"),
+					kapi.ResourceMemory: *resource.NewQuantity(512*1024*1024, resource.BinarySI),
+				},
+				Requests: kapi.ResourceList{
+					kapi.ResourceMemory: *resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+				},
+			},
+		},
+		{
+			name:        "invalid cpus",
+			resources:   project.DeployResources{Limits: project.ResourceConfig{CPUs: "half"}},
+			expectedErr: true,
+		},
+		{
+			name:        "invalid memory",
+			resources:   project.DeployResources{Reservations: project.ResourceConfig{Memory: "lots"}},
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		requirements, err := deployResources(test.resources)
+		if test.expectedErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !kapi.Semantic.DeepEqual(test.expected, requirements) {
+			t.Errorf("%s: expected %#v, got %#v", test.name, test.expected, requirements)
+		}
+	}
+}
+
+func TestSetReplicas(t *testing.T) {
+	tests := []struct {
+		name     string
+		configs  map[string]*project.ServiceConfig
+		expected int32
+	}{
+		{
+			name:     "no deploy block",
+			configs:  map[string]*project.ServiceConfig{"web": {}},
+			expected: 1,
+		},
+		{
+			name: "highest replicas of the pod",
+			configs: map[string]*project.ServiceConfig{
+				"web":   {Deploy: &project.DeployConfig{Replicas: intPtr(2)}},
+				"proxy": {ContainerName: "nginx", Deploy: &project.DeployConfig{Replicas: intPtr(3)}},
+				"db":    {Deploy: &project.DeployConfig{Replicas: intPtr(5)}},
+			},
+			expected: 3,
+		},
+		{
+			name:     "scaled down",
+			configs:  map[string]*project.ServiceConfig{"web": {Deploy: &project.DeployConfig{Replicas: intPtr(0)}}},
+			expected: 0,
+		},
+	}
+	for _, test := range tests {
+		dc := &deployapi.DeploymentConfig{}
+		dc.Spec.Replicas = 1
+		dc.Spec.Template = &kapi.PodTemplateSpec{
+			Spec: kapi.PodSpec{Containers: []kapi.Container{{Name: "web"}, {Name: "nginx"}}},
+		}
+		setReplicas(dc, test.configs)
+		if dc.Spec.Replicas != test.expected {
+			t.Errorf("%s: expected %d replicas, got %d", test.name, test.expected, dc.Spec.Replicas)
+		}
+	}
+}
+
+func TestNewServiceResourcesClaimAccessModes(t *testing.T) {
+	p := project.NewProject(&project.Context{})
+	p.Volumes = map[string]*project.VolumeConfig{
+		"single":     {},
+		"shared":     {},
+		"replicated": {},
+		"external":   {External: project.External{External: true}},
+	}
+	p.Configs = map[string]*project.ServiceConfig{
+		"db":    {Volumes: []string{"single:/var/lib/db", "shared:/shared"}},
+		"web":   {Volumes: []string{"shared:/shared:ro", "replicated:/cache", "external:/data"}, Deploy: &project.DeployConfig{Replicas: intPtr(2)}},
+		"proxy": {Volumes: []string{"./conf:/etc/nginx"}},
+	}
+
+	r, errs := newServiceResources(p, [][]string{{"db"}, {"web"}, {"proxy"}}, map[string][]string{})
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	expected := map[string]kapi.PersistentVolumeAccessMode{
+		"single":     kapi.ReadWriteOnce,
+		"shared":     kapi.ReadWriteMany,
+		"replicated": kapi.ReadWriteMany,
+	}
+	actual := map[string]kapi.PersistentVolumeAccessMode{}
+	for _, obj := range r.objects {
+		claim, ok := obj.(*kapi.PersistentVolumeClaim)
+		if !ok {
+			t.Errorf("unexpected object %#v", obj)
+			continue
+		}
+		actual[claim.Name] = claim.Spec.AccessModes[0]
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected claims %v, got %v", expected, actual)
+	}
+	if source := r.volumes[namedVolumeName("external")].PersistentVolumeClaim; source == nil || source.ClaimName != "external" {
+		t.Errorf("expected the external volume to use the existing claim, got %#v", r.volumes[namedVolumeName("external")])
+	}
+}
@@ -30,6 +30,7 @@ type Repository interface {
 	CloneBare(dir string, url string) error
 	CloneMirror(dir string, url string) error
 	Fetch(dir string) error
+	FetchRef(dir string, ref string) error
 	Checkout(dir string, ref string) error
 	SubmoduleUpdate(dir string, init, recursive bool) error
 	Archive(dir, ref, format string, w io.Writer) error
@@ -262,6 +263,14 @@ func (r *repository) Fetch(location string) error {
 	return err
 }
 
+// FetchRef fetches a single ref from the origin remote of the provided git repository, leaving
+// it in FETCH_HEAD. It is used for refs that are not fetched by a clone, like the refs of pull
+// requests.
+func (r *repository) FetchRef(location string, ref string) error {
+	_, _, err := r.git(location, "fetch", "origin", ref)
+	return err
+}
+
 // Archive creates a archive of the Git repo at directory location at commit ref and with the given Git format,
 // and then writes that to the provided io.Writer
 func (r *repository) Archive(location, ref, format string, w io.Writer) error {
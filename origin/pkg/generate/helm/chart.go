@@ -0,0 +1,195 @@
+package helm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/github.com/ghodss/yaml"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+)
+
+// Metadata is the content of the Chart.yaml file of a chart.
+type Metadata struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	AppVersion  string   `json:"appVersion,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Home        string   `json:"home,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+}
+
+// Chart is a Helm chart loaded from a directory or an archive.
+type Chart struct {
+	Metadata Metadata
+	// Values are the default values of the chart, from values.yaml
+	Values map[string]interface{}
+	// Templates are the files of the templates directory, by path relative to the chart
+	Templates map[string]string
+	// Files are the other files of the chart, by path relative to the chart
+	Files map[string][]byte
+	// Dependencies are the names of the charts this chart depends on
+	Dependencies []string
+}
+
+// IsPossibleHelmChart returns true if the path is a chart archive or a directory
+// containing a Chart.yaml file.
+func IsPossibleHelmChart(p string) bool {
+	if strings.HasSuffix(p, ".tgz") || strings.HasSuffix(p, ".tar.gz") {
+		return true
+	}
+	_, err := os.Stat(filepath.Join(p, "Chart.yaml"))
+	return err == nil
+}
+
+// LoadChart reads the chart in the directory or gzipped tar archive at path.
+func LoadChart(p string) (*Chart, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	var files map[string][]byte
+	if info.IsDir() {
+		files, err = loadDir(p)
+	} else {
+		files, err = loadArchive(p)
+	}
+	if err != nil {
+		return nil, err
+	}
+	chart, err := newChart(files)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a valid Helm chart: %v", p, err)
+	}
+	return chart, nil
+}
+
+// loadDir returns the contents of all the files in the directory dir.
+func loadDir(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = data
+		return nil
+	})
+	return files, err
+}
+
+// loadArchive returns the contents of all the files in a chart archive. Charts
+// are packaged in a directory named after the chart, which is removed from the
+// file names.
+func loadArchive(p string) (map[string][]byte, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a gzipped archive: %v", p, err)
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	r := tar.NewReader(gz)
+	for {
+		header, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+		name := strings.TrimPrefix(path.Clean(header.Name), "/")
+		parts := strings.SplitN(name, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		files[parts[1]] = data
+	}
+	return files, nil
+}
+
+// newChart sorts the files of a chart.
+func newChart(files map[string][]byte) (*Chart, error) {
+	chart := &Chart{
+		Values:    make(map[string]interface{}),
+		Templates: make(map[string]string),
+		Files:     make(map[string][]byte),
+	}
+	data, ok := files["Chart.yaml"]
+	if !ok {
+		return nil, fmt.Errorf("Chart.yaml is missing")
+	}
+	if err := yaml.Unmarshal(data, &chart.Metadata); err != nil {
+		return nil, fmt.Errorf("unable to read Chart.yaml: %v", err)
+	}
+	if len(chart.Metadata.Name) == 0 {
+		return nil, fmt.Errorf("Chart.yaml does not contain a name")
+	}
+	if data, ok := files["values.yaml"]; ok {
+		if err := yaml.Unmarshal(data, &chart.Values); err != nil {
+			return nil, fmt.Errorf("unable to read values.yaml: %v", err)
+		}
+		if chart.Values == nil {
+			chart.Values = make(map[string]interface{})
+		}
+	}
+
+	dependencies := sets.NewString()
+	for name, data := range files {
+		switch {
+		case name == "Chart.yaml", name == "values.yaml":
+		case strings.HasPrefix(name, "templates/"):
+			chart.Templates[name] = string(data)
+		case strings.HasPrefix(name, "charts/"):
+			dependency := strings.SplitN(strings.TrimPrefix(name, "charts/"), "/", 2)[0]
+			dependency = strings.TrimSuffix(strings.TrimSuffix(dependency, ".tgz"), ".tar.gz")
+			dependencies.Insert(dependency)
+		default:
+			chart.Files[name] = data
+		}
+	}
+	if data, ok := files["requirements.yaml"]; ok {
+		requirements := struct {
+			Dependencies []struct {
+				Name string `json:"name"`
+			} `json:"dependencies"`
+		}{}
+		if err := yaml.Unmarshal(data, &requirements); err != nil {
+			return nil, fmt.Errorf("unable to read requirements.yaml: %v", err)
+		}
+		for _, dependency := range requirements.Dependencies {
+			dependencies.Insert(dependency.Name)
+		}
+	}
+	chart.Dependencies = dependencies.List()
+	return chart, nil
+}
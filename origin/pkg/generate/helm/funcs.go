@@ -0,0 +1,266 @@
+package helm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/openshift/github.com/ghodss/yaml"
+)
+
+// funcMap returns the subset of the functions available to Helm templates
+// (the Go template builtins, the Sprig library, and the Helm specific ones)
+// that make sense outside of Tiller. Charts using other functions, such as
+// the random generators, can't be converted. The include function executes
+// the templates returned by root.
+func funcMap(root func() *template.Template) template.FuncMap {
+	return template.FuncMap{
+		// strings
+		"quote":      func(s ...interface{}) string { return joinQuoted("%q", s) },
+		"squote":     func(s ...interface{}) string { return joinQuoted("'%v'", s) },
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"title":      strings.Title,
+		"trim":       strings.TrimSpace,
+		"trimAll":    func(cutset, s string) string { return strings.Trim(s, cutset) },
+		"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+		"trunc":      trunc,
+		"replace":    func(old, new, s string) string { return strings.Replace(s, old, new, -1) },
+		"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"nospace":    func(s string) string { return strings.Replace(s, " ", "", -1) },
+		"cat":        cat,
+		"indent":     indent,
+		"nindent":    func(spaces int, s string) string { return "\n" + indent(spaces, s) },
+		"join":       join,
+		"splitList":  func(sep, s string) []string { return strings.Split(s, sep) },
+		"toString":   toString,
+		"b64enc":     func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec":     b64dec,
+		"sha256sum":  func(s string) string { sum := sha256.Sum256([]byte(s)); return hex.EncodeToString(sum[:]) },
+
+		// defaults and flow control
+		"default":  func(d interface{}, given ...interface{}) interface{} { return defaultValue(d, given) },
+		"empty":    empty,
+		"coalesce": coalesce,
+		"ternary": func(t, f interface{}, condition bool) interface{} {
+			if condition {
+				return t
+			}
+			return f
+		},
+		"required": required,
+		"fail":     func(msg string) (string, error) { return "", errors.New(msg) },
+
+		// numbers
+		"int":     toInt64,
+		"int64":   toInt64,
+		"float64": toFloat64,
+		"add":     func(a, b interface{}) int64 { return toInt64(a) + toInt64(b) },
+		"sub":     func(a, b interface{}) int64 { return toInt64(a) - toInt64(b) },
+		"mul":     func(a, b interface{}) int64 { return toInt64(a) * toInt64(b) },
+		"div":     func(a, b interface{}) int64 { return toInt64(a) / toInt64(b) },
+		"mod":     func(a, b interface{}) int64 { return toInt64(a) % toInt64(b) },
+
+		// lists and dictionaries
+		"list":   func(v ...interface{}) []interface{} { return v },
+		"dict":   dict,
+		"hasKey": func(d map[string]interface{}, key string) bool { _, ok := d[key]; return ok },
+
+		// encoding
+		"toYaml": toYaml,
+		"toJson": toJson,
+
+		// templates
+		"include": func(name string, data interface{}) (string, error) {
+			buf := &bytes.Buffer{}
+			if err := root().ExecuteTemplate(buf, name, data); err != nil {
+				return "", err
+			}
+			return buf.String(), nil
+		},
+	}
+}
+
+func joinQuoted(format string, values []interface{}) string {
+	var out []string
+	for _, v := range values {
+		if v != nil {
+			out = append(out, fmt.Sprintf(format, toString(v)))
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+func trunc(length int, s string) string {
+	if length >= 0 && len(s) > length {
+		return s[:length]
+	}
+	return s
+}
+
+func cat(values ...interface{}) string {
+	var out []string
+	for _, v := range values {
+		if v != nil {
+			out = append(out, toString(v))
+		}
+	}
+	return strings.Join(out, " ")
+}
+
+func indent(spaces int, s string) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.Replace(s, "\n", "\n"+pad, -1)
+}
+
+func join(sep string, v interface{}) string {
+	var out []string
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			out = append(out, toString(value.Index(i).Interface()))
+		}
+	default:
+		return toString(v)
+	}
+	return strings.Join(out, sep)
+}
+
+func toString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case []byte:
+		return string(t)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func b64dec(s string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func empty(v interface{}) bool {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return true
+	}
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool:
+		return !value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return value.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return value.IsNil()
+	}
+	return false
+}
+
+func defaultValue(d interface{}, given []interface{}) interface{} {
+	if len(given) == 0 || empty(given[0]) {
+		return d
+	}
+	return given[0]
+}
+
+func coalesce(values ...interface{}) interface{} {
+	for _, v := range values {
+		if !empty(v) {
+			return v
+		}
+	}
+	return nil
+}
+
+func required(msg string, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, errors.New(msg)
+	}
+	if s, ok := v.(string); ok && len(s) == 0 {
+		return nil, errors.New(msg)
+	}
+	return v, nil
+}
+
+func toInt64(v interface{}) int64 {
+	switch t := v.(type) {
+	case int:
+		return int64(t)
+	case int32:
+		return int64(t)
+	case int64:
+		return t
+	case float64:
+		return int64(t)
+	case string:
+		i, _ := strconv.ParseInt(t, 10, 64)
+		return i
+	case bool:
+		if t {
+			return 1
+		}
+	}
+	return 0
+}
+
+func toFloat64(v interface{}) float64 {
+	switch t := v.(type) {
+	case float64:
+		return t
+	case string:
+		f, _ := strconv.ParseFloat(t, 64)
+		return f
+	}
+	return float64(toInt64(v))
+}
+
+func dict(v ...interface{}) map[string]interface{} {
+	d := make(map[string]interface{})
+	for i := 0; i+1 < len(v); i += 2 {
+		d[toString(v[i])] = v[i+1]
+	}
+	return d
+}
+
+func toYaml(v interface{}) string {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		// like Helm, errors are swallowed
+		return ""
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
+
+func toJson(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
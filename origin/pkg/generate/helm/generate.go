@@ -0,0 +1,540 @@
+package helm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/openshift/github.com/ghodss/yaml"
+
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	"github.com/openshift/kubernetes/pkg/apimachinery/registered"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+	"github.com/openshift/kubernetes/pkg/version"
+
+	oapi "github.com/openshift/origin/pkg/api"
+	"github.com/openshift/origin/pkg/generate/app"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+const (
+	// ReleaseNameParameter is the template parameter replacing the name of the Helm release.
+	ReleaseNameParameter = "NAME"
+
+	// HookAnnotation marks the objects Tiller creates at a specific point of the release lifecycle.
+	HookAnnotation = "helm.sh/hook"
+)
+
+var (
+	documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+	undefinedFunction = regexp.MustCompile(`function "([^"]+)" not defined`)
+)
+
+// Files gives templates access to the files of the chart that are neither
+// templates nor values, like the .Files object of Helm.
+type Files map[string][]byte
+
+// Get returns the content of the file name, or an empty string.
+func (f Files) Get(name string) string {
+	return string(f[name])
+}
+
+// GetBytes returns the content of the file name, or nil.
+func (f Files) GetBytes(name string) []byte {
+	return f[name]
+}
+
+// apiVersions are the API versions available to templates through .Capabilities.
+type apiVersions []string
+
+// Has returns true if the API version is available.
+func (v apiVersions) Has(version string) bool {
+	for _, s := range v {
+		if s == version {
+			return true
+		}
+	}
+	return false
+}
+
+// Generate renders the Helm chart in the directory or archive at chartPath into an
+// OpenShift template. The scalar values of the chart, and the name of the
+// release, become template parameters when the templates of the chart print
+// them as they are. Values used in the logic of the templates, and the other
+// parts of a chart that a template can't express, are reported as warnings.
+func Generate(chartPath string) (*templateapi.Template, error) {
+	chart, err := LoadChart(chartPath)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := make(map[string][]string)
+	warn := func(name, msg string) {
+		warnings[msg] = append(warnings[msg], name)
+	}
+	for _, dependency := range chart.Dependencies {
+		warn(dependency, "dependencies on other charts are not imported, import them separately")
+	}
+
+	r := newRenderer(chart, warn)
+	data := renderData(chart)
+	baseline := r.renderAll(data, warn)
+
+	// find the values the templates print without transformation
+	var params []*parameter
+	for _, p := range probes(data) {
+		marker := fmt.Sprintf("helmvalue%dmarker", len(params))
+		switch usage := r.usage(data, p, marker, baseline); usage {
+		case valueParameterized:
+			p.marker = marker
+			params = append(params, p)
+		case valueUsedInLogic:
+			warn(p.path, "the value is used in the logic of the chart templates and is not a parameter")
+		}
+	}
+
+	// render the chart once with all the parameters replaced by their markers
+	data = withMarkers(data, params)
+	rendered, err := r.render(data)
+	if err != nil || !sameOutput(rendered, baseline, params) {
+		// the values interact in a way the individual renders could not detect
+		for _, p := range params {
+			warn(p.path, "the value is used in the logic of the chart templates and is not a parameter")
+		}
+		params, rendered = nil, baseline
+	}
+
+	template := &templateapi.Template{}
+	template.Name = chart.Metadata.Name
+	template.Annotations = make(map[string]string)
+	template.Annotations[oapi.OpenShiftDisplayName] = chart.Metadata.Name
+	template.Annotations["description"] = chart.Metadata.Description
+	template.Annotations["openshift.io/website"] = chart.Metadata.Home
+	template.Annotations["tags"] = strings.Join(chart.Metadata.Keywords, ",")
+	template.Annotations["iconURL"] = chart.Metadata.Icon
+
+	names := sets.NewString()
+	for _, p := range params {
+		p.name = uniqueName(p.name, names)
+		template.Parameters = append(template.Parameters, templateapi.Parameter{
+			Name:        p.name,
+			DisplayName: p.path,
+			Description: p.description,
+			Value:       toString(p.value),
+		})
+	}
+
+	for _, name := range r.files {
+		docs := documentSeparator.Split(rendered[name], -1)
+		baseDocs := documentSeparator.Split(baseline[name], -1)
+		if len(docs) != len(baseDocs) {
+			baseDocs = nil
+		}
+		for i, doc := range docs {
+			obj, err := toObject(doc)
+			if err != nil {
+				return nil, fmt.Errorf("%s does not render valid YAML: %v", name, err)
+			}
+			if obj == nil {
+				continue
+			}
+			var base interface{}
+			if baseDocs != nil {
+				if base, err = toObject(baseDocs[i]); err != nil {
+					base = nil
+				}
+			}
+			object, err := templateObject(name, replaceMarkers(obj, base, params).(map[string]interface{}), warn)
+			if err != nil {
+				return nil, err
+			}
+			if object != nil {
+				template.Objects = append(template.Objects, object)
+			}
+		}
+	}
+
+	// generate warnings
+	if len(warnings) > 0 {
+		allWarnings := sets.NewString()
+		for msg, names := range warnings {
+			allWarnings.Insert(fmt.Sprintf("%s: %s", strings.Join(sets.NewString(names...).List(), ","), msg))
+		}
+		template.Annotations[app.GenerationWarningAnnotation] = fmt.Sprintf("not all Helm chart features were honored:\n* %s", strings.Join(allWarnings.List(), "\n* "))
+	}
+
+	return template, nil
+}
+
+// renderData returns the objects available to the templates of a chart, with
+// the release named after the chart.
+func renderData(chart *Chart) map[string]interface{} {
+	var versions apiVersions
+	for _, gv := range registered.EnabledVersions() {
+		versions = append(versions, gv.String())
+	}
+	return map[string]interface{}{
+		"Values": chart.Values,
+		"Release": map[string]interface{}{
+			"Name":      chart.Metadata.Name,
+			"Namespace": "",
+			"Service":   "Tiller",
+			"IsInstall": true,
+			"IsUpgrade": false,
+			"Revision":  1,
+		},
+		"Chart": chart.Metadata,
+		"Files": Files(chart.Files),
+		"Capabilities": map[string]interface{}{
+			"KubeVersion": version.Get(),
+			"APIVersions": versions,
+		},
+	}
+}
+
+// renderer executes the templates of a chart.
+type renderer struct {
+	template *template.Template
+	// files are the templates producing objects, in order
+	files []string
+}
+
+// newRenderer parses the templates of the chart. Templates that can't be parsed,
+// usually because they use functions that are not supported, are skipped with a
+// warning.
+func newRenderer(chart *Chart, warn func(name, msg string)) *renderer {
+	r := &renderer{}
+	r.template = template.New(chart.Metadata.Name).Funcs(funcMap(func() *template.Template { return r.template }))
+
+	var names []string
+	for name := range chart.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := r.template.New(name).Parse(chart.Templates[name]); err != nil {
+			if match := undefinedFunction.FindStringSubmatch(err.Error()); match != nil {
+				warn(name, fmt.Sprintf("the template function %s is not supported, the file was skipped", match[1]))
+			} else {
+				warn(name, fmt.Sprintf("the file could not be parsed and was skipped (%v)", err))
+			}
+			continue
+		}
+		// partials and the installation notes produce no objects
+		base := path.Base(name)
+		if strings.HasPrefix(base, "_") || base == "NOTES.txt" {
+			continue
+		}
+		r.files = append(r.files, name)
+	}
+	return r
+}
+
+// renderAll renders each file, skipping the files that fail with a warning.
+func (r *renderer) renderAll(data map[string]interface{}, warn func(name, msg string)) map[string]string {
+	out := make(map[string]string)
+	var files []string
+	for _, name := range r.files {
+		s, err := r.renderFile(name, data)
+		if err != nil {
+			warn(name, fmt.Sprintf("the file could not be rendered and was skipped (%v)", err))
+			continue
+		}
+		out[name] = s
+		files = append(files, name)
+	}
+	r.files = files
+	return out
+}
+
+// render renders all the files, or returns the first error.
+func (r *renderer) render(data map[string]interface{}) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, name := range r.files {
+		s, err := r.renderFile(name, data)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = s
+	}
+	return out, nil
+}
+
+func (r *renderer) renderFile(name string, data map[string]interface{}) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := r.template.ExecuteTemplate(buf, name, data); err != nil {
+		return "", err
+	}
+	// like Helm, missing values print as empty strings
+	return strings.Replace(buf.String(), "<no value>", "", -1), nil
+}
+
+// parameter is a value of the chart that may become a template parameter.
+type parameter struct {
+	// path is the location of the value in the objects available to templates
+	path        string
+	keys        []string
+	value       interface{}
+	name        string
+	description string
+	// marker replaces the value to find where the templates print it
+	marker string
+}
+
+// probes returns the scalar values of the chart and the name of the release as
+// possible parameters, in order.
+func probes(data map[string]interface{}) []*parameter {
+	params := []*parameter{{
+		path:        ".Release.Name",
+		keys:        []string{"Release", "Name"},
+		value:       data["Release"].(map[string]interface{})["Name"],
+		name:        ReleaseNameParameter,
+		description: "The name of the release, used to name the objects of the chart.",
+	}}
+	var visit func(keys []string, values map[string]interface{})
+	visit = func(keys []string, values map[string]interface{}) {
+		var names []string
+		for name := range values {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			path := append(append([]string{}, keys...), name)
+			switch v := values[name].(type) {
+			case map[string]interface{}:
+				visit(path, v)
+			case string, bool, float64, int64:
+				params = append(params, &parameter{
+					path:        "." + strings.Join(path, "."),
+					keys:        path,
+					value:       v,
+					name:        parameterName(path[1:]),
+					description: fmt.Sprintf("The %s value of the chart.", strings.Join(path[1:], ".")),
+				})
+			}
+		}
+	}
+	if values, ok := data["Values"].(map[string]interface{}); ok {
+		visit([]string{"Values"}, values)
+	}
+	return params
+}
+
+type valueUsage int
+
+const (
+	valueUnused valueUsage = iota
+	valueParameterized
+	valueUsedInLogic
+)
+
+// usage renders the chart with the value of p replaced by marker to find out
+// whether the templates only print the value as it is, in which case putting the
+// value back in place of the marker gives the original output.
+func (r *renderer) usage(data map[string]interface{}, p *parameter, marker string, baseline map[string]string) valueUsage {
+	out, err := r.render(withValue(data, p.keys, marker))
+	if err != nil {
+		return valueUsedInLogic
+	}
+	found := false
+	for name, s := range out {
+		if strings.Contains(s, marker) {
+			found = true
+		}
+		if strings.Replace(s, marker, toString(p.value), -1) != baseline[name] {
+			return valueUsedInLogic
+		}
+	}
+	if found {
+		return valueParameterized
+	}
+
+	// a value that is not printed may still be tested by a condition
+	var other interface{}
+	if b, ok := p.value.(bool); ok {
+		other = !b
+	}
+	out, err = r.render(withValue(data, p.keys, other))
+	if err != nil {
+		return valueUsedInLogic
+	}
+	for name, s := range out {
+		if s != baseline[name] {
+			return valueUsedInLogic
+		}
+	}
+	return valueUnused
+}
+
+// sameOutput returns true if putting the values of the parameters back in place
+// of their markers gives the baseline output.
+func sameOutput(out, baseline map[string]string, params []*parameter) bool {
+	for name, s := range out {
+		for _, p := range params {
+			s = strings.Replace(s, p.marker, toString(p.value), -1)
+		}
+		if s != baseline[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// withMarkers returns a copy of data with the values of the parameters replaced
+// by their markers.
+func withMarkers(data map[string]interface{}, params []*parameter) map[string]interface{} {
+	for _, p := range params {
+		data = withValue(data, p.keys, p.marker)
+	}
+	return data
+}
+
+// withValue returns a copy of data with the value at keys replaced, sharing the
+// maps that are not on the path to the value.
+func withValue(data map[string]interface{}, keys []string, value interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		out[k] = v
+	}
+	if len(keys) == 1 {
+		out[keys[0]] = value
+		return out
+	}
+	child, _ := out[keys[0]].(map[string]interface{})
+	out[keys[0]] = withValue(child, keys[1:], value)
+	return out
+}
+
+// replaceMarkers replaces the markers in the strings of obj with references to
+// their parameters. A string made of a single marker where base, the object
+// rendered with the original values, has a number or a boolean becomes a
+// non-string reference. Markers in keys are replaced by the original values,
+// since parameters can't be used there.
+func replaceMarkers(obj, base interface{}, params []*parameter) interface{} {
+	switch t := obj.(type) {
+	case map[string]interface{}:
+		baseMap, _ := base.(map[string]interface{})
+		out := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			key := k
+			for _, p := range params {
+				key = strings.Replace(key, p.marker, toString(p.value), -1)
+			}
+			out[key] = replaceMarkers(v, baseMap[key], params)
+		}
+		return out
+	case []interface{}:
+		baseSlice, _ := base.([]interface{})
+		out := make([]interface{}, len(t))
+		for i, v := range t {
+			var baseItem interface{}
+			if i < len(baseSlice) {
+				baseItem = baseSlice[i]
+			}
+			out[i] = replaceMarkers(v, baseItem, params)
+		}
+		return out
+	case string:
+		for _, p := range params {
+			if t != p.marker {
+				continue
+			}
+			_, baseIsString := base.(string)
+			_, valueIsString := p.value.(string)
+			if (base != nil && !baseIsString) || (base == nil && !valueIsString) {
+				return fmt.Sprintf("${{%s}}", p.name)
+			}
+		}
+		for _, p := range params {
+			t = strings.Replace(t, p.marker, fmt.Sprintf("${%s}", p.name), -1)
+		}
+		return t
+	default:
+		return obj
+	}
+}
+
+// toObject converts a rendered YAML document to JSON, returning nil for empty documents.
+func toObject(doc string) (map[string]interface{}, error) {
+	data, err := yaml.YAMLToJSON([]byte(doc))
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// templateObject returns the rendered object as a raw template object, or nil
+// with a warning if it should not be part of the template.
+func templateObject(name string, obj map[string]interface{}, warn func(name, msg string)) (runtime.Object, error) {
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	if len(apiVersion) == 0 || len(kind) == 0 {
+		warn(name, "documents without an apiVersion and a kind are ignored")
+		return nil, nil
+	}
+	if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			if _, ok := annotations[HookAnnotation]; ok {
+				warn(name, "hooks are created along with the other objects instead of at their point of the release lifecycle")
+			}
+		}
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	gv, err := unversioned.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("%s renders an object with an invalid apiVersion: %v", name, err)
+	}
+	return &runtime.Unknown{
+		TypeMeta:    runtime.TypeMeta{APIVersion: gv.String(), Kind: kind},
+		Raw:         data,
+		ContentType: runtime.ContentTypeJSON,
+	}, nil
+}
+
+// parameterName converts the keys of a value to a parameter name, for instance
+// image.pullPolicy to IMAGE_PULL_POLICY.
+func parameterName(keys []string) string {
+	var parts []string
+	for _, key := range keys {
+		var word []rune
+		var prev rune
+		for _, r := range key {
+			switch {
+			case unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+				word = append(word, '_', r)
+			case unicode.IsLetter(r) || unicode.IsDigit(r):
+				word = append(word, unicode.ToUpper(r))
+			default:
+				r = '_'
+				word = append(word, r)
+			}
+			prev = r
+		}
+		parts = append(parts, string(word))
+	}
+	return strings.Join(parts, "_")
+}
+
+// uniqueName returns name, or name with a numeric suffix if it is already in names.
+func uniqueName(name string, names sets.String) string {
+	unique := name
+	for i := 2; names.Has(unique); i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names.Insert(unique)
+	return unique
+}
@@ -0,0 +1,206 @@
+package helm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/generate/app"
+	templateapi "github.com/openshift/origin/pkg/template/api"
+)
+
+var testChart = map[string]string{
+	"Chart.yaml": `name: web
+version: 0.1.0
+description: A web server
+keywords: [http, web]
+`,
+	"values.yaml": `replicaCount: 2
+image:
+  repository: nginx
+  tag: "1.11"
+  pullPolicy: IfNotPresent
+service:
+  port: 80
+persistence:
+  enabled: false
+`,
+	"requirements.yaml": `dependencies:
+- name: redis
+  version: 0.5.0
+`,
+	"templates/_helpers.tpl": `{{- define "fullname" -}}
+{{- printf "%s-%s" .Release.Name .Chart.Name | trunc 63 -}}
+{{- end -}}
+`,
+	"templates/deployment.yaml": `apiVersion: extensions/v1beta1
+kind: Deployment
+metadata:
+  name: {{ template "fullname" . }}
+spec:
+  replicas: {{ .Values.replicaCount }}
+  template:
+    metadata:
+      labels:
+        app: {{ template "fullname" . }}
+    spec:
+      containers:
+      - name: web
+        image: "{{ .Values.image.repository }}:{{ .Values.image.tag }}"
+        imagePullPolicy: {{ .Values.image.pullPolicy | quote }}
+        ports:
+        - containerPort: {{ .Values.service.port }}
+{{- if .Values.persistence.enabled }}
+        volumeMounts:
+        - name: data
+          mountPath: /data
+{{- end }}
+`,
+	"templates/service.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: {{ template "fullname" . }}
+  annotations:
+    port: "{{ .Values.service.port }}"
+spec:
+  ports:
+  - port: {{ add .Values.service.port 1 }}
+  selector:
+    app: {{ template "fullname" . }}
+`,
+	"templates/job.yaml": `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: {{ template "fullname" . }}-init
+  annotations:
+    "helm.sh/hook": post-install
+spec:
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: init
+        image: busybox
+`,
+	"templates/secret.yaml": `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ template "fullname" . }}
+data:
+  password: {{ randAlphaNum 10 | b64enc | quote }}
+`,
+	"templates/NOTES.txt": `Visit {{ template "fullname" . }}`,
+}
+
+func writeTestChart(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "helm")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, content := range testChart {
+		p := filepath.Join(dir, "web", name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return dir
+}
+
+func writeTestArchive(t *testing.T, dir string) string {
+	p := filepath.Join(dir, "web-0.1.0.tgz")
+	f, err := os.Create(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	gz := gzip.NewWriter(f)
+	w := tar.NewWriter(gz)
+	for name, content := range testChart {
+		header := &tar.Header{Name: "web/" + name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}
+		if err := w.WriteHeader(header); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return p
+}
+
+func TestGenerate(t *testing.T) {
+	dir := writeTestChart(t)
+	defer os.RemoveAll(dir)
+
+	for _, p := range []string{filepath.Join(dir, "web"), writeTestArchive(t, dir)} {
+		if !IsPossibleHelmChart(p) {
+			t.Errorf("%s: expected a possible chart", p)
+		}
+		template, err := Generate(p)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", p, err)
+		}
+		checkTemplate(t, p, template)
+	}
+}
+
+func checkTemplate(t *testing.T, p string, template *templateapi.Template) {
+	if template.Name != "web" || template.Annotations["description"] != "A web server" || template.Annotations["tags"] != "http,web" {
+		t.Errorf("%s: unexpected template metadata %#v", p, template.ObjectMeta)
+	}
+
+	params := make(map[string]string)
+	for _, param := range template.Parameters {
+		params[param.Name] = param.Value
+	}
+	expectedParams := map[string]string{
+		"NAME":              "web",
+		"REPLICA_COUNT":     "2",
+		"IMAGE_REPOSITORY":  "nginx",
+		"IMAGE_TAG":         "1.11",
+		"IMAGE_PULL_POLICY": "IfNotPresent",
+	}
+	if !reflect.DeepEqual(expectedParams, params) {
+		t.Errorf("%s: expected parameters %v, got %v", p, expectedParams, params)
+	}
+
+	var objects []string
+	for _, obj := range template.Objects {
+		objects = append(objects, string(obj.(*runtime.Unknown).Raw))
+	}
+	expectedObjects := []string{
+		`{"apiVersion":"extensions/v1beta1","kind":"Deployment","metadata":{"name":"${NAME}-web"},"spec":{"replicas":"${{REPLICA_COUNT}}","template":{"metadata":{"labels":{"app":"${NAME}-web"}},"spec":{"containers":[{"image":"${IMAGE_REPOSITORY}:${IMAGE_TAG}","imagePullPolicy":"${IMAGE_PULL_POLICY}","name":"web","ports":[{"containerPort":80}]}]}}}}`,
+		`{"apiVersion":"batch/v1","kind":"Job","metadata":{"annotations":{"helm.sh/hook":"post-install"},"name":"${NAME}-web-init"},"spec":{"template":{"spec":{"containers":[{"image":"busybox","name":"init"}],"restartPolicy":"Never"}}}}`,
+		`{"apiVersion":"v1","kind":"Service","metadata":{"annotations":{"port":"80"},"name":"${NAME}-web"},"spec":{"ports":[{"port":81}],"selector":{"app":"${NAME}-web"}}}`,
+	}
+	if !reflect.DeepEqual(expectedObjects, objects) {
+		t.Errorf("%s: expected objects\n%s\ngot\n%s", p, strings.Join(expectedObjects, "\n"), strings.Join(objects, "\n"))
+	}
+
+	warnings := template.Annotations[app.GenerationWarningAnnotation]
+	for _, s := range []string{
+		"redis: dependencies on other charts are not imported",
+		"templates/job.yaml: hooks are created along with the other objects",
+		"templates/secret.yaml: the template function randAlphaNum is not supported",
+		".Values.persistence.enabled,.Values.service.port: the value is used in the logic of the chart templates",
+	} {
+		if !strings.Contains(warnings, s) {
+			t.Errorf("%s: expected the warnings to contain %q, got:\n%s", p, s, warnings)
+		}
+	}
+}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/openshift/github.com/blang/semver"
 	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/golang/glog"
@@ -328,6 +330,9 @@ func ManifestMatchesImage(image *Image, newManifest []byte) (bool, error) {
 		if err != nil {
 			return false, err
 		}
+	case ocischema.MediaTypeManifest, manifestlist.MediaTypeManifestList, manifestlist.MediaTypeOCIIndex:
+		// these manifests are addressed by the digest of their raw content
+		canonical = newManifest
 	case schema1.MediaTypeManifest, "":
 		var m schema1.SignedManifest
 		if err := json.Unmarshal(newManifest, &m); err != nil {
@@ -346,16 +351,26 @@ func ManifestMatchesImage(image *Image, newManifest []byte) (bool, error) {
 // ImageConfigMatchesImage returns true if the provided image config matches a digest
 // stored in the manifest of the image.
 func ImageConfigMatchesImage(image *Image, imageConfig []byte) (bool, error) {
-	if image.DockerImageManifestMediaType != schema2.MediaTypeManifest {
-		return false, nil
-	}
+	var configDigest digest.Digest
 
-	var m schema2.DeserializedManifest
-	if err := json.Unmarshal([]byte(image.DockerImageManifest), &m); err != nil {
-		return false, err
+	switch image.DockerImageManifestMediaType {
+	case schema2.MediaTypeManifest:
+		var m schema2.DeserializedManifest
+		if err := json.Unmarshal([]byte(image.DockerImageManifest), &m); err != nil {
+			return false, err
+		}
+		configDigest = m.Config.Digest
+	case ocischema.MediaTypeManifest:
+		var m ocischema.DeserializedManifest
+		if err := json.Unmarshal([]byte(image.DockerImageManifest), &m); err != nil {
+			return false, err
+		}
+		configDigest = m.Config.Digest
+	default:
+		return false, nil
 	}
 
-	v, err := digest.NewDigestVerifier(m.Config.Digest)
+	v, err := digest.NewDigestVerifier(configDigest)
 	if err != nil {
 		return false, err
 	}
@@ -379,6 +394,10 @@ func ImageWithMetadata(image *Image) error {
 		// don't update image already filled
 		return nil
 	}
+	if len(image.DockerImageManifests) > 0 && len(image.DockerImageManifestMediaType) > 0 {
+		glog.V(5).Infof("Manifest list metadata already filled for %s", image.Name)
+		return nil
+	}
 
 	manifestData := image.DockerImageManifest
 
@@ -453,7 +472,15 @@ func ImageWithMetadata(image *Image) error {
 			image.DockerImageMetadata.Size = v1Metadata.Size
 		}
 	case 2:
-		image.DockerImageManifestMediaType = schema2.MediaTypeManifest
+		mediaType, err := schema2ManifestMediaType(manifest.MediaType, manifestData)
+		if err != nil {
+			return err
+		}
+		image.DockerImageManifestMediaType = mediaType
+
+		if IsManifestList(mediaType) {
+			return imageWithManifestList(image, manifestData)
+		}
 
 		if len(image.DockerImageConfig) == 0 {
 			return fmt.Errorf("dockerImageConfig must not be empty for manifest schema 2")
@@ -503,6 +530,54 @@ func ImageWithMetadata(image *Image) error {
 	return nil
 }
 
+// IsManifestList returns true if the media type describes a manifest list or an OCI image index, that is a
+// manifest referencing other manifests rather than layers.
+func IsManifestList(mediaType string) bool {
+	return mediaType == manifestlist.MediaTypeManifestList || mediaType == manifestlist.MediaTypeOCIIndex
+}
+
+// schema2ManifestMediaType returns the media type of a manifest with schema version 2. OCI image manifests and
+// indexes may omit their media type, an index is then recognized by its list of manifests.
+func schema2ManifestMediaType(mediaType, manifestData string) (string, error) {
+	if len(mediaType) > 0 {
+		return mediaType, nil
+	}
+	var index struct {
+		Manifests json.RawMessage `json:"manifests"`
+	}
+	if err := json.Unmarshal([]byte(manifestData), &index); err != nil {
+		return "", err
+	}
+	if index.Manifests != nil {
+		return manifestlist.MediaTypeOCIIndex, nil
+	}
+	return ocischema.MediaTypeManifest, nil
+}
+
+// imageWithManifestList fills the child manifests of an image that is a manifest list or an OCI image index.
+// Such an image has no layers or configuration of its own.
+func imageWithManifestList(image *Image, manifestData string) error {
+	var list manifestlist.ManifestList
+	if err := json.Unmarshal([]byte(manifestData), &list); err != nil {
+		return err
+	}
+
+	image.DockerImageLayers = nil
+	image.DockerImageMetadata = DockerImage{}
+	image.DockerImageManifests = make([]ImageManifest, len(list.Manifests))
+	for i, m := range list.Manifests {
+		image.DockerImageManifests[i] = ImageManifest{
+			Digest:       m.Digest.String(),
+			MediaType:    m.MediaType,
+			ManifestSize: m.Size,
+			Architecture: m.Platform.Architecture,
+			OS:           m.Platform.OS,
+			Variant:      m.Platform.Variant,
+		}
+	}
+	return nil
+}
+
 // DockerImageReferenceForStream returns a DockerImageReference that represents
 // the ImageStream or false, if no valid reference exists.
 func DockerImageReferenceForStream(stream *ImageStream) (DockerImageReference, error) {
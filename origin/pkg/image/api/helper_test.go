@@ -748,6 +748,58 @@ func TestImageWithMetadata(t *testing.T) {
 				},
 			},
 		},
+		"manifest list": {
+			image: Image{
+				ObjectMeta:          kapi.ObjectMeta{Name: "sha256:b3e8a4d4ab9f2f2f3a5ca6a4ee7c6b3c1a0d0a1e0a8e8d3b7d4f24fb8fa07a33"},
+				DockerImageManifest: manifestListData,
+			},
+			expectedImage: Image{
+				ObjectMeta:                   kapi.ObjectMeta{Name: "sha256:b3e8a4d4ab9f2f2f3a5ca6a4ee7c6b3c1a0d0a1e0a8e8d3b7d4f24fb8fa07a33"},
+				DockerImageManifest:          manifestListData,
+				DockerImageManifestMediaType: "application/vnd.docker.distribution.manifest.list.v2+json",
+				DockerImageManifests: []ImageManifest{
+					{
+						Digest:       "sha256:e692418e4cbaf90ca69d05a66403747baa33ee08806650b51fab815ad7fc331f",
+						MediaType:    "application/vnd.docker.distribution.manifest.v2+json",
+						ManifestSize: 7143,
+						Architecture: "ppc64le",
+						OS:           "linux",
+					},
+					{
+						Digest:       "sha256:5b0bcabd1ed22e9fb1310cf6c2dec7cdef19f0ad69efa1f392e94a4333501270",
+						MediaType:    "application/vnd.docker.distribution.manifest.v2+json",
+						ManifestSize: 7682,
+						Architecture: "arm",
+						OS:           "linux",
+						Variant:      "v7",
+					},
+				},
+			},
+		},
+		"OCI index without media type": {
+			image: Image{
+				DockerImageManifest: `{"schemaVersion": 2, "manifests": [{"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 500, "digest": "sha256:e692418e4cbaf90ca69d05a66403747baa33ee08806650b51fab815ad7fc331f", "platform": {"architecture": "amd64", "os": "linux"}}]}`,
+			},
+			expectedImage: Image{
+				DockerImageManifest:          `{"schemaVersion": 2, "manifests": [{"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 500, "digest": "sha256:e692418e4cbaf90ca69d05a66403747baa33ee08806650b51fab815ad7fc331f", "platform": {"architecture": "amd64", "os": "linux"}}]}`,
+				DockerImageManifestMediaType: "application/vnd.oci.image.index.v1+json",
+				DockerImageManifests: []ImageManifest{
+					{
+						Digest:       "sha256:e692418e4cbaf90ca69d05a66403747baa33ee08806650b51fab815ad7fc331f",
+						MediaType:    "application/vnd.oci.image.manifest.v1+json",
+						ManifestSize: 500,
+						Architecture: "amd64",
+						OS:           "linux",
+					},
+				},
+			},
+		},
+		"OCI manifest without config": {
+			image: Image{
+				DockerImageManifest: `{"schemaVersion": 2, "config": {"mediaType": "application/vnd.oci.image.config.v1+json", "size": 7023, "digest": "sha256:b5b2b2c507a0944348e0303114d8d93aaaa081732b86451d9bce1f432a537bc7"}, "layers": []}`,
+			},
+			expectError: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -766,6 +818,32 @@ func TestImageWithMetadata(t *testing.T) {
 	}
 }
 
+const manifestListData = `{
+   "schemaVersion": 2,
+   "mediaType": "application/vnd.docker.distribution.manifest.list.v2+json",
+   "manifests": [
+      {
+         "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+         "size": 7143,
+         "digest": "sha256:e692418e4cbaf90ca69d05a66403747baa33ee08806650b51fab815ad7fc331f",
+         "platform": {
+            "architecture": "ppc64le",
+            "os": "linux"
+         }
+      },
+      {
+         "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+         "size": 7682,
+         "digest": "sha256:5b0bcabd1ed22e9fb1310cf6c2dec7cdef19f0ad69efa1f392e94a4333501270",
+         "platform": {
+            "architecture": "arm",
+            "os": "linux",
+            "variant": "v7"
+         }
+      }
+   ]
+}`
+
 func TestLatestTaggedImage(t *testing.T) {
 	tests := []struct {
 		tag            string
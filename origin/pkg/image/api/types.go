@@ -34,6 +34,10 @@ const (
 	// ResourceImageStreams represents a number of image streams in a project.
 	ResourceImageStreams kapi.ResourceName = "openshift.io/imagestreams"
 
+	// ResourceImageStreamsStorage represents the number of bytes taken by the unique image layers referenced
+	// by image stream statuses of a project. Only images managed by the integrated registry are counted.
+	ResourceImageStreamsStorage kapi.ResourceName = "openshift.io/imagestreams.storage"
+
 	// ResourceImageStreamImages represents a number of unique references to images in all image stream
 	// statuses of a project.
 	ResourceImageStreamImages kapi.ResourceName = "openshift.io/images"
@@ -76,6 +80,25 @@ type Image struct {
 	DockerImageManifestMediaType string
 	// DockerImageConfig is a JSON blob that the runtime uses to set up the container. This is a part of manifest schema v2.
 	DockerImageConfig string
+	// DockerImageManifests holds information about the child manifests when this image is a manifest list
+	// or an OCI image index. It is empty for single-platform images.
+	DockerImageManifests []ImageManifest
+}
+
+// ImageManifest represents a platform-specific manifest referenced by a manifest list or an OCI image index.
+type ImageManifest struct {
+	// Digest is the unique identifier of the child manifest. It refers to an Image object.
+	Digest string
+	// MediaType is the media type of the child manifest.
+	MediaType string
+	// ManifestSize is the size of the raw child manifest in bytes.
+	ManifestSize int64
+	// Architecture is the CPU architecture the image runs on, for example amd64 or ppc64le.
+	Architecture string
+	// OS is the operating system the image runs on, for example linux.
+	OS string
+	// Variant is an optional variant of the CPU, for example v7 for ARMv7.
+	Variant string
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -310,11 +333,14 @@ type TagEventConditionType string
 const (
 	// ImportSuccess with status False means the import of the specific tag failed
 	ImportSuccess TagEventConditionType = "ImportSuccess"
+	// ImageReplicated with status False means the integrated registry failed to replicate the image pushed
+	// to the tag to one of the configured remote registries
+	ImageReplicated TagEventConditionType = "ImageReplicated"
 )
 
 // TagEventCondition contains condition information for a tag event.
 type TagEventCondition struct {
-	// Type of tag event condition, currently only ImportSuccess
+	// Type of tag event condition, ImportSuccess or ImageReplicated
 	Type TagEventConditionType
 	// Status of the condition, one of True, False, Unknown.
 	Status kapi.ConditionStatus
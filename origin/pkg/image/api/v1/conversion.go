@@ -54,6 +54,22 @@ func Convert_api_Image_To_v1_Image(in *newer.Image, out *Image, s conversion.Sco
 		out.DockerImageLayers = nil
 	}
 
+	if in.DockerImageManifests != nil {
+		out.DockerImageManifests = make([]ImageManifest, len(in.DockerImageManifests))
+		for i := range in.DockerImageManifests {
+			out.DockerImageManifests[i] = ImageManifest{
+				Digest:       in.DockerImageManifests[i].Digest,
+				MediaType:    in.DockerImageManifests[i].MediaType,
+				ManifestSize: in.DockerImageManifests[i].ManifestSize,
+				Architecture: in.DockerImageManifests[i].Architecture,
+				OS:           in.DockerImageManifests[i].OS,
+				Variant:      in.DockerImageManifests[i].Variant,
+			}
+		}
+	} else {
+		out.DockerImageManifests = nil
+	}
+
 	if in.Signatures != nil {
 		out.Signatures = make([]ImageSignature, len(in.Signatures))
 		for i := range in.Signatures {
@@ -117,6 +133,22 @@ func Convert_v1_Image_To_api_Image(in *Image, out *newer.Image, s conversion.Sco
 		out.DockerImageLayers = nil
 	}
 
+	if in.DockerImageManifests != nil {
+		out.DockerImageManifests = make([]newer.ImageManifest, len(in.DockerImageManifests))
+		for i := range in.DockerImageManifests {
+			out.DockerImageManifests[i] = newer.ImageManifest{
+				Digest:       in.DockerImageManifests[i].Digest,
+				MediaType:    in.DockerImageManifests[i].MediaType,
+				ManifestSize: in.DockerImageManifests[i].ManifestSize,
+				Architecture: in.DockerImageManifests[i].Architecture,
+				OS:           in.DockerImageManifests[i].OS,
+				Variant:      in.DockerImageManifests[i].Variant,
+			}
+		}
+	} else {
+		out.DockerImageManifests = nil
+	}
+
 	if in.Signatures != nil {
 		out.Signatures = make([]newer.ImageSignature, len(in.Signatures))
 		for i := range in.Signatures {
@@ -15,6 +15,7 @@
 		ImageImportStatus
 		ImageLayer
 		ImageList
+		ImageManifest
 		ImageSignature
 		ImageStream
 		ImageStreamImage
@@ -88,103 +89,111 @@ func (m *ImageList) Reset()                    { *m = ImageList{} }
 func (*ImageList) ProtoMessage()               {}
 func (*ImageList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{5} }
 
+func (m *ImageManifest) Reset()                    { *m = ImageManifest{} }
+func (*ImageManifest) ProtoMessage()               {}
+func (*ImageManifest) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{6} }
+
 func (m *ImageSignature) Reset()                    { *m = ImageSignature{} }
 func (*ImageSignature) ProtoMessage()               {}
-func (*ImageSignature) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{6} }
+func (*ImageSignature) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{7} }
 
 func (m *ImageStream) Reset()                    { *m = ImageStream{} }
 func (*ImageStream) ProtoMessage()               {}
-func (*ImageStream) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{7} }
+func (*ImageStream) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{8} }
 
 func (m *ImageStreamImage) Reset()                    { *m = ImageStreamImage{} }
 func (*ImageStreamImage) ProtoMessage()               {}
-func (*ImageStreamImage) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{8} }
+func (*ImageStreamImage) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{9} }
 
 func (m *ImageStreamImport) Reset()                    { *m = ImageStreamImport{} }
 func (*ImageStreamImport) ProtoMessage()               {}
-func (*ImageStreamImport) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{9} }
+func (*ImageStreamImport) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{10} }
 
 func (m *ImageStreamImportSpec) Reset()                    { *m = ImageStreamImportSpec{} }
 func (*ImageStreamImportSpec) ProtoMessage()               {}
-func (*ImageStreamImportSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{10} }
+func (*ImageStreamImportSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{11} }
 
 func (m *ImageStreamImportStatus) Reset()      { *m = ImageStreamImportStatus{} }
 func (*ImageStreamImportStatus) ProtoMessage() {}
 func (*ImageStreamImportStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptorGenerated, []int{11}
+	return fileDescriptorGenerated, []int{12}
 }
 
 func (m *ImageStreamList) Reset()                    { *m = ImageStreamList{} }
 func (*ImageStreamList) ProtoMessage()               {}
-func (*ImageStreamList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{12} }
+func (*ImageStreamList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
 
 func (m *ImageStreamMapping) Reset()                    { *m = ImageStreamMapping{} }
 func (*ImageStreamMapping) ProtoMessage()               {}
-func (*ImageStreamMapping) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
+func (*ImageStreamMapping) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
 
 func (m *ImageStreamSpec) Reset()                    { *m = ImageStreamSpec{} }
 func (*ImageStreamSpec) ProtoMessage()               {}
-func (*ImageStreamSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
+func (*ImageStreamSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
 
 func (m *ImageStreamStatus) Reset()                    { *m = ImageStreamStatus{} }
 func (*ImageStreamStatus) ProtoMessage()               {}
-func (*ImageStreamStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
+func (*ImageStreamStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
 
 func (m *ImageStreamTag) Reset()                    { *m = ImageStreamTag{} }
 func (*ImageStreamTag) ProtoMessage()               {}
-func (*ImageStreamTag) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
+func (*ImageStreamTag) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{17} }
 
 func (m *ImageStreamTagList) Reset()                    { *m = ImageStreamTagList{} }
 func (*ImageStreamTagList) ProtoMessage()               {}
-func (*ImageStreamTagList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{17} }
+func (*ImageStreamTagList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
 
 func (m *NamedTagEventList) Reset()                    { *m = NamedTagEventList{} }
 func (*NamedTagEventList) ProtoMessage()               {}
-func (*NamedTagEventList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
+func (*NamedTagEventList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
 
 func (m *RepositoryImportSpec) Reset()                    { *m = RepositoryImportSpec{} }
 func (*RepositoryImportSpec) ProtoMessage()               {}
-func (*RepositoryImportSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
+func (*RepositoryImportSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
 
-func (m *RepositoryImportStatus) Reset()                    { *m = RepositoryImportStatus{} }
-func (*RepositoryImportStatus) ProtoMessage()               {}
-func (*RepositoryImportStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
+func (m *RepositoryImportStatus) Reset()      { *m = RepositoryImportStatus{} }
+func (*RepositoryImportStatus) ProtoMessage() {}
+func (*RepositoryImportStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{21}
+}
 
 func (m *SignatureCondition) Reset()                    { *m = SignatureCondition{} }
 func (*SignatureCondition) ProtoMessage()               {}
-func (*SignatureCondition) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{21} }
+func (*SignatureCondition) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{22} }
 
-func (m *SignatureGenericEntity) Reset()                    { *m = SignatureGenericEntity{} }
-func (*SignatureGenericEntity) ProtoMessage()               {}
-func (*SignatureGenericEntity) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{22} }
+func (m *SignatureGenericEntity) Reset()      { *m = SignatureGenericEntity{} }
+func (*SignatureGenericEntity) ProtoMessage() {}
+func (*SignatureGenericEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{23}
+}
 
 func (m *SignatureIssuer) Reset()                    { *m = SignatureIssuer{} }
 func (*SignatureIssuer) ProtoMessage()               {}
-func (*SignatureIssuer) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{23} }
+func (*SignatureIssuer) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{24} }
 
 func (m *SignatureSubject) Reset()                    { *m = SignatureSubject{} }
 func (*SignatureSubject) ProtoMessage()               {}
-func (*SignatureSubject) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{24} }
+func (*SignatureSubject) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
 
 func (m *TagEvent) Reset()                    { *m = TagEvent{} }
 func (*TagEvent) ProtoMessage()               {}
-func (*TagEvent) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
+func (*TagEvent) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
 
 func (m *TagEventCondition) Reset()                    { *m = TagEventCondition{} }
 func (*TagEventCondition) ProtoMessage()               {}
-func (*TagEventCondition) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
+func (*TagEventCondition) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{27} }
 
 func (m *TagImportPolicy) Reset()                    { *m = TagImportPolicy{} }
 func (*TagImportPolicy) ProtoMessage()               {}
-func (*TagImportPolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{27} }
+func (*TagImportPolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
 
 func (m *TagReference) Reset()                    { *m = TagReference{} }
 func (*TagReference) ProtoMessage()               {}
-func (*TagReference) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
+func (*TagReference) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
 
 func (m *TagReferencePolicy) Reset()                    { *m = TagReferencePolicy{} }
 func (*TagReferencePolicy) ProtoMessage()               {}
-func (*TagReferencePolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
+func (*TagReferencePolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{30} }
 
 func init() {
 	proto.RegisterType((*DockerImageReference)(nil), "github.com.openshift.origin.pkg.image.api.v1.DockerImageReference")
@@ -193,6 +202,7 @@ func init() {
 	proto.RegisterType((*ImageImportStatus)(nil), "github.com.openshift.origin.pkg.image.api.v1.ImageImportStatus")
 	proto.RegisterType((*ImageLayer)(nil), "github.com.openshift.origin.pkg.image.api.v1.ImageLayer")
 	proto.RegisterType((*ImageList)(nil), "github.com.openshift.origin.pkg.image.api.v1.ImageList")
+	proto.RegisterType((*ImageManifest)(nil), "github.com.openshift.origin.pkg.image.api.v1.ImageManifest")
 	proto.RegisterType((*ImageSignature)(nil), "github.com.openshift.origin.pkg.image.api.v1.ImageSignature")
 	proto.RegisterType((*ImageStream)(nil), "github.com.openshift.origin.pkg.image.api.v1.ImageStream")
 	proto.RegisterType((*ImageStreamImage)(nil), "github.com.openshift.origin.pkg.image.api.v1.ImageStreamImage")
@@ -339,6 +349,18 @@ func (m *Image) MarshalTo(data []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.DockerImageConfig)))
 	i += copy(data[i:], m.DockerImageConfig)
+	if len(m.DockerImageManifests) > 0 {
+		for _, msg := range m.DockerImageManifests {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -501,6 +523,47 @@ func (m *ImageList) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ImageManifest) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ImageManifest) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Digest)))
+	i += copy(data[i:], m.Digest)
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.MediaType)))
+	i += copy(data[i:], m.MediaType)
+	data[i] = 0x18
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.ManifestSize))
+	data[i] = 0x22
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Architecture)))
+	i += copy(data[i:], m.Architecture)
+	data[i] = 0x2a
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.OS)))
+	i += copy(data[i:], m.OS)
+	data[i] = 0x32
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Variant)))
+	i += copy(data[i:], m.Variant)
+	return i, nil
+}
+
 func (m *ImageSignature) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1624,6 +1687,12 @@ func (m *Image) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.DockerImageConfig)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DockerImageManifests) > 0 {
+		for _, e := range m.DockerImageManifests {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1681,6 +1750,23 @@ func (m *ImageList) Size() (n int) {
 	return n
 }
 
+func (m *ImageManifest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MediaType)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ManifestSize))
+	l = len(m.Architecture)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.OS)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Variant)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ImageSignature) Size() (n int) {
 	var l int
 	_ = l
@@ -2096,6 +2182,7 @@ func (this *Image) String() string {
 		`DockerImageSignatures:` + fmt.Sprintf("%v", this.DockerImageSignatures) + `,`,
 		`DockerImageManifestMediaType:` + fmt.Sprintf("%v", this.DockerImageManifestMediaType) + `,`,
 		`DockerImageConfig:` + fmt.Sprintf("%v", this.DockerImageConfig) + `,`,
+		`DockerImageManifests:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DockerImageManifests), "ImageManifest", "ImageManifest", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2148,6 +2235,21 @@ func (this *ImageList) String() string {
 	}, "")
 	return s
 }
+func (this *ImageManifest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageManifest{`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`MediaType:` + fmt.Sprintf("%v", this.MediaType) + `,`,
+		`ManifestSize:` + fmt.Sprintf("%v", this.ManifestSize) + `,`,
+		`Architecture:` + fmt.Sprintf("%v", this.Architecture) + `,`,
+		`OS:` + fmt.Sprintf("%v", this.OS) + `,`,
+		`Variant:` + fmt.Sprintf("%v", this.Variant) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ImageSignature) String() string {
 	if this == nil {
 		return "nil"
@@ -2991,6 +3093,37 @@ func (m *Image) Unmarshal(data []byte) error {
 			}
 			m.DockerImageConfig = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DockerImageManifests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DockerImageManifests = append(m.DockerImageManifests, ImageManifest{})
+			if err := m.DockerImageManifests[len(m.DockerImageManifests)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(data[iNdEx:])
@@ -3555,6 +3688,220 @@ func (m *ImageList) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *ImageManifest) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MediaType = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestSize", wireType)
+			}
+			m.ManifestSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ManifestSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Architecture", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Architecture = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OS = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ImageSignature) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -7314,143 +7661,150 @@ var (
 )
 
 var fileDescriptorGenerated = []byte{
-	// 2200 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x19, 0x5b, 0x6f, 0x1b, 0x59,
-	0x39, 0x33, 0x63, 0x27, 0xce, 0xe7, 0x34, 0x97, 0xd3, 0xa4, 0xeb, 0x35, 0xdd, 0x24, 0x1a, 0xb6,
-	0xab, 0x22, 0xda, 0xb1, 0x1a, 0xb4, 0xa8, 0x5b, 0xba, 0xed, 0xd6, 0x71, 0x58, 0x99, 0x36, 0xdb,
-	0xe8, 0xc4, 0x5b, 0x55, 0xc0, 0x82, 0x4e, 0xec, 0x93, 0xc9, 0x21, 0xf6, 0x8c, 0x35, 0x73, 0x9c,
-	0x92, 0x3e, 0x71, 0xd3, 0x8a, 0x17, 0x24, 0x1e, 0x78, 0x42, 0x3c, 0x81, 0xc4, 0x33, 0xfc, 0x06,
-	0x2a, 0x54, 0x89, 0x97, 0x8a, 0x07, 0x2e, 0x12, 0x0a, 0x34, 0x3c, 0x80, 0xf8, 0x09, 0xfb, 0x84,
-	0xe6, 0xcc, 0x99, 0xab, 0xc7, 0xde, 0xd8, 0x69, 0x02, 0xfb, 0x66, 0x9f, 0xef, 0x7a, 0xbe, 0xfb,
-	0xf9, 0x06, 0x6e, 0x9b, 0x8c, 0xef, 0xf5, 0x76, 0x8c, 0xa6, 0xdd, 0xa9, 0xd8, 0x5d, 0x6a, 0xb9,
-	0x7b, 0x6c, 0x97, 0x57, 0x6c, 0x87, 0x99, 0xcc, 0xaa, 0x74, 0xf7, 0xcd, 0x0a, 0xeb, 0x10, 0x93,
-	0x56, 0x48, 0x97, 0x55, 0x0e, 0x6e, 0x54, 0x4c, 0x6a, 0x51, 0x87, 0x70, 0xda, 0x32, 0xba, 0x8e,
-	0xcd, 0x6d, 0x74, 0x2d, 0xa2, 0x36, 0x42, 0x6a, 0xc3, 0xa7, 0x36, 0xba, 0xfb, 0xa6, 0x21, 0xa8,
-	0x0d, 0xd2, 0x65, 0xc6, 0xc1, 0x8d, 0xf2, 0xf5, 0x98, 0x2c, 0xd3, 0x36, 0xed, 0x8a, 0x60, 0xb2,
-	0xd3, 0xdb, 0x15, 0xff, 0xc4, 0x1f, 0xf1, 0xcb, 0x67, 0x5e, 0x7e, 0x7b, 0xff, 0xa6, 0x6b, 0x30,
-	0xbb, 0xb2, 0xdf, 0xdb, 0xa1, 0x8e, 0x45, 0x39, 0x75, 0x85, 0x42, 0x9e, 0x2a, 0x3d, 0xeb, 0x80,
-	0x3a, 0x2e, 0xb3, 0x2d, 0xda, 0x4a, 0xeb, 0x54, 0xbe, 0x36, 0x98, 0xac, 0xff, 0x06, 0xe5, 0xeb,
-	0xd9, 0xd8, 0x4e, 0xcf, 0xe2, 0xac, 0x43, 0xfb, 0xd0, 0x6f, 0x64, 0xa3, 0xf7, 0x38, 0x6b, 0x57,
-	0x98, 0xc5, 0x5d, 0xee, 0xa4, 0x49, 0xf4, 0x3f, 0x2a, 0xb0, 0x58, 0xb3, 0x9b, 0xfb, 0xd4, 0xa9,
-	0x7b, 0xc6, 0xc0, 0x74, 0x97, 0x3a, 0xd4, 0x6a, 0x52, 0x74, 0x0d, 0x0a, 0x0e, 0x35, 0x99, 0xcb,
-	0x9d, 0xc3, 0x92, 0xb2, 0xaa, 0x5c, 0x9d, 0xae, 0xce, 0x3f, 0x3f, 0x5a, 0x99, 0x38, 0x3e, 0x5a,
-	0x29, 0x60, 0x79, 0x8e, 0x43, 0x0c, 0x54, 0x81, 0x69, 0x8b, 0x74, 0xa8, 0xdb, 0x25, 0x4d, 0x5a,
-	0x52, 0x05, 0xfa, 0x82, 0x44, 0x9f, 0xfe, 0x20, 0x00, 0xe0, 0x08, 0x07, 0xad, 0x42, 0xce, 0xfb,
-	0x53, 0xd2, 0x04, 0xee, 0x8c, 0xc4, 0xcd, 0x79, 0xb8, 0x58, 0x40, 0xd0, 0x1b, 0xa0, 0x71, 0x62,
-	0x96, 0x72, 0x02, 0xa1, 0x28, 0x11, 0xb4, 0x06, 0x31, 0xb1, 0x77, 0x8e, 0xca, 0xa0, 0xb2, 0x5a,
-	0x29, 0x2f, 0xa0, 0x20, 0xa1, 0x6a, 0xbd, 0x86, 0x55, 0x56, 0xd3, 0xff, 0x36, 0x05, 0x79, 0x71,
-	0x1d, 0xf4, 0x18, 0x0a, 0x1d, 0xca, 0x49, 0x8b, 0x70, 0x22, 0x6e, 0x51, 0x5c, 0xbb, 0x6a, 0xf8,
-	0x46, 0x32, 0x22, 0x23, 0x89, 0x58, 0xf0, 0xa3, 0xc0, 0x78, 0xb8, 0xf3, 0x1d, 0xda, 0xe4, 0x9b,
-	0x94, 0x93, 0x2a, 0x92, 0x5c, 0x21, 0x3a, 0xc3, 0x21, 0x37, 0xb4, 0x05, 0x8b, 0xad, 0x0c, 0xbb,
-	0xc9, 0xcb, 0x5f, 0x96, 0xb4, 0x99, 0xb6, 0xc5, 0x99, 0x94, 0xe8, 0x29, 0x5c, 0x8c, 0x9d, 0x6f,
-	0x06, 0x6a, 0x6b, 0x42, 0xed, 0x2f, 0x0e, 0x50, 0x5b, 0x86, 0x82, 0x81, 0xc9, 0x93, 0x8d, 0xef,
-	0x72, 0x6a, 0x79, 0x61, 0x57, 0xfd, 0x9c, 0x94, 0x7e, 0xb1, 0xd6, 0xcf, 0x0f, 0x67, 0x09, 0x41,
-	0x3b, 0x50, 0xce, 0x38, 0x7e, 0xe4, 0x87, 0xb1, 0xf4, 0x81, 0x2e, 0xb9, 0x96, 0x6b, 0x03, 0x31,
-	0xf1, 0x10, 0x2e, 0x68, 0x33, 0x79, 0x3f, 0x62, 0xb1, 0x5d, 0xea, 0x72, 0xe9, 0xc2, 0x4c, 0x95,
-	0x25, 0x0a, 0xce, 0xa2, 0x43, 0xdf, 0x57, 0x60, 0x21, 0x76, 0xfe, 0x80, 0x1c, 0x52, 0xc7, 0x2d,
-	0x4d, 0xae, 0x6a, 0x57, 0x8b, 0x6b, 0x37, 0x8d, 0x51, 0x52, 0xdf, 0x88, 0x18, 0x54, 0x5f, 0x97,
-	0x7a, 0x2c, 0xd4, 0xd2, 0xac, 0x71, 0xbf, 0x34, 0xd4, 0x05, 0x70, 0x99, 0x69, 0x11, 0xde, 0x73,
-	0xa8, 0x5b, 0x9a, 0x12, 0xb2, 0x6f, 0x8f, 0x21, 0x7b, 0x3b, 0x60, 0x12, 0x05, 0x5d, 0x78, 0xe4,
-	0xe2, 0x98, 0x0c, 0xf4, 0x10, 0x96, 0x62, 0x6a, 0x44, 0x48, 0xa5, 0xc2, 0xaa, 0x76, 0x75, 0xa6,
-	0xfa, 0xfa, 0xf1, 0xd1, 0xca, 0x52, 0x2d, 0x0b, 0x01, 0x67, 0xd3, 0xa1, 0x3d, 0xb8, 0x9c, 0x61,
-	0xdd, 0x4d, 0xda, 0x62, 0xa4, 0x71, 0xd8, 0xa5, 0xa5, 0x69, 0xe1, 0x9e, 0x37, 0xa5, 0x5a, 0x97,
-	0x6b, 0x43, 0x70, 0xf1, 0x50, 0x4e, 0xe8, 0xfd, 0x84, 0xbf, 0xd6, 0x6d, 0x6b, 0x97, 0x99, 0x25,
-	0x10, 0xec, 0xb3, 0xac, 0xee, 0x23, 0xe0, 0x7e, 0x1a, 0xfd, 0x3f, 0x2a, 0xcc, 0x89, 0xff, 0xf5,
-	0x4e, 0xd7, 0x76, 0xf8, 0x76, 0x97, 0x36, 0xd1, 0x43, 0xc8, 0xed, 0x3a, 0x76, 0x47, 0x26, 0xf9,
-	0xf5, 0x93, 0x24, 0x79, 0x98, 0x79, 0x51, 0xf9, 0xf9, 0xaa, 0x63, 0x77, 0xb0, 0x60, 0x84, 0xbe,
-	0x06, 0x2a, 0xb7, 0x45, 0x36, 0x17, 0xd7, 0xd6, 0x86, 0xb3, 0x7b, 0x60, 0x37, 0x49, 0x3b, 0xcd,
-	0x73, 0xd2, 0xab, 0x47, 0x0d, 0x1b, 0xab, 0xdc, 0x46, 0x4f, 0x60, 0x86, 0x09, 0x55, 0xb7, 0xec,
-	0x36, 0x6b, 0x1e, 0xca, 0x94, 0x7e, 0x77, 0xb4, 0x40, 0x69, 0x10, 0xb3, 0x1e, 0x63, 0x52, 0x5d,
-	0x94, 0x4a, 0xcf, 0xc4, 0x4f, 0x71, 0x42, 0x10, 0xba, 0x07, 0x73, 0xcc, 0x6a, 0xb6, 0x7b, 0xad,
-	0x28, 0xdd, 0xbc, 0x5c, 0x2e, 0x54, 0x5f, 0x93, 0xc4, 0x73, 0xf5, 0x24, 0x18, 0xa7, 0xf1, 0xf5,
-	0xbf, 0x2b, 0xb0, 0x10, 0x37, 0x36, 0x27, 0xbc, 0xe7, 0xa2, 0x0f, 0x61, 0xd2, 0x15, 0xbf, 0x4e,
-	0x60, 0xf0, 0x58, 0x3b, 0x34, 0x7c, 0xf2, 0xea, 0xac, 0x14, 0x3f, 0xe9, 0xff, 0xc7, 0x92, 0x19,
-	0x6a, 0x40, 0x5e, 0xdc, 0x59, 0xda, 0xfd, 0x4b, 0x63, 0xa4, 0x52, 0x75, 0xfa, 0xf8, 0x68, 0xc5,
-	0xaf, 0xfe, 0xd8, 0x67, 0x16, 0x74, 0x12, 0x2d, 0xbb, 0x93, 0xe8, 0x1f, 0x2b, 0x00, 0x51, 0x52,
-	0x87, 0x9d, 0x49, 0x19, 0xd8, 0x99, 0xae, 0x40, 0xce, 0x65, 0x4f, 0x7d, 0x25, 0xb5, 0xa8, 0xcf,
-	0x09, 0xf2, 0x6d, 0xf6, 0x94, 0x62, 0x01, 0xf6, 0x7a, 0x62, 0x27, 0x4c, 0x23, 0x2d, 0xd9, 0x13,
-	0xa3, 0x9c, 0x89, 0x70, 0xf4, 0x67, 0x0a, 0x4c, 0xfb, 0x8a, 0x30, 0x97, 0xa3, 0x8f, 0xfa, 0x5a,
-	0x57, 0xe5, 0x84, 0x46, 0xf6, 0xc8, 0x45, 0x07, 0x0b, 0x3b, 0x76, 0x70, 0x12, 0xeb, 0x5f, 0x8f,
-	0x21, 0xcf, 0x38, 0xed, 0xb8, 0x25, 0x55, 0x54, 0xad, 0xb1, 0x4c, 0x7d, 0x41, 0xf2, 0xcf, 0xd7,
-	0x3d, 0x4e, 0xd8, 0x67, 0xa8, 0xff, 0x75, 0x12, 0x66, 0x93, 0x55, 0xe6, 0x0c, 0xdb, 0xf0, 0x2a,
-	0xe4, 0xb8, 0x67, 0x5f, 0x35, 0xe9, 0x2d, 0x61, 0x5a, 0x01, 0x41, 0x57, 0x60, 0xaa, 0x69, 0x5b,
-	0x9c, 0x5a, 0x5c, 0x38, 0x61, 0xa6, 0x5a, 0x3c, 0x3e, 0x5a, 0x99, 0x5a, 0xf7, 0x8f, 0x70, 0x00,
-	0x43, 0x1c, 0xa0, 0x69, 0x5b, 0x2d, 0xc6, 0x99, 0x6d, 0xb9, 0xa5, 0x9c, 0x30, 0xca, 0x7b, 0xa3,
-	0x19, 0x25, 0xbc, 0xef, 0x7a, 0xc0, 0x28, 0x52, 0x3e, 0x3c, 0x72, 0x71, 0x4c, 0x0e, 0xfa, 0x0a,
-	0x5c, 0x10, 0x2c, 0xea, 0x2d, 0x6a, 0x71, 0xc6, 0x0f, 0x65, 0x37, 0x5c, 0x92, 0x64, 0x17, 0xea,
-	0x71, 0x20, 0x4e, 0xe2, 0xa2, 0x1f, 0x2b, 0x30, 0xe3, 0xb5, 0x06, 0xda, 0x5a, 0x6f, 0x13, 0xd6,
-	0x09, 0x9a, 0xdf, 0x07, 0xa7, 0x69, 0x40, 0xe2, 0x12, 0x01, 0xc3, 0x0d, 0x8b, 0x3b, 0xb1, 0x42,
-	0x13, 0x07, 0xe1, 0x84, 0x64, 0x84, 0x61, 0xaa, 0xe9, 0x50, 0x6f, 0xae, 0x2c, 0x4d, 0x0d, 0x9d,
-	0x57, 0xd2, 0xb1, 0xda, 0x60, 0x1d, 0x2a, 0x3d, 0xe2, 0xd3, 0xe3, 0x80, 0x11, 0x32, 0xa1, 0xc0,
-	0x5c, 0xb7, 0x47, 0x5b, 0xd5, 0xc3, 0x52, 0x61, 0x9c, 0x8a, 0x19, 0x5e, 0xaa, 0xee, 0xb1, 0x71,
-	0xaa, 0x33, 0x5e, 0x2a, 0xd4, 0x25, 0x4b, 0x1c, 0x32, 0x47, 0x7b, 0x81, 0xa0, 0x86, 0x2d, 0xda,
-	0x5d, 0x71, 0xed, 0xce, 0x98, 0x82, 0xb6, 0x7b, 0x22, 0x46, 0xe3, 0x92, 0x1a, 0x36, 0x0e, 0xb9,
-	0x97, 0xef, 0xc2, 0x42, 0x9f, 0x7d, 0xd1, 0x3c, 0x68, 0xfb, 0x54, 0x0e, 0xd9, 0xd8, 0xfb, 0x89,
-	0x16, 0x21, 0x7f, 0x40, 0xda, 0x3d, 0x19, 0xd5, 0xd8, 0xff, 0x73, 0x4b, 0xbd, 0xa9, 0xe8, 0xbf,
-	0x55, 0xa1, 0xe8, 0x3b, 0x8c, 0x3b, 0x94, 0x74, 0xce, 0x30, 0xb1, 0xbe, 0x0d, 0x39, 0xb7, 0x4b,
-	0x9b, 0xb2, 0x12, 0xbf, 0x3b, 0x4e, 0x4c, 0x09, 0x15, 0xbd, 0xee, 0x1c, 0xe5, 0xa5, 0xf7, 0x0f,
-	0x0b, 0xc6, 0xc8, 0x0c, 0x5b, 0x88, 0xdf, 0x0e, 0xef, 0x8e, 0x2f, 0x62, 0x68, 0x53, 0xd1, 0x7f,
-	0xaf, 0xc0, 0x7c, 0x0c, 0xfb, 0xac, 0x1f, 0x06, 0x8f, 0x5f, 0x41, 0x0f, 0x8b, 0x0a, 0x6b, 0xac,
-	0x8f, 0xe9, 0xbf, 0x53, 0x65, 0x2b, 0x0e, 0x2e, 0xe2, 0x35, 0xe4, 0x33, 0xbc, 0x09, 0x4d, 0x84,
-	0xc0, 0xfa, 0xd8, 0xfe, 0x89, 0xc6, 0xb4, 0xcc, 0x40, 0xe8, 0xa4, 0x02, 0x61, 0xe3, 0xb4, 0x82,
-	0x86, 0x87, 0xc3, 0xcf, 0x55, 0x58, 0xca, 0x54, 0x0e, 0xbd, 0x05, 0x93, 0xfe, 0xf4, 0x24, 0xec,
-	0x58, 0x88, 0x38, 0xf8, 0x38, 0x58, 0x42, 0x91, 0x03, 0xe0, 0xd0, 0xae, 0xed, 0x32, 0x6e, 0x3b,
-	0x87, 0xd2, 0x3a, 0xd5, 0xd1, 0x94, 0xc6, 0x21, 0x7d, 0xcc, 0x38, 0xb3, 0x9e, 0x27, 0x22, 0x08,
-	0x8e, 0x49, 0x41, 0xd4, 0xd3, 0x8d, 0x98, 0xd4, 0x33, 0x92, 0x36, 0x66, 0x42, 0xc6, 0x45, 0x45,
-	0x57, 0xf3, 0x98, 0x62, 0xc9, 0x5c, 0xff, 0xb3, 0x0a, 0xaf, 0x0d, 0x30, 0x28, 0xfa, 0x28, 0x61,
-	0x9e, 0xe2, 0xda, 0x3b, 0x63, 0xfb, 0xa9, 0x0a, 0x19, 0x56, 0xe5, 0x19, 0x56, 0xad, 0x9d, 0xd2,
-	0xaa, 0x32, 0x12, 0x86, 0xd8, 0xd5, 0x4c, 0xd9, 0xf5, 0xee, 0xf8, 0x76, 0x4d, 0x85, 0x5d, 0xca,
-	0xb2, 0x2f, 0x14, 0xf9, 0x68, 0xf1, 0x4d, 0x70, 0x1e, 0x23, 0xde, 0xb7, 0x92, 0x23, 0xde, 0x29,
-	0xfc, 0x95, 0x3d, 0xe8, 0xfd, 0x4b, 0x01, 0x14, 0xc3, 0xda, 0x24, 0xdd, 0x2e, 0xb3, 0xcc, 0xcf,
-	0x62, 0x69, 0xfd, 0xb4, 0x27, 0xc2, 0xb3, 0xa4, 0xf3, 0x44, 0xb5, 0xd8, 0x4e, 0xbc, 0xc4, 0xa3,
-	0xf0, 0x92, 0x0f, 0x87, 0x37, 0x24, 0x93, 0xa5, 0x5a, 0x16, 0x12, 0xce, 0xa6, 0x45, 0xdf, 0x84,
-	0x1c, 0x27, 0x66, 0xe0, 0xb1, 0x5b, 0x23, 0xbf, 0x10, 0x33, 0xde, 0xb4, 0x0d, 0x62, 0xba, 0x58,
-	0x70, 0xd5, 0xff, 0xa0, 0x24, 0x1a, 0x88, 0xcc, 0xeb, 0x33, 0xb9, 0x08, 0x49, 0x5c, 0x64, 0xc4,
-	0xac, 0xf2, 0xde, 0x5a, 0xad, 0x06, 0x31, 0x37, 0x0e, 0xa8, 0xc5, 0xbd, 0xc0, 0xce, 0xbc, 0xcd,
-	0x33, 0x2d, 0x78, 0x67, 0x88, 0xdb, 0x34, 0xc8, 0x59, 0x86, 0xde, 0x87, 0x7e, 0x80, 0xf8, 0x81,
-	0x77, 0x1a, 0xbf, 0x4c, 0x25, 0xb6, 0x98, 0x6b, 0x00, 0x72, 0x23, 0xcb, 0x6c, 0x4b, 0x84, 0x9f,
-	0x16, 0x29, 0xf2, 0x7e, 0x08, 0xc1, 0x31, 0x2c, 0xe4, 0x66, 0xbc, 0x54, 0xee, 0x8e, 0xac, 0x91,
-	0xb0, 0xed, 0xc9, 0x1f, 0x2a, 0x61, 0xea, 0xe5, 0x5f, 0xf5, 0x54, 0xf3, 0xa7, 0x64, 0x15, 0x69,
-	0x10, 0xf3, 0x3c, 0x6a, 0x23, 0x49, 0xd6, 0xc6, 0xdb, 0x63, 0xd7, 0xc6, 0x06, 0x31, 0x07, 0x94,
-	0xc7, 0x1f, 0xa9, 0xb0, 0xd0, 0x17, 0xc9, 0x41, 0xa5, 0x51, 0x06, 0xac, 0xb5, 0xbf, 0x91, 0xd4,
-	0xeb, 0xcb, 0xe3, 0xf9, 0x35, 0x5b, 0xa3, 0x54, 0xe4, 0x68, 0xe7, 0x12, 0x39, 0xfa, 0xaf, 0x54,
-	0x58, 0xcc, 0x1a, 0x77, 0x5e, 0xfd, 0xca, 0x2e, 0xbd, 0x66, 0x53, 0xff, 0x87, 0x6b, 0x36, 0x6d,
-	0xc4, 0x35, 0xdb, 0xcf, 0x54, 0xb8, 0x94, 0x3d, 0xbe, 0x9c, 0xd5, 0xae, 0x2d, 0x9a, 0x7c, 0xd4,
-	0x33, 0x9d, 0x7c, 0xd0, 0x2d, 0x98, 0x25, 0x2d, 0x3f, 0x1a, 0x48, 0xdb, 0xab, 0xdf, 0x22, 0xf2,
-	0xa6, 0xab, 0xe8, 0xf8, 0x68, 0x65, 0xf6, 0x5e, 0x02, 0x82, 0x53, 0x98, 0xfa, 0x91, 0x06, 0xa8,
-	0x7f, 0xad, 0x82, 0x6e, 0xc9, 0xad, 0x8f, 0x9f, 0x45, 0x6f, 0xc5, 0xb7, 0x3e, 0x9f, 0x1c, 0xad,
-	0x5c, 0xea, 0xa7, 0x88, 0xed, 0x83, 0x1e, 0x85, 0xe6, 0xf4, 0x77, 0x46, 0x77, 0x92, 0xf6, 0xf9,
-	0xe4, 0x68, 0x65, 0xe8, 0x37, 0x3a, 0x23, 0xe4, 0x99, 0xb2, 0xe7, 0x1e, 0x5c, 0x68, 0x13, 0x97,
-	0x6f, 0x39, 0xf6, 0x0e, 0x6d, 0x30, 0xf9, 0x69, 0x6b, 0xc4, 0x45, 0x48, 0xb8, 0xf7, 0x79, 0x10,
-	0xe7, 0x84, 0x93, 0x8c, 0xd1, 0x13, 0x40, 0xde, 0x41, 0xc3, 0x21, 0x96, 0xeb, 0xdf, 0xce, 0x13,
-	0x97, 0x1b, 0x5d, 0x5c, 0x59, 0x8a, 0x43, 0x0f, 0xfa, 0xd8, 0xe1, 0x0c, 0x11, 0xde, 0x03, 0xc9,
-	0xa1, 0xc4, 0xb5, 0x2d, 0xb9, 0xa6, 0x0a, 0x3d, 0x8e, 0xc5, 0x29, 0x96, 0x50, 0xf4, 0x05, 0x98,
-	0xea, 0x50, 0xd7, 0xf5, 0xda, 0xc5, 0xa4, 0x40, 0x9c, 0x93, 0x88, 0x53, 0x9b, 0xfe, 0x31, 0x0e,
-	0xe0, 0xfa, 0xc7, 0x0a, 0x44, 0xee, 0x12, 0x0d, 0x8f, 0x35, 0x37, 0xfc, 0xf5, 0xd6, 0x4d, 0x98,
-	0xb1, 0x1d, 0x93, 0x58, 0xec, 0xa9, 0xdf, 0x1d, 0x7d, 0x67, 0x87, 0xf9, 0xf8, 0x30, 0x06, 0xc3,
-	0x09, 0x4c, 0xaf, 0xab, 0x36, 0xed, 0x4e, 0xc7, 0xb6, 0xbc, 0xf2, 0x2b, 0xdd, 0x1c, 0x2b, 0x53,
-	0x01, 0x04, 0xc7, 0xb0, 0xf4, 0xdf, 0x28, 0x30, 0x97, 0x5a, 0x18, 0xa1, 0x5f, 0x28, 0x70, 0xc9,
-	0xcd, 0x54, 0x4e, 0xa6, 0x62, 0x6d, 0xcc, 0x3d, 0x51, 0x82, 0x57, 0x75, 0x59, 0xaa, 0x36, 0xc0,
-	0x10, 0x78, 0x80, 0x0e, 0xfa, 0xbf, 0x15, 0x98, 0x4f, 0xaf, 0x9e, 0xfe, 0xcf, 0x75, 0x46, 0x6f,
-	0x43, 0xb1, 0xdb, 0xdb, 0x69, 0xb3, 0xe6, 0x7d, 0x7a, 0x58, 0xaf, 0x49, 0xdf, 0x5c, 0x94, 0xcc,
-	0x8a, 0x5b, 0x11, 0x08, 0xc7, 0xf1, 0xf4, 0x9f, 0xa8, 0x50, 0x08, 0x5a, 0x0f, 0x7a, 0x14, 0x2d,
-	0x1b, 0x95, 0xd1, 0x83, 0x3e, 0x8c, 0xc5, 0xbe, 0x85, 0xe3, 0xab, 0xff, 0xa4, 0xfb, 0xf9, 0x60,
-	0x6a, 0xf2, 0x1f, 0x16, 0xd9, 0x6f, 0x8f, 0xe4, 0x0c, 0x98, 0x3b, 0xc9, 0x0c, 0xa8, 0xff, 0x5a,
-	0x83, 0x85, 0xbe, 0x56, 0x8c, 0xde, 0x49, 0x94, 0xc5, 0x2b, 0xa9, 0xb2, 0xb8, 0xd4, 0x47, 0x70,
-	0x0e, 0x55, 0x31, 0xbb, 0x56, 0x69, 0xe7, 0x59, 0xab, 0x72, 0x27, 0xad, 0x55, 0xf9, 0xe1, 0xb5,
-	0x2a, 0xe5, 0xa8, 0xc9, 0x13, 0x39, 0xaa, 0x0b, 0x73, 0xa9, 0x89, 0x02, 0x5d, 0x83, 0x02, 0xb3,
-	0x5c, 0xda, 0xec, 0x39, 0x54, 0x2e, 0x9a, 0xc2, 0x41, 0xb5, 0x2e, 0xcf, 0x71, 0x88, 0x81, 0x2a,
-	0x30, 0xed, 0x36, 0xf7, 0x68, 0xab, 0xd7, 0xa6, 0x2d, 0xe1, 0x9b, 0x42, 0xf4, 0x15, 0x69, 0x3b,
-	0x00, 0xe0, 0x08, 0x47, 0xff, 0x65, 0x1e, 0x66, 0xe2, 0x2f, 0x8e, 0x13, 0x7c, 0xd0, 0xfa, 0x81,
-	0x02, 0x45, 0x62, 0x59, 0x36, 0x27, 0xfe, 0x64, 0xe8, 0x0f, 0x04, 0xf7, 0xc7, 0x7f, 0xe5, 0x18,
-	0xf7, 0x22, 0x6e, 0xfe, 0x47, 0x84, 0x30, 0xc5, 0x63, 0x10, 0x1c, 0x17, 0x8a, 0xee, 0xcb, 0x71,
-	0x50, 0x1b, 0x67, 0x1c, 0x2c, 0xa4, 0x46, 0xc1, 0x0a, 0x4c, 0x3b, 0x61, 0xfe, 0xe6, 0x92, 0x56,
-	0x8b, 0x92, 0x36, 0xc2, 0x41, 0x46, 0xc2, 0xb7, 0x79, 0xe1, 0xdb, 0xd9, 0x21, 0x8f, 0xb0, 0xf4,
-	0xac, 0x39, 0x79, 0x5e, 0xb3, 0xe6, 0x0f, 0x15, 0x98, 0x0b, 0xd5, 0x96, 0xc2, 0xfd, 0x4f, 0x2e,
-	0xef, 0x8d, 0xef, 0x2f, 0x29, 0x3f, 0x1c, 0x57, 0x53, 0x00, 0x9c, 0x96, 0x58, 0xbe, 0x03, 0xf3,
-	0x69, 0x17, 0x8f, 0xf4, 0x1d, 0x63, 0x0b, 0x50, 0xbf, 0xfc, 0x4f, 0x1b, 0xeb, 0xfa, 0x29, 0xa2,
-	0x02, 0x56, 0x7d, 0xf3, 0xf9, 0xcb, 0xe5, 0x89, 0x17, 0x2f, 0x97, 0x27, 0xfe, 0xf2, 0x72, 0x79,
-	0xe2, 0x7b, 0xc7, 0xcb, 0xca, 0xf3, 0xe3, 0x65, 0xe5, 0xc5, 0xf1, 0xb2, 0xf2, 0x8f, 0xe3, 0x65,
-	0xe5, 0xa7, 0xff, 0x5c, 0x9e, 0xf8, 0xba, 0x7a, 0x70, 0xe3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x9e, 0x8d, 0xce, 0xae, 0x51, 0x26, 0x00, 0x00,
+	// 2316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x5a, 0xcf, 0x6f, 0x1c, 0x49,
+	0xf5, 0x4f, 0x77, 0x8f, 0xed, 0xf1, 0x9b, 0x89, 0x1d, 0x57, 0xec, 0xec, 0xec, 0x7c, 0xb3, 0xb6,
+	0xd5, 0xdf, 0xcd, 0x2a, 0x88, 0xa4, 0x47, 0x31, 0x5a, 0x94, 0xcd, 0x86, 0x64, 0x33, 0x99, 0xb0,
+	0x1a, 0x12, 0x6f, 0xa2, 0xf2, 0x6c, 0x14, 0x01, 0x0b, 0x2a, 0xf7, 0x94, 0xdb, 0x85, 0x67, 0xba,
+	0x47, 0xdd, 0x35, 0x0e, 0xce, 0x89, 0x5f, 0x42, 0x5c, 0x56, 0xe2, 0xc0, 0x09, 0x71, 0x02, 0x89,
+	0x33, 0xfc, 0x0d, 0x44, 0x28, 0x12, 0x97, 0x88, 0x03, 0x3f, 0x2e, 0x86, 0x98, 0x03, 0x08, 0xf1,
+	0x17, 0xec, 0x09, 0x55, 0x75, 0x4d, 0xff, 0x9a, 0xf6, 0xc4, 0x33, 0x89, 0x0d, 0xdc, 0x3c, 0xf5,
+	0x5e, 0xbd, 0xf7, 0xea, 0xf3, 0x5e, 0xbd, 0xf7, 0xea, 0xb5, 0xe1, 0xba, 0xc3, 0xf8, 0x76, 0x7f,
+	0xd3, 0xb2, 0xbd, 0x6e, 0xcd, 0xeb, 0x51, 0x37, 0xd8, 0x66, 0x5b, 0xbc, 0xe6, 0xf9, 0xcc, 0x61,
+	0x6e, 0xad, 0xb7, 0xe3, 0xd4, 0x58, 0x97, 0x38, 0xb4, 0x46, 0x7a, 0xac, 0xb6, 0x7b, 0xa5, 0xe6,
+	0x50, 0x97, 0xfa, 0x84, 0xd3, 0xb6, 0xd5, 0xf3, 0x3d, 0xee, 0xa1, 0x4b, 0xf1, 0x6e, 0x2b, 0xda,
+	0x6d, 0x85, 0xbb, 0xad, 0xde, 0x8e, 0x63, 0xc9, 0xdd, 0x16, 0xe9, 0x31, 0x6b, 0xf7, 0x4a, 0xf5,
+	0x72, 0x42, 0x97, 0xe3, 0x39, 0x5e, 0x4d, 0x0a, 0xd9, 0xec, 0x6f, 0xc9, 0x5f, 0xf2, 0x87, 0xfc,
+	0x2b, 0x14, 0x5e, 0x7d, 0x77, 0xe7, 0x6a, 0x60, 0x31, 0xaf, 0xb6, 0xd3, 0xdf, 0xa4, 0xbe, 0x4b,
+	0x39, 0x0d, 0xa4, 0x41, 0xc2, 0x94, 0xbe, 0xbb, 0x4b, 0xfd, 0x80, 0x79, 0x2e, 0x6d, 0x67, 0x6d,
+	0xaa, 0x5e, 0x3a, 0x7c, 0xdb, 0xf0, 0x09, 0xaa, 0x97, 0xf3, 0xb9, 0xfd, 0xbe, 0xcb, 0x59, 0x97,
+	0x0e, 0xb1, 0x5f, 0xc9, 0x67, 0xef, 0x73, 0xd6, 0xa9, 0x31, 0x97, 0x07, 0xdc, 0xcf, 0x6e, 0x31,
+	0x7f, 0xaf, 0xc1, 0x62, 0xc3, 0xb3, 0x77, 0xa8, 0xdf, 0x14, 0x60, 0x60, 0xba, 0x45, 0x7d, 0xea,
+	0xda, 0x14, 0x5d, 0x82, 0xa2, 0x4f, 0x1d, 0x16, 0x70, 0x7f, 0xaf, 0xa2, 0xad, 0x6a, 0x17, 0x67,
+	0xeb, 0x67, 0x9e, 0xed, 0xaf, 0x9c, 0x3a, 0xd8, 0x5f, 0x29, 0x62, 0xb5, 0x8e, 0x23, 0x0e, 0x54,
+	0x83, 0x59, 0x97, 0x74, 0x69, 0xd0, 0x23, 0x36, 0xad, 0xe8, 0x92, 0x7d, 0x41, 0xb1, 0xcf, 0x7e,
+	0x34, 0x20, 0xe0, 0x98, 0x07, 0xad, 0x42, 0x41, 0xfc, 0xa8, 0x18, 0x92, 0xb7, 0xac, 0x78, 0x0b,
+	0x82, 0x17, 0x4b, 0x0a, 0x7a, 0x0b, 0x0c, 0x4e, 0x9c, 0x4a, 0x41, 0x32, 0x94, 0x14, 0x83, 0xd1,
+	0x22, 0x0e, 0x16, 0xeb, 0xa8, 0x0a, 0x3a, 0x6b, 0x54, 0xa6, 0x24, 0x15, 0x14, 0x55, 0x6f, 0x36,
+	0xb0, 0xce, 0x1a, 0xe6, 0xbf, 0x8a, 0x30, 0x25, 0x8f, 0x83, 0x1e, 0x41, 0xb1, 0x4b, 0x39, 0x69,
+	0x13, 0x4e, 0xe4, 0x29, 0x4a, 0x6b, 0x17, 0xad, 0x10, 0x24, 0x2b, 0x06, 0x49, 0xc6, 0x42, 0x18,
+	0x05, 0xd6, 0xfd, 0xcd, 0x6f, 0x51, 0x9b, 0xaf, 0x53, 0x4e, 0xea, 0x48, 0x49, 0x85, 0x78, 0x0d,
+	0x47, 0xd2, 0xd0, 0x03, 0x58, 0x6c, 0xe7, 0xe0, 0xa6, 0x0e, 0x7f, 0x5e, 0xed, 0xcd, 0xc5, 0x16,
+	0xe7, 0xee, 0x44, 0x4f, 0xe0, 0x6c, 0x62, 0x7d, 0x7d, 0x60, 0xb6, 0x21, 0xcd, 0xfe, 0xfc, 0x21,
+	0x66, 0xab, 0x50, 0xb0, 0x30, 0x79, 0x7c, 0xe7, 0xdb, 0x9c, 0xba, 0x22, 0xec, 0xea, 0xff, 0xa7,
+	0xb4, 0x9f, 0x6d, 0x0c, 0xcb, 0xc3, 0x79, 0x4a, 0xd0, 0x26, 0x54, 0x73, 0x96, 0x1f, 0x86, 0x61,
+	0xac, 0x7c, 0x60, 0x2a, 0xa9, 0xd5, 0xc6, 0xa1, 0x9c, 0x78, 0x84, 0x14, 0xb4, 0x9e, 0x3e, 0x1f,
+	0x71, 0xd9, 0x16, 0x0d, 0xb8, 0x72, 0x61, 0xae, 0xc9, 0x8a, 0x05, 0xe7, 0xed, 0x43, 0xdf, 0xd5,
+	0x60, 0x21, 0xb1, 0x7e, 0x8f, 0xec, 0x51, 0x3f, 0xa8, 0x4c, 0xaf, 0x1a, 0x17, 0x4b, 0x6b, 0x57,
+	0xad, 0x71, 0xae, 0xbe, 0x15, 0x0b, 0xa8, 0xbf, 0xa9, 0xec, 0x58, 0x68, 0x64, 0x45, 0xe3, 0x61,
+	0x6d, 0xa8, 0x07, 0x10, 0x30, 0xc7, 0x25, 0xbc, 0xef, 0xd3, 0xa0, 0x32, 0x23, 0x75, 0x5f, 0x9f,
+	0x40, 0xf7, 0xc6, 0x40, 0x48, 0x1c, 0x74, 0xd1, 0x52, 0x80, 0x13, 0x3a, 0xd0, 0x7d, 0x58, 0x4a,
+	0x98, 0x11, 0x33, 0x55, 0x8a, 0xab, 0xc6, 0xc5, 0x72, 0xfd, 0xcd, 0x83, 0xfd, 0x95, 0xa5, 0x46,
+	0x1e, 0x03, 0xce, 0xdf, 0x87, 0xb6, 0xe1, 0x7c, 0x0e, 0xba, 0xeb, 0xb4, 0xcd, 0x48, 0x6b, 0xaf,
+	0x47, 0x2b, 0xb3, 0xd2, 0x3d, 0x6f, 0x2b, 0xb3, 0xce, 0x37, 0x46, 0xf0, 0xe2, 0x91, 0x92, 0xd0,
+	0x87, 0x29, 0x7f, 0xdd, 0xf6, 0xdc, 0x2d, 0xe6, 0x54, 0x40, 0x8a, 0xcf, 0x43, 0x3d, 0x64, 0xc0,
+	0xc3, 0x7b, 0xd0, 0xa7, 0x1a, 0x2c, 0xe6, 0x68, 0x0a, 0x2a, 0x25, 0xe9, 0x80, 0xf7, 0x27, 0x70,
+	0xc0, 0x40, 0x46, 0xee, 0xc5, 0x8d, 0x14, 0xe0, 0x5c, 0xb5, 0xe6, 0x3f, 0x75, 0x98, 0x97, 0x4b,
+	0xcd, 0x6e, 0xcf, 0xf3, 0xf9, 0x46, 0x8f, 0xda, 0xe8, 0x3e, 0x14, 0xb6, 0x7c, 0xaf, 0xab, 0x92,
+	0xce, 0xe5, 0xa3, 0x24, 0x9d, 0x28, 0x13, 0xc4, 0xe9, 0xf0, 0xcb, 0xbe, 0xd7, 0xc5, 0x52, 0x10,
+	0xfa, 0x0a, 0xe8, 0xdc, 0x93, 0xd9, 0xa5, 0xb4, 0xb6, 0x36, 0x5a, 0xdc, 0x3d, 0xcf, 0x26, 0x9d,
+	0xac, 0xcc, 0x69, 0x91, 0x1f, 0x5b, 0x1e, 0xd6, 0xb9, 0x87, 0x1e, 0x43, 0x99, 0x49, 0x53, 0x1f,
+	0x78, 0x1d, 0x66, 0xef, 0xa9, 0x14, 0xf3, 0xa5, 0xf1, 0x70, 0x6b, 0x11, 0xa7, 0x99, 0x10, 0x52,
+	0x5f, 0x54, 0x46, 0x97, 0x93, 0xab, 0x38, 0xa5, 0x08, 0xdd, 0x82, 0x79, 0xe6, 0xda, 0x9d, 0x7e,
+	0x3b, 0xbe, 0xfe, 0x22, 0xb7, 0x14, 0xeb, 0x6f, 0xa8, 0xcd, 0xf3, 0xcd, 0x34, 0x19, 0x67, 0xf9,
+	0xcd, 0xbf, 0x68, 0xb0, 0x90, 0x04, 0x9b, 0x13, 0xde, 0x0f, 0xd0, 0xc7, 0x30, 0x1d, 0xc8, 0xbf,
+	0x8e, 0x00, 0x78, 0xa2, 0x3c, 0x5b, 0xe1, 0xf6, 0xfa, 0x9c, 0x52, 0x3f, 0x1d, 0xfe, 0xc6, 0x4a,
+	0x18, 0x6a, 0xc1, 0x94, 0x3c, 0xb3, 0xc2, 0xfd, 0x0b, 0x13, 0x44, 0x56, 0x7d, 0xf6, 0x60, 0x7f,
+	0x25, 0xac, 0x46, 0x38, 0x14, 0x36, 0xa8, 0x6c, 0x46, 0x7e, 0x65, 0x33, 0x7f, 0xa8, 0x01, 0xc4,
+	0x49, 0x26, 0xaa, 0x94, 0xda, 0xa1, 0x95, 0xf2, 0x02, 0x14, 0x02, 0xf6, 0x24, 0x34, 0xd2, 0x88,
+	0xeb, 0xae, 0xdc, 0xbe, 0xc1, 0x9e, 0x50, 0x2c, 0xc9, 0xa2, 0x46, 0x77, 0xa3, 0x6b, 0x6d, 0xa4,
+	0x6b, 0x74, 0x7c, 0x87, 0x63, 0x1e, 0xf3, 0xa9, 0x06, 0xb3, 0xa1, 0x21, 0x2c, 0xe0, 0xe8, 0x93,
+	0xa1, 0x52, 0x5a, 0x3b, 0x22, 0xc8, 0x62, 0xbb, 0xac, 0xa8, 0x51, 0x07, 0x31, 0x58, 0x49, 0xd4,
+	0xd3, 0x47, 0x30, 0xc5, 0x38, 0xed, 0x06, 0x15, 0x7d, 0xd5, 0x98, 0x14, 0xea, 0xd3, 0x4a, 0xfe,
+	0x54, 0x53, 0x48, 0xc2, 0xa1, 0x40, 0xf3, 0x17, 0x3a, 0x9c, 0x4e, 0x97, 0x8e, 0x77, 0x60, 0xba,
+	0xcd, 0x1c, 0x11, 0x7d, 0x21, 0xa8, 0x91, 0xfb, 0x1b, 0x72, 0x15, 0x2b, 0x6a, 0x1a, 0x31, 0xfd,
+	0xe5, 0x88, 0xa1, 0xab, 0x50, 0xee, 0x2a, 0x25, 0x02, 0x78, 0x89, 0xb2, 0x11, 0xdf, 0x8c, 0xf5,
+	0x04, 0x0d, 0xa7, 0x38, 0xc5, 0x4e, 0xe2, 0xdb, 0xdb, 0x8c, 0x53, 0x5b, 0xe4, 0x65, 0x55, 0x72,
+	0xa3, 0x9d, 0xb7, 0x12, 0x34, 0x9c, 0xe2, 0x14, 0x8d, 0x90, 0x17, 0x64, 0x1b, 0xa1, 0xfb, 0x1b,
+	0x58, 0xf7, 0x02, 0xf4, 0x39, 0x98, 0xd9, 0x25, 0x3e, 0x23, 0x2e, 0xaf, 0x4c, 0x4b, 0x86, 0x79,
+	0xc5, 0x30, 0xf3, 0x30, 0x5c, 0xc6, 0x03, 0xba, 0xf9, 0xe7, 0x69, 0x98, 0x4b, 0xd7, 0x86, 0x63,
+	0x6c, 0x9e, 0x56, 0xa1, 0xc0, 0x63, 0x4c, 0xa3, 0x98, 0x96, 0x70, 0x4a, 0x0a, 0xba, 0x00, 0x33,
+	0xb6, 0xe7, 0x72, 0xea, 0x72, 0x09, 0x62, 0xb9, 0x5e, 0x12, 0x56, 0xdf, 0x0e, 0x97, 0xf0, 0x80,
+	0x86, 0x38, 0x80, 0xed, 0xb9, 0x6d, 0xc6, 0x99, 0xe7, 0x06, 0x95, 0x82, 0x0c, 0x9d, 0x0f, 0xc6,
+	0x0b, 0x9d, 0xe8, 0xbc, 0xb7, 0x07, 0x82, 0x62, 0xe3, 0xa3, 0xa5, 0x00, 0x27, 0xf4, 0xa0, 0xf7,
+	0xe1, 0xb4, 0x14, 0xd1, 0x6c, 0x53, 0x97, 0x33, 0xbe, 0xa7, 0xd0, 0x5f, 0x52, 0xdb, 0x4e, 0x37,
+	0x93, 0x44, 0x9c, 0xe6, 0x45, 0x3f, 0xd2, 0xa0, 0x2c, 0x0a, 0x3a, 0x6d, 0xdf, 0xee, 0x10, 0xd6,
+	0x1d, 0xb4, 0x2c, 0x1f, 0xbd, 0x4a, 0xdb, 0x60, 0x6d, 0x24, 0x04, 0xde, 0x71, 0xb9, 0x9f, 0x48,
+	0xc7, 0x49, 0x12, 0x4e, 0x69, 0x46, 0x18, 0x66, 0x6c, 0x9f, 0x8a, 0xd7, 0x40, 0x65, 0x66, 0x64,
+	0x97, 0x99, 0xbd, 0xd1, 0x2d, 0xd6, 0xa5, 0xca, 0x23, 0xe1, 0x7e, 0x3c, 0x10, 0x84, 0x1c, 0x28,
+	0xb2, 0x20, 0xe8, 0xd3, 0x76, 0x7d, 0xaf, 0x52, 0x9c, 0xa4, 0xae, 0x44, 0x87, 0x6a, 0x0a, 0x31,
+	0x7e, 0xbd, 0x2c, 0x12, 0x46, 0x53, 0x89, 0xc4, 0x91, 0x70, 0xb4, 0x3d, 0x50, 0xd4, 0xf2, 0x64,
+	0x93, 0x52, 0x5a, 0xbb, 0x31, 0xa1, 0xa2, 0x8d, 0xbe, 0x8c, 0xd1, 0xa4, 0xa6, 0x96, 0x87, 0x23,
+	0xe9, 0xd5, 0x9b, 0xb0, 0x30, 0x84, 0x2f, 0x3a, 0x03, 0xc6, 0x0e, 0x55, 0x4f, 0x23, 0x2c, 0xfe,
+	0x44, 0x8b, 0x30, 0xb5, 0x4b, 0x3a, 0x7d, 0x15, 0xd5, 0x38, 0xfc, 0x71, 0x4d, 0xbf, 0xaa, 0x99,
+	0xbf, 0xd6, 0xa1, 0x14, 0x3a, 0x8c, 0xfb, 0x94, 0x74, 0x8f, 0xf1, 0x62, 0x7d, 0x13, 0x0a, 0x41,
+	0x8f, 0xda, 0x15, 0x7d, 0x12, 0xe4, 0x13, 0x26, 0x8a, 0x1e, 0x26, 0xbe, 0x97, 0xe2, 0x17, 0x96,
+	0x82, 0x91, 0x13, 0x15, 0xda, 0xb0, 0x69, 0xb8, 0x39, 0xb9, 0x8a, 0x91, 0xa5, 0xd7, 0xfc, 0xad,
+	0x06, 0x67, 0x12, 0xdc, 0xc7, 0xfd, 0x9c, 0x7b, 0xf4, 0x1a, 0x2a, 0x7d, 0x5c, 0x7e, 0x12, 0xd5,
+	0xde, 0xfc, 0x8d, 0x0e, 0x0b, 0xa9, 0x83, 0x88, 0xb6, 0xe5, 0x18, 0x4f, 0x42, 0x53, 0x21, 0x70,
+	0x7b, 0x62, 0xff, 0xc4, 0xcd, 0x6c, 0x6e, 0x20, 0x74, 0x33, 0x81, 0x70, 0xe7, 0x55, 0x15, 0x8d,
+	0x0e, 0x87, 0x9f, 0xea, 0xb0, 0x94, 0x6b, 0x9c, 0x28, 0xe6, 0x61, 0x8f, 0x29, 0x71, 0x2c, 0xc6,
+	0x12, 0x42, 0x1e, 0xac, 0xa8, 0xc8, 0x07, 0xf0, 0x69, 0xcf, 0x0b, 0x18, 0xf7, 0xfc, 0x3d, 0x85,
+	0x4e, 0x7d, 0x3c, 0xa3, 0x71, 0xb4, 0x3f, 0x01, 0xce, 0x9c, 0xf0, 0x44, 0x4c, 0xc1, 0x09, 0x2d,
+	0x88, 0x0a, 0xdb, 0x88, 0x43, 0x05, 0x48, 0xc6, 0x84, 0x17, 0x32, 0xa9, 0x2a, 0x3e, 0x9a, 0x10,
+	0x8a, 0x95, 0x70, 0xf3, 0x8f, 0x3a, 0xbc, 0x71, 0x08, 0xa0, 0xe8, 0x93, 0x14, 0x3c, 0xa5, 0xb5,
+	0xf7, 0x26, 0xf6, 0x53, 0x1d, 0x72, 0x50, 0xe5, 0x39, 0xa8, 0x36, 0x5e, 0x11, 0x55, 0x15, 0x09,
+	0x23, 0x70, 0x75, 0x32, 0xb8, 0xde, 0x9c, 0x1c, 0xd7, 0x4c, 0xd8, 0x65, 0x90, 0x7d, 0xae, 0xc1,
+	0x7c, 0x02, 0x82, 0x93, 0x68, 0x84, 0xbf, 0x91, 0x6e, 0x84, 0x5f, 0xc1, 0x5f, 0xf9, 0xed, 0xf0,
+	0xdf, 0x35, 0x40, 0x09, 0xae, 0x75, 0xd2, 0xeb, 0x31, 0xd7, 0xf9, 0x5f, 0x4c, 0xad, 0x2f, 0x7b,
+	0x48, 0x3d, 0x4d, 0x3b, 0x4f, 0x66, 0x8b, 0x8d, 0xd4, 0xfc, 0x24, 0x0e, 0x2f, 0xf5, 0x12, 0x78,
+	0x4b, 0x09, 0x59, 0x6a, 0xe4, 0x31, 0xe1, 0xfc, 0xbd, 0xe8, 0xeb, 0x50, 0xe0, 0xc4, 0x19, 0x78,
+	0xec, 0xda, 0xd8, 0xef, 0xe8, 0x9c, 0x97, 0x7f, 0x8b, 0x38, 0x01, 0x96, 0x52, 0xcd, 0xdf, 0x69,
+	0xa9, 0x02, 0xa2, 0xee, 0xf5, 0xb1, 0x1c, 0x84, 0xa4, 0x0e, 0x32, 0xe6, 0xad, 0x12, 0x2f, 0xd2,
+	0x76, 0x8b, 0x38, 0x77, 0x76, 0xa9, 0xcb, 0x45, 0x60, 0xe7, 0x9e, 0xe6, 0xa9, 0x01, 0x73, 0x89,
+	0xd3, 0xb4, 0xc8, 0x71, 0x86, 0xde, 0xc7, 0x61, 0x80, 0x84, 0x81, 0xf7, 0x2a, 0x7e, 0x99, 0x49,
+	0xcd, 0x9e, 0xd7, 0x00, 0xd4, 0x1c, 0x5d, 0x4c, 0x47, 0xc3, 0x47, 0x5e, 0x64, 0xc8, 0x87, 0x11,
+	0x05, 0x27, 0xb8, 0x50, 0x90, 0xf3, 0x52, 0xb9, 0x39, 0xb6, 0x45, 0x12, 0xdb, 0xa3, 0x3f, 0x54,
+	0xa2, 0xab, 0x37, 0xf5, 0xba, 0xbb, 0x9a, 0x3f, 0xa4, 0xb3, 0x48, 0x8b, 0x38, 0x27, 0x91, 0x1b,
+	0x49, 0x3a, 0x37, 0x5e, 0x9f, 0x38, 0x37, 0xb6, 0x88, 0x73, 0x48, 0x7a, 0xfc, 0x81, 0x0e, 0x0b,
+	0x43, 0x91, 0x3c, 0xc8, 0x34, 0xda, 0x21, 0x1f, 0x23, 0xbe, 0x96, 0xb6, 0xeb, 0x8b, 0x93, 0xf9,
+	0x35, 0xdf, 0xa2, 0x4c, 0xe4, 0x18, 0x27, 0x12, 0x39, 0x62, 0x68, 0xb2, 0x98, 0xd7, 0xee, 0xbc,
+	0xfe, 0xc1, 0x66, 0x76, 0x18, 0xa9, 0xff, 0x07, 0x87, 0x91, 0xc6, 0x98, 0xc3, 0xc8, 0x9f, 0xe8,
+	0x70, 0x2e, 0xbf, 0x7d, 0x39, 0xae, 0x89, 0x64, 0xdc, 0xf9, 0xe8, 0xc7, 0xda, 0xf9, 0xa0, 0x6b,
+	0x30, 0x47, 0xda, 0x61, 0x34, 0x90, 0x8e, 0xc8, 0xdf, 0x32, 0xf2, 0x66, 0xeb, 0xe8, 0x60, 0x7f,
+	0x65, 0xee, 0x56, 0x8a, 0x82, 0x33, 0x9c, 0xe6, 0xbe, 0x01, 0x68, 0x78, 0xac, 0x82, 0xae, 0xa9,
+	0xa9, 0x4f, 0x78, 0x8b, 0xde, 0x49, 0x4e, 0x7d, 0x3e, 0xdb, 0x5f, 0x39, 0x37, 0xbc, 0x23, 0x31,
+	0x0f, 0x7a, 0x18, 0xc1, 0x19, 0xce, 0x8c, 0x6e, 0xa4, 0xf1, 0xf9, 0x6c, 0x7f, 0x65, 0xe4, 0x97,
+	0x55, 0x2b, 0x92, 0x99, 0xc1, 0x73, 0x1b, 0x4e, 0x77, 0x48, 0xc0, 0x1f, 0xf8, 0xde, 0x26, 0x15,
+	0x53, 0x8d, 0x97, 0x7c, 0x6e, 0xcb, 0x1d, 0x84, 0x44, 0x73, 0x9f, 0x7b, 0x49, 0x49, 0x38, 0x2d,
+	0x18, 0x3d, 0x06, 0x24, 0x16, 0x5a, 0x3e, 0x71, 0x83, 0xf0, 0x74, 0xac, 0x1b, 0xce, 0xf9, 0xc6,
+	0x54, 0x57, 0x55, 0xea, 0xd0, 0xbd, 0x21, 0x71, 0x38, 0x47, 0x85, 0x78, 0x20, 0xf9, 0x94, 0x04,
+	0x9e, 0xab, 0xc6, 0x54, 0x91, 0xc7, 0xb1, 0x5c, 0xc5, 0x8a, 0x2a, 0x86, 0x85, 0x5d, 0x1a, 0x04,
+	0xa2, 0x5c, 0x64, 0x86, 0x85, 0xeb, 0xe1, 0x32, 0x1e, 0xd0, 0xc5, 0x88, 0x3a, 0x76, 0x97, 0x2c,
+	0x78, 0xcc, 0xbe, 0x13, 0x8e, 0xb7, 0xae, 0x42, 0xd9, 0xf3, 0x1d, 0xe2, 0xb2, 0x27, 0x61, 0x75,
+	0xd4, 0xd2, 0x83, 0xcc, 0xfb, 0x09, 0x1a, 0x4e, 0x71, 0x8a, 0xaa, 0x6a, 0x7b, 0xdd, 0xae, 0xe7,
+	0x8a, 0xf4, 0xab, 0xdc, 0x9c, 0x48, 0x53, 0x03, 0x0a, 0x4e, 0x70, 0x99, 0xbf, 0xd2, 0x60, 0x3e,
+	0x33, 0x30, 0x42, 0x3f, 0xd3, 0xe0, 0x5c, 0x90, 0x6b, 0x5c, 0x45, 0x9b, 0xe4, 0x7d, 0x92, 0x7f,
+	0xd0, 0xfa, 0xb2, 0x32, 0xed, 0x10, 0x20, 0xf0, 0x21, 0x36, 0x98, 0xff, 0xd0, 0xe0, 0x4c, 0x76,
+	0xf4, 0xf4, 0x5f, 0x6e, 0x33, 0x7a, 0x17, 0x4a, 0xbd, 0xfe, 0x66, 0x87, 0xd9, 0x77, 0xe9, 0x5e,
+	0xb3, 0xa1, 0x7c, 0x73, 0x56, 0x09, 0x2b, 0x3d, 0x88, 0x49, 0x38, 0xc9, 0x67, 0x7e, 0xaa, 0x43,
+	0x71, 0x50, 0x7a, 0xd0, 0xc3, 0x78, 0xd8, 0xa8, 0x8d, 0x1f, 0xf4, 0x51, 0x2c, 0x0e, 0x0d, 0x1c,
+	0x5f, 0xff, 0x87, 0xf8, 0xff, 0x1f, 0x74, 0x4d, 0xe1, 0xc3, 0x22, 0xff, 0xed, 0x91, 0xee, 0x01,
+	0x0b, 0x47, 0xe9, 0x01, 0xcd, 0x5f, 0x1a, 0xb0, 0x30, 0x54, 0x8a, 0xd1, 0x7b, 0xa9, 0xb4, 0x78,
+	0x21, 0x93, 0x16, 0x97, 0x86, 0x36, 0x9c, 0x40, 0x56, 0xcc, 0xcf, 0x55, 0xc6, 0x49, 0xe6, 0xaa,
+	0xc2, 0x51, 0x73, 0xd5, 0xd4, 0xe8, 0x5c, 0x95, 0x71, 0xd4, 0xf4, 0x91, 0x1c, 0xd5, 0x83, 0xf9,
+	0x4c, 0x47, 0x21, 0xfe, 0x1f, 0x86, 0xb9, 0x01, 0xb5, 0xfb, 0x7e, 0xe8, 0xa9, 0x62, 0xdc, 0xa8,
+	0x36, 0xd5, 0x3a, 0x8e, 0x38, 0xc4, 0x97, 0xa3, 0xc0, 0xde, 0xa6, 0xed, 0x7e, 0x87, 0xb6, 0xa5,
+	0x6f, 0x8a, 0xf1, 0x97, 0xa3, 0x8d, 0x01, 0x01, 0xc7, 0x3c, 0xe6, 0xcf, 0xa7, 0xa0, 0x9c, 0x7c,
+	0x71, 0x1c, 0xe1, 0xb3, 0xdf, 0xf7, 0x34, 0x28, 0x11, 0xd7, 0xf5, 0x38, 0x09, 0x3b, 0xc3, 0xb0,
+	0x21, 0xb8, 0x3b, 0xf9, 0x2b, 0xc7, 0xba, 0x15, 0x4b, 0x0b, 0x3f, 0x22, 0x44, 0x57, 0x3c, 0x41,
+	0xc1, 0x49, 0xa5, 0xe8, 0xae, 0x6a, 0x07, 0x8d, 0x49, 0xda, 0xc1, 0x62, 0xa6, 0x15, 0xac, 0xc1,
+	0xac, 0x1f, 0xdd, 0xdf, 0x42, 0x1a, 0xb5, 0xf8, 0xd2, 0xc6, 0x3c, 0xc8, 0x4a, 0xf9, 0x76, 0x4a,
+	0xfa, 0x76, 0x6e, 0xc4, 0x23, 0x2c, 0xdb, 0x6b, 0x4e, 0x9f, 0x54, 0xaf, 0xf9, 0x7d, 0x0d, 0xe6,
+	0x23, 0xb3, 0x95, 0xf2, 0xf0, 0x93, 0xcb, 0x07, 0x93, 0xfb, 0x4b, 0xe9, 0x8f, 0xda, 0xd5, 0x0c,
+	0x01, 0x67, 0x35, 0x56, 0x6f, 0xc0, 0x99, 0xac, 0x8b, 0xc7, 0xfa, 0x8e, 0xf1, 0x00, 0xd0, 0xb0,
+	0xfe, 0x97, 0xb5, 0x75, 0xc3, 0x3b, 0xe2, 0x04, 0x56, 0x7f, 0xfb, 0xd9, 0x8b, 0xe5, 0x53, 0xcf,
+	0x5f, 0x2c, 0x9f, 0xfa, 0xd3, 0x8b, 0xe5, 0x53, 0xdf, 0x39, 0x58, 0xd6, 0x9e, 0x1d, 0x2c, 0x6b,
+	0xcf, 0x0f, 0x96, 0xb5, 0xbf, 0x1e, 0x2c, 0x6b, 0x3f, 0xfe, 0xdb, 0xf2, 0xa9, 0xaf, 0xea, 0xbb,
+	0x57, 0xfe, 0x3d, 0x00, 0xe6, 0x58, 0x2b, 0x6c, 0x07, 0x28, 0x00, 0x00,
 }
@@ -30,6 +30,7 @@ var map_Image = map[string]string{
 	"dockerImageSignatures":        "DockerImageSignatures provides the signatures as opaque blobs. This is a part of manifest schema v1.",
 	"dockerImageManifestMediaType": "DockerImageManifestMediaType specifies the mediaType of manifest. This is a part of manifest schema v2.",
 	"dockerImageConfig":            "DockerImageConfig is a JSON blob that the runtime uses to set up the container. This is a part of manifest schema v2.",
+	"dockerImageManifests":         "DockerImageManifests holds information about the child manifests when this image is a manifest list or an OCI image index. It is empty for single-platform images.",
 }
 
 func (Image) SwaggerDoc() map[string]string {
@@ -80,6 +81,20 @@ func (ImageList) SwaggerDoc() map[string]string {
 	return map_ImageList
 }
 
+var map_ImageManifest = map[string]string{
+	"":             "ImageManifest represents a platform-specific manifest referenced by a manifest list or an OCI image index.",
+	"digest":       "Digest is the unique identifier of the child manifest. It refers to an Image object.",
+	"mediaType":    "MediaType is the media type of the child manifest.",
+	"manifestSize": "ManifestSize is the size of the raw child manifest in bytes.",
+	"architecture": "Architecture is the CPU architecture the image runs on, for example amd64 or ppc64le.",
+	"os":           "OS is the operating system the image runs on, for example linux.",
+	"variant":      "Variant is an optional variant of the CPU, for example v7 for ARMv7.",
+}
+
+func (ImageManifest) SwaggerDoc() map[string]string {
+	return map_ImageManifest
+}
+
 var map_ImageSignature = map[string]string{
 	"":              "ImageSignature holds a signature of an image. It allows to verify image identity and possibly other claims as long as the signature is trusted. Based on this information it is possible to restrict runnable images to those matching cluster-wide policy. Mandatory fields should be parsed by clients doing image verification. The others are parsed from signature's content by the server. They serve just an informative purpose.",
 	"metadata":      "Standard object's metadata.",
@@ -303,7 +318,7 @@ func (TagEvent) SwaggerDoc() map[string]string {
 
 var map_TagEventCondition = map[string]string{
 	"":                   "TagEventCondition contains condition information for a tag event.",
-	"type":               "Type of tag event condition, currently only ImportSuccess",
+	"type":               "Type of tag event condition, ImportSuccess or ImageReplicated",
 	"status":             "Status of the condition, one of True, False, Unknown.",
 	"lastTransitionTime": "LastTransitionTIme is the time the condition transitioned from one status to another.",
 	"reason":             "Reason is a brief machine readable explanation for the condition's last transition.",
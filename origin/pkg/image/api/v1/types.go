@@ -42,6 +42,25 @@ type Image struct {
 	DockerImageManifestMediaType string `json:"dockerImageManifestMediaType,omitempty" protobuf:"bytes,9,opt,name=dockerImageManifestMediaType"`
 	// DockerImageConfig is a JSON blob that the runtime uses to set up the container. This is a part of manifest schema v2.
 	DockerImageConfig string `json:"dockerImageConfig,omitempty" protobuf:"bytes,10,opt,name=dockerImageConfig"`
+	// DockerImageManifests holds information about the child manifests when this image is a manifest list
+	// or an OCI image index. It is empty for single-platform images.
+	DockerImageManifests []ImageManifest `json:"dockerImageManifests,omitempty" protobuf:"bytes,11,rep,name=dockerImageManifests"`
+}
+
+// ImageManifest represents a platform-specific manifest referenced by a manifest list or an OCI image index.
+type ImageManifest struct {
+	// Digest is the unique identifier of the child manifest. It refers to an Image object.
+	Digest string `json:"digest" protobuf:"bytes,1,opt,name=digest"`
+	// MediaType is the media type of the child manifest.
+	MediaType string `json:"mediaType" protobuf:"bytes,2,opt,name=mediaType"`
+	// ManifestSize is the size of the raw child manifest in bytes.
+	ManifestSize int64 `json:"manifestSize" protobuf:"varint,3,opt,name=manifestSize"`
+	// Architecture is the CPU architecture the image runs on, for example amd64 or ppc64le.
+	Architecture string `json:"architecture" protobuf:"bytes,4,opt,name=architecture"`
+	// OS is the operating system the image runs on, for example linux.
+	OS string `json:"os" protobuf:"bytes,5,opt,name=os"`
+	// Variant is an optional variant of the CPU, for example v7 for ARMv7.
+	Variant string `json:"variant,omitempty" protobuf:"bytes,6,opt,name=variant"`
 }
 
 // ImageLayer represents a single layer of the image. Some images may have multiple layers. Some may have none.
@@ -257,11 +276,14 @@ type TagEventConditionType string
 const (
 	// ImportSuccess with status False means the import of the specific tag failed
 	ImportSuccess TagEventConditionType = "ImportSuccess"
+	// ImageReplicated with status False means the integrated registry failed to replicate the image pushed
+	// to the tag to one of the configured remote registries
+	ImageReplicated TagEventConditionType = "ImageReplicated"
 )
 
 // TagEventCondition contains condition information for a tag event.
 type TagEventCondition struct {
-	// Type of tag event condition, currently only ImportSuccess
+	// Type of tag event condition, ImportSuccess or ImageReplicated
 	Type TagEventConditionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=TagEventConditionType"`
 	// Status of the condition, one of True, False, Unknown.
 	Status kapi.ConditionStatus `json:"status" protobuf:"bytes,2,opt,name=status,casttype=k8s.io/kubernetes/pkg/api/v1.ConditionStatus"`
@@ -34,6 +34,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_api_ImageLayer_To_v1_ImageLayer,
 		Convert_v1_ImageList_To_api_ImageList,
 		Convert_api_ImageList_To_v1_ImageList,
+		Convert_v1_ImageManifest_To_api_ImageManifest,
+		Convert_api_ImageManifest_To_v1_ImageManifest,
 		Convert_v1_ImageSignature_To_api_ImageSignature,
 		Convert_api_ImageSignature_To_v1_ImageSignature,
 		Convert_v1_ImageStream_To_api_ImageStream,
@@ -135,6 +137,7 @@ func autoConvert_v1_Image_To_api_Image(in *Image, out *api.Image, s conversion.S
 	out.DockerImageSignatures = *(*[][]byte)(unsafe.Pointer(&in.DockerImageSignatures))
 	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifests = *(*[]api.ImageManifest)(unsafe.Pointer(&in.DockerImageManifests))
 	return nil
 }
 
@@ -164,6 +167,7 @@ func autoConvert_api_Image_To_v1_Image(in *api.Image, out *Image, s conversion.S
 	out.DockerImageSignatures = *(*[][]byte)(unsafe.Pointer(&in.DockerImageSignatures))
 	out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 	out.DockerImageConfig = in.DockerImageConfig
+	out.DockerImageManifests = *(*[]ImageManifest)(unsafe.Pointer(&in.DockerImageManifests))
 	return nil
 }
 
@@ -315,6 +319,34 @@ func Convert_api_ImageList_To_v1_ImageList(in *api.ImageList, out *ImageList, s
 	return autoConvert_api_ImageList_To_v1_ImageList(in, out, s)
 }
 
+func autoConvert_v1_ImageManifest_To_api_ImageManifest(in *ImageManifest, out *api.ImageManifest, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.MediaType = in.MediaType
+	out.ManifestSize = in.ManifestSize
+	out.Architecture = in.Architecture
+	out.OS = in.OS
+	out.Variant = in.Variant
+	return nil
+}
+
+func Convert_v1_ImageManifest_To_api_ImageManifest(in *ImageManifest, out *api.ImageManifest, s conversion.Scope) error {
+	return autoConvert_v1_ImageManifest_To_api_ImageManifest(in, out, s)
+}
+
+func autoConvert_api_ImageManifest_To_v1_ImageManifest(in *api.ImageManifest, out *ImageManifest, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.MediaType = in.MediaType
+	out.ManifestSize = in.ManifestSize
+	out.Architecture = in.Architecture
+	out.OS = in.OS
+	out.Variant = in.Variant
+	return nil
+}
+
+func Convert_api_ImageManifest_To_v1_ImageManifest(in *api.ImageManifest, out *ImageManifest, s conversion.Scope) error {
+	return autoConvert_api_ImageManifest_To_v1_ImageManifest(in, out, s)
+}
+
 func autoConvert_v1_ImageSignature_To_api_ImageSignature(in *ImageSignature, out *api.ImageSignature, s conversion.Scope) error {
 	if err := api_v1.Convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
 		return err
@@ -26,6 +26,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageImportStatus, InType: reflect.TypeOf(&ImageImportStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageLayer, InType: reflect.TypeOf(&ImageLayer{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageList, InType: reflect.TypeOf(&ImageList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageManifest, InType: reflect.TypeOf(&ImageManifest{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageSignature, InType: reflect.TypeOf(&ImageSignature{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageStream, InType: reflect.TypeOf(&ImageStream{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ImageStreamImage, InType: reflect.TypeOf(&ImageStreamImage{})},
@@ -115,6 +116,15 @@ func DeepCopy_v1_Image(in interface{}, out interface{}, c *conversion.Cloner) er
 		}
 		out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 		out.DockerImageConfig = in.DockerImageConfig
+		if in.DockerImageManifests != nil {
+			in, out := &in.DockerImageManifests, &out.DockerImageManifests
+			*out = make([]ImageManifest, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.DockerImageManifests = nil
+		}
 		return nil
 	}
 }
@@ -190,6 +200,20 @@ func DeepCopy_v1_ImageList(in interface{}, out interface{}, c *conversion.Cloner
 	}
 }
 
+func DeepCopy_v1_ImageManifest(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ImageManifest)
+		out := out.(*ImageManifest)
+		out.Digest = in.Digest
+		out.MediaType = in.MediaType
+		out.ManifestSize = in.ManifestSize
+		out.Architecture = in.Architecture
+		out.OS = in.OS
+		out.Variant = in.Variant
+		return nil
+	}
+}
+
 func DeepCopy_v1_ImageSignature(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*ImageSignature)
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/openshift/github.com/docker/distribution/digest"
 	"github.com/openshift/github.com/docker/distribution/reference"
 	"github.com/golang/glog"
 
@@ -65,6 +66,12 @@ func validateImage(image *api.Image, fldPath *field.Path) field.ErrorList {
 		result = append(result, validateImageSignature(&sig, fldPath.Child("signatures").Index(i))...)
 	}
 
+	for i, manifest := range image.DockerImageManifests {
+		if _, err := digest.ParseDigest(manifest.Digest); err != nil {
+			result = append(result, field.Invalid(fldPath.Child("dockerImageManifests").Index(i).Child("digest"), manifest.Digest, err.Error()))
+		}
+	}
+
 	return result
 }
 
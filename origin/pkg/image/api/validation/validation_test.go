@@ -49,6 +49,15 @@ func TestValidateImageMissingFields(t *testing.T) {
 			field.ErrorTypeRequired,
 			"dockerImageReference",
 		},
+		"invalid child manifest digest": {
+			api.Image{
+				ObjectMeta:           kapi.ObjectMeta{Name: "foo"},
+				DockerImageReference: "ref",
+				DockerImageManifests: []api.ImageManifest{{Digest: "not-a-digest"}},
+			},
+			field.ErrorTypeInvalid,
+			"dockerImageManifests[0].digest",
+		},
 	}
 
 	for k, v := range errorCases {
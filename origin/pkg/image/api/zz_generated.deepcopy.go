@@ -37,6 +37,7 @@ func RegisterDeepCopies(scheme *runtime.Scheme) error {
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageImportStatus, InType: reflect.TypeOf(&ImageImportStatus{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageLayer, InType: reflect.TypeOf(&ImageLayer{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageList, InType: reflect.TypeOf(&ImageList{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageManifest, InType: reflect.TypeOf(&ImageManifest{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageSignature, InType: reflect.TypeOf(&ImageSignature{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageStream, InType: reflect.TypeOf(&ImageStream{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_api_ImageStreamImage, InType: reflect.TypeOf(&ImageStreamImage{})},
@@ -451,6 +452,15 @@ func DeepCopy_api_Image(in interface{}, out interface{}, c *conversion.Cloner) e
 		}
 		out.DockerImageManifestMediaType = in.DockerImageManifestMediaType
 		out.DockerImageConfig = in.DockerImageConfig
+		if in.DockerImageManifests != nil {
+			in, out := &in.DockerImageManifests, &out.DockerImageManifests
+			*out = make([]ImageManifest, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.DockerImageManifests = nil
+		}
 		return nil
 	}
 }
@@ -526,6 +536,20 @@ func DeepCopy_api_ImageList(in interface{}, out interface{}, c *conversion.Clone
 	}
 }
 
+func DeepCopy_api_ImageManifest(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ImageManifest)
+		out := out.(*ImageManifest)
+		out.Digest = in.Digest
+		out.MediaType = in.MediaType
+		out.ManifestSize = in.ManifestSize
+		out.Architecture = in.Architecture
+		out.OS = in.OS
+		out.Variant = in.Variant
+		return nil
+	}
+}
+
 func DeepCopy_api_ImageSignature(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*ImageSignature)
@@ -0,0 +1,64 @@
+package trigger
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// TriggerAnnotationKey is set on a Kubernetes Deployment to update the image of its containers
+	// whenever the image stream tags they follow point to a new image. The value is the JSON
+	// encoded list of ImageTriggers of the Deployment.
+	TriggerAnnotationKey = "image.openshift.io/triggers"
+)
+
+// ImageTrigger updates the image of some containers of a pod template with the latest image of
+// an image stream tag.
+type ImageTrigger struct {
+	// From is the image stream tag to follow.
+	From ObjectReference `json:"from"`
+	// ContainerNames are the containers whose image is updated.
+	ContainerNames []string `json:"containerNames"`
+	// Paused stops the trigger from updating the containers.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ObjectReference identifies the image stream tag of a trigger. Namespace defaults to the
+// namespace of the annotated object.
+type ObjectReference struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Triggers returns the image triggers recorded in the annotations of an object, or nil if it
+// has none.
+func Triggers(annotations map[string]string) ([]ImageTrigger, error) {
+	value, ok := annotations[TriggerAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	var triggers []ImageTrigger
+	if err := json.Unmarshal([]byte(value), &triggers); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %v", TriggerAnnotationKey, err)
+	}
+	for i, t := range triggers {
+		if t.From.Kind != "ImageStreamTag" {
+			return nil, fmt.Errorf("invalid %s annotation: trigger %d must reference an ImageStreamTag, not %q", TriggerAnnotationKey, i, t.From.Kind)
+		}
+		if len(t.From.Name) == 0 {
+			return nil, fmt.Errorf("invalid %s annotation: trigger %d has no image stream tag name", TriggerAnnotationKey, i)
+		}
+	}
+	return triggers, nil
+}
+
+// SetTriggers records the image triggers in the annotations of an object.
+func SetTriggers(annotations map[string]string, triggers []ImageTrigger) error {
+	data, err := json.Marshal(triggers)
+	if err != nil {
+		return err
+	}
+	annotations[TriggerAnnotationKey] = string(data)
+	return nil
+}
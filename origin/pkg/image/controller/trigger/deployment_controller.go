@@ -0,0 +1,278 @@
+package trigger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/apis/extensions"
+	"github.com/openshift/kubernetes/pkg/client/cache"
+	kextensionsclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/extensions/internalversion"
+	kcontroller "github.com/openshift/kubernetes/pkg/controller"
+	utilruntime "github.com/openshift/kubernetes/pkg/util/runtime"
+	"github.com/openshift/kubernetes/pkg/util/wait"
+	"github.com/openshift/kubernetes/pkg/util/workqueue"
+
+	oscache "github.com/openshift/origin/pkg/client/cache"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	// We must avoid processing deployments until the deployment and image stream stores have
+	// synced. If they haven't synced, to avoid a hot loop, we'll wait this long between checks.
+	storeSyncedPollPeriod = 100 * time.Millisecond
+	// MaxRetries is the number of times a deployment will be retried before it is dropped out
+	// of the queue.
+	MaxRetries = 5
+
+	// imageStreamIndex indexes the deployments by the image streams their triggers follow.
+	imageStreamIndex = "imagestream"
+)
+
+// DeploymentImageTriggerController updates the containers of the Kubernetes Deployments annotated
+// with TriggerAnnotationKey to the latest image of the image stream tags they follow. The
+// deployment controller then rolls out the new images.
+type DeploymentImageTriggerController struct {
+	client kextensionsclient.DeploymentsGetter
+
+	// queue contains the keys of the deployments that need to be synced.
+	queue workqueue.RateLimitingInterface
+
+	deploymentLister       cache.StoreToDeploymentLister
+	deploymentListerSynced func() bool
+	streamLister           oscache.StoreToImageStreamLister
+	streamListerSynced     func() bool
+
+	// syncHandler does the work. It's factored out for unit testing
+	syncHandler func(key string) error
+}
+
+// NewDeploymentImageTriggerController returns a new DeploymentImageTriggerController. It adds an
+// index to the deployment informer, which must not have been started yet.
+func NewDeploymentImageTriggerController(deploymentInformer, streamInformer cache.SharedIndexInformer, client kextensionsclient.DeploymentsGetter) (*DeploymentImageTriggerController, error) {
+	c := &DeploymentImageTriggerController{
+		client: client,
+
+		queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+
+	if err := deploymentInformer.AddIndexers(cache.Indexers{imageStreamIndex: imageStreamIndexFunc}); err != nil {
+		return nil, err
+	}
+
+	deploymentInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueDeployment,
+		UpdateFunc: func(old, cur interface{}) {
+			c.enqueueDeployment(cur)
+		},
+	})
+	streamInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addImageStream,
+		UpdateFunc: c.updateImageStream,
+	})
+
+	c.deploymentLister.Indexer = deploymentInformer.GetIndexer()
+	c.deploymentListerSynced = deploymentInformer.HasSynced
+	c.streamLister.Indexer = streamInformer.GetIndexer()
+	c.streamListerSynced = streamInformer.HasSynced
+
+	c.syncHandler = c.syncDeployment
+	return c, nil
+}
+
+// Run begins watching and syncing.
+func (c *DeploymentImageTriggerController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Wait for the stores to sync before starting any work in this controller.
+	for !c.deploymentListerSynced() || !c.streamListerSynced() {
+		glog.V(4).Infof("Waiting for the deployment and image stream caches to sync before starting the image trigger controller workers")
+		select {
+		case <-time.After(storeSyncedPollPeriod):
+		case <-stopCh:
+			return
+		}
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+	glog.Infof("Shutting down deployment image trigger controller")
+}
+
+func (c *DeploymentImageTriggerController) enqueueDeployment(obj interface{}) {
+	deployment := obj.(*extensions.Deployment)
+	if _, ok := deployment.Annotations[TriggerAnnotationKey]; !ok {
+		return
+	}
+	key, err := kcontroller.KeyFunc(deployment)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", deployment, err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *DeploymentImageTriggerController) addImageStream(obj interface{}) {
+	c.enqueueDeploymentsForImageStream(obj.(*imageapi.ImageStream))
+}
+
+func (c *DeploymentImageTriggerController) updateImageStream(old, cur interface{}) {
+	// A periodic relist will send update events for all known streams.
+	newStream := cur.(*imageapi.ImageStream)
+	if newStream.ResourceVersion == old.(*imageapi.ImageStream).ResourceVersion {
+		return
+	}
+	c.enqueueDeploymentsForImageStream(newStream)
+}
+
+// enqueueDeploymentsForImageStream enqueues the deployments with a trigger on a tag of the
+// image stream, including those of other namespaces.
+func (c *DeploymentImageTriggerController) enqueueDeploymentsForImageStream(stream *imageapi.ImageStream) {
+	objs, err := c.deploymentLister.Indexer.ByIndex(imageStreamIndex, stream.Namespace+"/"+stream.Name)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't list the deployments following image stream %s/%s: %v", stream.Namespace, stream.Name, err))
+		return
+	}
+	for _, obj := range objs {
+		c.enqueueDeployment(obj)
+	}
+}
+
+// imageStreamIndexFunc returns the namespace/name keys of the image streams the triggers of a
+// deployment follow. Deployments with an invalid trigger annotation are not indexed.
+func imageStreamIndexFunc(obj interface{}) ([]string, error) {
+	deployment, ok := obj.(*extensions.Deployment)
+	if !ok {
+		return nil, fmt.Errorf("expected a deployment, got %T", obj)
+	}
+	triggers, err := Triggers(deployment.Annotations)
+	if err != nil {
+		return nil, nil
+	}
+	keys := []string{}
+	for _, t := range triggers {
+		name, _, _ := imageapi.SplitImageStreamTag(t.From.Name)
+		keys = append(keys, triggerNamespace(t, deployment.Namespace)+"/"+name)
+	}
+	return keys, nil
+}
+
+func (c *DeploymentImageTriggerController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+// processNextWorkItem deals with one key off the queue. It returns false when it's time to quit.
+func (c *DeploymentImageTriggerController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncHandler(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	if c.queue.NumRequeues(key) < MaxRetries {
+		glog.V(2).Infof("Error updating images of deployment %v: %v", key, err)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	utilruntime.HandleError(fmt.Errorf("%v failed with : %v", key, err))
+	c.queue.Forget(key)
+	return true
+}
+
+// syncDeployment updates the containers of the deployment with the given key to the latest
+// images of its triggers.
+func (c *DeploymentImageTriggerController) syncDeployment(key string) error {
+	obj, exists, err := c.deploymentLister.Indexer.GetByKey(key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		glog.V(4).Infof("Deployment %v has been deleted", key)
+		return nil
+	}
+
+	// make a copy to avoid mutating cache state
+	t, err := kapi.Scheme.DeepCopy(obj)
+	if err != nil {
+		return err
+	}
+	deployment := t.(*extensions.Deployment)
+	changed, err := c.updateImages(deployment)
+	if err != nil || !changed {
+		return err
+	}
+
+	glog.V(4).Infof("Updating the images of deployment %s", key)
+	_, err = c.client.Deployments(deployment.Namespace).Update(deployment)
+	return err
+}
+
+// updateImages sets the image of the containers of the deployment to the latest image of their
+// trigger. Triggers on image stream tags that have no image yet are ignored.
+func (c *DeploymentImageTriggerController) updateImages(deployment *extensions.Deployment) (bool, error) {
+	triggers, err := Triggers(deployment.Annotations)
+	if err != nil {
+		// retrying won't help until the annotation is fixed
+		utilruntime.HandleError(fmt.Errorf("deployment %s/%s: %v", deployment.Namespace, deployment.Name, err))
+		return false, nil
+	}
+
+	changed := false
+	for _, t := range triggers {
+		if t.Paused {
+			continue
+		}
+		name, tag, ok := imageapi.SplitImageStreamTag(t.From.Name)
+		if !ok {
+			continue
+		}
+		stream, err := c.streamLister.ImageStreams(triggerNamespace(t, deployment.Namespace)).Get(name)
+		if kapierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		image, ok := imageapi.ResolveLatestTaggedImage(stream, tag)
+		if !ok {
+			continue
+		}
+		for _, name := range t.ContainerNames {
+			changed = setContainerImage(&deployment.Spec.Template.Spec, name, image) || changed
+		}
+	}
+	return changed, nil
+}
+
+func setContainerImage(spec *kapi.PodSpec, name, image string) bool {
+	for _, containers := range [][]kapi.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			if containers[i].Name != name || containers[i].Image == image {
+				continue
+			}
+			containers[i].Image = image
+			return true
+		}
+	}
+	return false
+}
+
+func triggerNamespace(t ImageTrigger, namespace string) string {
+	if len(t.From.Namespace) > 0 {
+		return t.From.Namespace
+	}
+	return namespace
+}
@@ -0,0 +1,158 @@
+package trigger
+
+import (
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/apis/extensions"
+	"github.com/openshift/kubernetes/pkg/client/cache"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+	"github.com/openshift/kubernetes/pkg/util/workqueue"
+
+	oscache "github.com/openshift/origin/pkg/client/cache"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func testDeployment(triggers string) *extensions.Deployment {
+	return &extensions.Deployment{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "web",
+			Namespace:   "app",
+			Annotations: map[string]string{TriggerAnnotationKey: triggers},
+		},
+		Spec: extensions.DeploymentSpec{
+			Template: kapi.PodTemplateSpec{
+				Spec: kapi.PodSpec{
+					Containers: []kapi.Container{
+						{Name: "web", Image: "web:old"},
+						{Name: "proxy", Image: "proxy:old"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testImageStream(namespace, name, tag, image string) *imageapi.ImageStream {
+	return &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: name, Namespace: namespace},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				tag: {Items: []imageapi.TagEvent{{DockerImageReference: image}}},
+			},
+		},
+	}
+}
+
+func TestSyncDeployment(t *testing.T) {
+	testCases := []struct {
+		name     string
+		triggers string
+		streams  []*imageapi.ImageStream
+		images   []string
+	}{
+		{
+			name:     "updates the container of the trigger",
+			triggers: `[{"from":{"kind":"ImageStreamTag","name":"web:latest"},"containerNames":["web"]}]`,
+			streams:  []*imageapi.ImageStream{testImageStream("app", "web", "latest", "registry/app/web@sha256:1")},
+			images:   []string{"registry/app/web@sha256:1", "proxy:old"},
+		},
+		{
+			name:     "follows image streams of other namespaces",
+			triggers: `[{"from":{"kind":"ImageStreamTag","name":"proxy:v1","namespace":"shared"},"containerNames":["proxy"]}]`,
+			streams:  []*imageapi.ImageStream{testImageStream("shared", "proxy", "v1", "registry/shared/proxy@sha256:2")},
+			images:   []string{"web:old", "registry/shared/proxy@sha256:2"},
+		},
+		{
+			name:     "ignores paused triggers",
+			triggers: `[{"from":{"kind":"ImageStreamTag","name":"web:latest"},"containerNames":["web"],"paused":true}]`,
+			streams:  []*imageapi.ImageStream{testImageStream("app", "web", "latest", "registry/app/web@sha256:1")},
+		},
+		{
+			name:     "ignores tags without an image",
+			triggers: `[{"from":{"kind":"ImageStreamTag","name":"web:latest"},"containerNames":["web"]}]`,
+			streams:  []*imageapi.ImageStream{testImageStream("app", "web", "stable", "registry/app/web@sha256:1")},
+		},
+		{
+			name:     "ignores missing image streams",
+			triggers: `[{"from":{"kind":"ImageStreamTag","name":"web:latest"},"containerNames":["web"]}]`,
+		},
+		{
+			name:     "ignores invalid annotations",
+			triggers: `[{"from":{"kind":"DockerImage","name":"web:latest"},"containerNames":["web"]}]`,
+			streams:  []*imageapi.ImageStream{testImageStream("app", "web", "latest", "registry/app/web@sha256:1")},
+		},
+	}
+
+	for _, test := range testCases {
+		deployment := testDeployment(test.triggers)
+		client := fake.NewSimpleClientset(deployment)
+		c := &DeploymentImageTriggerController{client: client.Extensions()}
+		c.deploymentLister.Indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		c.deploymentLister.Indexer.Add(deployment)
+		c.streamLister = oscache.StoreToImageStreamLister{Indexer: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})}
+		for _, stream := range test.streams {
+			c.streamLister.Indexer.Add(stream)
+		}
+
+		if err := c.syncDeployment("app/web"); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		var updated *extensions.Deployment
+		for _, action := range client.Actions() {
+			if action.Matches("update", "deployments") {
+				updated = action.(core.UpdateAction).GetObject().(*extensions.Deployment)
+			}
+		}
+		if len(test.images) == 0 {
+			if updated != nil {
+				t.Errorf("%s: unexpected update of the deployment", test.name)
+			}
+			continue
+		}
+		if updated == nil {
+			t.Errorf("%s: expected the deployment to be updated", test.name)
+			continue
+		}
+		for i, container := range updated.Spec.Template.Spec.Containers {
+			if container.Image != test.images[i] {
+				t.Errorf("%s: expected image %q for container %s, got %q", test.name, test.images[i], container.Name, container.Image)
+			}
+		}
+		if deployment.Spec.Template.Spec.Containers[0].Image != "web:old" {
+			t.Errorf("%s: the cached deployment was mutated", test.name)
+		}
+	}
+}
+
+func TestEnqueueDeploymentsForImageStream(t *testing.T) {
+	following := testDeployment(`[{"from":{"kind":"ImageStreamTag","name":"web:latest"},"containerNames":["web"]}]`)
+	otherNamespace := testDeployment(`[{"from":{"kind":"ImageStreamTag","name":"web:latest"},"containerNames":["web"]}]`)
+	otherNamespace.Namespace = "other"
+	crossNamespace := testDeployment(`[{"from":{"kind":"ImageStreamTag","name":"web:v1","namespace":"app"},"containerNames":["web"]}]`)
+	crossNamespace.Namespace = "shared"
+	otherStream := testDeployment(`[{"from":{"kind":"ImageStreamTag","name":"proxy:latest"},"containerNames":["proxy"]}]`)
+	otherStream.Name = "proxy"
+
+	c := &DeploymentImageTriggerController{queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())}
+	c.deploymentLister.Indexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{imageStreamIndex: imageStreamIndexFunc})
+	for _, deployment := range []*extensions.Deployment{following, otherNamespace, crossNamespace, otherStream} {
+		c.deploymentLister.Indexer.Add(deployment)
+	}
+
+	c.enqueueDeploymentsForImageStream(testImageStream("app", "web", "latest", "registry/app/web@sha256:1"))
+
+	keys := sets.NewString()
+	for c.queue.Len() > 0 {
+		key, _ := c.queue.Get()
+		keys.Insert(key.(string))
+		c.queue.Done(key)
+	}
+	if expected := sets.NewString("app/web", "shared/web"); !keys.Equal(expected) {
+		t.Errorf("expected %v to be queued, got %v", expected.List(), keys.List())
+	}
+}
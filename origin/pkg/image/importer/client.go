@@ -15,8 +15,8 @@ import (
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/context"
 	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
-	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/openshift/github.com/docker/distribution/reference"
 	"github.com/openshift/github.com/docker/distribution/registry/api/errcode"
 	registryclient "github.com/openshift/github.com/docker/distribution/registry/client"
@@ -64,9 +64,28 @@ func (c Context) WithCredentials(credentials auth.CredentialStore) RepositoryRet
 	}
 }
 
+// WithPushCredentials returns a RepositoryRetriever whose repositories are authorized to be pushed to. The
+// tokens also grant pull access to the mountFrom repositories of the same registry, so that blobs can be
+// mounted from them.
+func (c Context) WithPushCredentials(credentials auth.CredentialStore, mountFrom ...string) RepositoryRetriever {
+	return &repositoryRetriever{
+		context:     c,
+		credentials: credentials,
+		actions:     []string{"pull", "push"},
+		mountFrom:   mountFrom,
+
+		pings:    make(map[url.URL]error),
+		redirect: make(map[url.URL]*url.URL),
+	}
+}
+
 type repositoryRetriever struct {
 	context     Context
 	credentials auth.CredentialStore
+	// actions requested for the repository token, pull if empty
+	actions []string
+	// mountFrom are repositories the token additionally requests pull access to
+	mountFrom []string
 
 	pings    map[url.URL]error
 	redirect map[url.URL]*url.URL
@@ -103,13 +122,27 @@ func (r *repositoryRetriever) Repository(ctx gocontext.Context, registry *url.UR
 		}
 	}
 
+	actions := r.actions
+	if len(actions) == 0 {
+		actions = []string{"pull"}
+	}
+	scopes := []auth.Scope{auth.RepositoryScope{Repository: repoName, Actions: actions}}
+	for _, from := range r.mountFrom {
+		if from != repoName {
+			scopes = append(scopes, auth.RepositoryScope{Repository: from, Actions: []string{"pull"}})
+		}
+	}
 	rt := transport.NewTransport(
 		t,
 		// TODO: slightly smarter authorizer that retries unauthenticated requests
 		// TODO: make multiple attempts if the first credential fails
 		auth.NewAuthorizer(
 			r.context.Challenges,
-			auth.NewTokenHandler(t, r.credentials, repoName, "pull"),
+			auth.NewTokenHandlerWithOptions(auth.TokenHandlerOptions{
+				Transport:   t,
+				Credentials: r.credentials,
+				Scopes:      scopes,
+			}),
 			auth.NewBasicHandler(r.credentials),
 		),
 	)
@@ -189,7 +222,27 @@ func schema1ToImage(manifest *schema1.SignedManifest, d digest.Digest) (*api.Ima
 	return image, nil
 }
 
-func schema2ToImage(manifest *schema2.DeserializedManifest, imageConfig []byte, d digest.Digest) (*api.Image, error) {
+func manifestListToImage(list *manifestlist.DeserializedManifestList, d digest.Digest) (*api.Image, error) {
+	mediatype, payload, err := list.Payload()
+	if err != nil {
+		return nil, err
+	}
+	if len(d) == 0 {
+		d = digest.FromBytes(payload)
+	}
+
+	image := &api.Image{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: d.String(),
+		},
+		DockerImageManifest:          string(payload),
+		DockerImageManifestMediaType: mediatype,
+	}
+
+	return image, nil
+}
+
+func schema2ToImage(manifest distribution.Manifest, imageConfig []byte, d digest.Digest) (*api.Image, error) {
 	mediatype, payload, err := manifest.Payload()
 	if err != nil {
 		return nil, err
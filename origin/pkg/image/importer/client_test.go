@@ -41,8 +41,9 @@ type mockRepository struct {
 
 	blobs *mockBlobStore
 
-	manifest distribution.Manifest
-	tags     map[string]string
+	manifest  distribution.Manifest
+	manifests map[digest.Digest]distribution.Manifest
+	tags      map[string]string
 }
 
 func (r *mockRepository) Name() string { return "test" }
@@ -64,6 +65,9 @@ func (r *mockRepository) Get(ctx context.Context, dgst digest.Digest, options ..
 			return r.manifest, r.getByTagErr
 		}
 	}
+	if m, ok := r.manifests[dgst]; ok {
+		return m, nil
+	}
 	return r.manifest, r.getErr
 }
 func (r *mockRepository) Delete(ctx context.Context, dgst digest.Digest) error {
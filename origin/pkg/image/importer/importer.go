@@ -10,6 +10,8 @@ import (
 
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/openshift/github.com/docker/distribution/reference"
@@ -470,15 +472,17 @@ func (isi *ImageStreamImporter) importRepositoryFromDocker(ctx gocontext.Context
 			continue
 		}
 
-		if signedManifest, isSchema1 := manifest.(*schema1.SignedManifest); isSchema1 {
+		if list, isList := manifest.(*manifestlist.DeserializedManifestList); isList {
+			importDigest.Image, err = manifestListToImage(list, d)
+		} else if signedManifest, isSchema1 := manifest.(*schema1.SignedManifest); isSchema1 {
 			importDigest.Image, err = schema1ToImage(signedManifest, d)
-		} else if deserializedManifest, isSchema2 := manifest.(*schema2.DeserializedManifest); isSchema2 {
-			imageConfig, getImportConfigErr := b.Get(ctx, deserializedManifest.Config.Digest)
+		} else if configDigest, hasConfig := manifestConfigDigest(manifest); hasConfig {
+			imageConfig, getImportConfigErr := b.Get(ctx, configDigest)
 			if getImportConfigErr != nil {
 				glog.V(5).Infof("unable to access the image config using digest %q for repository %#v: %#v", d, repository, getImportConfigErr)
 				if isDockerError(getImportConfigErr, v2.ErrorCodeManifestUnknown) {
 					ref := repository.Ref
-					ref.ID = deserializedManifest.Config.Digest.String()
+					ref.ID = configDigest.String()
 					importDigest.Err = kapierrors.NewNotFound(api.Resource("dockerimage"), ref.Exact())
 				} else {
 					importDigest.Err = formatRepositoryError(repository, "", importDigest.Name, getImportConfigErr)
@@ -486,7 +490,7 @@ func (isi *ImageStreamImporter) importRepositoryFromDocker(ctx gocontext.Context
 				continue
 			}
 
-			importDigest.Image, err = schema2ToImage(deserializedManifest, imageConfig, d)
+			importDigest.Image, err = schema2ToImage(manifest, imageConfig, d)
 		} else {
 			glog.V(5).Infof("unsupported manifest type: %T", manifest)
 			continue
@@ -501,7 +505,7 @@ func (isi *ImageStreamImporter) importRepositoryFromDocker(ctx gocontext.Context
 			importDigest.Err = err
 			continue
 		}
-		if importDigest.Image.DockerImageMetadata.Size == 0 {
+		if len(importDigest.Image.DockerImageManifests) == 0 && importDigest.Image.DockerImageMetadata.Size == 0 {
 			if err := isi.calculateImageSize(ctx, repo, importDigest.Image); err != nil {
 				importDigest.Err = err
 				continue
@@ -535,16 +539,18 @@ func (isi *ImageStreamImporter) importRepositoryFromDocker(ctx gocontext.Context
 			manifest = m
 		}
 
-		if signedManifest, isSchema1 := manifest.(*schema1.SignedManifest); isSchema1 {
+		if list, isList := manifest.(*manifestlist.DeserializedManifestList); isList {
+			importTag.Image, err = manifestListToImage(list, "")
+		} else if signedManifest, isSchema1 := manifest.(*schema1.SignedManifest); isSchema1 {
 			importTag.Image, err = schema1ToImage(signedManifest, "")
-		} else if deserializedManifest, isSchema2 := manifest.(*schema2.DeserializedManifest); isSchema2 {
-			imageConfig, getImportConfigErr := b.Get(ctx, deserializedManifest.Config.Digest)
+		} else if configDigest, hasConfig := manifestConfigDigest(manifest); hasConfig {
+			imageConfig, getImportConfigErr := b.Get(ctx, configDigest)
 			if getImportConfigErr != nil {
-				glog.V(5).Infof("unable to access image config using digest %q for tag %q for repository %#v: %#v", deserializedManifest.Config.Digest, importTag.Name, repository, getImportConfigErr)
+				glog.V(5).Infof("unable to access image config using digest %q for tag %q for repository %#v: %#v", configDigest, importTag.Name, repository, getImportConfigErr)
 				importTag.Err = formatRepositoryError(repository, importTag.Name, "", getImportConfigErr)
 				continue
 			}
-			importTag.Image, err = schema2ToImage(deserializedManifest, imageConfig, "")
+			importTag.Image, err = schema2ToImage(manifest, imageConfig, "")
 		} else {
 			glog.V(5).Infof("unsupported manifest type: %T", manifest)
 			continue
@@ -558,7 +564,7 @@ func (isi *ImageStreamImporter) importRepositoryFromDocker(ctx gocontext.Context
 			importTag.Err = err
 			continue
 		}
-		if importTag.Image.DockerImageMetadata.Size == 0 {
+		if len(importTag.Image.DockerImageManifests) == 0 && importTag.Image.DockerImageMetadata.Size == 0 {
 			if err := isi.calculateImageSize(ctx, repo, importTag.Image); err != nil {
 				importTag.Err = err
 				continue
@@ -567,6 +573,17 @@ func (isi *ImageStreamImporter) importRepositoryFromDocker(ctx gocontext.Context
 	}
 }
 
+// manifestConfigDigest returns the digest of the image config referenced by a schema2 or an OCI image manifest.
+func manifestConfigDigest(manifest distribution.Manifest) (digest.Digest, bool) {
+	switch t := manifest.(type) {
+	case *schema2.DeserializedManifest:
+		return t.Config.Digest, true
+	case *ocischema.DeserializedManifest:
+		return t.Config.Digest, true
+	}
+	return "", false
+}
+
 func importRepositoryFromDockerV1(ctx gocontext.Context, repository *importRepository, limiter flowcontrol.RateLimiter) {
 	value := ctx.Value(ContextKeyV1RegistryClient)
 	if value == nil {
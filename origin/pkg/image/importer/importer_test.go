@@ -9,6 +9,7 @@ import (
 
 	"github.com/openshift/github.com/docker/distribution"
 	"github.com/openshift/github.com/docker/distribution/digest"
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 
@@ -51,6 +52,24 @@ func TestImport(t *testing.T) {
 		MediaType: schema2.MediaTypeConfig,
 	}
 	t.Logf("busybox manifest schema 2 digest: %q", digest.FromBytes([]byte(busyboxManifest)))
+	busyboxManifestList, err := manifestlist.FromDescriptors([]manifestlist.ManifestDescriptor{
+		{
+			Descriptor: distribution.Descriptor{Digest: digest.Digest("sha256:5b0bcabd1ed22e9fb1310cf6c2dec7cdef19f0ad69efa1f392e94a4333501270"), MediaType: schema2.MediaTypeManifest},
+			Platform:   manifestlist.PlatformSpec{Architecture: "ppc64le", OS: "linux"},
+		},
+		{
+			Descriptor: distribution.Descriptor{Digest: digest.Digest(busyboxDigest), MediaType: schema2.MediaTypeManifest},
+			Platform:   manifestlist.PlatformSpec{Architecture: "amd64", OS: "linux"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, busyboxManifestListPayload, err := busyboxManifestList.Payload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	busyboxManifestListDigest := digest.FromBytes(busyboxManifestListPayload).String()
 
 	insecureRetriever := &mockRetriever{
 		repo: &mockRepository{
@@ -215,6 +234,45 @@ func TestImport(t *testing.T) {
 				}
 			},
 		},
+		{
+			retriever: &mockRetriever{
+				repo: &mockRepository{
+					manifest: busyboxManifestList,
+				},
+			},
+			isi: api.ImageStreamImport{
+				Spec: api.ImageStreamImportSpec{
+					Images: []api.ImageImportSpec{
+						{From: kapi.ObjectReference{Kind: "DockerImage", Name: "test:busybox"}},
+					},
+				},
+			},
+			expect: func(isi *api.ImageStreamImport, t *testing.T) {
+				if len(isi.Status.Images) != 1 {
+					t.Errorf("unexpected number of images: %#v", isi.Status.Repository.Images)
+				}
+				image := isi.Status.Images[0]
+				if image.Status.Status != unversioned.StatusSuccess {
+					t.Errorf("unexpected status: %#v", image.Status)
+				}
+				// the manifest list itself is imported, referencing the images of all platforms
+				if image.Image.Name != busyboxManifestListDigest {
+					t.Errorf("unexpected image: %q != %q", image.Image.Name, busyboxManifestListDigest)
+				}
+				if image.Image.DockerImageManifestMediaType != manifestlist.MediaTypeManifestList {
+					t.Errorf("unexpected manifest media type: %s", image.Image.DockerImageManifestMediaType)
+				}
+				if len(image.Image.DockerImageManifests) != 2 {
+					t.Fatalf("unexpected child manifests: %#v", image.Image.DockerImageManifests)
+				}
+				if child := image.Image.DockerImageManifests[1]; child.Digest != busyboxDigest || child.Architecture != "amd64" || child.OS != "linux" {
+					t.Errorf("unexpected child manifest: %#v", child)
+				}
+				if len(image.Image.DockerImageLayers) != 0 {
+					t.Errorf("unexpected layers: %#v", image.Image.DockerImageLayers)
+				}
+			},
+		},
 		{
 			retriever: &mockRetriever{
 				repo: &mockRepository{
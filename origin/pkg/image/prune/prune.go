@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/openshift/github.com/docker/distribution/manifest/ocischema"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 	"github.com/openshift/github.com/docker/distribution/registry/api/errcode"
 	"github.com/golang/glog"
@@ -330,7 +331,8 @@ func addImagesToGraph(g graph.Graph, images *imageapi.ImageList, algorithm prune
 		glog.V(4).Infof("Adding image %q to graph", image.Name)
 		imageNode := imagegraph.EnsureImageNode(g, image)
 
-		if image.DockerImageManifestMediaType == schema2.MediaTypeManifest && len(image.DockerImageMetadata.ID) > 0 {
+		if (image.DockerImageManifestMediaType == schema2.MediaTypeManifest || image.DockerImageManifestMediaType == ocischema.MediaTypeManifest) &&
+			len(image.DockerImageMetadata.ID) > 0 {
 			configName := image.DockerImageMetadata.ID
 			glog.V(4).Infof("Adding image config %q to graph", configName)
 			configNode := imagegraph.EnsureImageComponentConfigNode(g, configName)
@@ -400,28 +402,31 @@ func addImageStreamsToGraph(g graph.Graph, streams *imageapi.ImageStreamList, li
 					}
 				}
 
-				glog.V(4).Infof("Checking for existing strong reference from stream %s/%s to image %s", stream.Namespace, stream.Name, imageNode.Image.Name)
-				if edge := g.Edge(imageStreamNode, imageNode); edge != nil && g.EdgeKinds(edge).Has(ReferencedImageEdgeKind) {
-					glog.V(4).Infof("Strong reference found")
-					continue
-				}
-
-				glog.V(4).Infof("Adding edge (kind=%s) from %q to %q", kind, imageStreamNode.UniqueName(), imageNode.UniqueName())
-				g.AddEdge(imageStreamNode, imageNode, kind)
-
-				glog.V(4).Infof("Adding stream->(layer|config) references")
-				// add stream -> layer references so we can prune them later
-				for _, s := range g.From(imageNode) {
-					cn, ok := s.(*imagegraph.ImageComponentNode)
-					if !ok {
+				// images of a manifest list are referenced through the list
+				for _, imageNode := range append([]*imagegraph.ImageNode{imageNode}, manifestListImageNodes(g, imageNode)...) {
+					glog.V(4).Infof("Checking for existing strong reference from stream %s/%s to image %s", stream.Namespace, stream.Name, imageNode.Image.Name)
+					if edge := g.Edge(imageStreamNode, imageNode); edge != nil && g.EdgeKinds(edge).Has(ReferencedImageEdgeKind) {
+						glog.V(4).Infof("Strong reference found")
 						continue
 					}
 
-					glog.V(4).Infof("Adding reference from stream %q to %s", stream.Name, cn.Describe())
-					if cn.Type == imagegraph.ImageComponentTypeConfig {
-						g.AddEdge(imageStreamNode, s, ReferencedImageConfigEdgeKind)
-					} else {
-						g.AddEdge(imageStreamNode, s, ReferencedImageLayerEdgeKind)
+					glog.V(4).Infof("Adding edge (kind=%s) from %q to %q", kind, imageStreamNode.UniqueName(), imageNode.UniqueName())
+					g.AddEdge(imageStreamNode, imageNode, kind)
+
+					glog.V(4).Infof("Adding stream->(layer|config) references")
+					// add stream -> layer references so we can prune them later
+					for _, s := range g.From(imageNode) {
+						cn, ok := s.(*imagegraph.ImageComponentNode)
+						if !ok {
+							continue
+						}
+
+						glog.V(4).Infof("Adding reference from stream %q to %s", stream.Name, cn.Describe())
+						if cn.Type == imagegraph.ImageComponentTypeConfig {
+							g.AddEdge(imageStreamNode, s, ReferencedImageConfigEdgeKind)
+						} else {
+							g.AddEdge(imageStreamNode, s, ReferencedImageLayerEdgeKind)
+						}
 					}
 				}
 			}
@@ -429,6 +434,20 @@ func addImageStreamsToGraph(g graph.Graph, streams *imageapi.ImageStreamList, li
 	}
 }
 
+// manifestListImageNodes returns the nodes of the images referenced by the given image if it is a manifest list.
+func manifestListImageNodes(g graph.Graph, imageNode *imagegraph.ImageNode) []*imagegraph.ImageNode {
+	var nodes []*imagegraph.ImageNode
+	for _, manifest := range imageNode.Image.DockerImageManifests {
+		n := imagegraph.FindImage(g, manifest.Digest)
+		if n == nil {
+			glog.V(2).Infof("Unable to find image %q of manifest list %q in graph - skipping", manifest.Digest, imageNode.Image.Name)
+			continue
+		}
+		nodes = append(nodes, n.(*imagegraph.ImageNode))
+	}
+	return nodes
+}
+
 // exceedsLimits checks if given image exceeds LimitRanges defined in ImageStream's namespace.
 func exceedsLimits(is *imageapi.ImageStream, image *imageapi.Image, limits map[string][]*kapi.LimitRange) bool {
 	limitRanges, ok := limits[is.Namespace]
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/openshift/github.com/docker/distribution/manifest/manifestlist"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema1"
 	"github.com/openshift/github.com/docker/distribution/manifest/schema2"
 
@@ -109,6 +110,15 @@ func imageWithLayers(id, ref string, configName *string, layers ...string) image
 	return image
 }
 
+func manifestListImage(id, ref string, manifests ...string) imageapi.Image {
+	image := imageWithLayers(id, ref, nil)
+	image.DockerImageManifestMediaType = manifestlist.MediaTypeManifestList
+	for _, manifest := range manifests {
+		image.DockerImageManifests = append(image.DockerImageManifests, imageapi.ImageManifest{Digest: manifest})
+	}
+	return image
+}
+
 func unmanagedImage(id, ref string, hasAnnotations bool, annotation, value string) imageapi.Image {
 	image := imageWithLayers(id, ref, nil)
 	if !hasAnnotations {
@@ -768,6 +778,26 @@ func TestImagePruning(t *testing.T) {
 				registryURL + "|layer8",
 			},
 		},
+		"manifest list references its images": {
+			images: imageList(
+				manifestListImage("sha256:0000000000000000000000000000000000000000000000000000000000000010", registryURL+"/foo/bar@sha256:0000000000000000000000000000000000000000000000000000000000000010",
+					"sha256:0000000000000000000000000000000000000000000000000000000000000011",
+					"sha256:0000000000000000000000000000000000000000000000000000000000000012"),
+				imageWithLayers("sha256:0000000000000000000000000000000000000000000000000000000000000011", registryURL+"/foo/bar@sha256:0000000000000000000000000000000000000000000000000000000000000011", &config1, "layer1", "layer2"),
+				imageWithLayers("sha256:0000000000000000000000000000000000000000000000000000000000000012", registryURL+"/foo/bar@sha256:0000000000000000000000000000000000000000000000000000000000000012", &config2, "layer3"),
+				imageWithLayers("sha256:0000000000000000000000000000000000000000000000000000000000000013", registryURL+"/foo/bar@sha256:0000000000000000000000000000000000000000000000000000000000000013", nil, "layer4"),
+			),
+			streams: streamList(
+				stream(registryURL, "foo", "bar", tags(
+					tag("latest",
+						tagEvent("sha256:0000000000000000000000000000000000000000000000000000000000000010", registryURL+"/foo/bar@sha256:0000000000000000000000000000000000000000000000000000000000000010"),
+					),
+				)),
+			),
+			expectedImageDeletions: []string{"sha256:0000000000000000000000000000000000000000000000000000000000000013"},
+			expectedStreamUpdates:  []string{},
+			expectedBlobDeletions:  []string{registryURL + "|layer4"},
+		},
 		"images with duplicate layers and configs": {
 			images: imageList(
 				imageWithLayers("sha256:0000000000000000000000000000000000000000000000000000000000000001", registryURL+"/foo/bar@sha256:0000000000000000000000000000000000000000000000000000000000000001", &config1, "layer1", "layer2", "layer3", "layer4"),
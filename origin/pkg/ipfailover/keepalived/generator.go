@@ -3,6 +3,7 @@ package keepalived
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	kapi "github.com/openshift/kubernetes/pkg/api"
 
@@ -14,13 +15,20 @@ import (
 const defaultInterface = "eth0"
 const libModulesVolumeName = "lib-modules"
 const libModulesPath = "/lib/modules"
+const checkScriptsVolumeName = "check-scripts"
 
 //  Generate the IP failover monitor (keepalived) container environment entries.
-func generateEnvEntries(name string, options *ipfailover.IPFailoverConfigCmdOptions) app.Environment {
+func generateEnvEntries(name string, options *ipfailover.IPFailoverConfigCmdOptions, groups []*ipfailover.VIPGroup) app.Environment {
 	watchPort := strconv.Itoa(options.WatchPort)
 	replicas := strconv.FormatInt(int64(options.Replicas), 10)
 	interval := strconv.Itoa(options.CheckInterval)
 	VRRPIDOffset := strconv.Itoa(options.VRRPIDOffset)
+	encodedGroups := make([]string, 0, len(groups))
+	for _, g := range groups {
+		resolved := *g
+		resolved.CheckScript = ipfailover.ResolveCheckScript(g.CheckScript, options)
+		encodedGroups = append(encodedGroups, resolved.Encode())
+	}
 	env := app.Environment{}
 
 	env.Add(app.Environment{
@@ -31,12 +39,13 @@ func generateEnvEntries(name string, options *ipfailover.IPFailoverConfigCmdOpti
 		"OPENSHIFT_HA_MONITOR_PORT":      watchPort,
 		"OPENSHIFT_HA_VRRP_ID_OFFSET":    VRRPIDOffset,
 		"OPENSHIFT_HA_REPLICA_COUNT":     replicas,
-		"OPENSHIFT_HA_USE_UNICAST":       "false",
+		"OPENSHIFT_HA_USE_UNICAST":       strconv.FormatBool(options.UseUnicast),
+		"OPENSHIFT_HA_UNICAST_PEERS":     options.UnicastPeers,
+		"OPENSHIFT_HA_VIP_GROUPS":        strings.Join(encodedGroups, "\n"),
 		"OPENSHIFT_HA_IPTABLES_CHAIN":    options.IptablesChain,
 		"OPENSHIFT_HA_NOTIFY_SCRIPT":     options.NotifyScript,
-		"OPENSHIFT_HA_CHECK_SCRIPT":      options.CheckScript,
+		"OPENSHIFT_HA_CHECK_SCRIPT":      ipfailover.ResolveCheckScript(options.CheckScript, options),
 		"OPENSHIFT_HA_CHECK_INTERVAL":    interval,
-		// "OPENSHIFT_HA_UNICAST_PEERS":     "127.0.0.1",
 	})
 	return env
 }
@@ -61,6 +70,22 @@ func generateFailoverMonitorContainerConfig(name string, options *ipfailover.IPF
 		ReadOnly:  true,
 		MountPath: libModulesPath,
 	}
+	if len(options.CheckScriptConfigMap) > 0 {
+		mounts = append(mounts, kapi.VolumeMount{
+			Name:      checkScriptsVolumeName,
+			ReadOnly:  true,
+			MountPath: ipfailover.CheckScriptMountPath,
+		})
+	}
+
+	//  The node name lets the keepalived image tell whether it runs on a
+	//  preferred node of a VIP group.
+	envVars := append(env.List(), kapi.EnvVar{
+		Name: "OPENSHIFT_HA_NODE_NAME",
+		ValueFrom: &kapi.EnvVarSource{
+			FieldRef: &kapi.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+		},
+	})
 
 	livenessProbe := &kapi.Probe{
 		InitialDelaySeconds: 10,
@@ -82,7 +107,7 @@ func generateFailoverMonitorContainerConfig(name string, options *ipfailover.IPF
 		},
 		ImagePullPolicy: kapi.PullIfNotPresent,
 		VolumeMounts:    mounts,
-		Env:             env.List(),
+		Env:             envVars,
 		LivenessProbe:   livenessProbe,
 	}
 }
@@ -91,11 +116,16 @@ func generateFailoverMonitorContainerConfig(name string, options *ipfailover.IPF
 func generateContainerConfig(name string, options *ipfailover.IPFailoverConfigCmdOptions) ([]kapi.Container, error) {
 	containers := make([]kapi.Container, 0)
 
-	if len(options.VirtualIPs) < 1 {
+	groups, err := ipfailover.ParseVIPGroups(options)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(options.VirtualIPs) < 1 && len(groups) == 0 {
 		return containers, nil
 	}
 
-	env := generateEnvEntries(name, options)
+	env := generateEnvEntries(name, options, groups)
 
 	c := generateFailoverMonitorContainerConfig(name, options, env)
 	if c != nil {
@@ -106,14 +136,29 @@ func generateContainerConfig(name string, options *ipfailover.IPFailoverConfigCm
 }
 
 //  Generate the IP failover monitor (keepalived) container volume config.
-func generateVolumeConfig() []kapi.Volume {
+func generateVolumeConfig(options *ipfailover.IPFailoverConfigCmdOptions) []kapi.Volume {
 	//  The keepalived container needs access to the kernel modules
 	//  directory in order to load the module.
 	hostPath := &kapi.HostPathVolumeSource{Path: libModulesPath}
 	src := kapi.VolumeSource{HostPath: hostPath}
 
 	vol := kapi.Volume{Name: libModulesVolumeName, VolumeSource: src}
-	return []kapi.Volume{vol}
+	volumes := []kapi.Volume{vol}
+
+	//  Check scripts supplied in a config map must be executable.
+	if len(options.CheckScriptConfigMap) > 0 {
+		mode := int32(0755)
+		volumes = append(volumes, kapi.Volume{
+			Name: checkScriptsVolumeName,
+			VolumeSource: kapi.VolumeSource{
+				ConfigMap: &kapi.ConfigMapVolumeSource{
+					LocalObjectReference: kapi.LocalObjectReference{Name: options.CheckScriptConfigMap},
+					DefaultMode:          &mode,
+				},
+			},
+		})
+	}
+	return volumes
 }
 
 //  Generates the node selector (if any) to use.
@@ -147,7 +192,7 @@ func GenerateDeploymentConfig(name string, options *ipfailover.IPFailoverConfigC
 			},
 			NodeSelector:       generateNodeSelector(name, selector),
 			Containers:         containers,
-			Volumes:            generateVolumeConfig(),
+			Volumes:            generateVolumeConfig(options),
 			ServiceAccountName: options.ServiceAccount,
 		},
 	}
@@ -121,3 +121,48 @@ func TestGenerateDeploymentConfig(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateDeploymentConfigVIPGroups(t *testing.T) {
+	options := makeIPFailoverConfigOptions("router=geo-us-west", 2, "ipfailover")
+	options.UseUnicast = true
+	options.UnicastPeers = "10.0.0.1,10.0.0.2"
+	options.CheckScriptConfigMap = "checks"
+	options.VIPGroups = []string{
+		"10.1.1.10-11;vrid=10;nodes=node-a;check=web.sh",
+		"10.1.1.20;vrid=20;priority=150;preempt=false",
+	}
+
+	dc, err := GenerateDeploymentConfig("config-test-groups", options, makeSelector(options))
+	if err != nil {
+		t.Fatalf("Test case for VIP groups got an error %v where none was expected", err)
+	}
+
+	podSpec := dc.Spec.Template.Spec
+	if len(podSpec.Containers) != 1 {
+		t.Fatalf("Test case for VIP groups got %d containers where 1 was expected", len(podSpec.Containers))
+	}
+
+	env := map[string]string{}
+	nodeNameFromField := false
+	for _, e := range podSpec.Containers[0].Env {
+		env[e.Name] = e.Value
+		if e.Name == "OPENSHIFT_HA_NODE_NAME" && e.ValueFrom != nil && e.ValueFrom.FieldRef != nil {
+			nodeNameFromField = e.ValueFrom.FieldRef.FieldPath == "spec.nodeName"
+		}
+	}
+	expectedGroups := "10|200|preempt_delay 300|node-a|/etc/keepalived/checks/web.sh|10.1.1.10-11\n" +
+		"20|150|nopreempt|||10.1.1.20"
+	if env["OPENSHIFT_HA_VIP_GROUPS"] != expectedGroups {
+		t.Errorf("Test case for VIP groups got OPENSHIFT_HA_VIP_GROUPS %q where %q was expected", env["OPENSHIFT_HA_VIP_GROUPS"], expectedGroups)
+	}
+	if env["OPENSHIFT_HA_USE_UNICAST"] != "true" || env["OPENSHIFT_HA_UNICAST_PEERS"] != "10.0.0.1,10.0.0.2" {
+		t.Errorf("Test case for VIP groups got unicast settings %q/%q", env["OPENSHIFT_HA_USE_UNICAST"], env["OPENSHIFT_HA_UNICAST_PEERS"])
+	}
+	if !nodeNameFromField {
+		t.Errorf("Test case for VIP groups expected OPENSHIFT_HA_NODE_NAME to come from spec.nodeName")
+	}
+
+	if len(podSpec.Volumes) != 2 || podSpec.Volumes[1].ConfigMap == nil || podSpec.Volumes[1].ConfigMap.Name != "checks" {
+		t.Errorf("Test case for VIP groups expected the check script config map to be mounted, got volumes %#v", podSpec.Volumes)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/glog"
 	kapi "github.com/openshift/kubernetes/pkg/api"
 	"github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/labels"
 	"github.com/openshift/kubernetes/pkg/runtime"
 
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
@@ -108,6 +109,53 @@ func (p *KeepalivedPlugin) GetDeploymentConfig() (*deployapi.DeploymentConfig, e
 	return dc, nil
 }
 
+// GetUnicastPeers returns the addresses of the nodes the IP Failover pods may
+// run on. Each keepalived instance sends its VRRP adverts to these peers when
+// unicast is enabled, skipping its own address.
+func (p *KeepalivedPlugin) GetUnicastPeers(selector map[string]string) ([]string, error) {
+	_, kClient, err := p.Factory.Clients()
+	if err != nil {
+		return nil, fmt.Errorf("error getting client: %v", err)
+	}
+
+	nodeSelector := labels.SelectorFromSet(generateNodeSelector(p.Name, selector))
+	nodes, err := kClient.Core().Nodes().List(kapi.ListOptions{LabelSelector: nodeSelector})
+	if err != nil {
+		return nil, fmt.Errorf("error listing nodes for unicast peers: %v", err)
+	}
+
+	peers := unicastPeersForNodes(nodes.Items)
+	if len(peers) == 0 {
+		return nil, fmt.Errorf("no nodes with an address match selector %q, use --unicast-peers to list the peers", nodeSelector.String())
+	}
+
+	glog.V(4).Infof("KeepAlived IP Failover config: %q - unicast peers: %v", p.Name, peers)
+
+	return peers, nil
+}
+
+// unicastPeersForNodes picks the internal address of each node, falling back
+// to the legacy host address.
+func unicastPeersForNodes(nodes []kapi.Node) []string {
+	peers := []string{}
+	for _, node := range nodes {
+		address := ""
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == kapi.NodeInternalIP {
+				address = addr.Address
+				break
+			}
+			if addr.Type == kapi.NodeLegacyHostIP && len(address) == 0 {
+				address = addr.Address
+			}
+		}
+		if len(address) > 0 {
+			peers = append(peers, address)
+		}
+	}
+	return peers
+}
+
 // Generate the config and services for this IP Failover configuration plugin.
 func (p *KeepalivedPlugin) Generate() (*kapi.List, error) {
 	selector, err := p.GetSelector()
@@ -115,8 +163,16 @@ func (p *KeepalivedPlugin) Generate() (*kapi.List, error) {
 		return nil, fmt.Errorf("error getting selector: %v", err)
 	}
 
-	if len(p.Options.VirtualIPs) == 0 {
-		return nil, fmt.Errorf("you must specify at least one virtual IP address (--virtual-ips=) for keepalived to expose")
+	if len(p.Options.VirtualIPs) == 0 && len(p.Options.VIPGroups) == 0 {
+		return nil, fmt.Errorf("you must specify at least one virtual IP address (--virtual-ips= or --vip-group=) for keepalived to expose")
+	}
+
+	if p.Options.UseUnicast && len(p.Options.UnicastPeers) == 0 {
+		peers, err := p.GetUnicastPeers(selector)
+		if err != nil {
+			return nil, err
+		}
+		p.Options.UnicastPeers = strings.Join(peers, ",")
 	}
 
 	dc, err := GenerateDeploymentConfig(p.Name, p.Options, selector)
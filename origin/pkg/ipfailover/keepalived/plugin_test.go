@@ -3,6 +3,8 @@ package keepalived
 import (
 	"testing"
 
+	kapi "github.com/openshift/kubernetes/pkg/api"
+
 	"github.com/openshift/origin/pkg/ipfailover"
 )
 
@@ -203,3 +205,23 @@ func TestPluginGetSelector(t *testing.T) {
 }
 
 // TODO: tests for Create, Generate, GetService, GetNamespace.
+
+func TestUnicastPeersForNodes(t *testing.T) {
+	nodes := []kapi.Node{
+		{Status: kapi.NodeStatus{Addresses: []kapi.NodeAddress{
+			{Type: kapi.NodeExternalIP, Address: "1.2.3.4"},
+			{Type: kapi.NodeInternalIP, Address: "10.0.0.1"},
+		}}},
+		{Status: kapi.NodeStatus{Addresses: []kapi.NodeAddress{
+			{Type: kapi.NodeLegacyHostIP, Address: "10.0.0.2"},
+		}}},
+		{Status: kapi.NodeStatus{Addresses: []kapi.NodeAddress{
+			{Type: kapi.NodeHostName, Address: "node-3"},
+		}}},
+	}
+
+	peers := unicastPeersForNodes(nodes)
+	if len(peers) != 2 || peers[0] != "10.0.0.1" || peers[1] != "10.0.0.2" {
+		t.Errorf("Test unicast peers got %v expected [10.0.0.1 10.0.0.2]", peers)
+	}
+}
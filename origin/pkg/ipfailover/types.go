@@ -32,6 +32,23 @@ const (
 
 	// DefaultInterface is the default network interface.
 	DefaultInterface = "eth0"
+
+	// DefaultVIPGroupPriority is the VRRP priority used on the preferred
+	// nodes of a VIP group when none is specified.
+	DefaultVIPGroupPriority = 200
+
+	// MinVIPGroupPriority is the lowest priority a VIP group may use. The
+	// keepalived image spreads the priorities of the nodes over the 64
+	// values below the group priority, which must all be valid priorities.
+	MinVIPGroupPriority = 65
+
+	// DefaultPreemptDelay is the number of seconds a node waits after
+	// startup before preempting a lower priority master.
+	DefaultPreemptDelay = 300
+
+	// CheckScriptMountPath is where the check script config map is mounted
+	// inside the keepalived container.
+	CheckScriptMountPath = "/etc/keepalived/checks"
 )
 
 // IPFailoverConfigCmdOptions are options supported by the IP Failover admin command.
@@ -55,4 +72,15 @@ type IPFailoverConfigCmdOptions struct {
 	WatchPort        int
 	VRRPIDOffset     int
 	Replicas         int32
+
+	// UseUnicast sends VRRP adverts to the other selected nodes instead of
+	// multicasting them. UnicastPeers overrides the discovered peer list.
+	UseUnicast   bool
+	UnicastPeers string
+	// VIPGroups holds the raw --vip-group values, see ParseVIPGroup.
+	VIPGroups []string
+	// CheckScriptConfigMap names a config map holding check scripts. It is
+	// mounted at CheckScriptMountPath and relative check script names are
+	// resolved against it.
+	CheckScriptConfigMap string
 }
@@ -81,7 +81,38 @@ func ValidateVirtualIPs(vips string) error {
 	return nil
 }
 
+// ValidateUnicastPeers validates a comma separated list of peer addresses.
+func ValidateUnicastPeers(peers string) error {
+	peers = strings.TrimSpace(peers)
+	if len(peers) < 1 {
+		return nil
+	}
+
+	for _, ip := range strings.Split(peers, ",") {
+		if err := ValidateIPAddress(ip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ValidateCmdOptions validates command line operations.
 func ValidateCmdOptions(options *IPFailoverConfigCmdOptions) error {
-	return ValidateVirtualIPs(options.VirtualIPs)
+	if err := ValidateVirtualIPs(options.VirtualIPs); err != nil {
+		return err
+	}
+
+	if len(options.UnicastPeers) > 0 && !options.UseUnicast {
+		return fmt.Errorf("--unicast-peers requires --unicast")
+	}
+	if err := ValidateUnicastPeers(options.UnicastPeers); err != nil {
+		return err
+	}
+
+	groups, err := ParseVIPGroups(options)
+	if err != nil {
+		return err
+	}
+	return ValidateVIPGroups(groups, options)
 }
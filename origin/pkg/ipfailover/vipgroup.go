@@ -0,0 +1,198 @@
+package ipfailover
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	kvalidation "github.com/openshift/kubernetes/pkg/util/validation"
+)
+
+// VIPGroup is a set of virtual IPs that fail over together as a single VRRP
+// instance with its own virtual router id and priority settings.
+type VIPGroup struct {
+	// VirtualIPs is a comma separated list of addresses and ranges, in the
+	// same format as --virtual-ips.
+	VirtualIPs string
+	// VRID is the virtual router id of the group, before the offset is applied.
+	VRID int
+	// Priority is the VRRP priority of the group on its preferred nodes.
+	Priority int
+	// Preempt controls whether a higher priority node takes the group back
+	// from a lower priority master. PreemptDelay is in seconds.
+	Preempt      bool
+	PreemptDelay int
+	// PreferredNodes are the names of the nodes that should hold the group.
+	// When empty, every node is equally preferred.
+	PreferredNodes []string
+	// CheckScript overrides the check script for this group.
+	CheckScript string
+}
+
+// ParseVIPGroup parses a --vip-group value of the form
+//
+//	VIPS[;vrid=N][;priority=N][;preempt=true|false|SECONDS][;nodes=NODE1:NODE2][;check=SCRIPT]
+//
+// where VIPS uses the same syntax as --virtual-ips.
+func ParseVIPGroup(spec string) (*VIPGroup, error) {
+	parts := strings.Split(spec, ";")
+	group := &VIPGroup{
+		VirtualIPs:   strings.TrimSpace(parts[0]),
+		Priority:     DefaultVIPGroupPriority,
+		Preempt:      true,
+		PreemptDelay: DefaultPreemptDelay,
+	}
+	if len(group.VirtualIPs) == 0 {
+		return nil, fmt.Errorf("VIP group %q must start with at least one virtual IP", spec)
+	}
+	if err := ValidateVirtualIPs(group.VirtualIPs); err != nil {
+		return nil, err
+	}
+
+	for _, part := range parts[1:] {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("VIP group %q: %q must be of the form key=value", spec, part)
+		}
+		key, value := kv[0], kv[1]
+		switch key {
+		case "vrid":
+			vrid, err := strconv.Atoi(value)
+			if err != nil || vrid < 1 || vrid > 255 {
+				return nil, fmt.Errorf("VIP group %q: vrid must be in the range 1..255", spec)
+			}
+			group.VRID = vrid
+		case "priority":
+			priority, err := strconv.Atoi(value)
+			if err != nil || priority < MinVIPGroupPriority || priority > 254 {
+				return nil, fmt.Errorf("VIP group %q: priority must be in the range %d..254", spec, MinVIPGroupPriority)
+			}
+			group.Priority = priority
+		case "preempt":
+			switch value {
+			case "true":
+				group.Preempt = true
+			case "false":
+				group.Preempt = false
+			default:
+				delay, err := strconv.Atoi(value)
+				if err != nil || delay < 0 {
+					return nil, fmt.Errorf("VIP group %q: preempt must be true, false or a delay in seconds", spec)
+				}
+				group.Preempt, group.PreemptDelay = true, delay
+			}
+		case "nodes":
+			for _, node := range strings.Split(value, ":") {
+				if len(node) == 0 {
+					continue
+				}
+				if errs := kvalidation.IsDNS1123Subdomain(node); len(errs) > 0 {
+					return nil, fmt.Errorf("VIP group %q: invalid node name %q: %s", spec, node, strings.Join(errs, ", "))
+				}
+				group.PreferredNodes = append(group.PreferredNodes, node)
+			}
+		case "check":
+			group.CheckScript = value
+		default:
+			return nil, fmt.Errorf("VIP group %q: unknown option %q", spec, key)
+		}
+	}
+	if group.VRID == 0 {
+		return nil, fmt.Errorf("VIP group %q: vrid is required", spec)
+	}
+	return group, nil
+}
+
+// PreemptOption returns the keepalived preemption setting of the group.
+func (g *VIPGroup) PreemptOption() string {
+	if !g.Preempt {
+		return "nopreempt"
+	}
+	return fmt.Sprintf("preempt_delay %d", g.PreemptDelay)
+}
+
+// Encode serializes the group into the single line format understood by the
+// keepalived image: VRID|PRIORITY|PREEMPT|NODES|CHECK|VIPS
+func (g *VIPGroup) Encode() string {
+	return strings.Join([]string{
+		strconv.Itoa(g.VRID),
+		strconv.Itoa(g.Priority),
+		g.PreemptOption(),
+		strings.Join(g.PreferredNodes, " "),
+		g.CheckScript,
+		g.VirtualIPs,
+	}, "|")
+}
+
+// ParseVIPGroups parses every --vip-group value in the options.
+func ParseVIPGroups(options *IPFailoverConfigCmdOptions) ([]*VIPGroup, error) {
+	groups := []*VIPGroup{}
+	for _, spec := range options.VIPGroups {
+		group, err := ParseVIPGroup(spec)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// ResolveCheckScript returns the path of a check script inside the container.
+// Relative names refer to keys of the check script config map when one is set.
+func ResolveCheckScript(script string, options *IPFailoverConfigCmdOptions) string {
+	if len(script) == 0 || len(options.CheckScriptConfigMap) == 0 || strings.HasPrefix(script, "/") {
+		return script
+	}
+	return path.Join(CheckScriptMountPath, script)
+}
+
+// ExpandedVirtualIPCount returns the number of addresses in a list of virtual
+// IPs and ranges. The list is assumed to be valid.
+func ExpandedVirtualIPCount(vips string) int {
+	count := 0
+	for _, iprange := range strings.Split(vips, ",") {
+		iprange = strings.TrimSpace(iprange)
+		if len(iprange) == 0 {
+			continue
+		}
+		limits := strings.Split(iprange, "-")
+		if len(limits) != 2 {
+			count++
+			continue
+		}
+		octets := strings.Split(limits[0], ".")
+		start, err1 := strconv.Atoi(octets[len(octets)-1])
+		end, err2 := strconv.Atoi(limits[1])
+		if err1 != nil || err2 != nil || end < start {
+			count++
+			continue
+		}
+		count += end - start + 1
+	}
+	return count
+}
+
+// ValidateVIPGroups checks that the groups do not share virtual router ids
+// with each other or with the per address instances created for --virtual-ips.
+func ValidateVIPGroups(groups []*VIPGroup, options *IPFailoverConfigCmdOptions) error {
+	reserved := ExpandedVirtualIPCount(options.VirtualIPs)
+	seen := map[int]bool{}
+	for _, g := range groups {
+		if g.VRID <= reserved {
+			return fmt.Errorf("VIP group %s: vrid %d is used by --virtual-ips, which uses ids 1..%d", g.VirtualIPs, g.VRID, reserved)
+		}
+		if g.VRID+options.VRRPIDOffset > 255 {
+			return fmt.Errorf("VIP group %s: vrid %d plus the vrrp-id-offset %d exceeds 255", g.VirtualIPs, g.VRID, options.VRRPIDOffset)
+		}
+		if seen[g.VRID] {
+			return fmt.Errorf("VIP group %s: vrid %d is used by more than one group", g.VirtualIPs, g.VRID)
+		}
+		seen[g.VRID] = true
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package ipfailover
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVIPGroup(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected *VIPGroup
+	}{
+		{
+			spec: "10.1.1.1;vrid=10",
+			expected: &VIPGroup{
+				VirtualIPs:   "10.1.1.1",
+				VRID:         10,
+				Priority:     DefaultVIPGroupPriority,
+				Preempt:      true,
+				PreemptDelay: DefaultPreemptDelay,
+			},
+		},
+		{
+			spec: "10.1.1.1-3,10.2.2.2; vrid=20; priority=150; preempt=false; nodes=node-a:node-b.example.com; check=web.sh",
+			expected: &VIPGroup{
+				VirtualIPs:     "10.1.1.1-3,10.2.2.2",
+				VRID:           20,
+				Priority:       150,
+				Preempt:        false,
+				PreemptDelay:   DefaultPreemptDelay,
+				PreferredNodes: []string{"node-a", "node-b.example.com"},
+				CheckScript:    "web.sh",
+			},
+		},
+		{
+			spec: "10.1.1.1;vrid=30;preempt=60",
+			expected: &VIPGroup{
+				VirtualIPs:   "10.1.1.1",
+				VRID:         30,
+				Priority:     DefaultVIPGroupPriority,
+				Preempt:      true,
+				PreemptDelay: 60,
+			},
+		},
+		{
+			spec: "10.1.1.1;vrid=40;priority=65",
+			expected: &VIPGroup{
+				VirtualIPs:   "10.1.1.1",
+				VRID:         40,
+				Priority:     MinVIPGroupPriority,
+				Preempt:      true,
+				PreemptDelay: DefaultPreemptDelay,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		group, err := ParseVIPGroup(tc.spec)
+		if err != nil {
+			t.Errorf("Test valid group=%q got error %s expected: no error.", tc.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(group, tc.expected) {
+			t.Errorf("Test valid group=%q got %#v expected: %#v", tc.spec, group, tc.expected)
+		}
+	}
+
+	invalidSpecs := []string{"", ";vrid=1", "10.1.1.1", "10.1.1.1;vrid=0",
+		"10.1.1.1;vrid=256", "10.1.1.1;vrid=1;priority=255", "10.1.1.1;vrid=1;priority=64",
+		"10.1.1.1;vrid=1;preempt=later", "10.1.1.1;vrid=1;nodes=Bad_Node",
+		"10.1.1.1;vrid=1;color=blue", "10.1.1.1;vrid", "10.1.1.300;vrid=1",
+	}
+
+	for _, spec := range invalidSpecs {
+		if _, err := ParseVIPGroup(spec); err == nil {
+			t.Errorf("Test invalid group=%q got no error expected: error.", spec)
+		}
+	}
+}
+
+func TestVIPGroupEncode(t *testing.T) {
+	group := &VIPGroup{
+		VirtualIPs:     "10.1.1.1-3",
+		VRID:           20,
+		Priority:       150,
+		Preempt:        false,
+		PreferredNodes: []string{"node-a", "node-b"},
+		CheckScript:    "/etc/keepalived/checks/web.sh",
+	}
+	expected := "20|150|nopreempt|node-a node-b|/etc/keepalived/checks/web.sh|10.1.1.1-3"
+	if encoded := group.Encode(); encoded != expected {
+		t.Errorf("Test encode got %q expected: %q", encoded, expected)
+	}
+
+	group.Preempt, group.PreemptDelay = true, 10
+	if option := group.PreemptOption(); option != "preempt_delay 10" {
+		t.Errorf("Test preempt option got %q expected: %q", option, "preempt_delay 10")
+	}
+}
+
+func TestValidateVIPGroups(t *testing.T) {
+	tests := []struct {
+		name       string
+		virtualIPs string
+		offset     int
+		vrids      []int
+		valid      bool
+	}{
+		{name: "groups only", vrids: []int{1, 2}, valid: true},
+		{name: "after virtual ips", virtualIPs: "10.1.1.1-3,10.2.2.2", vrids: []int{5}, valid: true},
+		{name: "overlaps virtual ips", virtualIPs: "10.1.1.1-3,10.2.2.2", vrids: []int{4}},
+		{name: "duplicate", vrids: []int{7, 7}},
+		{name: "offset too large", offset: 250, vrids: []int{6}},
+	}
+
+	for _, tc := range tests {
+		options := &IPFailoverConfigCmdOptions{VirtualIPs: tc.virtualIPs, VRRPIDOffset: tc.offset}
+		groups := []*VIPGroup{}
+		for _, vrid := range tc.vrids {
+			groups = append(groups, &VIPGroup{VirtualIPs: "10.9.9.9", VRID: vrid})
+		}
+		err := ValidateVIPGroups(groups, options)
+		if tc.valid && err != nil {
+			t.Errorf("Test %s got error %s expected: no error.", tc.name, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("Test %s got no error expected: error.", tc.name)
+		}
+	}
+}
+
+func TestResolveCheckScript(t *testing.T) {
+	options := &IPFailoverConfigCmdOptions{}
+	if script := ResolveCheckScript("web.sh", options); script != "web.sh" {
+		t.Errorf("Test without config map got %q expected: %q", script, "web.sh")
+	}
+
+	options.CheckScriptConfigMap = "checks"
+	if script := ResolveCheckScript("web.sh", options); script != "/etc/keepalived/checks/web.sh" {
+		t.Errorf("Test with config map got %q expected: %q", script, "/etc/keepalived/checks/web.sh")
+	}
+	if script := ResolveCheckScript("/usr/bin/check", options); script != "/usr/bin/check" {
+		t.Errorf("Test absolute path got %q expected: %q", script, "/usr/bin/check")
+	}
+}
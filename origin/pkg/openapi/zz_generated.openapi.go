@@ -23452,7 +23452,7 @@ var OpenAPIDefinitions *common.OpenAPIDefinitions = &common.OpenAPIDefinitions{
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of tag event condition, currently only ImportSuccess",
+							Description: "Type of tag event condition, ImportSuccess or ImageReplicated",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -32,6 +32,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Project{},
 		&ProjectList{},
 		&ProjectRequest{},
+		&ProjectHealth{},
 	)
 	return nil
 }
@@ -39,3 +40,4 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 func (obj *ProjectRequest) GetObjectKind() unversioned.ObjectKind { return &obj.TypeMeta }
 func (obj *Project) GetObjectKind() unversioned.ObjectKind        { return &obj.TypeMeta }
 func (obj *ProjectList) GetObjectKind() unversioned.ObjectKind    { return &obj.TypeMeta }
+func (obj *ProjectHealth) GetObjectKind() unversioned.ObjectKind  { return &obj.TypeMeta }
@@ -47,6 +47,34 @@ type ProjectRequest struct {
 	Description string
 }
 
+// ProjectHealth describes the problems found by analyzing the resources of a project, the same
+// problems 'oc status' reports.
+type ProjectHealth struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Markers are the problems found in the project
+	Markers []ProjectHealthMarker
+	// AnalyzedTime is when the resources of the project were last analyzed
+	AnalyzedTime unversioned.Time
+}
+
+// ProjectHealthMarker describes a single problem found in a project.
+type ProjectHealthMarker struct {
+	// Severity is how important the problem is: info, warning or error
+	Severity string
+	// Key is a short string identifying the kind of problem
+	Key string
+	// Message describes the problem
+	Message string
+	// Suggestion is advice for resolving the problem
+	Suggestion string
+	// Object is the object the problem was found on, if any
+	Object *kapi.ObjectReference
+	// RelatedObjects are other objects involved in the problem
+	RelatedObjects []kapi.ObjectReference
+}
+
 // These constants represent annotations keys affixed to projects
 const (
 	// ProjectNodeSelector is an annotation that holds the node selector;
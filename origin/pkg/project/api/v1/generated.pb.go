@@ -10,6 +10,8 @@
 
 	It has these top-level messages:
 		Project
+		ProjectHealth
+		ProjectHealthMarker
 		ProjectList
 		ProjectRequest
 		ProjectSpec
@@ -41,24 +43,34 @@ func (m *Project) Reset()                    { *m = Project{} }
 func (*Project) ProtoMessage()               {}
 func (*Project) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{0} }
 
+func (m *ProjectHealth) Reset()                    { *m = ProjectHealth{} }
+func (*ProjectHealth) ProtoMessage()               {}
+func (*ProjectHealth) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{1} }
+
+func (m *ProjectHealthMarker) Reset()                    { *m = ProjectHealthMarker{} }
+func (*ProjectHealthMarker) ProtoMessage()               {}
+func (*ProjectHealthMarker) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{2} }
+
 func (m *ProjectList) Reset()                    { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage()               {}
-func (*ProjectList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{1} }
+func (*ProjectList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{3} }
 
 func (m *ProjectRequest) Reset()                    { *m = ProjectRequest{} }
 func (*ProjectRequest) ProtoMessage()               {}
-func (*ProjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{2} }
+func (*ProjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{4} }
 
 func (m *ProjectSpec) Reset()                    { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage()               {}
-func (*ProjectSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{3} }
+func (*ProjectSpec) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{5} }
 
 func (m *ProjectStatus) Reset()                    { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage()               {}
-func (*ProjectStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{4} }
+func (*ProjectStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{6} }
 
 func init() {
 	proto.RegisterType((*Project)(nil), "github.com.openshift.origin.pkg.project.api.v1.Project")
+	proto.RegisterType((*ProjectHealth)(nil), "github.com.openshift.origin.pkg.project.api.v1.ProjectHealth")
+	proto.RegisterType((*ProjectHealthMarker)(nil), "github.com.openshift.origin.pkg.project.api.v1.ProjectHealthMarker")
 	proto.RegisterType((*ProjectList)(nil), "github.com.openshift.origin.pkg.project.api.v1.ProjectList")
 	proto.RegisterType((*ProjectRequest)(nil), "github.com.openshift.origin.pkg.project.api.v1.ProjectRequest")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.openshift.origin.pkg.project.api.v1.ProjectSpec")
@@ -106,6 +118,108 @@ func (m *Project) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ProjectHealth) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ProjectHealth) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.ObjectMeta.Size()))
+	n4, err := m.ObjectMeta.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if len(m.Markers) > 0 {
+		for _, msg := range m.Markers {
+			data[i] = 0x12
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(data, i, uint64(m.AnalyzedTime.Size()))
+	n5, err := m.AnalyzedTime.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	return i, nil
+}
+
+func (m *ProjectHealthMarker) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ProjectHealthMarker) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Severity)))
+	i += copy(data[i:], m.Severity)
+	data[i] = 0x12
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Key)))
+	i += copy(data[i:], m.Key)
+	data[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Message)))
+	i += copy(data[i:], m.Message)
+	data[i] = 0x22
+	i++
+	i = encodeVarintGenerated(data, i, uint64(len(m.Suggestion)))
+	i += copy(data[i:], m.Suggestion)
+	if m.Object != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintGenerated(data, i, uint64(m.Object.Size()))
+		n6, err := m.Object.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.RelatedObjects) > 0 {
+		for _, msg := range m.RelatedObjects {
+			data[i] = 0x32
+			i++
+			i = encodeVarintGenerated(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *ProjectList) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -124,11 +238,11 @@ func (m *ProjectList) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ListMeta.Size()))
-	n4, err := m.ListMeta.MarshalTo(data[i:])
+	n7, err := m.ListMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n7
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			data[i] = 0x12
@@ -162,11 +276,11 @@ func (m *ProjectRequest) MarshalTo(data []byte) (int, error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintGenerated(data, i, uint64(m.ObjectMeta.Size()))
-	n5, err := m.ObjectMeta.MarshalTo(data[i:])
+	n8, err := m.ObjectMeta.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n8
 	data[i] = 0x12
 	i++
 	i = encodeVarintGenerated(data, i, uint64(len(m.DisplayName)))
@@ -272,6 +386,46 @@ func (m *Project) Size() (n int) {
 	return n
 }
 
+func (m *ProjectHealth) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Markers) > 0 {
+		for _, e := range m.Markers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.AnalyzedTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectHealthMarker) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Severity)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Suggestion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.RelatedObjects) > 0 {
+		for _, e := range m.RelatedObjects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ProjectList) Size() (n int) {
 	var l int
 	_ = l
@@ -343,6 +497,33 @@ func (this *Project) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectHealth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectHealth{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(this.ObjectMeta.String(), "ObjectMeta", "k8s_io_kubernetes_pkg_api_v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Markers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Markers), "ProjectHealthMarker", "ProjectHealthMarker", 1), `&`, ``, 1) + `,`,
+		`AnalyzedTime:` + strings.Replace(strings.Replace(this.AnalyzedTime.String(), "Time", "k8s_io_kubernetes_pkg_api_unversioned.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectHealthMarker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectHealthMarker{`,
+		`Severity:` + fmt.Sprintf("%v", this.Severity) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Suggestion:` + fmt.Sprintf("%v", this.Suggestion) + `,`,
+		`Object:` + strings.Replace(fmt.Sprintf("%v", this.Object), "ObjectReference", "k8s_io_kubernetes_pkg_api_v1.ObjectReference", 1) + `,`,
+		`RelatedObjects:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RelatedObjects), "ObjectReference", "k8s_io_kubernetes_pkg_api_v1.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectList) String() string {
 	if this == nil {
 		return "nil"
@@ -534,6 +715,377 @@ func (m *Project) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *ProjectHealth) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markers = append(m.Markers, ProjectHealthMarker{})
+			if err := m.Markers[len(m.Markers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnalyzedTime.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectHealthMarker) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectHealthMarker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectHealthMarker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severity = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suggestion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suggestion = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Object == nil {
+				m.Object = &k8s_io_kubernetes_pkg_api_v1.ObjectReference{}
+			}
+			if err := m.Object.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelatedObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelatedObjects = append(m.RelatedObjects, k8s_io_kubernetes_pkg_api_v1.ObjectReference{})
+			if err := m.RelatedObjects[len(m.RelatedObjects)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectList) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
@@ -1047,41 +1599,54 @@ var (
 )
 
 var fileDescriptorGenerated = []byte{
-	// 562 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x53, 0x4f, 0x6f, 0x12, 0x41,
-	0x14, 0x67, 0xa1, 0xd4, 0x32, 0xd8, 0xc6, 0xac, 0x17, 0xc2, 0x61, 0x21, 0xc4, 0x03, 0x51, 0x3b,
-	0x13, 0x9a, 0x34, 0x9a, 0x58, 0x3d, 0x6c, 0x8c, 0x89, 0x89, 0x7f, 0x9a, 0xf5, 0x62, 0x4c, 0x7b,
-	0x18, 0x96, 0xc7, 0x32, 0x02, 0x3b, 0xe3, 0xcc, 0x2c, 0x89, 0x9e, 0xfc, 0x08, 0x7e, 0x0d, 0xbf,
-	0x09, 0xf1, 0xd4, 0x63, 0x4f, 0x44, 0xf0, 0x5b, 0xf4, 0x64, 0x76, 0x76, 0x0a, 0x6b, 0xa9, 0x24,
-	0x36, 0xf1, 0xc6, 0x7b, 0xfb, 0xfb, 0xf3, 0xe6, 0xf7, 0x1e, 0xe8, 0x59, 0xc4, 0xf4, 0x20, 0xe9,
-	0xe2, 0x90, 0x8f, 0x09, 0x17, 0x10, 0xab, 0x01, 0xeb, 0x6b, 0xc2, 0x25, 0x8b, 0x58, 0x4c, 0xc4,
-	0x30, 0x22, 0x42, 0xf2, 0x8f, 0x10, 0x6a, 0x42, 0x05, 0x23, 0x93, 0x0e, 0x89, 0x20, 0x06, 0x49,
-	0x35, 0xf4, 0xb0, 0x90, 0x5c, 0x73, 0x17, 0xaf, 0xf8, 0x78, 0xc9, 0xc7, 0x19, 0x1f, 0x8b, 0x61,
-	0x84, 0x2d, 0x1f, 0x53, 0xc1, 0xf0, 0xa4, 0x53, 0xdf, 0xcf, 0xf9, 0x45, 0x3c, 0xe2, 0xc4, 0xc8,
-	0x74, 0x93, 0xbe, 0xa9, 0x4c, 0x61, 0x7e, 0x65, 0xf2, 0xf5, 0xc3, 0xe1, 0x63, 0x85, 0x19, 0x27,
-	0xc3, 0xa4, 0x0b, 0x32, 0x06, 0x0d, 0xca, 0x0c, 0x95, 0x0e, 0x93, 0xc4, 0x13, 0x90, 0x8a, 0xf1,
-	0x18, 0x7a, 0x57, 0xa7, 0xaa, 0x3f, 0xfc, 0x3b, 0x6d, 0xfd, 0x0d, 0xf5, 0xfd, 0xeb, 0xd1, 0x32,
-	0x89, 0x35, 0x1b, 0xc3, 0x1a, 0xbc, 0x73, 0x3d, 0x3c, 0xd1, 0x6c, 0x44, 0x58, 0xac, 0x95, 0x96,
-	0x57, 0x29, 0xad, 0xef, 0x45, 0x74, 0xeb, 0x38, 0x0b, 0xc2, 0x7d, 0x8f, 0x76, 0xc6, 0xa0, 0x69,
-	0x8f, 0x6a, 0x5a, 0x73, 0x9a, 0x4e, 0xbb, 0x7a, 0xd0, 0xc6, 0x99, 0x22, 0x5e, 0x29, 0x9a, 0xe8,
-	0xb2, 0xc8, 0xf0, 0xdb, 0x6e, 0xca, 0x7b, 0x0d, 0x9a, 0xfa, 0xee, 0x74, 0xd6, 0x28, 0x2c, 0x66,
-	0x0d, 0xb4, 0xea, 0x05, 0x4b, 0x35, 0xf7, 0x14, 0x6d, 0x29, 0x01, 0x61, 0xad, 0x68, 0x54, 0x9f,
-	0xfc, 0xe3, 0x6a, 0xb0, 0x1d, 0xf0, 0x9d, 0x80, 0xd0, 0xbf, 0x6d, 0x8d, 0xb6, 0xd2, 0x2a, 0x30,
-	0xb2, 0x2e, 0xa0, 0x6d, 0xa5, 0xa9, 0x4e, 0x54, 0xad, 0x64, 0x0c, 0x9e, 0xde, 0xd4, 0xc0, 0x88,
-	0xf8, 0x7b, 0xd6, 0x62, 0x3b, 0xab, 0x03, 0x2b, 0xde, 0xfa, 0xe1, 0xa0, 0xaa, 0x45, 0xbe, 0x62,
-	0x4a, 0xbb, 0xa7, 0x6b, 0x79, 0x91, 0x0d, 0x79, 0xe5, 0xae, 0x02, 0xa7, 0x74, 0x13, 0xdb, 0x1d,
-	0x6b, 0xb5, 0x73, 0xd9, 0xc9, 0x85, 0x76, 0x82, 0xca, 0x4c, 0xc3, 0x58, 0xd5, 0x8a, 0xcd, 0x52,
-	0xbb, 0x7a, 0xf0, 0xe8, 0x86, 0x8f, 0xf2, 0x77, 0xad, 0x47, 0xf9, 0x65, 0xaa, 0x16, 0x64, 0xa2,
-	0xad, 0x73, 0x07, 0xed, 0x59, 0x44, 0x00, 0x9f, 0x12, 0x50, 0xff, 0x73, 0xff, 0x87, 0xa8, 0xda,
-	0x63, 0x4a, 0x8c, 0xe8, 0xe7, 0x37, 0x74, 0x0c, 0xe6, 0x0c, 0x2a, 0xfe, 0x5d, 0x4b, 0xa9, 0x3e,
-	0x5f, 0x7d, 0x0a, 0xf2, 0x38, 0x43, 0x03, 0x15, 0x4a, 0x26, 0x34, 0xe3, 0xb1, 0x59, 0x6e, 0x9e,
-	0xb6, 0xfa, 0x14, 0xe4, 0x71, 0xad, 0xe1, 0x72, 0x4d, 0xe9, 0x8d, 0xb8, 0x27, 0x08, 0xf5, 0x59,
-	0x4c, 0x47, 0xec, 0x0b, 0x48, 0x55, 0x73, 0x9a, 0xa5, 0x76, 0xc5, 0x3f, 0x4a, 0x47, 0x7d, 0xb1,
-	0xec, 0x5e, 0xcc, 0x1a, 0xf7, 0x37, 0xfd, 0x31, 0xf1, 0x12, 0x6a, 0x86, 0xcc, 0xe9, 0xb5, 0x42,
-	0xb4, 0xfb, 0xc7, 0xf5, 0xb8, 0x01, 0x2a, 0x8b, 0x01, 0x55, 0x60, 0x22, 0xac, 0xf8, 0x47, 0x97,
-	0xe9, 0x1f, 0xa7, 0xcd, 0x8b, 0x59, 0xe3, 0xc1, 0x46, 0xa3, 0x54, 0x5f, 0x09, 0x1a, 0x82, 0x81,
-	0x07, 0x99, 0x94, 0x7f, 0x6f, 0x3a, 0xf7, 0x0a, 0x67, 0x73, 0xaf, 0x70, 0x3e, 0xf7, 0x0a, 0x5f,
-	0x17, 0x9e, 0x33, 0x5d, 0x78, 0xce, 0xd9, 0xc2, 0x73, 0x7e, 0x2e, 0x3c, 0xe7, 0xdb, 0x2f, 0xaf,
-	0xf0, 0xa1, 0x38, 0xe9, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xb3, 0x68, 0xc8, 0x32, 0x05,
-	0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x6e, 0xfb, 0x44,
+	0x10, 0xc7, 0xe3, 0xb8, 0x49, 0x9a, 0x4d, 0x1b, 0x90, 0x8b, 0x50, 0x14, 0x09, 0x27, 0x8a, 0x38,
+	0x04, 0xda, 0xae, 0x95, 0x4a, 0x15, 0x48, 0x14, 0x24, 0x0c, 0x42, 0x20, 0x28, 0x94, 0x2d, 0x07,
+	0x84, 0xda, 0xc3, 0xc6, 0x99, 0x38, 0x4b, 0xe2, 0x3f, 0x78, 0xd7, 0x91, 0xd2, 0x13, 0x12, 0x2f,
+	0xc0, 0x6b, 0xf0, 0x16, 0x1c, 0x2b, 0x4e, 0x3d, 0xf6, 0x14, 0xd1, 0xf0, 0x16, 0x3d, 0x21, 0xaf,
+	0x37, 0x8e, 0x9b, 0xf6, 0x57, 0xb5, 0x95, 0x7a, 0xcb, 0xce, 0x7e, 0xe7, 0x33, 0xb3, 0x33, 0xe3,
+	0x09, 0xfa, 0xcc, 0x65, 0x62, 0x14, 0xf7, 0xb1, 0x13, 0x78, 0x56, 0x10, 0x82, 0xcf, 0x47, 0x6c,
+	0x28, 0xac, 0x20, 0x62, 0x2e, 0xf3, 0xad, 0x70, 0xec, 0x5a, 0x61, 0x14, 0xfc, 0x0a, 0x8e, 0xb0,
+	0x68, 0xc8, 0xac, 0x69, 0xcf, 0x72, 0xc1, 0x87, 0x88, 0x0a, 0x18, 0xe0, 0x30, 0x0a, 0x44, 0x60,
+	0xe0, 0x95, 0x3f, 0xce, 0xfc, 0x71, 0xea, 0x8f, 0xc3, 0xb1, 0x8b, 0x95, 0x3f, 0xa6, 0x21, 0xc3,
+	0xd3, 0x5e, 0x73, 0x3f, 0x17, 0xcf, 0x0d, 0xdc, 0xc0, 0x92, 0x98, 0x7e, 0x3c, 0x94, 0x27, 0x79,
+	0x90, 0xbf, 0x52, 0x7c, 0xf3, 0x70, 0xfc, 0x31, 0xc7, 0x2c, 0xb0, 0xc6, 0x71, 0x1f, 0x22, 0x1f,
+	0x04, 0x70, 0x99, 0x54, 0x92, 0x4c, 0xec, 0x4f, 0x21, 0xe2, 0x2c, 0xf0, 0x61, 0xb0, 0x9e, 0x55,
+	0x73, 0xef, 0xcd, 0x6e, 0xf7, 0xdf, 0xd0, 0xdc, 0x7f, 0x58, 0x1d, 0xc5, 0xbe, 0x60, 0x1e, 0xdc,
+	0x93, 0xf7, 0x1e, 0x96, 0xc7, 0x82, 0x4d, 0x2c, 0xe6, 0x0b, 0x2e, 0xa2, 0x75, 0x97, 0xce, 0x5f,
+	0x45, 0x54, 0x39, 0x49, 0x0b, 0x61, 0xfc, 0x8c, 0x36, 0x3d, 0x10, 0x74, 0x40, 0x05, 0x6d, 0x68,
+	0x6d, 0xad, 0x5b, 0x3b, 0xe8, 0xe2, 0x94, 0x88, 0x57, 0x44, 0x59, 0xba, 0xb4, 0x64, 0xf8, 0x87,
+	0x7e, 0xe2, 0x77, 0x0c, 0x82, 0xda, 0xc6, 0xe5, 0xbc, 0x55, 0x58, 0xcc, 0x5b, 0x68, 0x65, 0x23,
+	0x19, 0xcd, 0x38, 0x47, 0x1b, 0x3c, 0x04, 0xa7, 0x51, 0x94, 0xd4, 0x4f, 0x9e, 0xd9, 0x1a, 0xac,
+	0x12, 0x3c, 0x0d, 0xc1, 0xb1, 0xb7, 0x54, 0xa0, 0x8d, 0xe4, 0x44, 0x24, 0xd6, 0x00, 0x54, 0xe6,
+	0x82, 0x8a, 0x98, 0x37, 0x74, 0x19, 0xe0, 0xd3, 0x97, 0x06, 0x90, 0x10, 0xbb, 0xae, 0x42, 0x94,
+	0xd3, 0x33, 0x51, 0xf0, 0xce, 0xdf, 0x45, 0xb4, 0xad, 0x94, 0x5f, 0x03, 0x9d, 0x88, 0xd1, 0x2b,
+	0x56, 0xcc, 0x47, 0x15, 0x8f, 0x46, 0x63, 0x88, 0x78, 0xa3, 0xd8, 0xd6, 0xbb, 0xb5, 0x83, 0x2f,
+	0x5e, 0xf8, 0xa6, 0x34, 0xd3, 0x63, 0xc9, 0xb2, 0xdf, 0x52, 0x31, 0x2b, 0xe9, 0x99, 0x93, 0x65,
+	0x10, 0x03, 0xd0, 0x16, 0xf5, 0xe9, 0x64, 0x76, 0x01, 0x83, 0x9f, 0x98, 0x07, 0xaa, 0x90, 0xbb,
+	0x8f, 0xbc, 0x26, 0x37, 0xe5, 0x38, 0x71, 0xb1, 0xdf, 0x51, 0xf0, 0xad, 0xcf, 0x73, 0x20, 0x72,
+	0x07, 0xdb, 0xf9, 0x43, 0x47, 0x3b, 0x0f, 0x24, 0x66, 0xec, 0xa1, 0x4d, 0x0e, 0x53, 0x88, 0x98,
+	0x98, 0xc9, 0x42, 0x56, 0xed, 0xb7, 0x15, 0x6d, 0xf3, 0x54, 0xd9, 0x49, 0xa6, 0x30, 0xde, 0x43,
+	0xfa, 0x18, 0x66, 0x72, 0x9a, 0xaa, 0x76, 0x4d, 0x09, 0xf5, 0x6f, 0x61, 0x46, 0x12, 0xbb, 0xf1,
+	0x01, 0xaa, 0x78, 0xc0, 0x39, 0x75, 0xd3, 0x67, 0x54, 0x73, 0xcf, 0x4e, 0xcd, 0x64, 0x79, 0x6f,
+	0x1c, 0x20, 0xc4, 0x63, 0xd7, 0x05, 0x2e, 0x58, 0xe0, 0x37, 0x36, 0xa4, 0x3a, 0x6b, 0xcc, 0x69,
+	0x76, 0x43, 0x72, 0x2a, 0xe3, 0x47, 0x54, 0x0e, 0x64, 0xcb, 0x1a, 0x25, 0x59, 0xa4, 0xfd, 0xa7,
+	0xb4, 0x9c, 0xc0, 0x10, 0x22, 0xf0, 0x1d, 0xb0, 0x51, 0x32, 0x59, 0xca, 0xa8, 0x40, 0x86, 0x87,
+	0xea, 0x11, 0x4c, 0x92, 0xcf, 0x32, 0xbd, 0xe0, 0x8d, 0x72, 0x5b, 0x7f, 0x3e, 0xfa, 0x5d, 0x95,
+	0x79, 0x9d, 0xdc, 0x81, 0x91, 0x35, 0x78, 0xe7, 0x1f, 0x0d, 0xd5, 0x54, 0x17, 0xbe, 0x63, 0x5c,
+	0x18, 0xe7, 0xf7, 0xc6, 0xd8, 0x7a, 0x62, 0xe3, 0x13, 0x77, 0x39, 0xcd, 0x59, 0xbb, 0x96, 0x96,
+	0xdc, 0x2c, 0x9f, 0xa1, 0x12, 0x13, 0xe0, 0x2d, 0x27, 0xf9, 0xa3, 0x17, 0x4e, 0xb2, 0xbd, 0xad,
+	0x62, 0x94, 0xbe, 0x49, 0x68, 0x24, 0x85, 0x76, 0xae, 0x35, 0x54, 0x57, 0x0a, 0x02, 0xbf, 0xc5,
+	0xc0, 0x5f, 0x73, 0x91, 0x1d, 0xa2, 0xda, 0x80, 0xf1, 0x70, 0x42, 0x67, 0xdf, 0x53, 0x0f, 0xd4,
+	0x04, 0xee, 0x28, 0x97, 0xda, 0x97, 0xab, 0x2b, 0x92, 0xd7, 0x49, 0x37, 0xe0, 0x4e, 0xc4, 0x42,
+	0x39, 0x67, 0xfa, 0x9a, 0xdb, 0xea, 0x8a, 0xe4, 0x75, 0x9d, 0x71, 0xd6, 0xa6, 0x64, 0xd9, 0x19,
+	0x67, 0x08, 0x0d, 0x99, 0x4f, 0x27, 0xec, 0x22, 0x59, 0x0b, 0x5a, 0x5b, 0xef, 0x56, 0xed, 0xa3,
+	0x24, 0xd5, 0xaf, 0x32, 0xeb, 0xed, 0xbc, 0xf5, 0xe1, 0x63, 0xff, 0x30, 0x38, 0x93, 0xca, 0x24,
+	0x73, 0xbc, 0x8e, 0x93, 0x2d, 0xb7, 0x74, 0xed, 0x19, 0x04, 0x95, 0xc2, 0x11, 0xe5, 0xa0, 0x3e,
+	0xc8, 0xa3, 0x65, 0xf5, 0x4f, 0x12, 0xe3, 0xed, 0xbc, 0xb5, 0xfb, 0x68, 0xa0, 0x84, 0xcf, 0x43,
+	0xea, 0x80, 0x94, 0x93, 0x14, 0x65, 0xbf, 0x7f, 0x79, 0x63, 0x16, 0xae, 0x6e, 0xcc, 0xc2, 0xf5,
+	0x8d, 0x59, 0xf8, 0x7d, 0x61, 0x6a, 0x97, 0x0b, 0x53, 0xbb, 0x5a, 0x98, 0xda, 0xbf, 0x0b, 0x53,
+	0xfb, 0xf3, 0x3f, 0xb3, 0xf0, 0x4b, 0x71, 0xda, 0xfb, 0x7f, 0x00, 0xc4, 0x37, 0x5c, 0xc6, 0xfb,
+	0x07, 0x00, 0x00,
 }
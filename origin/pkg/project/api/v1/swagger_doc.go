@@ -16,6 +16,31 @@ func (Project) SwaggerDoc() map[string]string {
 	return map_Project
 }
 
+var map_ProjectHealth = map[string]string{
+	"":             "ProjectHealth describes the problems found by analyzing the resources of a project, the same problems 'oc status' reports. It is read from the health subresource of a project.",
+	"metadata":     "Standard object's metadata.",
+	"markers":      "Markers are the problems found in the project",
+	"analyzedTime": "AnalyzedTime is when the resources of the project were last analyzed",
+}
+
+func (ProjectHealth) SwaggerDoc() map[string]string {
+	return map_ProjectHealth
+}
+
+var map_ProjectHealthMarker = map[string]string{
+	"":               "ProjectHealthMarker describes a single problem found in a project.",
+	"severity":       "Severity is how important the problem is: info, warning or error",
+	"key":            "Key is a short string identifying the kind of problem",
+	"message":        "Message describes the problem",
+	"suggestion":     "Suggestion is advice for resolving the problem",
+	"object":         "Object is the object the problem was found on, if any",
+	"relatedObjects": "RelatedObjects are other objects involved in the problem",
+}
+
+func (ProjectHealthMarker) SwaggerDoc() map[string]string {
+	return map_ProjectHealthMarker
+}
+
 var map_ProjectList = map[string]string{
 	"":         "ProjectList is a list of Project objects.",
 	"metadata": "Standard object's metadata.",
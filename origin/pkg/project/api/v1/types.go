@@ -68,3 +68,32 @@ type ProjectRequest struct {
 	// Description is the description to apply to a project
 	Description string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
 }
+
+// ProjectHealth describes the problems found by analyzing the resources of a project, the same
+// problems 'oc status' reports. It is read from the health subresource of a project.
+type ProjectHealth struct {
+	unversioned.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	kapi.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Markers are the problems found in the project
+	Markers []ProjectHealthMarker `json:"markers" protobuf:"bytes,2,rep,name=markers"`
+	// AnalyzedTime is when the resources of the project were last analyzed
+	AnalyzedTime unversioned.Time `json:"analyzedTime" protobuf:"bytes,3,opt,name=analyzedTime"`
+}
+
+// ProjectHealthMarker describes a single problem found in a project.
+type ProjectHealthMarker struct {
+	// Severity is how important the problem is: info, warning or error
+	Severity string `json:"severity" protobuf:"bytes,1,opt,name=severity"`
+	// Key is a short string identifying the kind of problem
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+	// Message describes the problem
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
+	// Suggestion is advice for resolving the problem
+	Suggestion string `json:"suggestion,omitempty" protobuf:"bytes,4,opt,name=suggestion"`
+	// Object is the object the problem was found on, if any
+	Object *kapi.ObjectReference `json:"object,omitempty" protobuf:"bytes,5,opt,name=object"`
+	// RelatedObjects are other objects involved in the problem
+	RelatedObjects []kapi.ObjectReference `json:"relatedObjects,omitempty" protobuf:"bytes,6,rep,name=relatedObjects"`
+}
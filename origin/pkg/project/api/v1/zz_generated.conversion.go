@@ -23,6 +23,10 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedConversionFuncs(
 		Convert_v1_Project_To_api_Project,
 		Convert_api_Project_To_v1_Project,
+		Convert_v1_ProjectHealth_To_api_ProjectHealth,
+		Convert_api_ProjectHealth_To_v1_ProjectHealth,
+		Convert_v1_ProjectHealthMarker_To_api_ProjectHealthMarker,
+		Convert_api_ProjectHealthMarker_To_v1_ProjectHealthMarker,
 		Convert_v1_ProjectList_To_api_ProjectList,
 		Convert_api_ProjectList_To_v1_ProjectList,
 		Convert_v1_ProjectRequest_To_api_ProjectRequest,
@@ -68,6 +72,116 @@ func Convert_api_Project_To_v1_Project(in *api.Project, out *Project, s conversi
 	return autoConvert_api_Project_To_v1_Project(in, out, s)
 }
 
+func autoConvert_v1_ProjectHealth_To_api_ProjectHealth(in *ProjectHealth, out *api.ProjectHealth, s conversion.Scope) error {
+	if err := api_v1.Convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Markers != nil {
+		in, out := &in.Markers, &out.Markers
+		*out = make([]api.ProjectHealthMarker, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ProjectHealthMarker_To_api_ProjectHealthMarker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Markers = nil
+	}
+	out.AnalyzedTime = in.AnalyzedTime
+	return nil
+}
+
+func Convert_v1_ProjectHealth_To_api_ProjectHealth(in *ProjectHealth, out *api.ProjectHealth, s conversion.Scope) error {
+	return autoConvert_v1_ProjectHealth_To_api_ProjectHealth(in, out, s)
+}
+
+func autoConvert_api_ProjectHealth_To_v1_ProjectHealth(in *api.ProjectHealth, out *ProjectHealth, s conversion.Scope) error {
+	if err := api_v1.Convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if in.Markers != nil {
+		in, out := &in.Markers, &out.Markers
+		*out = make([]ProjectHealthMarker, len(*in))
+		for i := range *in {
+			if err := Convert_api_ProjectHealthMarker_To_v1_ProjectHealthMarker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Markers = nil
+	}
+	out.AnalyzedTime = in.AnalyzedTime
+	return nil
+}
+
+func Convert_api_ProjectHealth_To_v1_ProjectHealth(in *api.ProjectHealth, out *ProjectHealth, s conversion.Scope) error {
+	return autoConvert_api_ProjectHealth_To_v1_ProjectHealth(in, out, s)
+}
+
+func autoConvert_v1_ProjectHealthMarker_To_api_ProjectHealthMarker(in *ProjectHealthMarker, out *api.ProjectHealthMarker, s conversion.Scope) error {
+	out.Severity = in.Severity
+	out.Key = in.Key
+	out.Message = in.Message
+	out.Suggestion = in.Suggestion
+	if in.Object != nil {
+		in, out := &in.Object, &out.Object
+		*out = new(pkg_api.ObjectReference)
+		if err := api_v1.Convert_v1_ObjectReference_To_api_ObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Object = nil
+	}
+	if in.RelatedObjects != nil {
+		in, out := &in.RelatedObjects, &out.RelatedObjects
+		*out = make([]pkg_api.ObjectReference, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_v1_ObjectReference_To_api_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RelatedObjects = nil
+	}
+	return nil
+}
+
+func Convert_v1_ProjectHealthMarker_To_api_ProjectHealthMarker(in *ProjectHealthMarker, out *api.ProjectHealthMarker, s conversion.Scope) error {
+	return autoConvert_v1_ProjectHealthMarker_To_api_ProjectHealthMarker(in, out, s)
+}
+
+func autoConvert_api_ProjectHealthMarker_To_v1_ProjectHealthMarker(in *api.ProjectHealthMarker, out *ProjectHealthMarker, s conversion.Scope) error {
+	out.Severity = in.Severity
+	out.Key = in.Key
+	out.Message = in.Message
+	out.Suggestion = in.Suggestion
+	if in.Object != nil {
+		in, out := &in.Object, &out.Object
+		*out = new(api_v1.ObjectReference)
+		if err := api_v1.Convert_api_ObjectReference_To_v1_ObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Object = nil
+	}
+	if in.RelatedObjects != nil {
+		in, out := &in.RelatedObjects, &out.RelatedObjects
+		*out = make([]api_v1.ObjectReference, len(*in))
+		for i := range *in {
+			if err := api_v1.Convert_api_ObjectReference_To_v1_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.RelatedObjects = nil
+	}
+	return nil
+}
+
+func Convert_api_ProjectHealthMarker_To_v1_ProjectHealthMarker(in *api.ProjectHealthMarker, out *ProjectHealthMarker, s conversion.Scope) error {
+	return autoConvert_api_ProjectHealthMarker_To_v1_ProjectHealthMarker(in, out, s)
+}
+
 func autoConvert_v1_ProjectList_To_api_ProjectList(in *ProjectList, out *api.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -20,6 +20,8 @@ func init() {
 func RegisterDeepCopies(scheme *runtime.Scheme) error {
 	return scheme.AddGeneratedDeepCopyFuncs(
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_Project, InType: reflect.TypeOf(&Project{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectHealth, InType: reflect.TypeOf(&ProjectHealth{})},
+		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectHealthMarker, InType: reflect.TypeOf(&ProjectHealthMarker{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectList, InType: reflect.TypeOf(&ProjectList{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectRequest, InType: reflect.TypeOf(&ProjectRequest{})},
 		conversion.GeneratedDeepCopyFunc{Fn: DeepCopy_v1_ProjectSpec, InType: reflect.TypeOf(&ProjectSpec{})},
@@ -43,6 +45,58 @@ func DeepCopy_v1_Project(in interface{}, out interface{}, c *conversion.Cloner)
 	}
 }
 
+func DeepCopy_v1_ProjectHealth(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ProjectHealth)
+		out := out.(*ProjectHealth)
+		out.TypeMeta = in.TypeMeta
+		if err := api_v1.DeepCopy_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, c); err != nil {
+			return err
+		}
+		if in.Markers != nil {
+			in, out := &in.Markers, &out.Markers
+			*out = make([]ProjectHealthMarker, len(*in))
+			for i := range *in {
+				if err := DeepCopy_v1_ProjectHealthMarker(&(*in)[i], &(*out)[i], c); err != nil {
+					return err
+				}
+			}
+		} else {
+			out.Markers = nil
+		}
+		out.AnalyzedTime = in.AnalyzedTime.DeepCopy()
+		return nil
+	}
+}
+
+func DeepCopy_v1_ProjectHealthMarker(in interface{}, out interface{}, c *conversion.Cloner) error {
+	{
+		in := in.(*ProjectHealthMarker)
+		out := out.(*ProjectHealthMarker)
+		out.Severity = in.Severity
+		out.Key = in.Key
+		out.Message = in.Message
+		out.Suggestion = in.Suggestion
+		if in.Object != nil {
+			in, out := &in.Object, &out.Object
+			*out = new(api_v1.ObjectReference)
+			**out = **in
+		} else {
+			out.Object = nil
+		}
+		if in.RelatedObjects != nil {
+			in, out := &in.RelatedObjects, &out.RelatedObjects
+			*out = make([]api_v1.ObjectReference, len(*in))
+			for i := range *in {
+				(*out)[i] = (*in)[i]
+			}
+		} else {
+			out.RelatedObjects = nil
+		}
+		return nil
+	}
+}
+
 func DeepCopy_v1_ProjectList(in interface{}, out interface{}, c *conversion.Cloner) error {
 	{
 		in := in.(*ProjectList)
@@ -0,0 +1,235 @@
+package health
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/openshift/github.com/gonum/graph"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/meta"
+	"github.com/openshift/kubernetes/pkg/api/unversioned"
+	"github.com/openshift/kubernetes/pkg/client/cache"
+	kclientset "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	utilruntime "github.com/openshift/kubernetes/pkg/util/runtime"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/cli/describe"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+// DefaultTTL bounds how long a result is served for resources that are not watched by an informer,
+// like routes, services and builds.
+const DefaultTTL = 30 * time.Second
+
+// MarkerFunc returns the status markers of a namespace.
+type MarkerFunc func(namespace string) (osgraph.Markers, error)
+
+// Cache computes the health of projects on demand and keeps the result per namespace until an
+// object in the namespace changes or the result is older than the TTL.
+type Cache struct {
+	markers MarkerFunc
+	ttl     time.Duration
+	now     func() time.Time
+
+	lock sync.Mutex
+	// entries holds the last computed health of each namespace.
+	entries map[string]*projectapi.ProjectHealth
+	// generations is bumped every time a namespace is invalidated while it is analyzed, so a
+	// result computed concurrently with a change is not stored. inflight counts the analyses in
+	// progress per namespace; both are only kept while the count is not zero.
+	generations map[string]int64
+	inflight    map[string]int
+}
+
+// NewCache returns a cache that analyzes projects with the given clients. The clients must be able
+// to read every resource in any namespace.
+func NewCache(kc kclientset.Interface, oc client.Interface, ttl time.Duration) *Cache {
+	describer := &describe.ProjectStatusDescriber{
+		K:                           kc,
+		C:                           oc,
+		Suggest:                     true,
+		LogsCommandName:             "oc logs",
+		SecurityPolicyCommandFormat: "oadm policy add-scc-to-user anyuid -n %s -z %s",
+		SetProbeCommandName:         "oc set probe",
+	}
+	return NewCacheForMarkers(describer.ProjectMarkers, ttl)
+}
+
+// NewCacheForMarkers returns a cache around an arbitrary marker function.
+func NewCacheForMarkers(markers MarkerFunc, ttl time.Duration) *Cache {
+	return &Cache{
+		markers:     markers,
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     make(map[string]*projectapi.ProjectHealth),
+		generations: make(map[string]int64),
+		inflight:    make(map[string]int),
+	}
+}
+
+// Get returns the health of the namespace, analyzing it if there is no fresh result.
+func (c *Cache) Get(namespace string) (*projectapi.ProjectHealth, error) {
+	c.lock.Lock()
+	entry, ok := c.entries[namespace]
+	if ok && c.now().Sub(entry.AnalyzedTime.Time) < c.ttl {
+		c.lock.Unlock()
+		return copyHealth(entry)
+	}
+	generation := c.generations[namespace]
+	c.inflight[namespace]++
+	c.lock.Unlock()
+
+	var health *projectapi.ProjectHealth
+	defer func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if health != nil && c.generations[namespace] == generation {
+			c.entries[namespace] = health
+		}
+		if c.inflight[namespace]--; c.inflight[namespace] == 0 {
+			delete(c.inflight, namespace)
+			delete(c.generations, namespace)
+		}
+	}()
+
+	markers, err := c.markers(namespace)
+	if err != nil {
+		return nil, err
+	}
+	health = &projectapi.ProjectHealth{
+		ObjectMeta:   kapi.ObjectMeta{Name: namespace},
+		Markers:      ConvertMarkers(markers),
+		AnalyzedTime: unversioned.NewTime(c.now()),
+	}
+	return copyHealth(health)
+}
+
+// Invalidate drops the cached health of the namespace.
+func (c *Cache) Invalidate(namespace string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, namespace)
+	if c.inflight[namespace] > 0 {
+		c.generations[namespace]++
+	}
+}
+
+// InvalidateOnChange registers the cache with an informer, so a change to any object the informer
+// watches invalidates the namespace of that object.
+func (c *Cache) InvalidateOnChange(informer cache.SharedIndexInformer) {
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.invalidateObject,
+		UpdateFunc: func(old, cur interface{}) {
+			c.invalidateObject(cur)
+		},
+		DeleteFunc: c.invalidateObject,
+	})
+}
+
+// InvalidateOnNamespaceDelete registers the cache with a namespace informer, so the health of a
+// namespace is dropped when the namespace is deleted.
+func (c *Cache) InvalidateOnNamespaceDelete(informer cache.SharedIndexInformer) {
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				c.Invalidate(tombstone.Key)
+				return
+			}
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				utilruntime.HandleError(err)
+				return
+			}
+			c.Invalidate(accessor.GetName())
+		},
+	})
+}
+
+func (c *Cache) invalidateObject(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		namespace, _, err := cache.SplitMetaNamespaceKey(tombstone.Key)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+		c.Invalidate(namespace)
+		return
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.Invalidate(accessor.GetNamespace())
+}
+
+// ConvertMarkers turns graph markers into their API representation.
+func ConvertMarkers(markers osgraph.Markers) []projectapi.ProjectHealthMarker {
+	out := make([]projectapi.ProjectHealthMarker, 0, len(markers))
+	for _, marker := range markers {
+		m := projectapi.ProjectHealthMarker{
+			Severity:   string(marker.Severity),
+			Key:        marker.Key,
+			Message:    marker.Message,
+			Suggestion: string(marker.Suggestion),
+		}
+		if marker.Node != nil {
+			m.Object = nodeReference(marker.Node)
+		}
+		for _, node := range marker.RelatedNodes {
+			if ref := nodeReference(node); ref != nil {
+				m.RelatedObjects = append(m.RelatedObjects, *ref)
+			}
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+type objectifier interface {
+	Object() interface{}
+}
+
+// nodeReference returns a reference to the object behind a graph node, or nil if the node does not
+// stand for an API object.
+func nodeReference(node graph.Node) *kapi.ObjectReference {
+	n, ok := node.(objectifier)
+	if !ok {
+		return nil
+	}
+	obj, ok := n.Object().(runtime.Object)
+	if !ok {
+		return nil
+	}
+	// synthetic nodes for missing objects carry no self link, so the reference is built from the
+	// scheme instead of kapi.GetReference
+	kinds, _, err := kapi.Scheme.ObjectKinds(obj)
+	if err != nil {
+		glog.V(4).Infof("Unable to reference %T in project health: %v", obj, err)
+		return nil
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		glog.V(4).Infof("Unable to reference %T in project health: %v", obj, err)
+		return nil
+	}
+	return &kapi.ObjectReference{
+		Kind:            kinds[0].Kind,
+		Namespace:       accessor.GetNamespace(),
+		Name:            accessor.GetName(),
+		UID:             accessor.GetUID(),
+		ResourceVersion: accessor.GetResourceVersion(),
+	}
+}
+
+func copyHealth(health *projectapi.ProjectHealth) (*projectapi.ProjectHealth, error) {
+	copied, err := kapi.Scheme.DeepCopy(health)
+	if err != nil {
+		return nil, err
+	}
+	return copied.(*projectapi.ProjectHealth), nil
+}
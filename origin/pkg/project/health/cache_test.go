@@ -0,0 +1,113 @@
+package health
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openshift/github.com/gonum/graph"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/client/cache"
+
+	osgraph "github.com/openshift/origin/pkg/api/graph"
+	_ "github.com/openshift/origin/pkg/api/install"
+	kubegraph "github.com/openshift/origin/pkg/api/kubegraph/nodes"
+)
+
+func TestCache(t *testing.T) {
+	calls := 0
+	now := time.Unix(1000, 0)
+	c := NewCacheForMarkers(func(namespace string) (osgraph.Markers, error) {
+		calls++
+		return osgraph.Markers{{Severity: osgraph.WarningSeverity, Key: "Test", Message: namespace}}, nil
+	}, time.Minute)
+	c.now = func() time.Time { return now }
+
+	health, err := c.Get("ns")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if health.Name != "ns" || len(health.Markers) != 1 || health.Markers[0].Message != "ns" {
+		t.Fatalf("unexpected health: %#v", health)
+	}
+	if _, err := c.Get("ns"); err != nil || calls != 1 {
+		t.Fatalf("expected the cached result to be reused, analyzed %d times: %v", calls, err)
+	}
+
+	c.invalidateObject(&kapi.Pod{ObjectMeta: kapi.ObjectMeta{Namespace: "other", Name: "pod"}})
+	if c.Get("ns"); calls != 1 {
+		t.Errorf("a change in another namespace must not invalidate the result")
+	}
+	c.invalidateObject(cache.DeletedFinalStateUnknown{Key: "ns/pod"})
+	if c.Get("ns"); calls != 2 {
+		t.Errorf("expected a deleted object to invalidate its namespace, analyzed %d times", calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if c.Get("ns"); calls != 3 {
+		t.Errorf("expected an expired result to be analyzed again, analyzed %d times", calls)
+	}
+}
+
+func TestCacheBounded(t *testing.T) {
+	var c *Cache
+	c = NewCacheForMarkers(func(namespace string) (osgraph.Markers, error) {
+		// a change while the namespace is analyzed must keep the result from being stored
+		c.Invalidate(namespace)
+		return nil, nil
+	}, time.Minute)
+
+	if _, err := c.Get("changing"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := c.entries["changing"]; ok {
+		t.Errorf("expected a result computed during a change not to be stored")
+	}
+
+	c.markers = func(namespace string) (osgraph.Markers, error) { return nil, nil }
+	if _, err := c.Get("ns"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		c.invalidateObject(&kapi.Pod{ObjectMeta: kapi.ObjectMeta{Namespace: fmt.Sprintf("other-%d", i), Name: "pod"}})
+	}
+	if len(c.generations) != 0 || len(c.inflight) != 0 {
+		t.Errorf("expected no state for namespaces that are not analyzed, got %v and %v", c.generations, c.inflight)
+	}
+	if len(c.entries) != 1 {
+		t.Fatalf("expected one cached namespace, got %v", c.entries)
+	}
+
+	c.Invalidate("ns")
+	if len(c.entries) != 0 {
+		t.Errorf("expected the namespace to be dropped, got %v", c.entries)
+	}
+}
+
+func TestConvertMarkers(t *testing.T) {
+	g := osgraph.New()
+	pod := kubegraph.EnsurePodNode(g, &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "pod", UID: "uid"}})
+	secret := kubegraph.EnsureSecretNode(g, &kapi.Secret{ObjectMeta: kapi.ObjectMeta{Namespace: "ns", Name: "secret"}})
+
+	markers := ConvertMarkers(osgraph.Markers{
+		{Node: pod, RelatedNodes: []graph.Node{secret}, Severity: osgraph.ErrorSeverity, Key: "Key", Message: "message", Suggestion: "fix it"},
+		{Severity: osgraph.InfoSeverity, Key: "NoNode"},
+	})
+	if len(markers) != 2 {
+		t.Fatalf("expected two markers, got %#v", markers)
+	}
+	m := markers[0]
+	if m.Severity != "error" || m.Key != "Key" || m.Message != "message" || m.Suggestion != "fix it" {
+		t.Errorf("unexpected marker: %#v", m)
+	}
+	if m.Object == nil || m.Object.Kind != "Pod" || m.Object.Namespace != "ns" || m.Object.Name != "pod" || m.Object.UID != "uid" {
+		t.Errorf("unexpected object reference: %#v", m.Object)
+	}
+	if len(m.RelatedObjects) != 1 || m.RelatedObjects[0].Kind != "Secret" || m.RelatedObjects[0].Name != "secret" {
+		t.Errorf("unexpected related objects: %#v", m.RelatedObjects)
+	}
+	if markers[1].Object != nil || len(markers[1].RelatedObjects) != 0 {
+		t.Errorf("expected a marker without nodes to reference nothing: %#v", markers[1])
+	}
+}
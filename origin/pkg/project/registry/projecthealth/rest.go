@@ -0,0 +1,82 @@
+package projecthealth
+
+import (
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/api/rest"
+	"github.com/openshift/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+// HealthGetter returns the health of a project.
+type HealthGetter interface {
+	Get(namespace string) (*projectapi.ProjectHealth, error)
+}
+
+// REST implements the read-only projects/health subresource.
+type REST struct {
+	projects   rest.Getter
+	health     HealthGetter
+	authorizer authorizer.Authorizer
+}
+
+var _ = rest.Getter(&REST{})
+
+// NewREST returns a new REST. The health of a project is only returned if the project can be
+// retrieved from projects.
+func NewREST(projects rest.Getter, health HealthGetter, authorizer authorizer.Authorizer) *REST {
+	return &REST{projects: projects, health: health, authorizer: authorizer}
+}
+
+// New returns a new ProjectHealth.
+func (r *REST) New() runtime.Object {
+	return &projectapi.ProjectHealth{}
+}
+
+// Get returns the health of the named project. Access to the project has already been checked by
+// the authorizer against the projects/health subresource. The health is computed with privileged
+// clients, so the problems that name secrets are dropped unless the user can read the secrets of
+// the project.
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	if _, err := r.projects.Get(ctx, name); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, kerrors.NewNotFound(projectapi.Resource("project"), name)
+		}
+		return nil, err
+	}
+	health, err := r.health.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := authorizer.DefaultAuthorizationAttributes{Verb: "get", Resource: "secrets", APIGroup: kapi.GroupName}
+	if allowed, _, err := r.authorizer.Authorize(kapi.WithNamespace(ctx, name), attributes); err != nil || !allowed {
+		health.Markers = withoutSecrets(health.Markers)
+	}
+	return health, nil
+}
+
+// withoutSecrets returns the markers that do not refer to a secret.
+func withoutSecrets(markers []projectapi.ProjectHealthMarker) []projectapi.ProjectHealthMarker {
+	filtered := make([]projectapi.ProjectHealthMarker, 0, len(markers))
+	for _, marker := range markers {
+		if !refersToSecret(marker) {
+			filtered = append(filtered, marker)
+		}
+	}
+	return filtered
+}
+
+func refersToSecret(marker projectapi.ProjectHealthMarker) bool {
+	if marker.Object != nil && marker.Object.Kind == "Secret" {
+		return true
+	}
+	for _, ref := range marker.RelatedObjects {
+		if ref.Kind == "Secret" {
+			return true
+		}
+	}
+	return false
+}
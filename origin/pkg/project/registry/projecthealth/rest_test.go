@@ -0,0 +1,119 @@
+package projecthealth
+
+import (
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+
+	"github.com/openshift/origin/pkg/authorization/authorizer"
+	projectapi "github.com/openshift/origin/pkg/project/api"
+)
+
+type fakeProjects map[string]bool
+
+func (p fakeProjects) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	if !p[name] {
+		return nil, kerrors.NewNotFound(kapi.Resource("namespaces"), name)
+	}
+	return &projectapi.Project{ObjectMeta: kapi.ObjectMeta{Name: name}}, nil
+}
+
+type fakeHealth struct{}
+
+func (fakeHealth) Get(namespace string) (*projectapi.ProjectHealth, error) {
+	return &projectapi.ProjectHealth{
+		ObjectMeta: kapi.ObjectMeta{Name: namespace},
+		Markers: []projectapi.ProjectHealthMarker{
+			{
+				Key:            "MissingSecret",
+				Object:         &kapi.ObjectReference{Kind: "DeploymentConfig", Name: "app"},
+				RelatedObjects: []kapi.ObjectReference{{Kind: "Secret", Name: "db-password"}},
+			},
+			{
+				Key:    "CrashLoopingPod",
+				Object: &kapi.ObjectReference{Kind: "Pod", Name: "app-1-abcde"},
+			},
+		},
+	}, nil
+}
+
+type fakeAuthorizer struct {
+	allowed bool
+	action  authorizer.Action
+}
+
+func (a *fakeAuthorizer) Authorize(ctx kapi.Context, action authorizer.Action) (bool, string, error) {
+	a.action = action
+	return a.allowed, "", nil
+}
+
+func (a *fakeAuthorizer) GetAllowedSubjects(ctx kapi.Context, attributes authorizer.Action) (sets.String, sets.String, error) {
+	return nil, nil, nil
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name     string
+		project  string
+		secrets  bool
+		notFound bool
+		markers  []string
+	}{
+		{
+			name:    "can read secrets",
+			project: "test",
+			secrets: true,
+			markers: []string{"MissingSecret", "CrashLoopingPod"},
+		},
+		{
+			name:    "can't read secrets",
+			project: "test",
+			markers: []string{"CrashLoopingPod"},
+		},
+		{
+			name:     "missing project",
+			project:  "missing",
+			secrets:  true,
+			notFound: true,
+		},
+	}
+
+	for _, test := range tests {
+		authz := &fakeAuthorizer{allowed: test.secrets}
+		storage := NewREST(fakeProjects{"test": true}, fakeHealth{}, authz)
+
+		obj, err := storage.Get(kapi.NewContext(), test.project)
+		if test.notFound {
+			if !kerrors.IsNotFound(err) {
+				t.Errorf("%s: expected a not found error, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if authz.action == nil || authz.action.GetVerb() != "get" || authz.action.GetResource() != "secrets" {
+			t.Errorf("%s: expected access to secrets to be checked, got %#v", test.name, authz.action)
+		}
+
+		health := obj.(*projectapi.ProjectHealth)
+		var markers []string
+		for _, marker := range health.Markers {
+			markers = append(markers, marker.Key)
+		}
+		if len(markers) != len(test.markers) {
+			t.Errorf("%s: expected markers %v, got %v", test.name, test.markers, markers)
+			continue
+		}
+		for i := range markers {
+			if markers[i] != test.markers[i] {
+				t.Errorf("%s: expected markers %v, got %v", test.name, test.markers, markers)
+				break
+			}
+		}
+	}
+}
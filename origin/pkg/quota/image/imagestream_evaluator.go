@@ -1,8 +1,12 @@
 package image
 
 import (
+	"fmt"
+
 	"github.com/openshift/kubernetes/pkg/admission"
 	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/resource"
+	"github.com/openshift/kubernetes/pkg/labels"
 	kquota "github.com/openshift/kubernetes/pkg/quota"
 	"github.com/openshift/kubernetes/pkg/quota/generic"
 	"github.com/openshift/kubernetes/pkg/runtime"
@@ -14,32 +18,85 @@ import (
 const imageStreamEvaluatorName = "Evaluator.ImageStream"
 
 // NewImageStreamEvaluator computes resource usage of ImageStreams. Instantiating this is necessary for
-// resource quota admission controller to properly work on image stream related objects.
-func NewImageStreamEvaluator(store *oscache.StoreToImageStreamLister) kquota.Evaluator {
+// resource quota admission controller to properly work on image stream related objects. Besides the
+// number of image streams, it computes the storage taken by their images using the given layers getter.
+func NewImageStreamEvaluator(store *oscache.StoreToImageStreamLister, layers ImageLayersGetter) kquota.Evaluator {
+	admittedResources := []kapi.ResourceName{
+		imageapi.ResourceImageStreams,
+	}
 	allResources := []kapi.ResourceName{
 		imageapi.ResourceImageStreams,
+		imageapi.ResourceImageStreamsStorage,
 	}
 
-	return &generic.GenericEvaluator{
-		Name:              imageStreamEvaluatorName,
-		InternalGroupKind: imageapi.Kind("ImageStream"),
-		InternalOperationResources: map[admission.Operation][]kapi.ResourceName{
-			admission.Create: allResources,
+	return &imageStreamEvaluator{
+		GenericEvaluator: &generic.GenericEvaluator{
+			Name:              imageStreamEvaluatorName,
+			InternalGroupKind: imageapi.Kind("ImageStream"),
+			InternalOperationResources: map[admission.Operation][]kapi.ResourceName{
+				admission.Create: admittedResources,
+			},
+			MatchedResourceNames: allResources,
+			MatchesScopeFunc:     generic.MatchesNoScopeFunc,
+			ConstraintsFunc:      generic.ObjectCountConstraintsFunc(imageapi.ResourceImageStreams),
+			UsageFunc:            generic.ObjectCountUsageFunc(imageapi.ResourceImageStreams),
+			ListFuncByNamespace: func(namespace string, options kapi.ListOptions) ([]runtime.Object, error) {
+				list, err := store.ImageStreams(namespace).List(options.LabelSelector)
+				if err != nil {
+					return nil, err
+				}
+				results := make([]runtime.Object, 0, len(list))
+				for _, is := range list {
+					results = append(results, is)
+				}
+				return results, nil
+			},
 		},
-		MatchedResourceNames: allResources,
-		MatchesScopeFunc:     generic.MatchesNoScopeFunc,
-		ConstraintsFunc:      generic.ObjectCountConstraintsFunc(imageapi.ResourceImageStreams),
-		UsageFunc:            generic.ObjectCountUsageFunc(imageapi.ResourceImageStreams),
-		ListFuncByNamespace: func(namespace string, options kapi.ListOptions) ([]runtime.Object, error) {
-			list, err := store.ImageStreams(namespace).List(options.LabelSelector)
-			if err != nil {
-				return nil, err
-			}
-			results := make([]runtime.Object, 0, len(list))
-			for _, is := range list {
-				results = append(results, is)
+		store:  store,
+		layers: layers,
+	}
+}
+
+// imageStreamEvaluator adds the storage usage to the object counts computed by the generic evaluator.
+// Storage cannot be computed per object because layers shared among image streams must be counted once.
+// It is not admitted on image stream creation; the registry refuses blobs exceeding it instead.
+type imageStreamEvaluator struct {
+	*generic.GenericEvaluator
+
+	store  *oscache.StoreToImageStreamLister
+	layers ImageLayersGetter
+}
+
+var _ kquota.Evaluator = &imageStreamEvaluator{}
+
+// UsageStats calculates the number of image streams and the storage taken by their images.
+func (e *imageStreamEvaluator) UsageStats(options kquota.UsageStatsOptions) (kquota.UsageStats, error) {
+	stats, err := e.GenericEvaluator.UsageStats(options)
+	if err != nil {
+		return stats, err
+	}
+
+	list, err := e.store.ImageStreams(options.Namespace).List(labels.Everything())
+	if err != nil {
+		return stats, fmt.Errorf("%s: failed to list image streams: %v", e.Name, err)
+	}
+	streams := make([]*imageapi.ImageStream, 0, len(list))
+	for _, is := range list {
+		matchesScopes := true
+		for _, scope := range options.Scopes {
+			if !e.MatchesScope(scope, is) {
+				matchesScopes = false
 			}
-			return results, nil
-		},
+		}
+		if matchesScopes {
+			streams = append(streams, is)
+		}
+	}
+
+	size, err := GetImageStreamsStorageUsage(streams, e.layers)
+	if err != nil {
+		return stats, fmt.Errorf("%s: failed to compute image storage: %v", e.Name, err)
 	}
+	stats.Used[imageapi.ResourceImageStreamsStorage] = *resource.NewQuantity(size, resource.BinarySI)
+	return stats, nil
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/api/resource"
 	"github.com/openshift/kubernetes/pkg/client/cache"
 	kquota "github.com/openshift/kubernetes/pkg/quota"
 
@@ -91,7 +92,7 @@ func TestImageStreamEvaluatorUsageStats(t *testing.T) {
 		for _, is := range tc.iss {
 			store.Indexer.Add(&is)
 		}
-		evaluator := NewImageStreamEvaluator(&store)
+		evaluator := NewImageStreamEvaluator(&store, fakeImageLayersGetter{})
 
 		stats, err := evaluator.UsageStats(kquota.UsageStatsOptions{Namespace: tc.namespace})
 		if err != nil {
@@ -100,6 +101,7 @@ func TestImageStreamEvaluatorUsageStats(t *testing.T) {
 		}
 
 		expectedUsage := imagetest.ExpectedResourceListFor(tc.expectedISCount)
+		expectedUsage[imageapi.ResourceImageStreamsStorage] = *resource.NewQuantity(0, resource.BinarySI)
 		expectedResources := kquota.ResourceNames(expectedUsage)
 		if len(stats.Used) != len(expectedResources) {
 			t.Errorf("[%s]: got unexpected number of computed resources: %d != %d", tc.name, len(stats.Used), len(expectedResources))
@@ -183,7 +185,7 @@ func TestImageStreamEvaluatorUsage(t *testing.T) {
 		for _, is := range tc.iss {
 			store.Indexer.Add(&is)
 		}
-		evaluator := NewImageStreamEvaluator(&store)
+		evaluator := NewImageStreamEvaluator(&store, fakeImageLayersGetter{})
 
 		usage := evaluator.Usage(newIS)
 		expectedUsage := imagetest.ExpectedResourceListFor(tc.expectedISCount)
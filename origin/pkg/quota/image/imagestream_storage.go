@@ -0,0 +1,100 @@
+package image
+
+import (
+	lru "github.com/openshift/github.com/hashicorp/golang-lru"
+
+	kerrors "github.com/openshift/kubernetes/pkg/api/errors"
+	"github.com/openshift/kubernetes/pkg/util/sets"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// ImageLayersGetter returns the layers of an image that are stored in the integrated registry. Images
+// that are not managed by the registry have no stored layers.
+type ImageLayersGetter interface {
+	StoredLayers(imageName string) ([]imageapi.ImageLayer, error)
+}
+
+// DefaultImageLayersCacheSize is the number of images whose stored layers are remembered by the
+// caching ImageLayersGetter.
+const DefaultImageLayersCacheSize = 4096
+
+// cachingImageLayersGetter fetches images from the master and remembers the stored layers of the most
+// recently used ones. Layers of an image never change once it has been created, so entries are never
+// refreshed, only evicted.
+type cachingImageLayersGetter struct {
+	images osclient.ImagesInterfacer
+	layers *lru.Cache
+}
+
+// NewCachingImageLayersGetter returns an ImageLayersGetter backed by the image API.
+func NewCachingImageLayersGetter(images osclient.ImagesInterfacer) ImageLayersGetter {
+	return newCachingImageLayersGetter(images, DefaultImageLayersCacheSize)
+}
+
+func newCachingImageLayersGetter(images osclient.ImagesInterfacer, size int) *cachingImageLayersGetter {
+	layers, err := lru.New(size)
+	if err != nil {
+		// this should never happen
+		panic(err)
+	}
+	return &cachingImageLayersGetter{
+		images: images,
+		layers: layers,
+	}
+}
+
+func (g *cachingImageLayersGetter) StoredLayers(imageName string) ([]imageapi.ImageLayer, error) {
+	if cached, ok := g.layers.Get(imageName); ok {
+		return cached.([]imageapi.ImageLayer), nil
+	}
+
+	image, err := g.images.Images().Get(imageName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			// a pruned image still referenced by a stream takes no storage
+			return nil, nil
+		}
+		return nil, err
+	}
+	var layers []imageapi.ImageLayer
+	if image.Annotations[imageapi.ManagedByOpenShiftAnnotation] == "true" {
+		layers = image.DockerImageLayers
+	}
+
+	g.layers.Add(imageName, layers)
+	return layers, nil
+}
+
+// GetImageStreamsStorageUsage returns the sum of sizes of unique layers of all images referenced in the
+// status of the given image streams. A layer shared by several images or streams is counted once.
+func GetImageStreamsStorageUsage(streams []*imageapi.ImageStream, getter ImageLayersGetter) (int64, error) {
+	seenImages := sets.NewString()
+	seenLayers := sets.NewString()
+	var total int64
+
+	for _, is := range streams {
+		for _, history := range is.Status.Tags {
+			for _, event := range history.Items {
+				if len(event.Image) == 0 || seenImages.Has(event.Image) {
+					continue
+				}
+				seenImages.Insert(event.Image)
+
+				layers, err := getter.StoredLayers(event.Image)
+				if err != nil {
+					return 0, err
+				}
+				for _, layer := range layers {
+					if seenLayers.Has(layer.Name) {
+						continue
+					}
+					seenLayers.Insert(layer.Name)
+					total += layer.LayerSize
+				}
+			}
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,142 @@
+package image
+
+import (
+	"testing"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// fakeImageLayersGetter maps image names to their stored layers.
+type fakeImageLayersGetter map[string][]imageapi.ImageLayer
+
+func (f fakeImageLayersGetter) StoredLayers(imageName string) ([]imageapi.ImageLayer, error) {
+	return f[imageName], nil
+}
+
+func streamWithImages(name string, images ...string) *imageapi.ImageStream {
+	is := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: name},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{},
+		},
+	}
+	for _, image := range images {
+		list := is.Status.Tags["latest"]
+		list.Items = append(list.Items, imageapi.TagEvent{Image: image})
+		is.Status.Tags["latest"] = list
+	}
+	return is
+}
+
+func TestGetImageStreamsStorageUsage(t *testing.T) {
+	getter := fakeImageLayersGetter{
+		"sha256:a": {{Name: "layer1", LayerSize: 100}, {Name: "layer2", LayerSize: 20}},
+		"sha256:b": {{Name: "layer1", LayerSize: 100}, {Name: "layer3", LayerSize: 3}},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		streams  []*imageapi.ImageStream
+		expected int64
+	}{
+		{
+			name:     "no image streams",
+			expected: 0,
+		},
+		{
+			name:     "one image",
+			streams:  []*imageapi.ImageStream{streamWithImages("is", "sha256:a")},
+			expected: 120,
+		},
+		{
+			name:     "shared layer counted once",
+			streams:  []*imageapi.ImageStream{streamWithImages("is", "sha256:a", "sha256:b")},
+			expected: 123,
+		},
+		{
+			name: "same image in two streams",
+			streams: []*imageapi.ImageStream{
+				streamWithImages("is1", "sha256:a"),
+				streamWithImages("is2", "sha256:a"),
+			},
+			expected: 120,
+		},
+		{
+			name:     "unknown image takes no storage",
+			streams:  []*imageapi.ImageStream{streamWithImages("is", "sha256:unknown")},
+			expected: 0,
+		},
+	} {
+		size, err := GetImageStreamsStorageUsage(tc.streams, getter)
+		if err != nil {
+			t.Errorf("[%s]: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if size != tc.expected {
+			t.Errorf("[%s]: expected %d bytes, got %d", tc.name, tc.expected, size)
+		}
+	}
+}
+
+func TestCachingImageLayersGetter(t *testing.T) {
+	managed := &imageapi.Image{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "sha256:managed",
+			Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"},
+		},
+		DockerImageLayers: []imageapi.ImageLayer{{Name: "layer1", LayerSize: 10}},
+	}
+	external := &imageapi.Image{
+		ObjectMeta:        kapi.ObjectMeta{Name: "sha256:external"},
+		DockerImageLayers: []imageapi.ImageLayer{{Name: "layer2", LayerSize: 10}},
+	}
+	fake := testclient.NewSimpleFake(managed, external)
+	getter := NewCachingImageLayersGetter(fake)
+
+	for i := 0; i < 2; i++ {
+		layers, err := getter.StoredLayers(managed.Name)
+		if err != nil || len(layers) != 1 {
+			t.Fatalf("expected managed image to have one stored layer, got %v, %v", layers, err)
+		}
+	}
+	if layers, err := getter.StoredLayers(external.Name); err != nil || len(layers) != 0 {
+		t.Errorf("expected external image to have no stored layers, got %v, %v", layers, err)
+	}
+	if layers, err := getter.StoredLayers("sha256:missing"); err != nil || len(layers) != 0 {
+		t.Errorf("expected missing image to have no stored layers, got %v, %v", layers, err)
+	}
+
+	gets := 0
+	for _, action := range fake.Actions() {
+		if action.Matches("get", "images") {
+			gets++
+		}
+	}
+	if gets != 3 {
+		t.Errorf("expected the managed image to be fetched once, got %d image requests", gets)
+	}
+}
+
+func TestCachingImageLayersGetterEviction(t *testing.T) {
+	fake := testclient.NewSimpleFake(
+		&imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:a"}},
+		&imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:b"}},
+		&imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:c"}},
+	)
+	getter := newCachingImageLayersGetter(fake, 2)
+
+	for _, name := range []string{"sha256:a", "sha256:b", "sha256:c", "sha256:a"} {
+		if _, err := getter.StoredLayers(name); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if n := getter.layers.Len(); n != 2 {
+		t.Errorf("expected the cache to hold 2 images, got %d", n)
+	}
+	if gets := len(fake.Actions()); gets != 4 {
+		t.Errorf("expected the evicted image to be fetched again, got %d image requests", gets)
+	}
+}
@@ -15,7 +15,7 @@ import (
 // internal registry. It evaluates only image streams and related virtual resources that can cause a creation
 // of new image stream objects.
 func NewImageQuotaRegistry(isInformer shared.ImageStreamInformer, osClient osclient.Interface) quota.Registry {
-	imageStream := NewImageStreamEvaluator(isInformer.Lister())
+	imageStream := NewImageStreamEvaluator(isInformer.Lister(), NewCachingImageLayersGetter(osClient))
 	imageStreamTag := NewImageStreamTagEvaluator(isInformer.Lister(), osClient)
 	imageStreamImport := NewImageStreamImportEvaluator(isInformer.Lister())
 	return &generic.GenericRegistry{
@@ -0,0 +1,325 @@
+package servingcert
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/client/cache"
+	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	"github.com/openshift/kubernetes/pkg/controller"
+	"github.com/openshift/kubernetes/pkg/runtime"
+	"github.com/openshift/kubernetes/pkg/util/cert"
+	utilruntime "github.com/openshift/kubernetes/pkg/util/runtime"
+	"github.com/openshift/kubernetes/pkg/util/wait"
+	"github.com/openshift/kubernetes/pkg/util/workqueue"
+	"github.com/openshift/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/cmd/server/crypto"
+)
+
+const (
+	// InjectCABundleAnnotation requests the service serving CA bundle to be injected into a ConfigMap. The only
+	// recognized value is "true".
+	InjectCABundleAnnotation = "service.alpha.openshift.io/inject-cabundle"
+	// InjectedCABundleKey is the key of the ConfigMap data the service serving CA bundle is injected into.
+	InjectedCABundleKey = "service-ca.crt"
+	// InjectedCABundleRotatedAnnotation records when the CA bundle injected into a ConfigMap started to hold CAs that
+	// are no longer in the signer certificate file. They are dropped from the bundle after previousCAGracePeriod.
+	InjectedCABundleRotatedAnnotation = "service.alpha.openshift.io/cabundle-rotated"
+
+	// previousCAGracePeriod is how long the CAs removed from the signer certificate file are kept in the injected
+	// CA bundles, giving the serving certificates they issued time to be regenerated.
+	previousCAGracePeriod = 24 * time.Hour
+
+	// caBundleReloadInterval is how often the signer certificate file is checked for changes.
+	caBundleReloadInterval = time.Minute
+)
+
+// CABundle returns the certificates clients need to trust to verify the service serving certificates. The signer
+// certificate file holds the current CA first. During a CA rotation the previous CAs follow it, so that the
+// serving certificates they issued stay trusted until they are regenerated. Expired CAs are left out.
+func CABundle(caPEM []byte, now time.Time) ([]byte, error) {
+	certs, err := crypto.CertsFromPEM(caPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := []byte{}
+	for i, c := range certs {
+		// the current CA is always included, the serving certs can't be verified without it anyway
+		if i > 0 && now.After(c.NotAfter) {
+			glog.V(2).Infof("Leaving expired service serving CA %q out of the CA bundle", c.Subject.CommonName)
+			continue
+		}
+		bundle = append(bundle, cert.EncodeCertPEM(c)...)
+	}
+	return bundle, nil
+}
+
+// mergeCABundles appends the certificates of the previous bundle that are neither expired nor part of the bundle
+// already, and returns whether any was kept. This keeps the CA that signed the existing serving certificates
+// trusted after the signer changed.
+func mergeCABundles(bundle, previous []byte, now time.Time) ([]byte, bool, error) {
+	current, err := crypto.CertsFromPEM(bundle)
+	if err != nil {
+		return nil, false, err
+	}
+	// a previous bundle that can't be parsed is replaced
+	previousCerts, _ := crypto.CertsFromPEM(previous)
+
+	merged := append([]byte{}, bundle...)
+	kept := false
+	for _, c := range previousCerts {
+		if now.After(c.NotAfter) || containsCert(current, c) {
+			continue
+		}
+		glog.V(2).Infof("Keeping the previous service serving CA %q in the CA bundle", c.Subject.CommonName)
+		current = append(current, c)
+		merged = append(merged, cert.EncodeCertPEM(c)...)
+		kept = true
+	}
+	return merged, kept, nil
+}
+
+func containsCert(certs []*x509.Certificate, c *x509.Certificate) bool {
+	for _, existing := range certs {
+		if bytes.Equal(existing.Raw, c.Raw) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigMapCABundleInjectionController keeps the service serving CA bundle in the ConfigMaps annotated with
+// InjectCABundleAnnotation up to date. The signer certificate file is reloaded periodically, and the CAs
+// injected before it changed stay in the bundle until they expire or previousCAGracePeriod passes.
+type ConfigMapCABundleInjectionController struct {
+	configMapClient kcoreclient.ConfigMapsGetter
+
+	// ConfigMaps that need to be checked
+	queue workqueue.RateLimitingInterface
+
+	configMapCache      cache.Store
+	configMapController *cache.Controller
+	configMapHasSynced  informerSynced
+
+	caFile string
+
+	// caBundleLock guards caBundle, which is reloaded from caFile
+	caBundleLock sync.RWMutex
+	caBundle     []byte
+
+	// syncHandler does the work. It's factored out for unit testing
+	syncHandler func(configMapKey string) error
+}
+
+// NewConfigMapCABundleInjectionController creates a new ConfigMapCABundleInjectionController.
+// TODO this should accept a shared informer
+func NewConfigMapCABundleInjectionController(configMapClient kcoreclient.ConfigMapsGetter, caFile string, resyncInterval time.Duration) (*ConfigMapCABundleInjectionController, error) {
+	ic := &ConfigMapCABundleInjectionController{
+		configMapClient: configMapClient,
+
+		queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+
+		caFile: caFile,
+	}
+	if _, err := ic.loadCABundle(); err != nil {
+		return nil, err
+	}
+
+	ic.configMapCache, ic.configMapController = cache.NewInformer(
+		&cache.ListWatch{
+			ListFunc: func(options kapi.ListOptions) (runtime.Object, error) {
+				return ic.configMapClient.ConfigMaps(kapi.NamespaceAll).List(options)
+			},
+			WatchFunc: func(options kapi.ListOptions) (watch.Interface, error) {
+				return ic.configMapClient.ConfigMaps(kapi.NamespaceAll).Watch(options)
+			},
+		},
+		&kapi.ConfigMap{},
+		resyncInterval,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: ic.enqueueConfigMap,
+			UpdateFunc: func(old, cur interface{}) {
+				// Resync on configmap object relist.
+				ic.enqueueConfigMap(cur)
+			},
+		},
+	)
+	ic.configMapHasSynced = ic.configMapController.HasSynced
+
+	ic.syncHandler = ic.syncConfigMap
+
+	return ic, nil
+}
+
+// Run begins watching and syncing.
+func (ic *ConfigMapCABundleInjectionController) Run(workers int, stopCh <-chan struct{}) {
+	defer utilruntime.HandleCrash()
+	defer glog.Infof("Shutting down service serving CA bundle injection controller")
+	defer ic.queue.ShutDown()
+
+	glog.Infof("starting service serving CA bundle injection controller")
+	go ic.configMapController.Run(stopCh)
+
+	if !waitForCacheSync(stopCh, ic.configMapHasSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(ic.runWorker, time.Second, stopCh)
+	}
+	go wait.Until(ic.reloadCABundle, caBundleReloadInterval, stopCh)
+
+	<-stopCh
+}
+
+// loadCABundle reads the CA bundle from the signer certificate file and returns whether it changed.
+func (ic *ConfigMapCABundleInjectionController) loadCABundle() (bool, error) {
+	caPEM, err := ioutil.ReadFile(ic.caFile)
+	if err != nil {
+		return false, fmt.Errorf("error reading the service serving CA file %s: %v", ic.caFile, err)
+	}
+	caBundle, err := CABundle(caPEM, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("error parsing the service serving CA file %s: %v", ic.caFile, err)
+	}
+
+	ic.caBundleLock.Lock()
+	defer ic.caBundleLock.Unlock()
+	if bytes.Equal(ic.caBundle, caBundle) {
+		return false, nil
+	}
+	ic.caBundle = caBundle
+	return true, nil
+}
+
+// reloadCABundle reloads the CA bundle and requeues all the ConfigMaps when it changed.
+func (ic *ConfigMapCABundleInjectionController) reloadCABundle() {
+	changed, err := ic.loadCABundle()
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	if !changed {
+		return
+	}
+	glog.V(2).Infof("The service serving CA file %s changed, updating the injected CA bundles", ic.caFile)
+	for _, obj := range ic.configMapCache.List() {
+		ic.enqueueConfigMap(obj)
+	}
+}
+
+func (ic *ConfigMapCABundleInjectionController) getCABundle() []byte {
+	ic.caBundleLock.RLock()
+	defer ic.caBundleLock.RUnlock()
+	return ic.caBundle
+}
+
+func (ic *ConfigMapCABundleInjectionController) enqueueConfigMap(obj interface{}) {
+	configMap, ok := obj.(*kapi.ConfigMap)
+	if !ok || configMap.Annotations[InjectCABundleAnnotation] != "true" {
+		return
+	}
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		glog.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+
+	ic.queue.Add(key)
+}
+
+func (ic *ConfigMapCABundleInjectionController) runWorker() {
+	for ic.processNextWorkItem() {
+	}
+}
+
+// processNextWorkItem deals with one key off the queue.  It returns false when it's time to quit.
+func (ic *ConfigMapCABundleInjectionController) processNextWorkItem() bool {
+	key, quit := ic.queue.Get()
+	if quit {
+		return false
+	}
+	defer ic.queue.Done(key)
+
+	err := ic.syncHandler(key.(string))
+	if err == nil {
+		ic.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("%v failed with : %v", key, err))
+	ic.queue.AddRateLimited(key)
+
+	return true
+}
+
+// syncConfigMap injects the CA bundle into the ConfigMap with the given key unless it's already there.
+// This function is not meant to be invoked concurrently with the same key.
+func (ic *ConfigMapCABundleInjectionController) syncConfigMap(key string) error {
+	obj, exists, err := ic.configMapCache.GetByKey(key)
+	if err != nil {
+		glog.V(4).Infof("Unable to retrieve configmap %v from store: %v", key, err)
+		return err
+	}
+	if !exists {
+		glog.V(4).Infof("ConfigMap has been deleted %v", key)
+		return nil
+	}
+
+	configMap := obj.(*kapi.ConfigMap)
+	if configMap.Annotations[InjectCABundleAnnotation] != "true" {
+		return nil
+	}
+
+	now := time.Now()
+	previous := []byte(configMap.Data[InjectedCABundleKey])
+	// the grace period of the previous CAs starts when they are first kept, a missing or invalid time restarts it
+	rotated, err := time.Parse(time.RFC3339, configMap.Annotations[InjectedCABundleRotatedAnnotation])
+	if err != nil {
+		rotated = now
+	}
+	if now.Sub(rotated) > previousCAGracePeriod {
+		glog.V(2).Infof("Dropping the previous service serving CAs from configmap %s, they were rotated at %s", key, rotated)
+		previous = nil
+	}
+	caBundle, kept, err := mergeCABundles(ic.getCABundle(), previous, now)
+	if err != nil {
+		return err
+	}
+	rotatedValue := ""
+	if kept {
+		rotatedValue = rotated.Format(time.RFC3339)
+	}
+	if configMap.Data[InjectedCABundleKey] == string(caBundle) && configMap.Annotations[InjectedCABundleRotatedAnnotation] == rotatedValue {
+		return nil
+	}
+
+	// make a copy to avoid mutating cache state
+	t, err := kapi.Scheme.DeepCopy(obj)
+	if err != nil {
+		return err
+	}
+	configMap = t.(*kapi.ConfigMap)
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[InjectedCABundleKey] = string(caBundle)
+	if kept {
+		configMap.Annotations[InjectedCABundleRotatedAnnotation] = rotatedValue
+	} else {
+		delete(configMap.Annotations, InjectedCABundleRotatedAnnotation)
+	}
+
+	glog.V(4).Infof("Injecting the service serving CA bundle into configmap %s", key)
+	_, err = ic.configMapClient.ConfigMaps(configMap.Namespace).Update(configMap)
+	return err
+}
@@ -0,0 +1,313 @@
+package servingcert
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+	"github.com/openshift/kubernetes/pkg/client/testing/core"
+	"github.com/openshift/kubernetes/pkg/util/cert"
+)
+
+func makeTestCACert(t *testing.T, name string, notAfter time.Time) *x509.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return c
+}
+
+func TestCABundle(t *testing.T) {
+	now := time.Now()
+	current := makeTestCACert(t, "current", now.Add(time.Hour))
+	previous := makeTestCACert(t, "previous", now.Add(time.Hour))
+	expired := makeTestCACert(t, "expired", now.Add(-time.Hour))
+
+	caPEM := append(cert.EncodeCertPEM(current), cert.EncodeCertPEM(previous)...)
+	caPEM = append(caPEM, cert.EncodeCertPEM(expired)...)
+
+	bundle, err := CABundle(caPEM, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	certs, err := cert.ParseCertsPEM(bundle)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(certs) != 2 || certs[0].Subject.CommonName != "current" || certs[1].Subject.CommonName != "previous" {
+		t.Errorf("expected the current and the previous CA, got %d certificates", len(certs))
+	}
+
+	if _, err := CABundle([]byte("not a certificate"), now); err == nil {
+		t.Errorf("expected an error for invalid PEM")
+	}
+}
+
+func writeTestCAFile(t *testing.T, certs ...*x509.Certificate) string {
+	f, err := ioutil.TempFile("", "service-ca")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer f.Close()
+	for _, c := range certs {
+		if _, err := f.Write(cert.EncodeCertPEM(c)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return f.Name()
+}
+
+func TestMergeCABundles(t *testing.T) {
+	now := time.Now()
+	current := makeTestCACert(t, "current", now.Add(time.Hour))
+	previous := makeTestCACert(t, "previous", now.Add(time.Hour))
+	expired := makeTestCACert(t, "expired", now.Add(-time.Hour))
+
+	previousBundle := append(cert.EncodeCertPEM(previous), cert.EncodeCertPEM(current)...)
+	previousBundle = append(previousBundle, cert.EncodeCertPEM(expired)...)
+
+	bundle, kept, err := mergeCABundles(cert.EncodeCertPEM(current), previousBundle, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !kept {
+		t.Errorf("expected the previous CA to be reported as kept")
+	}
+	certs, err := cert.ParseCertsPEM(bundle)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(certs) != 2 || certs[0].Subject.CommonName != "current" || certs[1].Subject.CommonName != "previous" {
+		t.Errorf("expected the current and the previous CA, got %d certificates", len(certs))
+	}
+
+	bundle, kept, err = mergeCABundles(cert.EncodeCertPEM(current), []byte("not a certificate"), now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if kept {
+		t.Errorf("expected no previous CA to be kept")
+	}
+	if string(bundle) != string(cert.EncodeCertPEM(current)) {
+		t.Errorf("expected an invalid previous bundle to be replaced, got %s", bundle)
+	}
+}
+
+func TestSyncConfigMap(t *testing.T) {
+	now := time.Now()
+	current := makeTestCACert(t, "current", now.Add(time.Hour))
+	previous := makeTestCACert(t, "previous", now.Add(time.Hour))
+	expired := makeTestCACert(t, "expired", now.Add(-time.Hour))
+
+	caFile := writeTestCAFile(t, current)
+	defer os.Remove(caFile)
+	caBundle := string(cert.EncodeCertPEM(current))
+	recentlyRotated := now.Add(-time.Hour).Format(time.RFC3339)
+	longAgoRotated := now.Add(-previousCAGracePeriod - time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name             string
+		configMap        *kapi.ConfigMap
+		expectedUpdate   bool
+		expectedCABundle string
+		expectedRotated  bool
+	}{
+		{
+			name: "not annotated",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm"},
+			},
+		},
+		{
+			name: "annotated",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{InjectCABundleAnnotation: "true"}},
+				Data:       map[string]string{"other": "value"},
+			},
+			expectedUpdate:   true,
+			expectedCABundle: caBundle,
+		},
+		{
+			name: "invalid bundle",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{InjectCABundleAnnotation: "true"}},
+				Data:       map[string]string{InjectedCABundleKey: "old bundle"},
+			},
+			expectedUpdate:   true,
+			expectedCABundle: caBundle,
+		},
+		{
+			name: "previous CA is kept",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{InjectCABundleAnnotation: "true"}},
+				Data:       map[string]string{InjectedCABundleKey: string(cert.EncodeCertPEM(previous))},
+			},
+			expectedUpdate:   true,
+			expectedCABundle: caBundle + string(cert.EncodeCertPEM(previous)),
+			expectedRotated:  true,
+		},
+		{
+			name: "previous CA is kept during the grace period",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{
+					InjectCABundleAnnotation:          "true",
+					InjectedCABundleRotatedAnnotation: recentlyRotated,
+				}},
+				Data: map[string]string{InjectedCABundleKey: caBundle + string(cert.EncodeCertPEM(previous))},
+			},
+		},
+		{
+			name: "previous CA is dropped after the grace period",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{
+					InjectCABundleAnnotation:          "true",
+					InjectedCABundleRotatedAnnotation: longAgoRotated,
+				}},
+				Data: map[string]string{InjectedCABundleKey: caBundle + string(cert.EncodeCertPEM(previous))},
+			},
+			expectedUpdate:   true,
+			expectedCABundle: caBundle,
+		},
+		{
+			name: "stale rotation time is removed",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{
+					InjectCABundleAnnotation:          "true",
+					InjectedCABundleRotatedAnnotation: recentlyRotated,
+				}},
+				Data: map[string]string{InjectedCABundleKey: caBundle},
+			},
+			expectedUpdate:   true,
+			expectedCABundle: caBundle,
+		},
+		{
+			name: "expired CA is dropped",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{InjectCABundleAnnotation: "true"}},
+				Data:       map[string]string{InjectedCABundleKey: caBundle + string(cert.EncodeCertPEM(expired))},
+			},
+			expectedUpdate:   true,
+			expectedCABundle: caBundle,
+		},
+		{
+			name: "up to date",
+			configMap: &kapi.ConfigMap{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{InjectCABundleAnnotation: "true"}},
+				Data:       map[string]string{InjectedCABundleKey: caBundle},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		kubeclient := fake.NewSimpleClientset(tc.configMap)
+		controller, err := NewConfigMapCABundleInjectionController(kubeclient.Core(), caFile, 10*time.Minute)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		controller.configMapCache.Add(tc.configMap)
+		original := tc.configMap.Data[InjectedCABundleKey]
+
+		if err := controller.syncConfigMap("ns1/cm"); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+
+		var updated *kapi.ConfigMap
+		for _, action := range kubeclient.Actions() {
+			if action.Matches("update", "configmaps") {
+				updated = action.(core.UpdateAction).GetObject().(*kapi.ConfigMap)
+			}
+		}
+		if !tc.expectedUpdate {
+			if updated != nil {
+				t.Errorf("%s: unexpected update %#v", tc.name, updated)
+			}
+			continue
+		}
+		if updated == nil {
+			t.Errorf("%s: expected the configmap to be updated", tc.name)
+			continue
+		}
+		if updated.Data[InjectedCABundleKey] != tc.expectedCABundle {
+			t.Errorf("%s: expected the CA bundle to be injected, got %#v", tc.name, updated.Data)
+		}
+		if rotated, ok := updated.Annotations[InjectedCABundleRotatedAnnotation]; ok != tc.expectedRotated {
+			t.Errorf("%s: expected the rotation time to be recorded: %t, got %q", tc.name, tc.expectedRotated, rotated)
+		}
+		for k, v := range tc.configMap.Data {
+			if k != InjectedCABundleKey && updated.Data[k] != v {
+				t.Errorf("%s: expected %s to be preserved, got %#v", tc.name, k, updated.Data)
+			}
+		}
+		if tc.configMap.Data[InjectedCABundleKey] != original {
+			t.Errorf("%s: the cached configmap was mutated", tc.name)
+		}
+	}
+}
+
+func TestReloadCABundle(t *testing.T) {
+	now := time.Now()
+	current := makeTestCACert(t, "current", now.Add(time.Hour))
+	next := makeTestCACert(t, "next", now.Add(time.Hour))
+
+	caFile := writeTestCAFile(t, current)
+	defer os.Remove(caFile)
+
+	configMap := &kapi.ConfigMap{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "ns1", Name: "cm", Annotations: map[string]string{InjectCABundleAnnotation: "true"}},
+	}
+	controller, err := NewConfigMapCABundleInjectionController(fake.NewSimpleClientset(configMap).Core(), caFile, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	controller.configMapCache.Add(configMap)
+
+	controller.reloadCABundle()
+	if controller.queue.Len() != 0 {
+		t.Errorf("expected no configmap to be queued for an unchanged CA file")
+	}
+
+	if err := ioutil.WriteFile(caFile, cert.EncodeCertPEM(next), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	controller.reloadCABundle()
+	if string(controller.getCABundle()) != string(cert.EncodeCertPEM(next)) {
+		t.Errorf("expected the CA bundle to be reloaded")
+	}
+	if controller.queue.Len() != 1 {
+		t.Errorf("expected the configmap to be queued, got %d items", controller.queue.Len())
+	}
+
+	if err := ioutil.WriteFile(caFile, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	controller.reloadCABundle()
+	if string(controller.getCABundle()) != string(cert.EncodeCertPEM(next)) {
+		t.Errorf("expected an invalid CA file to be ignored")
+	}
+}
@@ -0,0 +1,270 @@
+package leaderlease
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	kapierrors "github.com/openshift/kubernetes/pkg/api/errors"
+	kcoreclient "github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/typed/core/internalversion"
+	utilruntime "github.com/openshift/kubernetes/pkg/util/runtime"
+	"github.com/openshift/kubernetes/pkg/util/wait"
+)
+
+// ConfigMapLeaseAnnotation is the annotation of the ConfigMap recording the current lease.
+const ConfigMapLeaseAnnotation = "leaderlease.openshift.io/lease"
+
+// configMapLease is the lease recorded on a ConfigMap.
+type configMapLease struct {
+	// Holder is the value of the client holding the lease
+	Holder string `json:"holder"`
+	// RenewTime is the last time the holder renewed the lease
+	RenewTime time.Time `json:"renewTime"`
+	// ExpireTime is when the lease expires unless it is renewed
+	ExpireTime time.Time `json:"expireTime"`
+}
+
+// ConfigMap takes and holds a leader lease recorded in an annotation of a
+// ConfigMap until it can no longer confirm it owns the lease, then returns.
+// Unlike the etcd lease, it only needs access to the API.
+type ConfigMap struct {
+	client    kcoreclient.ConfigMapsGetter
+	namespace string
+	name      string
+	value     string
+	ttl       time.Duration
+
+	// the fraction of the ttl to wait before trying to renew - for instance, 0.66 with TTL 30s
+	// will wait 20 seconds before attempting to renew the lease, then retry over the next 10
+	// seconds in the event of an error.
+	waitFraction float32
+	// the interval to wait between attempts to acquire the lease
+	pauseInterval time.Duration
+	// the maximum retries when releasing or renewing the lease
+	maxRetries int
+	// the shortest time between attempts to renew the lease
+	minimumRetryInterval time.Duration
+
+	// observedRecord is the last lease of another client seen on the ConfigMap, and
+	// observedTime the local time it was first seen. The lease expires its ttl after
+	// observedTime unless it changes, so that the clocks of the clients don't matter.
+	observedRecord string
+	observedTime   time.Time
+}
+
+// NewConfigMap creates a lease recorded on the ConfigMap namespace/name, held
+// by value for ttl and refreshed until the lease is lost, expires, or another
+// client takes it. The ConfigMap is created if it doesn't exist, and its data
+// is left alone.
+func NewConfigMap(client kcoreclient.ConfigMapsGetter, namespace, name, value string, ttl time.Duration) Leaser {
+	return &ConfigMap{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		value:     value,
+		ttl:       ttl,
+
+		waitFraction:         0.66,
+		pauseInterval:        time.Second,
+		maxRetries:           10,
+		minimumRetryInterval: 100 * time.Millisecond,
+	}
+}
+
+// AcquireAndHold implements an acquire and release of a lease.
+func (c *ConfigMap) AcquireAndHold(notify chan error) {
+	for {
+		ok, err := c.tryAcquire()
+		if err != nil {
+			utilruntime.HandleError(err)
+			time.Sleep(c.pauseInterval)
+			continue
+		}
+		if !ok {
+			time.Sleep(c.pauseInterval)
+			continue
+		}
+
+		// notify
+		notify <- nil
+		defer close(notify)
+
+		// hold the lease
+		if err := c.tryHold(); err != nil {
+			notify <- err
+		}
+		break
+	}
+}
+
+// tryAcquire tries to record the lease on the ConfigMap, creating it if
+// necessary, unless another client holds an unexpired lease. It returns true
+// if the lease was acquired.
+func (c *ConfigMap) tryAcquire() (bool, error) {
+	configMap, err := c.client.ConfigMaps(c.namespace).Get(c.name)
+	if kapierrors.IsNotFound(err) {
+		configMap = &kapi.ConfigMap{ObjectMeta: kapi.ObjectMeta{Namespace: c.namespace, Name: c.name}}
+		if err := c.setLease(configMap); err != nil {
+			return false, err
+		}
+		_, err := c.client.ConfigMaps(c.namespace).Create(configMap)
+		switch {
+		case err == nil:
+			glog.V(4).Infof("Lease %s/%s acquired, ttl %s", c.namespace, c.name, c.ttl)
+			return true, nil
+		case kapierrors.IsAlreadyExists(err):
+			return false, nil
+		default:
+			return false, fmt.Errorf("unable to create lease %s/%s: %v", c.namespace, c.name, err)
+		}
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve lease %s/%s: %v", c.namespace, c.name, err)
+	}
+
+	if lease := leaseFor(configMap); lease != nil && lease.Holder != c.value {
+		now := time.Now()
+		if record := configMap.Annotations[ConfigMapLeaseAnnotation]; record != c.observedRecord {
+			c.observedRecord = record
+			c.observedTime = now
+		}
+		// the ttl of the holder is a duration, it doesn't depend on the clock of the holder
+		ttl := lease.ExpireTime.Sub(lease.RenewTime)
+		if ttl <= 0 {
+			ttl = c.ttl
+		}
+		if expires := c.observedTime.Add(ttl); now.Before(expires) {
+			glog.V(4).Infof("Lease %s/%s owned by %s, waiting for expiration in %s", c.namespace, c.name, lease.Holder, expires.Sub(now))
+			return false, nil
+		}
+	}
+
+	if err := c.setLease(configMap); err != nil {
+		return false, err
+	}
+	_, err = c.client.ConfigMaps(c.namespace).Update(configMap)
+	switch {
+	case err == nil:
+		glog.V(4).Infof("Lease %s/%s acquired, ttl %s", c.namespace, c.name, c.ttl)
+		return true, nil
+	case kapierrors.IsConflict(err):
+		// another client changed the lease in the meantime
+		return false, nil
+	default:
+		return false, fmt.Errorf("unable to acquire lease %s/%s: %v", c.namespace, c.name, err)
+	}
+}
+
+// tryHold attempts to hold on to the lease by repeatedly renewing it, until
+// the renewal fails or the lease is deleted or changed to another client.
+func (c *ConfigMap) tryHold() error {
+	after := time.Duration(float32(c.ttl) * c.waitFraction)
+	last := c.ttl - after
+	interval := last / time.Duration(c.maxRetries)
+	if interval < c.minimumRetryInterval {
+		interval = c.minimumRetryInterval
+	}
+
+	// as long as we can renew the lease, loop
+	for {
+		time.Sleep(after)
+		err := wait.Poll(interval, last, func() (bool, error) {
+			glog.V(4).Infof("Renewing lease %s/%s", c.namespace, c.name)
+			configMap, err := c.client.ConfigMaps(c.namespace).Get(c.name)
+			switch {
+			case kapierrors.IsNotFound(err):
+				return false, fmt.Errorf("another client has revoked the lease %s/%s", c.namespace, c.name)
+			case err != nil:
+				utilruntime.HandleError(fmt.Errorf("unexpected error renewing lease %s/%s: %v", c.namespace, c.name, err))
+				return false, nil
+			}
+			if lease := leaseFor(configMap); lease == nil || lease.Holder != c.value {
+				return false, fmt.Errorf("another client has taken the lease %s/%s", c.namespace, c.name)
+			}
+			if err := c.setLease(configMap); err != nil {
+				return false, err
+			}
+			if _, err := c.client.ConfigMaps(c.namespace).Update(configMap); err != nil {
+				// the lease is checked again on conflicts
+				utilruntime.HandleError(fmt.Errorf("unexpected error renewing lease %s/%s: %v", c.namespace, c.name, err))
+				return false, nil
+			}
+			return true, nil
+		})
+
+		switch err {
+		case nil:
+			// wait again
+			glog.V(4).Infof("Lease %s/%s renewed", c.namespace, c.name)
+		case wait.ErrWaitTimeout:
+			return fmt.Errorf("unable to renew lease %s/%s: %v", c.namespace, c.name, err)
+		default:
+			return fmt.Errorf("lost lease %s/%s: %v", c.namespace, c.name, err)
+		}
+	}
+}
+
+// Release tries to remove the lease from the ConfigMap, if it is still held.
+func (c *ConfigMap) Release() {
+	for i := 0; i < c.maxRetries; i++ {
+		configMap, err := c.client.ConfigMaps(c.namespace).Get(c.name)
+		if kapierrors.IsNotFound(err) {
+			return
+		}
+		if err == nil {
+			if lease := leaseFor(configMap); lease == nil || lease.Holder != c.value {
+				return
+			}
+			delete(configMap.Annotations, ConfigMapLeaseAnnotation)
+			if _, err = c.client.ConfigMaps(c.namespace).Update(configMap); err == nil {
+				return
+			}
+		}
+		utilruntime.HandleError(fmt.Errorf("unable to release %s/%s: %v", c.namespace, c.name, err))
+	}
+}
+
+// setLease records the lease of this client on the ConfigMap, renewed now.
+func (c *ConfigMap) setLease(configMap *kapi.ConfigMap) error {
+	now := time.Now()
+	data, err := json.Marshal(configMapLease{
+		Holder:     c.value,
+		RenewTime:  now,
+		ExpireTime: now.Add(c.ttl),
+	})
+	if err != nil {
+		return err
+	}
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	configMap.Annotations[ConfigMapLeaseAnnotation] = string(data)
+	return nil
+}
+
+// ConfigMapLeaseHolder returns the value of the client holding the lease recorded on the
+// ConfigMap, or an empty string if there is none. The lease may have expired.
+func ConfigMapLeaseHolder(configMap *kapi.ConfigMap) string {
+	if lease := leaseFor(configMap); lease != nil {
+		return lease.Holder
+	}
+	return ""
+}
+
+// leaseFor returns the lease recorded on the ConfigMap, or nil if there is
+// none or it can't be read.
+func leaseFor(configMap *kapi.ConfigMap) *configMapLease {
+	value, ok := configMap.Annotations[ConfigMapLeaseAnnotation]
+	if !ok {
+		return nil
+	}
+	lease := &configMapLease{}
+	if err := json.Unmarshal([]byte(value), lease); err != nil {
+		glog.V(4).Infof("Ignoring the invalid lease on %s/%s: %v", configMap.Namespace, configMap.Name, err)
+		return nil
+	}
+	return lease
+}
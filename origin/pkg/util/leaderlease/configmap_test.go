@@ -0,0 +1,91 @@
+package leaderlease
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	kapi "github.com/openshift/kubernetes/pkg/api"
+	"github.com/openshift/kubernetes/pkg/client/clientset_generated/internalclientset/fake"
+)
+
+func testConfigMapLeaser(client *fake.Clientset, value string) *ConfigMap {
+	leaser := NewConfigMap(client.Core(), "test", "lock", value, 300*time.Millisecond).(*ConfigMap)
+	leaser.pauseInterval = 10 * time.Millisecond
+	leaser.minimumRetryInterval = 10 * time.Millisecond
+	return leaser
+}
+
+func TestConfigMapLease(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	a, b := testConfigMapLeaser(client, "a"), testConfigMapLeaser(client, "b")
+
+	notifyA := make(chan error, 1)
+	go a.AcquireAndHold(notifyA)
+	select {
+	case err := <-notifyA:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("a did not acquire the lease")
+	}
+
+	// a renews the lease, b waits for it for longer than the ttl
+	notifyB := make(chan error, 1)
+	go b.AcquireAndHold(notifyB)
+	select {
+	case err := <-notifyB:
+		t.Fatalf("b acquired the lease held by a: %v", err)
+	case <-time.After(time.Second):
+	}
+
+	// b takes the lease once a releases it
+	a.Release()
+	select {
+	case err := <-notifyB:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("b did not acquire the released lease")
+	}
+
+	// a notices it lost the lease
+	select {
+	case err, ok := <-notifyA:
+		if !ok || err == nil {
+			t.Fatalf("expected a to report losing the lease, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("a did not report losing the lease")
+	}
+
+	configMap, err := client.Core().ConfigMaps("test").Get("lock")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lease := leaseFor(configMap); lease == nil || lease.Holder != "b" {
+		t.Errorf("expected the lease to be held by b, got %#v", lease)
+	}
+}
+
+func TestConfigMapLeaseClockSkew(t *testing.T) {
+	// the clock of the holder is an hour behind, its lease looks expired
+	renewed := time.Now().Add(-time.Hour)
+	record := fmt.Sprintf(`{"holder":"a","renewTime":%q,"expireTime":%q}`, renewed.Format(time.RFC3339Nano), renewed.Add(300*time.Millisecond).Format(time.RFC3339Nano))
+	client := fake.NewSimpleClientset(&kapi.ConfigMap{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "lock", Annotations: map[string]string{ConfigMapLeaseAnnotation: record}},
+	})
+	b := testConfigMapLeaser(client, "b")
+
+	if ok, err := b.tryAcquire(); err != nil || ok {
+		t.Fatalf("expected b to wait for a lease it just observed, got %t %v", ok, err)
+	}
+
+	// the lease expires once it was left unchanged for its ttl
+	time.Sleep(400 * time.Millisecond)
+	if ok, err := b.tryAcquire(); err != nil || !ok {
+		t.Fatalf("expected b to acquire the expired lease, got %t %v", ok, err)
+	}
+}
@@ -6,6 +6,7 @@ trap os::test::junit::reconcile_output EXIT
 (
   set +e
   oc delete all,templates --all
+  oc delete node/debug-node
   exit 0
 ) &>/dev/null
 
@@ -25,6 +26,26 @@ os::cmd::expect_success_and_not_text "oc debug --tty=false dc/test-deployment-co
 os::cmd::expect_success_and_not_text "oc debug dc/test-deployment-config -o yaml -- /bin/env" 'stdin'
 os::cmd::expect_success_and_not_text "oc debug dc/test-deployment-config -o yaml -- /bin/env" 'tty'
 os::cmd::expect_failure_and_text "oc debug dc/test-deployment-config --node-name=invalid -- /bin/env" 'on node "invalid"'
+# Debugging a node prints a privileged pod with access to the host
+os::cmd::expect_success "echo 'apiVersion: v1
+kind: Node
+metadata:
+  labels:
+    kubernetes.io/hostname: debug-node
+  name: debug-node
+spec:
+  externalID: debug-node
+' | oc create -f -"
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml" 'nodeName: debug-node'
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml" 'hostPID: true'
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml" 'hostNetwork: true'
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml" 'privileged: true'
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml" 'mountPath: /host'
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml" 'path: /$'
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml" 'image: centos/tools'
+os::cmd::expect_success_and_text "oc debug node/debug-node --image=myregistry/tools -o yaml" 'image: myregistry/tools'
+os::cmd::expect_success_and_text "oc debug node/debug-node -o yaml -- chroot /host df -h" '\- chroot'
+os::cmd::expect_success 'oc delete node/debug-node'
 # Does not require a real resource on the server
 os::cmd::expect_success_and_not_text "oc debug -T -f examples/hello-openshift/hello-pod.json -o yaml" 'tty'
 os::cmd::expect_success_and_text "oc debug -f examples/hello-openshift/hello-pod.json --keep-liveness --keep-readiness -o yaml" ''
@@ -59,5 +59,20 @@ os::cmd::expect_success_and_not_text 'oc get is test --template "{{ range .statu
 os::cmd::expect_success_and_not_text 'oc get is test --template "{{ range .status.tags }}{{ range .items }}{{ .dockerImageReference }}{{ \"\n\" }}{{ end }}{{ end }}"' '^mysql'
 os::test::junit::declare_suite_end
 
+os::test::junit::declare_suite_start "cmd/migrate/deploymentconfigs"
+os::cmd::expect_success 'oc create deploymentconfig migrate-test --image=openshift/hello-openshift'
+# verify dry run
+os::cmd::expect_success_and_text     'oadm migrate deploymentconfigs --all-namespaces=false --loglevel=1' 'migrated \(dry run\): deploymentconfigs/migrate-test'
+os::cmd::expect_success_and_text     'oadm migrate deploymentconfigs --all-namespaces=false -o yaml' 'kind: Deployment'
+os::cmd::expect_success_and_text     'oadm migrate deploymentconfigs --all-namespaces=false -o yaml' 'migrate.openshift.io/deployment-config: migrate-test'
+os::cmd::expect_success              'oc get dc/migrate-test'
+os::cmd::expect_failure_and_text     'oc get deployment/migrate-test' 'not found'
+# lifecycle hooks can't be converted
+os::cmd::expect_success              'oc set deployment-hook dc/migrate-test --pre -- /bin/true'
+os::cmd::expect_failure_and_text     'oadm migrate deploymentconfigs --all-namespaces=false' 'pre lifecycle hook'
+os::cmd::expect_success_and_text     'oadm migrate deploymentconfigs --all-namespaces=false --drop-unsupported' 'dropping pre lifecycle hook'
+os::cmd::expect_success              'oc delete dc/migrate-test'
+os::test::junit::declare_suite_end
+
 os::test::junit::declare_suite_end
 
@@ -355,6 +355,15 @@ fi
 # verify a docker-compose.yml schema 2 resource can be transformed, and that it sets env vars correctly.
 os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/wordpress/docker-compose.yml -o yaml --as-template=other --dry-run' 'value: wordpress'
 
+# verify a docker-compose.yml schema 3 resource maps volumes, healthchecks, deploy blocks, secrets, and configs
+os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/v3/docker-compose.yml -o name --dry-run' 'persistentvolumeclaim/db-data'
+os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/v3/docker-compose.yml -o name --dry-run' 'secret/db-password'
+os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/v3/docker-compose.yml -o name --dry-run' 'configmap/nginx'
+os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/v3/docker-compose.yml -o yaml --dry-run' 'replicas: 2'
+os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/v3/docker-compose.yml -o yaml --dry-run' 'readinessProbe'
+os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/v3/docker-compose.yml --dry-run' 'web: deploy placement is not supported'
+os::cmd::expect_success_and_text 'oc import docker-compose -f test/testdata/app-scenarios/docker-compose/v3/docker-compose.yml --dry-run' 'web: networks is not supported'
+
 # check new-build
 os::cmd::expect_failure_and_text 'oc new-build mysql -o yaml' 'you must specify at least one source repository URL'
 os::cmd::expect_success_and_text 'oc new-build mysql --binary -o yaml --to mysql:bin' 'type: Binary'
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/openshift/github.com/Sirupsen/logrus"
@@ -26,11 +28,16 @@ var (
 		"links",
 		"volumes_from",
 	}
+	// serviceKeys are the keys of a service that are part of the service configuration
+	serviceKeys = yamlKeys(reflect.TypeOf(ServiceConfig{}), "extends")
 )
 
 type rawSchema struct {
 	Version  string        `yaml:"version"`
 	Services rawServiceMap `yaml:"services"`
+	Volumes  rawServiceMap `yaml:"volumes"`
+	Secrets  rawServiceMap `yaml:"secrets"`
+	Configs  rawServiceMap `yaml:"configs"`
 }
 
 type rawService map[string]interface{}
@@ -46,8 +53,11 @@ func mergeProject(p *Project, file string, bytes []byte) (map[string]*ServiceCon
 
 	var datas = make(rawServiceMap)
 	switch {
-	case schema.Version == "2":
+	case isSchemaVersion(schema.Version, "2"), isSchemaVersion(schema.Version, "3"):
 		datas = schema.Services
+		if err := mergeProjectObjects(p, file, &schema); err != nil {
+			return nil, err
+		}
 	case len(schema.Version) == 0:
 		datas = make(rawServiceMap)
 		if err := yaml.Unmarshal(bytes, &datas); err != nil {
@@ -61,6 +71,7 @@ func mergeProject(p *Project, file string, bytes []byte) (map[string]*ServiceCon
 		return nil, err
 	}
 
+	unsupported := make(map[string][]string)
 	for name, data := range datas {
 		data, err := parse(p.context.ResourceLookup, p.context.EnvironmentLookup, file, data, datas)
 		if err != nil {
@@ -75,6 +86,13 @@ func mergeProject(p *Project, file string, bytes []byte) (map[string]*ServiceCon
 			}
 
 			data = mergeConfig(rawExistingService, data)
+			unsupported[name] = append(unsupported[name], p.Configs[name].Unsupported...)
+		}
+
+		for k := range data {
+			if !serviceKeys[k] {
+				unsupported[name] = append(unsupported[name], k)
+			}
 		}
 
 		datas[name] = data
@@ -84,11 +102,94 @@ func mergeProject(p *Project, file string, bytes []byte) (map[string]*ServiceCon
 		return nil, err
 	}
 
+	for name, keys := range unsupported {
+		if config, ok := configs[name]; ok && len(keys) > 0 {
+			config.Unsupported = uniqueSorted(keys)
+		}
+	}
+
 	adjustValues(configs)
 
 	return configs, nil
 }
 
+// mergeProjectObjects adds the named volumes, secrets, and configs of a compose
+// file to the project. Later files override the objects of earlier files.
+func mergeProjectObjects(p *Project, file string, schema *rawSchema) error {
+	for _, objects := range []*rawServiceMap{&schema.Volumes, &schema.Secrets, &schema.Configs} {
+		if err := interpolate(p.context.EnvironmentLookup, objects); err != nil {
+			return err
+		}
+	}
+
+	volumes := make(map[string]*VolumeConfig)
+	if err := Convert(schema.Volumes, &volumes); err != nil {
+		return err
+	}
+	for name, volume := range volumes {
+		if volume == nil {
+			volume = &VolumeConfig{}
+		}
+		p.Volumes[name] = volume
+	}
+
+	for _, objects := range []struct {
+		raw    rawServiceMap
+		target map[string]*FileObjectConfig
+	}{
+		{raw: schema.Secrets, target: p.Secrets},
+		{raw: schema.Configs, target: p.ConfigObjects},
+	} {
+		converted := make(map[string]*FileObjectConfig)
+		if err := Convert(objects.raw, &converted); err != nil {
+			return err
+		}
+		for name, object := range converted {
+			if object == nil {
+				object = &FileObjectConfig{}
+			}
+			if len(object.File) > 0 && !filepath.IsAbs(object.File) {
+				object.File = filepath.Join(filepath.Dir(file), object.File)
+			}
+			objects.target[name] = object
+		}
+	}
+	return nil
+}
+
+// isSchemaVersion returns true if version is the major version or one of its minor versions.
+func isSchemaVersion(version, major string) bool {
+	return version == major || strings.HasPrefix(version, major+".")
+}
+
+// yamlKeys returns the yaml keys of the fields of a struct type, and the extra keys.
+func yamlKeys(t reflect.Type, extra ...string) map[string]bool {
+	keys := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if len(name) > 0 && name != "-" {
+			keys[name] = true
+		}
+	}
+	for _, k := range extra {
+		keys[k] = true
+	}
+	return keys
+}
+
+func uniqueSorted(values []string) []string {
+	var out []string
+	seen := make(map[string]bool)
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
 func adjustValues(configs map[string]*ServiceConfig) {
 	// yaml parser turns "no" into "false" but that is not valid for a restart policy
 	for _, v := range configs {
@@ -185,7 +286,92 @@ func resolveBuild(inFile string, serviceData rawService) (rawService, error) {
 	return serviceData, nil
 }
 
+// normalizeService converts the long syntax of the later versions of the schema
+// to the short syntax of the service configuration. The options that have no
+// short syntax are kept under keys that are not part of the configuration.
+func normalizeService(serviceData rawService) rawService {
+	if build, ok := serviceData["build"].(map[interface{}]interface{}); ok {
+		delete(serviceData, "build")
+		for k, v := range build {
+			switch k {
+			case "context":
+				serviceData["build"] = v
+			case "dockerfile":
+				serviceData["dockerfile"] = v
+			default:
+				serviceData[fmt.Sprintf("build.%v", k)] = v
+			}
+		}
+	}
+
+	if dependsOn, ok := serviceData["depends_on"].(map[interface{}]interface{}); ok {
+		var names []string
+		for k := range dependsOn {
+			names = append(names, fmt.Sprintf("%v", k))
+		}
+		sort.Strings(names)
+		serviceData["depends_on"] = names
+	}
+
+	if volumes, ok := serviceData["volumes"].([]interface{}); ok {
+		var short, tmpfs []interface{}
+		switch existing := serviceData["tmpfs"].(type) {
+		case string:
+			tmpfs = append(tmpfs, existing)
+		case []interface{}:
+			tmpfs = append(tmpfs, existing...)
+		}
+		for _, volume := range volumes {
+			long, ok := volume.(map[interface{}]interface{})
+			if !ok {
+				short = append(short, volume)
+				continue
+			}
+			if asString(long["type"]) == "tmpfs" {
+				tmpfs = append(tmpfs, asString(long["target"]))
+				continue
+			}
+			s := asString(long["target"])
+			if source := asString(long["source"]); len(source) > 0 {
+				s = source + ":" + s
+			}
+			if readOnly, _ := long["read_only"].(bool); readOnly {
+				s += ":ro"
+			}
+			short = append(short, s)
+		}
+		serviceData["volumes"] = short
+		if len(tmpfs) > 0 {
+			serviceData["tmpfs"] = tmpfs
+		}
+	}
+
+	if ports, ok := serviceData["ports"].([]interface{}); ok {
+		var short []interface{}
+		for _, port := range ports {
+			long, ok := port.(map[interface{}]interface{})
+			if !ok {
+				short = append(short, port)
+				continue
+			}
+			s := fmt.Sprintf("%v", long["target"])
+			if published, ok := long["published"]; ok {
+				s = fmt.Sprintf("%v:%s", published, s)
+			}
+			if protocol := asString(long["protocol"]); len(protocol) > 0 && protocol != "tcp" {
+				s += "/" + protocol
+			}
+			short = append(short, s)
+		}
+		serviceData["ports"] = short
+	}
+
+	return serviceData
+}
+
 func parse(resourceLookup ResourceLookup, environmentLookup EnvironmentLookup, inFile string, serviceData rawService, datas rawServiceMap) (rawService, error) {
+	serviceData = normalizeService(serviceData)
+
 	serviceData, err := readEnvFile(resourceLookup, inFile, serviceData)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,137 @@
+package project
+
+import (
+	"reflect"
+	"testing"
+)
+
+type mapEnvLookup map[string]string
+
+func (m mapEnvLookup) Lookup(key, serviceName string, config *ServiceConfig) []string {
+	if value, ok := m[key]; ok {
+		return []string{key + "=" + value}
+	}
+	return nil
+}
+
+func TestNormalizeService(t *testing.T) {
+	tests := []struct {
+		name     string
+		service  rawService
+		expected rawService
+	}{
+		{
+			name: "short syntax",
+			service: rawService{
+				"volumes": []interface{}{"data:/var/lib/data", "/tmp"},
+				"ports":   []interface{}{"8080:80"},
+			},
+			expected: rawService{
+				"volumes": []interface{}{"data:/var/lib/data", "/tmp"},
+				"ports":   []interface{}{"8080:80"},
+			},
+		},
+		{
+			name: "long syntax volumes",
+			service: rawService{
+				"volumes": []interface{}{
+					map[interface{}]interface{}{"type": "volume", "source": "data", "target": "/var/lib/data"},
+					map[interface{}]interface{}{"type": "bind", "source": "./config", "target": "/etc/app", "read_only": true},
+					map[interface{}]interface{}{"type": "volume", "target": "/cache"},
+					"logs:/var/log",
+				},
+			},
+			expected: rawService{
+				"volumes": []interface{}{"data:/var/lib/data", "./config:/etc/app:ro", "/cache", "logs:/var/log"},
+			},
+		},
+		{
+			name: "tmpfs volumes",
+			service: rawService{
+				"tmpfs": "/run",
+				"volumes": []interface{}{
+					map[interface{}]interface{}{"type": "tmpfs", "target": "/tmp"},
+					map[interface{}]interface{}{"type": "tmpfs", "target": "/var/cache"},
+				},
+			},
+			expected: rawService{
+				"tmpfs":   []interface{}{"/run", "/tmp", "/var/cache"},
+				"volumes": []interface{}(nil),
+			},
+		},
+		{
+			name: "long syntax ports",
+			service: rawService{
+				"ports": []interface{}{
+					map[interface{}]interface{}{"target": 80, "published": 8080},
+					map[interface{}]interface{}{"target": 53, "protocol": "udp"},
+					map[interface{}]interface{}{"target": 443, "published": 8443, "protocol": "tcp"},
+				},
+			},
+			expected: rawService{
+				"ports": []interface{}{"8080:80", "53/udp", "8443:443"},
+			},
+		},
+		{
+			name: "build and depends_on",
+			service: rawService{
+				"build":      map[interface{}]interface{}{"context": "./web", "dockerfile": "Dockerfile.dev", "target": "dev"},
+				"depends_on": map[interface{}]interface{}{"db": map[interface{}]interface{}{"condition": "service_healthy"}, "cache": nil},
+			},
+			expected: rawService{
+				"build":        "./web",
+				"dockerfile":   "Dockerfile.dev",
+				"build.target": "dev",
+				"depends_on":   []string{"cache", "db"},
+			},
+		},
+	}
+	for _, test := range tests {
+		if actual := normalizeService(test.service); !reflect.DeepEqual(test.expected, actual) {
+			t.Errorf("%s: expected %#v, got %#v", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestMergeProjectObjects(t *testing.T) {
+	p := NewProject(&Context{EnvironmentLookup: mapEnvLookup{"PREFIX": "prod"}})
+	p.Secrets["old"] = &FileObjectConfig{File: "/compose/old.txt"}
+	schema := &rawSchema{
+		Volumes: rawServiceMap{
+			"data":   nil,
+			"shared": rawService{"external": map[interface{}]interface{}{"name": "${PREFIX}-shared"}},
+		},
+		Secrets: rawServiceMap{
+			"db":  rawService{"file": "db_password.txt"},
+			"old": rawService{"file": "/etc/secrets/old.txt"},
+		},
+		Configs: rawServiceMap{
+			"nginx": rawService{"external": true},
+		},
+	}
+
+	if err := mergeProjectObjects(p, "/compose/docker-compose.yml", schema); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedVolumes := map[string]*VolumeConfig{
+		"data":   {},
+		"shared": {External: External{External: true, Name: "prod-shared"}},
+	}
+	if !reflect.DeepEqual(expectedVolumes, p.Volumes) {
+		t.Errorf("expected volumes %#v, got %#v", expectedVolumes, p.Volumes)
+	}
+	expectedSecrets := map[string]*FileObjectConfig{
+		"db":  {File: "/compose/db_password.txt"},
+		"old": {File: "/etc/secrets/old.txt"},
+	}
+	if !reflect.DeepEqual(expectedSecrets, p.Secrets) {
+		t.Errorf("expected secrets %#v, got %#v", expectedSecrets, p.Secrets)
+	}
+	expectedConfigs := map[string]*FileObjectConfig{
+		"nginx": {External: External{External: true}},
+	}
+	if !reflect.DeepEqual(expectedConfigs, p.ConfigObjects) {
+		t.Errorf("expected configs %#v, got %#v", expectedConfigs, p.ConfigObjects)
+	}
+}
@@ -59,8 +59,11 @@ func AddEnvironmentLookUp(context *Context) error {
 // NewProject create a new project with the specified context.
 func NewProject(context *Context) *Project {
 	p := &Project{
-		context: context,
-		Configs: make(map[string]*ServiceConfig),
+		context:       context,
+		Configs:       make(map[string]*ServiceConfig),
+		Volumes:       make(map[string]*VolumeConfig),
+		Secrets:       make(map[string]*FileObjectConfig),
+		ConfigObjects: make(map[string]*FileObjectConfig),
 	}
 
 	context.Project = p
@@ -215,6 +215,84 @@ type ServiceConfig struct {
 	LogOpt        map[string]string `yaml:"log_opt,omitempty"`
 	ExtraHosts    []string          `yaml:"extra_hosts,omitempty"`
 	Ulimits       Ulimits           `yaml:"ulimits,omitempty"`
+
+	// fields of the later versions of the schema
+	DependsOn   []string               `yaml:"depends_on,omitempty"`
+	Healthcheck *HealthcheckConfig     `yaml:"healthcheck,omitempty"`
+	Deploy      *DeployConfig          `yaml:"deploy,omitempty"`
+	Secrets     []ServiceFileReference `yaml:"secrets,omitempty"`
+	Configs     []ServiceFileReference `yaml:"configs,omitempty"`
+
+	// Unsupported lists the keys of the service that are not part of the configuration.
+	Unsupported []string `yaml:"-"`
+}
+
+// HealthcheckConfig holds the healthcheck of a service (schema 2.1 and above).
+type HealthcheckConfig struct {
+	Test        Stringorslice `yaml:"test,omitempty"`
+	Interval    string        `yaml:"interval,omitempty"`
+	Timeout     string        `yaml:"timeout,omitempty"`
+	Retries     int           `yaml:"retries,omitempty"`
+	StartPeriod string        `yaml:"start_period,omitempty"`
+	Disable     bool          `yaml:"disable,omitempty"`
+}
+
+// DeployConfig holds the deployment of a service to a swarm (schema 3 and above).
+type DeployConfig struct {
+	Mode           string                 `yaml:"mode,omitempty"`
+	Replicas       *int                   `yaml:"replicas,omitempty"`
+	Labels         SliceorMap             `yaml:"labels,omitempty"`
+	Resources      DeployResources        `yaml:"resources,omitempty"`
+	RestartPolicy  map[string]interface{} `yaml:"restart_policy,omitempty"`
+	UpdateConfig   map[string]interface{} `yaml:"update_config,omitempty"`
+	RollbackConfig map[string]interface{} `yaml:"rollback_config,omitempty"`
+	Placement      map[string]interface{} `yaml:"placement,omitempty"`
+	EndpointMode   string                 `yaml:"endpoint_mode,omitempty"`
+}
+
+// DeployResources holds the resource constraints of a deployed service.
+type DeployResources struct {
+	Limits       ResourceConfig `yaml:"limits,omitempty"`
+	Reservations ResourceConfig `yaml:"reservations,omitempty"`
+}
+
+// ResourceConfig holds an amount of CPU, as a decimal number of CPUs, and of
+// memory, as a byte value with an optional unit.
+type ResourceConfig struct {
+	CPUs   string `yaml:"cpus,omitempty"`
+	Memory string `yaml:"memory,omitempty"`
+}
+
+// ServiceFileReference references a secret or a config from a service.
+type ServiceFileReference struct {
+	Source string `yaml:"source"`
+	Target string `yaml:"target,omitempty"`
+	UID    string `yaml:"uid,omitempty"`
+	GID    string `yaml:"gid,omitempty"`
+	Mode   *int   `yaml:"mode,omitempty"`
+}
+
+// VolumeConfig holds a named volume of the project.
+type VolumeConfig struct {
+	Driver     string            `yaml:"driver,omitempty"`
+	DriverOpts map[string]string `yaml:"driver_opts,omitempty"`
+	External   External          `yaml:"external,omitempty"`
+	Labels     SliceorMap        `yaml:"labels,omitempty"`
+	Name       string            `yaml:"name,omitempty"`
+}
+
+// FileObjectConfig holds a secret or a config of the project (schema 3.1 and above).
+type FileObjectConfig struct {
+	File     string     `yaml:"file,omitempty"`
+	External External   `yaml:"external,omitempty"`
+	Labels   SliceorMap `yaml:"labels,omitempty"`
+	Name     string     `yaml:"name,omitempty"`
+}
+
+// External is set for the volumes, secrets, and configs created outside of the project.
+type External struct {
+	External bool
+	Name     string
 }
 
 // EnvironmentLookup defines methods to provides environment variable loading.
@@ -248,6 +326,9 @@ type ResourceLookup interface {
 type Project struct {
 	Name           string
 	Configs        map[string]*ServiceConfig
+	Volumes        map[string]*VolumeConfig
+	Secrets        map[string]*FileObjectConfig
+	ConfigObjects  map[string]*FileObjectConfig
 	Files          []string
 	ReloadCallback func() error
 	context        *Context
@@ -438,3 +438,55 @@ func (s *MaporSpaceSlice) Slice() []string {
 func NewMaporSpaceSlice(parts []string) MaporSpaceSlice {
 	return MaporSpaceSlice{parts}
 }
+
+// MarshalYAML implements the Marshaller interface.
+func (r ServiceFileReference) MarshalYAML() (value interface{}, err error) {
+	type plain ServiceFileReference
+	return plain(r), nil
+}
+
+// UnmarshalYAML implements the Unmarshaller interface. A reference is either
+// the name of the secret or config, or a map with the source and the options.
+func (r *ServiceFileReference) UnmarshalYAML(unmarshal func(value interface{}) error) error {
+	var source string
+	if err := unmarshal(&source); err == nil {
+		*r = ServiceFileReference{Source: source}
+		return nil
+	}
+	type plain ServiceFileReference
+	var value plain
+	if err := unmarshal(&value); err != nil {
+		return fmt.Errorf("Failed to unmarshal a secret or config reference: %v", err)
+	}
+	if len(value.Source) == 0 {
+		return fmt.Errorf("Secret and config references must have a source")
+	}
+	*r = ServiceFileReference(value)
+	return nil
+}
+
+// MarshalYAML implements the Marshaller interface.
+func (e External) MarshalYAML() (value interface{}, err error) {
+	if len(e.Name) > 0 {
+		return map[string]string{"name": e.Name}, nil
+	}
+	return e.External, nil
+}
+
+// UnmarshalYAML implements the Unmarshaller interface. External is either a
+// boolean, or a map with the name of the external object.
+func (e *External) UnmarshalYAML(unmarshal func(value interface{}) error) error {
+	var external bool
+	if err := unmarshal(&external); err == nil {
+		*e = External{External: external}
+		return nil
+	}
+	var value struct {
+		Name string `yaml:"name"`
+	}
+	if err := unmarshal(&value); err != nil {
+		return fmt.Errorf("Failed to unmarshal external: %v", err)
+	}
+	*e = External{External: true, Name: value.Name}
+	return nil
+}